@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	auditDirPerm  = 0o750
+	auditFilePerm = 0o600
+)
+
+// FileSink appends events as JSON lines and rotates the file once it exceeds
+// the configured size, keeping path.1 … path.N as backups. Without a size or
+// backup limit the file grows unbounded rather than losing records.
+type FileSink struct {
+	file       *os.File
+	path       string
+	size       int64
+	maxSize    int64
+	maxBackups int
+	mu         sync.Mutex
+}
+
+func NewFileSink(cfg config.AuditFileConfig) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), auditDirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	s := &FileSink{
+		path:       cfg.Path,
+		maxSize:    cfg.MaxSize,
+		maxBackups: cfg.MaxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Write(_ context.Context, event *entity.AuditEvent) error {
+	line, err := marshalEvent(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.maxSize > 0 && s.maxBackups > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, auditFilePerm)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// rotate shifts path.N-1 → path.N, …, path → path.1 and reopens path.
+// The oldest backup beyond maxBackups is dropped.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file: %w", err)
+	}
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		err := os.Rename(s.backup(i), s.backup(i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to rotate audit file: %w", err)
+		}
+	}
+	if err := os.Rename(s.path, s.backup(1)); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+	return s.open()
+}
+
+func (s *FileSink) backup(n int) string {
+	return fmt.Sprintf("%s.%d", s.path, n)
+}
@@ -0,0 +1,68 @@
+// Package audit contains the external destinations the audit log is fanned out
+// to in addition to Postgres.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+)
+
+// Sink receives sealed audit events. Write must be safe for concurrent use.
+type Sink interface {
+	Name() string
+	Write(ctx context.Context, event *entity.AuditEvent) error
+	Close() error
+}
+
+// NewSinks builds the sinks enabled in cfg. A sink is enabled by setting its
+// path, address or URL.
+func NewSinks(cfg config.AuditConfig) ([]Sink, error) {
+	var sinks []Sink
+	if cfg.File.Path != "" {
+		sink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, errors.Join(err, CloseAll(sinks))
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Syslog.Address != "" {
+		sink, err := NewSyslogSink(cfg.Syslog)
+		if err != nil {
+			return nil, errors.Join(err, CloseAll(sinks))
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.Webhook))
+	}
+	return sinks, nil
+}
+
+// CloseAll closes every sink and joins the errors.
+func CloseAll(sinks []Sink) error {
+	var errs []error
+	for _, s := range sinks {
+		if err := s.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// marshalEvent encodes an event for external sinks. Unlike the hashed form it
+// carries the record id and hash so copies can be matched against Postgres.
+func marshalEvent(event *entity.AuditEvent) ([]byte, error) {
+	raw, err := json.Marshal(struct {
+		*entity.AuditEvent
+		Hash string `json:"hash"`
+		ID   int64  `json:"id,omitempty"`
+	}{event, event.Hash, event.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit event: %w", err)
+	}
+	return raw, nil
+}
@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"encoding/json"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent() *entity.AuditEvent {
+	return &entity.AuditEvent{
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		UserID:    7,
+		Method:    "/keeper.go.grpc.v1.DataService/GetSecret",
+		Result:    "OK",
+		RequestID: `req"]1`,
+		Hash:      "abc",
+		ID:        1,
+	}
+}
+
+func TestFileSink_Rotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	line, err := marshalEvent(testEvent())
+	require.NoError(t, err)
+
+	sink, err := NewFileSink(config.AuditFileConfig{
+		Path:       path,
+		MaxSize:    int64(len(line)+1) * 2,
+		MaxBackups: 2,
+	})
+	require.NoError(t, err)
+
+	for range 7 {
+		require.NoError(t, sink.Write(t.Context(), testEvent()))
+	}
+	require.NoError(t, sink.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(current), "\n"))
+
+	backup, err := os.ReadFile(path + ".2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(backup), "\n"))
+
+	_, err = os.Stat(path + ".3")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(string(current))), &decoded))
+	assert.Equal(t, "abc", decoded["hash"])
+}
+
+func TestSyslogSink_RFC5424(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink, err := NewSyslogSink(config.AuditSyslogConfig{
+		Network: "udp",
+		Address: conn.LocalAddr().String(),
+		AppName: "keeper",
+	})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(t.Context(), testEvent()))
+
+	buf := make([]byte, 4096)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg := string(buf[:n])
+
+	assert.True(t, strings.HasPrefix(msg, "<110>1 2025-01-02T03:04:05Z "), msg)
+	assert.Contains(t, msg, " keeper ")
+	assert.Contains(t, msg, `[keeper@32473 user="7"`)
+	assert.Contains(t, msg, `request_id="req\"\]1"]`)
+	assert.Contains(t, msg, `"hash":"abc"`)
+}
+
+func TestSyslogSink_UnsupportedNetwork(t *testing.T) {
+	_, err := NewSyslogSink(config.AuditSyslogConfig{Network: "tcp", Address: "localhost:514"})
+	assert.Error(t, err)
+}
+
+func TestWebhookSink_Retry(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(config.AuditWebhookConfig{
+		URL:            srv.URL,
+		Timeout:        time.Second,
+		InitialBackoff: time.Millisecond,
+		MaxRetries:     3,
+	})
+
+	require.NoError(t, sink.Write(t.Context(), testEvent()))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestWebhookSink_NoRetryOnClientError(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(config.AuditWebhookConfig{
+		URL:            srv.URL,
+		Timeout:        time.Second,
+		InitialBackoff: time.Millisecond,
+		MaxRetries:     3,
+	})
+
+	assert.Error(t, sink.Write(t.Context(), testEvent()))
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// syslogPriority is facility 13 (log audit) with severity 6 (informational).
+	syslogPriority = 13*8 + 6
+	syslogVersion  = 1
+	syslogMsgID    = "audit"
+	// syslogSDID is the structured data id, 32473 is the IANA example PEN.
+	syslogSDID = "keeper@32473"
+	nilValue   = "-"
+)
+
+// SyslogSink sends RFC 5424 messages over a unix datagram or UDP socket, the
+// JSON event is the message body.
+type SyslogSink struct {
+	conn     net.Conn
+	network  string
+	address  string
+	hostname string
+	appName  string
+	mu       sync.Mutex
+}
+
+func NewSyslogSink(cfg config.AuditSyslogConfig) (*SyslogSink, error) {
+	if cfg.Network != "unixgram" && cfg.Network != "udp" {
+		return nil, fmt.Errorf("unsupported syslog network %q, expected unixgram or udp", cfg.Network)
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = nilValue
+	}
+	appName := cfg.AppName
+	if appName == "" {
+		appName = nilValue
+	}
+	s := &SyslogSink{
+		network:  cfg.Network,
+		address:  cfg.Address,
+		hostname: hostname,
+		appName:  appName,
+	}
+	if err := s.dial(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SyslogSink) Name() string {
+	return "syslog"
+}
+
+func (s *SyslogSink) Write(_ context.Context, event *entity.AuditEvent) error {
+	msg, err := s.format(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if err := s.dial(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.conn.Write(msg); err != nil {
+		// The syslog daemon may have restarted, reconnect once.
+		_ = s.conn.Close()
+		s.conn = nil
+		if err := s.dial(); err != nil {
+			return err
+		}
+		if _, err := s.conn.Write(msg); err != nil {
+			return fmt.Errorf("failed to write to syslog: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *SyslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	if err != nil {
+		return fmt.Errorf("failed to close syslog connection: %w", err)
+	}
+	return nil
+}
+
+func (s *SyslogSink) dial() error {
+	conn, err := net.Dial(s.network, s.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	s.conn = conn
+	return nil
+}
+
+// format renders
+// <PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID [SD-ELEMENT] MSG.
+func (s *SyslogSink) format(event *entity.AuditEvent) ([]byte, error) {
+	body, err := marshalEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "<%d>%d %s %s %s %d %s [%s",
+		syslogPriority,
+		syslogVersion,
+		event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		s.appName,
+		os.Getpid(),
+		syslogMsgID,
+		syslogSDID,
+	)
+	writeSDParam(&b, "user", strconv.FormatInt(event.UserID, 10))
+	writeSDParam(&b, "method", event.Method)
+	writeSDParam(&b, "result", event.Result)
+	writeSDParam(&b, "request_id", event.RequestID)
+	b.WriteString("] ")
+	b.Write(body)
+	return []byte(b.String()), nil
+}
+
+// writeSDParam escapes '"', '\' and ']' as RFC 5424 section 6.3.3 requires.
+func writeSDParam(b *strings.Builder, name, value string) {
+	value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+	fmt.Fprintf(b, ` %s="%s"`, name, value)
+}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"net/http"
+	"time"
+)
+
+// WebhookSink POSTs each event as JSON. Network errors and 5xx/429 responses
+// are retried with exponential backoff, other responses fail immediately.
+type WebhookSink struct {
+	client         *http.Client
+	url            string
+	initialBackoff time.Duration
+	maxRetries     int
+}
+
+func NewWebhookSink(cfg config.AuditWebhookConfig) *WebhookSink {
+	return &WebhookSink{
+		client:         &http.Client{Timeout: cfg.Timeout},
+		url:            cfg.URL,
+		initialBackoff: cfg.InitialBackoff,
+		maxRetries:     cfg.MaxRetries,
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Write(ctx context.Context, event *entity.AuditEvent) error {
+	body, err := marshalEvent(event)
+	if err != nil {
+		return err
+	}
+
+	backoff := s.initialBackoff
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= s.maxRetries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// post sends one attempt and reports whether a failure is worth retrying.
+func (s *WebhookSink) post(ctx context.Context, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("failed to call audit webhook: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return true, fmt.Errorf("audit webhook returned %s", resp.Status)
+	default:
+		return false, fmt.Errorf("audit webhook returned %s", resp.Status)
+	}
+}
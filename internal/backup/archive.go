@@ -0,0 +1,235 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"keeper/internal/security"
+	"slices"
+	"time"
+)
+
+// An archive is a tar stream, gzip-compressed and then encrypted with
+// security.NewStreamWriter. Table dumps come first in the order they must be
+// loaded, then file objects, and manifest.json closes the archive.
+const (
+	FormatVersion = 1
+
+	manifestName = "manifest.json"
+	tablesPrefix = "tables/"
+	objectPrefix = "objects/"
+	entryMode    = 0o600
+	// Table dumps are split into parts buffered in memory, so plaintext rows
+	// never touch the disk of the machine taking the backup.
+	tablePartSize = 8 << 20
+)
+
+var (
+	ErrChecksum = errors.New("backup entry does not match its checksum")
+	ErrManifest = errors.New("invalid backup manifest")
+)
+
+// Manifest describes one archive. An incremental archive lists every object
+// the database refers to, but carries only those that are new since its base;
+// the rest name the earlier archive that holds them.
+type Manifest struct {
+	Format        int       `json:"format"`
+	ID            string    `json:"id"`
+	Base          string    `json:"base,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	SchemaVersion uint      `json:"schema_version"`
+	Tables        []Table   `json:"tables"`
+	Objects       []Object  `json:"objects"`
+	// Missing lists objects the database referred to that were gone from
+	// the file store when the backup ran.
+	Missing []string `json:"missing,omitempty"`
+}
+
+// Table is a COPY text dump of one table. Concatenated in order its parts
+// are the input of COPY FROM.
+type Table struct {
+	Name  string `json:"name"`
+	Rows  int64  `json:"rows"`
+	Parts []Part `json:"parts"`
+}
+
+type Part struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+type Object struct {
+	Name    string `json:"name"`
+	ETag    string `json:"etag"`
+	Size    int64  `json:"size"`
+	SHA256  string `json:"sha256"`
+	Archive string `json:"archive"`
+}
+
+// Archives returns the IDs of the archives a restore of m needs, m's own first.
+func (m *Manifest) Archives() []string {
+	ids := []string{m.ID}
+	for _, o := range m.Objects {
+		if !slices.Contains(ids, o.Archive) {
+			ids = append(ids, o.Archive)
+		}
+	}
+	return ids
+}
+
+func (m *Manifest) validate() error {
+	if m.Format != FormatVersion {
+		return fmt.Errorf("%w: unsupported format %d", ErrManifest, m.Format)
+	}
+	if m.ID == "" {
+		return fmt.Errorf("%w: missing id", ErrManifest)
+	}
+	for _, o := range m.Objects {
+		if o.Name == "" || o.Archive == "" {
+			return fmt.Errorf("%w: object without a name or an archive", ErrManifest)
+		}
+	}
+	return nil
+}
+
+func newArchiveID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate archive id: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+func tableEntry(name string, part int) string {
+	return fmt.Sprintf("%s%s/%05d.copy", tablesPrefix, name, part)
+}
+
+func objectEntry(name string) string {
+	return objectPrefix + name
+}
+
+type archiveWriter struct {
+	enc io.WriteCloser
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	now time.Time
+}
+
+func newArchiveWriter(w io.Writer, key []byte, now time.Time) (*archiveWriter, error) {
+	enc, err := security.NewStreamWriter(w, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start encryption: %w", err)
+	}
+	gz := gzip.NewWriter(enc)
+	return &archiveWriter{enc: enc, gz: gz, tw: tar.NewWriter(gz), now: now}, nil
+}
+
+// add copies exactly size bytes from r into the entry and returns their
+// SHA-256.
+func (a *archiveWriter) add(name string, size int64, r io.Reader) (string, error) {
+	err := a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     entryMode,
+		ModTime:  a.now,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to write %s header: %w", name, err)
+	}
+	sum := sha256.New()
+	n, err := io.Copy(io.MultiWriter(a.tw, sum), io.LimitReader(r, size))
+	if err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if n != size {
+		return "", fmt.Errorf("failed to write %s: got %d of %d bytes", name, n, size)
+	}
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+func (a *archiveWriter) finish(m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if _, err := a.add(manifestName, int64(len(data)), bytes.NewReader(data)); err != nil {
+		return err
+	}
+	if err := a.tw.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	if err := a.gz.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	if err := a.enc.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	return nil
+}
+
+type archiveReader struct {
+	gz *gzip.Reader
+	tr *tar.Reader
+}
+
+func newArchiveReader(r io.Reader, key []byte) (*archiveReader, error) {
+	dec, err := security.NewStreamReader(r, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	gz, err := gzip.NewReader(dec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	return &archiveReader{gz: gz, tr: tar.NewReader(gz)}, nil
+}
+
+// next returns the next entry, or io.EOF once the tar stream ends and the rest
+// of the encrypted stream has been authenticated.
+func (a *archiveReader) next() (*tar.Header, error) {
+	hdr, err := a.tr.Next()
+	if errors.Is(err, io.EOF) {
+		if _, err := io.Copy(io.Discard, a.gz); err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	if hdr.Typeflag != tar.TypeReg {
+		return nil, fmt.Errorf("%w: unexpected entry %s", ErrManifest, hdr.Name)
+	}
+	return hdr, nil
+}
+
+// hashingReader checks the bytes read through it against a checksum once the
+// underlying reader is exhausted.
+type hashingReader struct {
+	r    io.Reader
+	sum  hash.Hash
+	want string
+	name string
+}
+
+func newHashingReader(r io.Reader, name, want string) *hashingReader {
+	return &hashingReader{r: r, sum: sha256.New(), want: want, name: name}
+}
+
+func (h *hashingReader) Read(p []byte) (int, error) {
+	n, err := h.r.Read(p)
+	h.sum.Write(p[:n])
+	if errors.Is(err, io.EOF) && hex.EncodeToString(h.sum.Sum(nil)) != h.want {
+		return n, fmt.Errorf("%w: %s", ErrChecksum, h.name)
+	}
+	return n, err
+}
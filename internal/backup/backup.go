@@ -0,0 +1,197 @@
+// Package backup writes and replays encrypted archives of the whole server:
+// every table of the database and the file objects its rows refer to.
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+	ErrNotEmpty       = errors.New("restore target is not empty")
+	ErrSchemaVersion  = errors.New("database schema version does not match the backup")
+)
+
+// Database is where a backup reads tables from and a restore loads them into.
+type Database interface {
+	// Snapshot calls fn with a view of every table as of one moment.
+	Snapshot(ctx context.Context, fn func(Snapshot) error) error
+	// Load calls fn in one transaction after checking every table is empty,
+	// and commits only if fn succeeds.
+	Load(ctx context.Context, fn func(Loader) error) error
+}
+
+type Snapshot interface {
+	SchemaVersion() uint
+	// Tables lists the tables in an order they can be loaded in.
+	Tables() []string
+	CopyOut(ctx context.Context, table string, w io.Writer) (int64, error)
+	// FileObjects lists the file store objects that rows refer to.
+	FileObjects(ctx context.Context) ([]string, error)
+}
+
+type Loader interface {
+	SchemaVersion() uint
+	CopyIn(ctx context.Context, table string, r io.Reader) (int64, error)
+}
+
+type ObjectInfo struct {
+	ETag string
+	Size int64
+}
+
+// ObjectStore is the bucket holding file objects. Stat returns
+// ErrObjectNotFound for names that do not exist.
+type ObjectStore interface {
+	Stat(ctx context.Context, name string) (ObjectInfo, error)
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	Put(ctx context.Context, name string, r io.Reader, size int64) error
+	Empty(ctx context.Context) (bool, error)
+}
+
+// Create writes an archive of db and the objects its rows refer to, encrypted
+// with key. Given the manifest of a previous archive it makes an incremental
+// one: tables are still dumped in full, but objects unchanged since base are
+// only listed, pointing at the archive that holds them.
+func Create(
+	ctx context.Context, db Database, objects ObjectStore, w io.Writer, key []byte, base *Manifest,
+) (*Manifest, error) {
+	id, err := newArchiveID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	m := &Manifest{
+		Format:    FormatVersion,
+		ID:        id,
+		CreatedAt: now,
+		Tables:    []Table{},
+		Objects:   []Object{},
+	}
+	previous := map[string]Object{}
+	if base != nil {
+		m.Base = base.ID
+		for _, o := range base.Objects {
+			previous[o.Name] = o
+		}
+	}
+
+	aw, err := newArchiveWriter(w, key, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	err = db.Snapshot(ctx, func(s Snapshot) error {
+		m.SchemaVersion = s.SchemaVersion()
+		for _, name := range s.Tables() {
+			table, err := dumpTable(ctx, s, aw, name)
+			if err != nil {
+				return err
+			}
+			m.Tables = append(m.Tables, table)
+		}
+		names, err = s.FileObjects(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list file objects: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump the database: %w", err)
+	}
+
+	for _, name := range names {
+		info, err := objects.Stat(ctx, name)
+		if errors.Is(err, ErrObjectNotFound) {
+			m.Missing = append(m.Missing, name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat object %s: %w", name, err)
+		}
+		if prev, ok := previous[name]; ok && info.ETag != "" && prev.ETag == info.ETag && prev.Size == info.Size {
+			m.Objects = append(m.Objects, prev)
+			continue
+		}
+		sum, err := copyObject(ctx, objects, aw, name, info.Size)
+		if err != nil {
+			return nil, err
+		}
+		m.Objects = append(m.Objects, Object{
+			Name:    name,
+			ETag:    info.ETag,
+			Size:    info.Size,
+			SHA256:  sum,
+			Archive: m.ID,
+		})
+	}
+
+	if err := aw.finish(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func dumpTable(ctx context.Context, s Snapshot, aw *archiveWriter, name string) (Table, error) {
+	tw := &tableWriter{aw: aw, table: name}
+	rows, err := s.CopyOut(ctx, name, tw)
+	if err != nil {
+		return Table{}, fmt.Errorf("failed to copy table %s: %w", name, err)
+	}
+	if tw.buf.Len() > 0 || len(tw.parts) == 0 {
+		if err := tw.flush(); err != nil {
+			return Table{}, err
+		}
+	}
+	return Table{Name: name, Rows: rows, Parts: tw.parts}, nil
+}
+
+// tableWriter cuts a table dump into archive entries of tablePartSize bytes.
+type tableWriter struct {
+	aw    *archiveWriter
+	table string
+	buf   bytes.Buffer
+	parts []Part
+}
+
+func (t *tableWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		k := min(len(p), tablePartSize-t.buf.Len())
+		t.buf.Write(p[:k])
+		p = p[k:]
+		n += k
+		if t.buf.Len() == tablePartSize {
+			if err := t.flush(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (t *tableWriter) flush() error {
+	size := int64(t.buf.Len())
+	sum, err := t.aw.add(tableEntry(t.table, len(t.parts)), size, &t.buf)
+	if err != nil {
+		return err
+	}
+	t.parts = append(t.parts, Part{Size: size, SHA256: sum})
+	t.buf.Reset()
+	return nil
+}
+
+func copyObject(ctx context.Context, objects ObjectStore, aw *archiveWriter, name string, size int64) (string, error) {
+	r, err := objects.Get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get object %s: %w", name, err)
+	}
+	defer func() { _ = r.Close() }()
+	return aw.add(objectEntry(name), size, r)
+}
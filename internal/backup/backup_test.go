@@ -0,0 +1,228 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+type memDB struct {
+	version uint
+	order   []string
+	tables  map[string][]byte
+}
+
+func (d *memDB) Snapshot(_ context.Context, fn func(Snapshot) error) error {
+	return fn(&memTx{db: d, tables: d.tables})
+}
+
+func (d *memDB) Load(_ context.Context, fn func(Loader) error) error {
+	for _, data := range d.tables {
+		if len(data) > 0 {
+			return ErrNotEmpty
+		}
+	}
+	staged := &memTx{db: d, tables: maps.Clone(d.tables)}
+	if err := fn(staged); err != nil {
+		return err
+	}
+	d.tables = staged.tables
+	return nil
+}
+
+type memTx struct {
+	db     *memDB
+	tables map[string][]byte
+}
+
+func (t *memTx) SchemaVersion() uint { return t.db.version }
+func (t *memTx) Tables() []string    { return t.db.order }
+
+func (t *memTx) CopyOut(_ context.Context, table string, w io.Writer) (int64, error) {
+	n, err := w.Write(t.tables[table])
+	return int64(bytes.Count(t.tables[table][:n], []byte("\n"))), err
+}
+
+func (t *memTx) CopyIn(_ context.Context, table string, r io.Reader) (int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	t.tables[table] = data
+	return int64(bytes.Count(data, []byte("\n"))), nil
+}
+
+func (t *memTx) FileObjects(context.Context) ([]string, error) {
+	var names []string
+	for _, line := range strings.Split(string(t.tables["secret_versions"]), "\n") {
+		if fields := strings.Split(line, "\t"); len(fields) == 2 && fields[1] != `\N` {
+			names = append(names, fields[1])
+		}
+	}
+	return names, nil
+}
+
+type memObjects map[string][]byte
+
+func (m memObjects) Stat(_ context.Context, name string) (ObjectInfo, error) {
+	data, ok := m[name]
+	if !ok {
+		return ObjectInfo{}, ErrObjectNotFound
+	}
+	return ObjectInfo{ETag: string(data[:4]), Size: int64(len(data))}, nil
+}
+
+func (m memObjects) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(m[name])), nil
+}
+
+func (m memObjects) Put(_ context.Context, name string, r io.Reader, size int64) error {
+	data, err := io.ReadAll(io.LimitReader(r, size))
+	m[name] = data
+	return err
+}
+
+func (m memObjects) Empty(context.Context) (bool, error) {
+	return len(m) == 0, nil
+}
+
+func randomBytes(size int) []byte {
+	data := make([]byte, size)
+	_, _ = rand.Read(data)
+	return data
+}
+
+func sourceDB() *memDB {
+	return &memDB{
+		version: 21,
+		order:   []string{"users", "secret_versions", "audit_log"},
+		tables: map[string][]byte{
+			"users":           []byte("1\talice\n2\tbob\n"),
+			"secret_versions": []byte("1\ta.bin\n2\t\\N\n3\tb.bin\n4\tgone.bin\n"),
+			"audit_log":       {},
+		},
+	}
+}
+
+func emptyDB() *memDB {
+	return &memDB{version: 21, order: []string{"users", "secret_versions", "audit_log"}, tables: map[string][]byte{}}
+}
+
+func archive(t *testing.T, db *memDB, objects memObjects, base *Manifest) (Source, *Manifest) {
+	t.Helper()
+	var buf bytes.Buffer
+	m, err := Create(t.Context(), db, objects, &buf, testKey, base)
+	require.NoError(t, err)
+	data := buf.Bytes()
+	return Source{
+		Name: m.ID,
+		Open: func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil },
+	}, m
+}
+
+func TestBackup_RoundTrip(t *testing.T) {
+	db := sourceDB()
+	db.tables["audit_log"] = bytes.Repeat([]byte("1\tx\n"), tablePartSize/4+10)
+	objects := memObjects{"a.bin": randomBytes(1000), "b.bin": randomBytes(70000)}
+
+	src, m := archive(t, db, objects, nil)
+	assert.Equal(t, uint(21), m.SchemaVersion)
+	assert.Equal(t, []string{"gone.bin"}, m.Missing)
+	require.Len(t, m.Tables, 3)
+	assert.Equal(t, int64(2), m.Tables[0].Rows)
+	assert.Len(t, m.Tables[2].Parts, 2)
+	require.Len(t, m.Objects, 2)
+
+	plan, err := Verify(testKey, src)
+	require.NoError(t, err)
+	assert.Equal(t, m.ID, plan.Manifest.ID)
+
+	target, restored := emptyDB(), memObjects{}
+	require.NoError(t, plan.Restore(t.Context(), target, restored))
+	assert.Equal(t, db.tables, target.tables)
+	assert.Equal(t, objects, restored)
+
+	// Restoring twice hits the data the first restore left behind.
+	assert.ErrorIs(t, plan.Restore(t.Context(), target, memObjects{}), ErrNotEmpty)
+	assert.ErrorIs(t, plan.Restore(t.Context(), emptyDB(), restored), ErrNotEmpty)
+
+	other := emptyDB()
+	other.version = 20
+	assert.ErrorIs(t, plan.Restore(t.Context(), other, memObjects{}), ErrSchemaVersion)
+}
+
+func TestBackup_Incremental(t *testing.T) {
+	db := sourceDB()
+	objects := memObjects{"a.bin": randomBytes(100), "b.bin": randomBytes(100)}
+	fullSrc, full := archive(t, db, objects, nil)
+
+	objects["b.bin"] = randomBytes(100)
+	objects["c.bin"] = randomBytes(100)
+	db.tables["secret_versions"] = append(db.tables["secret_versions"], "5\tc.bin\n"...)
+	incrSrc, incr := archive(t, db, objects, full)
+
+	assert.Equal(t, full.ID, incr.Base)
+	holders := map[string]string{}
+	for _, o := range incr.Objects {
+		holders[o.Name] = o.Archive
+	}
+	assert.Equal(t, map[string]string{"a.bin": full.ID, "b.bin": incr.ID, "c.bin": incr.ID}, holders)
+	assert.Equal(t, []string{incr.ID, full.ID}, incr.Archives())
+
+	_, err := Verify(testKey, incrSrc)
+	assert.ErrorIs(t, err, ErrManifest, "the base archive is needed for a.bin")
+
+	plan, err := Verify(testKey, fullSrc, incrSrc)
+	require.NoError(t, err)
+	target, restored := emptyDB(), memObjects{}
+	require.NoError(t, plan.Restore(t.Context(), target, restored))
+	assert.Equal(t, db.tables, target.tables)
+	assert.Equal(t, objects, restored)
+}
+
+func TestBackup_VerifyRejectsDamage(t *testing.T) {
+	src, _ := archive(t, sourceDB(), memObjects{"a.bin": randomBytes(100), "b.bin": randomBytes(100)}, nil)
+	r, err := src.Open()
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	_, err = Verify([]byte("another key"), src)
+	require.Error(t, err)
+
+	for _, damaged := range [][]byte{
+		data[:len(data)-1],
+		append(bytes.Clone(data[:100]), append([]byte{data[100] ^ 1}, data[101:]...)...),
+	} {
+		_, err := Verify(testKey, Source{
+			Name: "damaged",
+			Open: func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(damaged)), nil },
+		})
+		assert.Error(t, err)
+	}
+}
+
+func TestLoadOrder(t *testing.T) {
+	tables := []string{"audit_log", "secret_versions", "secrets", "teams", "users"}
+	refs := map[string][]string{
+		"secrets":         {"users", "teams"},
+		"secret_versions": {"secrets"},
+		"teams":           {"users", "teams"},
+		"audit_log":       {"partitioned_elsewhere"},
+	}
+	assert.Equal(t,
+		[]string{"audit_log", "users", "teams", "secrets", "secret_versions"},
+		loadOrder(tables, refs))
+
+	cycle := map[string][]string{"a": {"b"}, "b": {"a"}}
+	assert.Equal(t, []string{"c", "a", "b"}, loadOrder([]string{"a", "b", "c"}, cycle))
+}
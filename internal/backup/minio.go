@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// MinIO reads and writes file objects in the server's bucket. The bucket
+// must exist; a restore does not create it.
+type MinIO struct {
+	Client     *minio.Client
+	BucketName string
+}
+
+func NewMinIO(client *minio.Client, bucketName string) *MinIO {
+	return &MinIO{Client: client, BucketName: bucketName}
+}
+
+func (m *MinIO) Stat(ctx context.Context, name string) (ObjectInfo, error) {
+	info, err := m.Client.StatObject(ctx, m.BucketName, name, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return ObjectInfo{}, ErrObjectNotFound
+		}
+		return ObjectInfo{}, fmt.Errorf("stat object: %w", err)
+	}
+	return ObjectInfo{ETag: info.ETag, Size: info.Size}, nil
+}
+
+func (m *MinIO) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	obj, err := m.Client.GetObject(ctx, m.BucketName, name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+	return obj, nil
+}
+
+func (m *MinIO) Put(ctx context.Context, name string, r io.Reader, size int64) error {
+	if _, err := m.Client.PutObject(ctx, m.BucketName, name, r, size, minio.PutObjectOptions{}); err != nil {
+		return fmt.Errorf("put object: %w", err)
+	}
+	return nil
+}
+
+func (m *MinIO) Empty(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for obj := range m.Client.ListObjects(ctx, m.BucketName, minio.ListObjectsOptions{Recursive: true, MaxKeys: 1}) {
+		if obj.Err != nil {
+			return false, fmt.Errorf("list objects: %w", obj.Err)
+		}
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,289 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	migrationsTable = "schema_migrations"
+
+	selectSchemaVersion = `SELECT version, dirty FROM schema_migrations LIMIT 1`
+	selectTables        = `
+		SELECT tablename FROM pg_tables
+		WHERE schemaname = 'public' AND tablename <> 'schema_migrations'
+		ORDER BY tablename`
+	selectForeignKeys = `
+		SELECT src.relname, dst.relname
+		FROM pg_constraint c
+		JOIN pg_class src ON src.oid = c.conrelid
+		JOIN pg_class dst ON dst.oid = c.confrelid
+		JOIN pg_namespace n ON n.oid = src.relnamespace
+		WHERE c.contype = 'f' AND n.nspname = 'public'`
+	selectSequenceColumns = `
+		SELECT table_name, column_name FROM information_schema.columns
+		WHERE table_schema = 'public' AND table_name <> 'schema_migrations'
+		AND (is_identity = 'YES' OR column_default LIKE 'nextval(%')`
+	selectFileObjects = `
+		SELECT DISTINCT file_path FROM secret_versions
+		WHERE file_path IS NOT NULL AND file_path <> ''
+		ORDER BY file_path`
+)
+
+// Postgres backs up the public schema of the server database. The
+// golang-migrate bookkeeping table is not dumped; its version is recorded in
+// the manifest instead.
+type Postgres struct {
+	Pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{Pool: pool}
+}
+
+func (p *Postgres) Snapshot(ctx context.Context, fn func(Snapshot) error) error {
+	tx, err := p.Pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("failed to begin snapshot: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pt, err := newPgTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if err := fn(pt); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to end snapshot: %w", err)
+	}
+	return nil
+}
+
+func (p *Postgres) Load(ctx context.Context, fn func(Loader) error) error {
+	tx, err := p.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pt, err := newPgTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, table := range pt.tables {
+		var exists bool
+		query := "SELECT EXISTS (SELECT 1 FROM " + quoteTable(table) + ")"
+		if err := tx.QueryRow(ctx, query).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check table %s: %w", table, err)
+		}
+		if exists {
+			return fmt.Errorf("%w: table %s has rows", ErrNotEmpty, table)
+		}
+	}
+	if err := fn(pt); err != nil {
+		return err
+	}
+	if err := resetSequences(ctx, tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// SchemaVersion returns the applied migration version, or 0 for a database
+// that has never been migrated.
+func (p *Postgres) SchemaVersion(ctx context.Context) (uint, error) {
+	var exists bool
+	err := p.Pool.QueryRow(ctx, "SELECT to_regclass('public.schema_migrations') IS NOT NULL").Scan(&exists)
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up %s: %w", migrationsTable, err)
+	}
+	if !exists {
+		return 0, nil
+	}
+	return schemaVersion(ctx, p.Pool)
+}
+
+type pgTx struct {
+	tx      pgx.Tx
+	version uint
+	tables  []string
+}
+
+func newPgTx(ctx context.Context, tx pgx.Tx) (*pgTx, error) {
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := listTables(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &pgTx{tx: tx, version: version, tables: tables}, nil
+}
+
+func (t *pgTx) SchemaVersion() uint {
+	return t.version
+}
+
+func (t *pgTx) Tables() []string {
+	return t.tables
+}
+
+func (t *pgTx) CopyOut(ctx context.Context, table string, w io.Writer) (int64, error) {
+	tag, err := t.tx.Conn().PgConn().CopyTo(ctx, w, "COPY "+quoteTable(table)+" TO STDOUT")
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy table %s: %w", table, err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (t *pgTx) CopyIn(ctx context.Context, table string, r io.Reader) (int64, error) {
+	if !slices.Contains(t.tables, table) {
+		return 0, fmt.Errorf("%w: no table %s in the database", ErrSchemaVersion, table)
+	}
+	tag, err := t.tx.Conn().PgConn().CopyFrom(ctx, r, "COPY "+quoteTable(table)+" FROM STDIN")
+	if err != nil {
+		return 0, fmt.Errorf("failed to load table %s: %w", table, err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (t *pgTx) FileObjects(ctx context.Context) ([]string, error) {
+	rows, err := t.tx.Query(ctx, selectFileObjects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list file objects: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file objects: %w", err)
+	}
+	return names, nil
+}
+
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func schemaVersion(ctx context.Context, q querier) (uint, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := q.QueryRow(ctx, selectSchemaVersion).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty, fix the failed migration first", version)
+	}
+	return uint(version), nil
+}
+
+func listTables(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	rows, err := tx.Query(ctx, selectTables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, selectForeignKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys: %w", err)
+	}
+	refs := map[string][]string{}
+	var from, to string
+	_, err = pgx.ForEachRow(rows, []any{&from, &to}, func() error {
+		refs[from] = append(refs[from], to)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys: %w", err)
+	}
+	return loadOrder(tables, refs), nil
+}
+
+// loadOrder sorts tables so that every table comes after the tables its
+// foreign keys point at. Tables caught in a cycle keep their original order
+// at the end; loading them fails unless their constraints are deferrable.
+func loadOrder(tables []string, refs map[string][]string) []string {
+	ordered := make([]string, 0, len(tables))
+	placed := map[string]bool{}
+	for len(ordered) < len(tables) {
+		progress := false
+		for _, table := range tables {
+			if placed[table] {
+				continue
+			}
+			ready := true
+			for _, ref := range refs[table] {
+				if ref != table && !placed[ref] && slices.Contains(tables, ref) {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				ordered = append(ordered, table)
+				placed[table] = true
+				progress = true
+			}
+		}
+		if !progress {
+			for _, table := range tables {
+				if !placed[table] {
+					ordered = append(ordered, table)
+				}
+			}
+			break
+		}
+	}
+	return ordered
+}
+
+// resetSequences moves identity and serial sequences past the restored rows,
+// since COPY writes the ids without advancing them.
+func resetSequences(ctx context.Context, tx pgx.Tx) error {
+	rows, err := tx.Query(ctx, selectSequenceColumns)
+	if err != nil {
+		return fmt.Errorf("failed to list sequences: %w", err)
+	}
+	type column struct{ table, name string }
+	var columns []column
+	var table, name string
+	_, err = pgx.ForEachRow(rows, []any{&table, &name}, func() error {
+		columns = append(columns, column{table, name})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list sequences: %w", err)
+	}
+
+	for _, c := range columns {
+		query := "SELECT setval(pg_get_serial_sequence($1, $2), COALESCE(MAX(" +
+			pgx.Identifier{c.name}.Sanitize() + "), 0) + 1, false) FROM " + quoteTable(c.table)
+		if _, err := tx.Exec(ctx, query, quoteTable(c.table), c.name); err != nil {
+			return fmt.Errorf("failed to reset sequence of %s.%s: %w", c.table, c.name, err)
+		}
+	}
+	return nil
+}
+
+func quoteTable(name string) string {
+	return pgx.Identifier{"public", name}.Sanitize()
+}
@@ -0,0 +1,311 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Source is an archive that can be read more than once: a restore verifies
+// every archive before it replays any of them.
+type Source struct {
+	Name string
+	Open func() (io.ReadCloser, error)
+}
+
+// FileSource reads an archive from path.
+func FileSource(path string) Source {
+	return Source{
+		Name: path,
+		Open: func() (io.ReadCloser, error) {
+			f, err := os.Open(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open archive: %w", err)
+			}
+			return f, nil
+		},
+	}
+}
+
+// ReadManifest reads a whole archive, checks every entry against the manifest
+// and returns the manifest.
+func ReadManifest(r io.Reader, key []byte) (*Manifest, error) {
+	ar, err := newArchiveReader(r, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[string]Part{}
+	var m *Manifest
+	for {
+		hdr, err := ar.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			return nil, fmt.Errorf("%w: entry %s after the manifest", ErrManifest, hdr.Name)
+		}
+		if hdr.Name == manifestName {
+			m = &Manifest{}
+			if err := json.NewDecoder(ar.tr).Decode(m); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrManifest, err)
+			}
+			continue
+		}
+		if _, ok := entries[hdr.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicate entry %s", ErrManifest, hdr.Name)
+		}
+		sum := sha256.New()
+		n, err := io.Copy(sum, ar.tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", hdr.Name, err)
+		}
+		entries[hdr.Name] = Part{Size: n, SHA256: hex.EncodeToString(sum.Sum(nil))}
+	}
+	if m == nil {
+		return nil, fmt.Errorf("%w: archive has no manifest", ErrManifest)
+	}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	check := func(name string, want Part) error {
+		got, ok := entries[name]
+		if !ok {
+			return fmt.Errorf("%w: %s is missing", ErrChecksum, name)
+		}
+		if got != want {
+			return fmt.Errorf("%w: %s", ErrChecksum, name)
+		}
+		delete(entries, name)
+		return nil
+	}
+	for _, t := range m.Tables {
+		for i, p := range t.Parts {
+			if err := check(tableEntry(t.Name, i), p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, o := range m.Objects {
+		if o.Archive != m.ID {
+			continue
+		}
+		if err := check(objectEntry(o.Name), Part{Size: o.Size, SHA256: o.SHA256}); err != nil {
+			return nil, err
+		}
+	}
+	if len(entries) > 0 {
+		return nil, fmt.Errorf("%w: %d entries are not in the manifest", ErrManifest, len(entries))
+	}
+	return m, nil
+}
+
+// Plan is a verified restore of the last archive given to Verify.
+type Plan struct {
+	Manifest *Manifest
+	key      []byte
+	sources  map[string]Source
+}
+
+// Verify checks every archive and that together they hold every object the
+// last one refers to. The last archive is the one to restore; the others are
+// the earlier archives of an incremental chain.
+func Verify(key []byte, archives ...Source) (*Plan, error) {
+	if len(archives) == 0 {
+		return nil, errors.New("no archives to restore")
+	}
+	plan := &Plan{key: key, sources: map[string]Source{}}
+	manifests := map[string]*Manifest{}
+	for _, src := range archives {
+		m, err := readSource(src, key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", src.Name, err)
+		}
+		if _, ok := manifests[m.ID]; ok {
+			return nil, fmt.Errorf("%s: archive %s given twice", src.Name, m.ID)
+		}
+		manifests[m.ID] = m
+		plan.sources[m.ID] = src
+		plan.Manifest = m
+	}
+
+	for _, o := range plan.Manifest.Objects {
+		holder, ok := manifests[o.Archive]
+		if !ok {
+			return nil, fmt.Errorf("%w: archive %s holding %s was not given", ErrManifest, o.Archive, o.Name)
+		}
+		found := false
+		for _, h := range holder.Objects {
+			if h.Name == o.Name && h.Archive == holder.ID {
+				found = h.SHA256 == o.SHA256 && h.Size == o.Size
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: archive %s does not hold %s", ErrManifest, o.Archive, o.Name)
+		}
+	}
+	return plan, nil
+}
+
+func readSource(src Source, key []byte) (*Manifest, error) {
+	r, err := src.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+	return ReadManifest(r, key)
+}
+
+// Restore loads the tables into db and uploads the objects. Both targets must
+// be empty and the database must be migrated to the archive's schema version.
+func (p *Plan) Restore(ctx context.Context, db Database, objects ObjectStore) error {
+	if len(p.Manifest.Objects) > 0 {
+		empty, err := objects.Empty(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check the file store: %w", err)
+		}
+		if !empty {
+			return fmt.Errorf("%w: the file store bucket has objects", ErrNotEmpty)
+		}
+	}
+
+	err := db.Load(ctx, func(l Loader) error {
+		if l.SchemaVersion() != p.Manifest.SchemaVersion {
+			return fmt.Errorf("%w: database is at %d, backup at %d",
+				ErrSchemaVersion, l.SchemaVersion(), p.Manifest.SchemaVersion)
+		}
+		return p.loadTables(ctx, l)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load the database: %w", err)
+	}
+
+	for _, id := range p.Manifest.Archives() {
+		if err := p.uploadObjects(ctx, objects, id); err != nil {
+			return fmt.Errorf("failed to restore file objects: %w", err)
+		}
+	}
+	return nil
+}
+
+func (p *Plan) loadTables(ctx context.Context, l Loader) error {
+	r, err := p.sources[p.Manifest.ID].Open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+	ar, err := newArchiveReader(r, p.key)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range p.Manifest.Tables {
+		rows, err := l.CopyIn(ctx, t.Name, &partsReader{ar: ar, table: t})
+		if err != nil {
+			return fmt.Errorf("failed to load table %s: %w", t.Name, err)
+		}
+		if rows != t.Rows {
+			return fmt.Errorf("table %s: loaded %d rows, backup has %d", t.Name, rows, t.Rows)
+		}
+	}
+	return nil
+}
+
+func (p *Plan) uploadObjects(ctx context.Context, objects ObjectStore, id string) error {
+	wanted := map[string]Object{}
+	for _, o := range p.Manifest.Objects {
+		if o.Archive == id {
+			wanted[objectEntry(o.Name)] = o
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	r, err := p.sources[id].Open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+	ar, err := newArchiveReader(r, p.key)
+	if err != nil {
+		return err
+	}
+	for len(wanted) > 0 {
+		hdr, err := ar.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		o, ok := wanted[hdr.Name]
+		if !ok {
+			continue
+		}
+		hr := newHashingReader(ar.tr, hdr.Name, o.SHA256)
+		if err := objects.Put(ctx, o.Name, hr, o.Size); err != nil {
+			return fmt.Errorf("failed to put object %s: %w", o.Name, err)
+		}
+		// Put may stop at the object's size without seeing EOF.
+		if _, err := io.Copy(io.Discard, hr); err != nil {
+			return err
+		}
+		delete(wanted, hdr.Name)
+	}
+	if len(wanted) > 0 {
+		return fmt.Errorf("%w: archive %s lost %d objects", ErrManifest, id, len(wanted))
+	}
+	return nil
+}
+
+// partsReader reads the parts of one table from consecutive archive entries,
+// checking each against its checksum.
+type partsReader struct {
+	ar    *archiveReader
+	table Table
+	part  int
+	cur   io.Reader
+}
+
+func (r *partsReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if r.part == len(r.table.Parts) {
+				return 0, io.EOF
+			}
+			name := tableEntry(r.table.Name, r.part)
+			hdr, err := r.ar.next()
+			if errors.Is(err, io.EOF) {
+				return 0, fmt.Errorf("%w: %s is missing", ErrManifest, name)
+			}
+			if err != nil {
+				return 0, err
+			}
+			if hdr.Name != name {
+				return 0, fmt.Errorf("%w: expected %s, found %s", ErrManifest, name, hdr.Name)
+			}
+			r.cur = newHashingReader(r.ar.tr, name, r.table.Parts[r.part].SHA256)
+		}
+		n, err := r.cur.Read(p)
+		if errors.Is(err, io.EOF) {
+			r.cur = nil
+			r.part++
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
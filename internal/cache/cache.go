@@ -0,0 +1,400 @@
+// Package cache keeps an encrypted copy of the secrets the agent has read,
+// so they can still be read while the server is unreachable.
+//
+// Every user has a directory of their own under the cache root, holding a
+// random salt, a check value and one file per secret. The cache key is
+// derived from the user's cache passphrase and the salt with Argon2id, and
+// the encryption key and the key naming the files from it with HKDF-SHA256.
+// Nothing that decrypts the cache is stored, on disk or on the server, so a
+// copy of the directory is useless without the passphrase. File names are an
+// HMAC of the user, server, owner and path, which keeps paths out of
+// directory listings. Each file is AES-256-GCM over the JSON of an Entry; the
+// entry repeats its user, server, owner and path, so a file renamed onto
+// another name, or copied into another user's directory, is rejected.
+// keeper-agent sync keeps one more file per server, the Replica, sealed the
+// same way.
+package cache
+
+import (
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeper/internal/security"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	saltFile      = "salt"
+	checkFile     = "check"
+	legacyKeyFile = "key"
+	entrySuffix   = ".entry"
+	keySize       = 32
+	saltSize      = 16
+	checkValue    = "keeper-agent cache"
+
+	kdfMemory      = 64 * 1024
+	kdfIterations  = 3
+	kdfParallelism = 4
+
+	permissionDir  = 0o700
+	permissionFile = 0o600
+
+	infoEncryption = "keeper-agent cache encryption"
+	infoNames      = "keeper-agent cache names"
+)
+
+var (
+	// ErrMiss is returned by Get when there is no usable entry.
+	ErrMiss = errors.New("secret is not in the offline cache")
+	// ErrTooLarge is returned by Put for an entry larger than MaxSize.
+	ErrTooLarge = errors.New("secret is larger than the offline cache")
+	// ErrNoPassphrase is returned by Open without a passphrase.
+	ErrNoPassphrase = errors.New("the offline cache needs a passphrase")
+	// ErrPassphrase is returned by Open for a passphrase other than the one
+	// the cache was created with.
+	ErrPassphrase = errors.New("wrong offline cache passphrase")
+)
+
+// Entry is a secret as the server returned it, with the time it was read.
+type Entry struct {
+	CachedAt    time.Time `json:"cached_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
+	Server      string    `json:"server"`
+	Owner       string    `json:"owner,omitempty"`
+	Path        string    `json:"path"`
+	Description string    `json:"description,omitempty"`
+	FilePath    string    `json:"file_path,omitempty"`
+	Payload     []byte    `json:"payload"`
+	Version     int64     `json:"version"`
+	UserID      int64     `json:"user_id"`
+}
+
+// Age is how long ago the entry was read from the server.
+func (e *Entry) Age(now time.Time) time.Duration {
+	return now.Sub(e.CachedAt)
+}
+
+// Options limit the cache. Zero values mean no limit.
+type Options struct {
+	// TTL is how long an entry is served after it was read.
+	TTL time.Duration
+	// MaxSize caps the total size of the entry files in bytes; the entries
+	// cached longest ago are evicted first.
+	MaxSize int64
+}
+
+type Store struct {
+	now      func() time.Time
+	dir      string
+	key      []byte
+	namesKey []byte
+	opts     Options
+	userID   int64
+}
+
+// Open opens the cache of userID under root, creating the directory and the
+// salt on first use. Entries of other users are out of its reach. A
+// passphrase other than the one the cache was created with is rejected with
+// ErrPassphrase.
+func Open(root string, userID int64, passphrase string, opts Options) (*Store, error) {
+	if passphrase == "" {
+		return nil, ErrNoPassphrase
+	}
+	dir := userDir(root, userID)
+	if err := os.MkdirAll(dir, permissionDir); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	// Caches from before the passphrase kept their key in the clear.
+	if err := os.Remove(filepath.Join(dir, legacyKeyFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove old cache key: %w", err)
+	}
+	salt, err := loadSalt(filepath.Join(dir, saltFile))
+	if err != nil {
+		return nil, err
+	}
+	secret := argon2.IDKey([]byte(passphrase), salt, kdfIterations, kdfMemory, kdfParallelism, keySize)
+	key, err := hkdf.Key(sha256.New, secret, nil, infoEncryption, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive cache key: %w", err)
+	}
+	namesKey, err := hkdf.Key(sha256.New, secret, nil, infoNames, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive cache key: %w", err)
+	}
+	if err := checkKey(filepath.Join(dir, checkFile), key); err != nil {
+		return nil, err
+	}
+	return &Store{now: time.Now, dir: dir, key: key, namesKey: namesKey, opts: opts, userID: userID}, nil
+}
+
+func userDir(root string, userID int64) string {
+	return filepath.Join(root, strconv.FormatInt(userID, 10))
+}
+
+func loadSalt(path string) ([]byte, error) {
+	salt, err := os.ReadFile(path)
+	if err == nil {
+		if len(salt) != saltSize {
+			return nil, fmt.Errorf("cache salt %s is damaged, run keeper-agent cache clear", path)
+		}
+		return salt, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read cache salt: %w", err)
+	}
+
+	salt = make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate cache salt: %w", err)
+	}
+	// O_EXCL makes a concurrent agent that lost the race use the winner's salt.
+	if err := writeExclusive(path, salt); errors.Is(err, os.ErrExist) {
+		return loadSalt(path)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to create cache salt: %w", err)
+	}
+	return salt, nil
+}
+
+// checkKey tells a wrong passphrase apart from damaged entries: the check
+// file seals a known value with the key of the first passphrase used.
+func checkKey(path string, key []byte) error {
+	sealed, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		sealed, err = security.EncryptAESGCM([]byte(checkValue), key)
+		if err != nil {
+			return fmt.Errorf("failed to seal cache check: %w", err)
+		}
+		if err := writeExclusive(path, sealed); errors.Is(err, os.ErrExist) {
+			return checkKey(path, key)
+		} else if err != nil {
+			return fmt.Errorf("failed to create cache check: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read cache check: %w", err)
+	}
+	plain, err := security.DecryptAESGCM(key, sealed)
+	if err != nil || string(plain) != checkValue {
+		return ErrPassphrase
+	}
+	return nil
+}
+
+func writeExclusive(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, permissionFile)
+	if err != nil {
+		return fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+func (s *Store) name(server, owner, path string) string {
+	user := strconv.FormatInt(s.userID, 10)
+	id := security.HMACSHA256(s.namesKey, []byte(user+"\x00"+server+"\x00"+owner+"\x00"+path))
+	return filepath.Join(s.dir, hex.EncodeToString(id)+entrySuffix)
+}
+
+// Put stores e, replacing the entry of the same secret, and then evicts
+// expired entries and, over MaxSize, the ones cached longest ago.
+func (s *Store) Put(e *Entry) error {
+	e.UserID = s.userID
+	plain, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+	sealed, err := security.EncryptAESGCM(plain, s.key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt cache entry: %w", err)
+	}
+	if s.opts.MaxSize > 0 && int64(len(sealed)) > s.opts.MaxSize {
+		return ErrTooLarge
+	}
+
+	name := s.name(e.Server, e.Owner, e.Path)
+	partial := name + ".partial"
+	if err := os.WriteFile(partial, sealed, permissionFile); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(partial, name); err != nil {
+		_ = os.Remove(partial)
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return s.evict(name)
+}
+
+// Get returns the entry of a secret. An entry older than TTL is removed and
+// reported as a miss.
+func (s *Store) Get(server, owner, path string) (*Entry, error) {
+	name := s.name(server, owner, path)
+	e, err := s.read(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrMiss
+	}
+	if err != nil {
+		return nil, err
+	}
+	if e.UserID != s.userID || e.Server != server || e.Owner != owner || e.Path != path {
+		return nil, fmt.Errorf("cache entry %s does not belong to %s", filepath.Base(name), path)
+	}
+	if s.Expired(e) {
+		_ = os.Remove(name)
+		return nil, ErrMiss
+	}
+	return e, nil
+}
+
+// Remove drops the entry of a secret, if there is one.
+func (s *Store) Remove(server, owner, path string) error {
+	if err := os.Remove(s.name(server, owner, path)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove cache entry: %w", err)
+	}
+	return nil
+}
+
+// Status describes the cache for keeper-agent cache status.
+type Status struct {
+	Entries []*Entry
+	// Size is the total size of the entry files in bytes.
+	Size int64
+	// Unreadable counts entry files that can't be decrypted.
+	Unreadable int
+}
+
+// Status reads every entry, expired ones included, oldest first.
+func (s *Store) Status() (*Status, error) {
+	files, err := s.files()
+	if err != nil {
+		return nil, err
+	}
+	st := &Status{}
+	for _, f := range files {
+		st.Size += f.size
+		e, err := s.read(f.name)
+		if err != nil {
+			st.Unreadable++
+			continue
+		}
+		st.Entries = append(st.Entries, e)
+	}
+	slices.SortFunc(st.Entries, func(a, b *Entry) int { return a.CachedAt.Compare(b.CachedAt) })
+	return st, nil
+}
+
+// Expired reports whether e is older than the TTL.
+func (s *Store) Expired(e *Entry) bool {
+	return s.opts.TTL > 0 && e.Age(s.now()) > s.opts.TTL
+}
+
+func (s *Store) read(name string) (*Entry, error) {
+	sealed, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+	plain, err := security.DecryptAESGCM(s.key, sealed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt cache entry: %w", err)
+	}
+	var e Entry
+	if err := json.Unmarshal(plain, &e); err != nil {
+		return nil, fmt.Errorf("failed to decode cache entry: %w", err)
+	}
+	return &e, nil
+}
+
+type entryFile struct {
+	modTime time.Time
+	name    string
+	size    int64
+}
+
+func (s *Store) files() ([]entryFile, error) {
+	dirEntries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+	files := make([]entryFile, 0, len(dirEntries))
+	for _, d := range dirEntries {
+		if !strings.HasSuffix(d.Name(), entrySuffix) {
+			continue
+		}
+		info, err := d.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, entryFile{
+			modTime: info.ModTime(),
+			name:    filepath.Join(s.dir, d.Name()),
+			size:    info.Size(),
+		})
+	}
+	return files, nil
+}
+
+// evict removes expired entries and then, while the cache is over MaxSize,
+// the entries written longest ago. keep, the entry just written, stays. The
+// file time stands in for CachedAt so nothing has to be decrypted.
+func (s *Store) evict(keep string) error {
+	files, err := s.files()
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(files, func(a, b entryFile) int { return a.modTime.Compare(b.modTime) })
+
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+	now := s.now()
+	for _, f := range files {
+		if f.name == keep {
+			continue
+		}
+		stale := s.opts.TTL > 0 && now.Sub(f.modTime) > s.opts.TTL
+		if !stale && (s.opts.MaxSize <= 0 || total <= s.opts.MaxSize) {
+			continue
+		}
+		if err := os.Remove(f.name); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to evict cache entry: %w", err)
+		}
+		total -= f.size
+	}
+	return nil
+}
+
+// Clear removes the cache of every user with their keys, so entries copied
+// elsewhere can't be decrypted either.
+func Clear(root string) error {
+	if err := os.RemoveAll(root); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
+
+// ClearUser removes the cache of one user and leaves the others alone.
+func ClearUser(root string, userID int64) error {
+	if err := os.RemoveAll(userDir(root, userID)); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	server         = "keeper:8081"
+	testPassphrase = "correct horse"
+)
+
+func entry(path string, cachedAt time.Time, payload string) *Entry {
+	return &Entry{Server: server, Path: path, CachedAt: cachedAt, Version: 1, Payload: []byte(payload)}
+}
+
+func TestStore_PutGet(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, s.Put(entry("app/db", now, `{"password":"s3cret"}`)))
+
+	got, err := s.Get(server, "", "app/db")
+	require.NoError(t, err)
+	assert.Equal(t, `{"password":"s3cret"}`, string(got.Payload))
+	assert.True(t, got.CachedAt.Equal(now))
+
+	_, err = s.Get(server, "alice", "app/db")
+	require.ErrorIs(t, err, ErrMiss)
+	_, err = s.Get("other:8081", "", "app/db")
+	require.ErrorIs(t, err, ErrMiss)
+
+	// Neither paths nor values are stored in the clear.
+	files, err := os.ReadDir(s.dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3, "salt, check and the entry")
+	for _, f := range files {
+		assert.NotContains(t, f.Name(), "app")
+		data, err := os.ReadFile(filepath.Join(s.dir, f.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "s3cret")
+	}
+
+	// A second store with the same passphrase derives the same key.
+	reopened, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	_, err = reopened.Get(server, "", "app/db")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Remove(server, "", "app/db"))
+	_, err = s.Get(server, "", "app/db")
+	require.ErrorIs(t, err, ErrMiss)
+	require.NoError(t, s.Remove(server, "", "app/db"))
+}
+
+func TestStore_OtherUser(t *testing.T) {
+	dir := t.TempDir()
+	alice, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	require.NoError(t, alice.Put(entry("app/db", time.Now(), "a")))
+
+	bob, err := Open(dir, 2, testPassphrase, Options{})
+	require.NoError(t, err)
+	_, err = bob.Get(server, "", "app/db")
+	require.ErrorIs(t, err, ErrMiss)
+
+	// A copy of alice's entry under bob's name is sealed with another key.
+	sealed, err := os.ReadFile(alice.name(server, "", "app/db"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(bob.name(server, "", "app/db"), sealed, permissionFile))
+	_, err = bob.Get(server, "", "app/db")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrMiss)
+
+	require.NoError(t, ClearUser(dir, 2))
+	_, err = alice.Get(server, "", "app/db")
+	require.NoError(t, err, "clearing bob's cache leaves alice's alone")
+}
+
+func TestOpen_Passphrase(t *testing.T) {
+	dir := t.TempDir()
+	_, err := Open(dir, 1, "", Options{})
+	require.ErrorIs(t, err, ErrNoPassphrase)
+
+	// A cache from before the passphrase had its key in the clear.
+	require.NoError(t, os.MkdirAll(userDir(dir, 1), permissionDir))
+	require.NoError(t, os.WriteFile(filepath.Join(userDir(dir, 1), legacyKeyFile), make([]byte, keySize), permissionFile))
+
+	s, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	require.NoError(t, s.Put(entry("app/db", time.Now(), "a")))
+	_, err = os.Stat(filepath.Join(s.dir, legacyKeyFile))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = Open(dir, 1, "wrong", Options{})
+	require.ErrorIs(t, err, ErrPassphrase)
+
+	// Nothing in the directory is the key.
+	files, err := os.ReadDir(s.dir)
+	require.NoError(t, err)
+	for _, f := range files {
+		data, err := os.ReadFile(filepath.Join(s.dir, f.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), string(s.key))
+	}
+}
+
+func TestStore_RenamedEntry(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	require.NoError(t, s.Put(entry("app/db", time.Now(), "a")))
+
+	require.NoError(t, os.Rename(s.name(server, "", "app/db"), s.name(server, "", "app/web")))
+	_, err = s.Get(server, "", "app/web")
+	assert.ErrorContains(t, err, "does not belong")
+}
+
+func TestStore_TTL(t *testing.T) {
+	s, err := Open(t.TempDir(), 1, testPassphrase, Options{TTL: time.Hour})
+	require.NoError(t, err)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	require.NoError(t, s.Put(entry("fresh", now.Add(-59*time.Minute), "a")))
+	require.NoError(t, s.Put(entry("stale", now.Add(-61*time.Minute), "b")))
+
+	_, err = s.Get(server, "", "fresh")
+	require.NoError(t, err)
+	_, err = s.Get(server, "", "stale")
+	require.ErrorIs(t, err, ErrMiss)
+
+	st, err := s.Status()
+	require.NoError(t, err)
+	require.Len(t, st.Entries, 1, "an expired entry is removed when it is read")
+}
+
+func TestStore_MaxSize(t *testing.T) {
+	s, err := Open(t.TempDir(), 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	value := strings.Repeat("x", 1000)
+	require.NoError(t, s.Put(entry("one", time.Now(), value)))
+	st, err := s.Status()
+	require.NoError(t, err)
+	entrySize := st.Size
+
+	s.opts.MaxSize = 2*entrySize + entrySize/2
+	base := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(s.name(server, "", "one"), base, base))
+	require.NoError(t, s.Put(entry("two", time.Now(), value)))
+	require.NoError(t, s.Put(entry("three", time.Now(), value)))
+
+	_, err = s.Get(server, "", "one")
+	require.ErrorIs(t, err, ErrMiss, "the entry cached longest ago is evicted")
+	_, err = s.Get(server, "", "three")
+	require.NoError(t, err)
+
+	err = s.Put(entry("huge", time.Now(), strings.Repeat("x", int(s.opts.MaxSize))))
+	require.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestClear(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	s, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	require.NoError(t, s.Put(entry("app/db", time.Now(), "a")))
+	sealed, err := os.ReadFile(s.name(server, "", "app/db"))
+	require.NoError(t, err)
+
+	require.NoError(t, Clear(dir))
+	_, err = os.Stat(dir)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// A new salt gives a new key, which can't open entries of the old one.
+	s, err = Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(s.name(server, "", "app/db"), sealed, permissionFile))
+	_, err = s.Get(server, "", "app/db")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrMiss)
+}
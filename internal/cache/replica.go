@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeper/internal/security"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const replicaSuffix = ".replica"
+
+// OpKind is what a queued edit does to its path.
+type OpKind string
+
+const (
+	OpWrite  OpKind = "write"
+	OpDelete OpKind = "delete"
+)
+
+// Op is an edit made while the server was unreachable. Base is the latest
+// version of the path the edit was made on; keeper-agent sync pushes the
+// edit only if the server is still there, and reports a conflict otherwise.
+type Op struct {
+	QueuedAt    time.Time `json:"queued_at"`
+	ExpiredAt   time.Time `json:"expired_at"`
+	Kind        OpKind    `json:"kind"`
+	Path        string    `json:"path"`
+	Description string    `json:"description,omitempty"`
+	Payload     []byte    `json:"payload,omitempty"`
+	Base        int64     `json:"base"`
+}
+
+// Replica is the sync state of one user's own vault on one server: the
+// change cursor of the last sync, the latest version of every path as of
+// that sync, and the edits queued since. The values themselves are cache
+// entries. Unlike entries, the replica is never evicted, so queued edits
+// survive until they are pushed or the cache is cleared.
+type Replica struct {
+	SyncedAt time.Time        `json:"synced_at"`
+	Versions map[string]int64 `json:"versions"`
+	Server   string           `json:"server"`
+	Pending  []Op             `json:"pending,omitempty"`
+	Cursor   int64            `json:"cursor"`
+	UserID   int64            `json:"user_id"`
+}
+
+// Base is the version an edit of path made now is based on: the latest
+// version known from the last sync, or 0 for a path the replica hasn't seen.
+func (r *Replica) Base(path string) int64 {
+	return r.Versions[path]
+}
+
+// Queue appends an edit. A later edit of the same path keeps the base of the
+// first one, since the server hasn't seen either.
+func (r *Replica) Queue(op Op) {
+	for _, queued := range r.Pending {
+		if queued.Path == op.Path {
+			op.Base = queued.Base
+			break
+		}
+	}
+	r.Pending = append(r.Pending, op)
+}
+
+// Coalesced returns the last queued edit of every path, in queue order.
+func (r *Replica) Coalesced() []Op {
+	last := make(map[string]int, len(r.Pending))
+	for i, op := range r.Pending {
+		last[op.Path] = i
+	}
+	ops := make([]Op, 0, len(last))
+	for i, op := range r.Pending {
+		if last[op.Path] == i {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// Done drops the queued edits of path.
+func (r *Replica) Done(path string) {
+	pending := r.Pending[:0]
+	for _, op := range r.Pending {
+		if op.Path != path {
+			pending = append(pending, op)
+		}
+	}
+	r.Pending = pending
+}
+
+func (s *Store) replicaName(server string) string {
+	id := security.HMACSHA256(s.namesKey, []byte("replica\x00"+strconv.FormatInt(s.userID, 10)+"\x00"+server))
+	return filepath.Join(s.dir, hex.EncodeToString(id)+replicaSuffix)
+}
+
+// LoadReplica reads the store user's replica of server, or returns an empty
+// one if the agent has never synced with it for that user.
+func (s *Store) LoadReplica(server string) (*Replica, error) {
+	name := s.replicaName(server)
+	sealed, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Replica{Server: server, UserID: s.userID, Versions: map[string]int64{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replica: %w", err)
+	}
+	plain, err := security.DecryptAESGCM(s.key, sealed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt replica: %w", err)
+	}
+	var r Replica
+	if err := json.Unmarshal(plain, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode replica: %w", err)
+	}
+	if r.Server != server || r.UserID != s.userID {
+		return nil, fmt.Errorf("replica %s does not belong to user %d on %s", filepath.Base(name), s.userID, server)
+	}
+	if r.Versions == nil {
+		r.Versions = map[string]int64{}
+	}
+	return &r, nil
+}
+
+// SaveReplica replaces the stored replica of r.Server. A replica of another
+// user is refused.
+func (s *Store) SaveReplica(r *Replica) error {
+	if r.UserID != s.userID {
+		return fmt.Errorf("replica of user %d can't be saved for user %d", r.UserID, s.userID)
+	}
+	plain, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode replica: %w", err)
+	}
+	sealed, err := security.EncryptAESGCM(plain, s.key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt replica: %w", err)
+	}
+	name := s.replicaName(r.Server)
+	partial := name + ".partial"
+	if err := os.WriteFile(partial, sealed, permissionFile); err != nil {
+		return fmt.Errorf("failed to write replica: %w", err)
+	}
+	if err := os.Rename(partial, name); err != nil {
+		_ = os.Remove(partial)
+		return fmt.Errorf("failed to write replica: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplica_Queue(t *testing.T) {
+	r := &Replica{Server: server, Versions: map[string]int64{"app/db": 3}}
+	r.Queue(Op{Kind: OpWrite, Path: "app/db", Base: r.Base("app/db"), Payload: []byte("a")})
+	r.Queue(Op{Kind: OpWrite, Path: "app/web", Base: r.Base("app/web"), Payload: []byte("b")})
+	// The server hasn't seen the first edit, so the second keeps its base.
+	r.Queue(Op{Kind: OpDelete, Path: "app/db", Base: 4})
+
+	ops := r.Coalesced()
+	require.Len(t, ops, 2)
+	assert.Equal(t, "app/web", ops[0].Path)
+	assert.Equal(t, int64(0), ops[0].Base)
+	assert.Equal(t, OpDelete, ops[1].Kind)
+	assert.Equal(t, int64(3), ops[1].Base)
+
+	r.Done("app/db")
+	require.Len(t, r.Pending, 1)
+	assert.Equal(t, "app/web", r.Pending[0].Path)
+}
+
+func TestStore_Replica(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir, 1, testPassphrase, Options{MaxSize: 1})
+	require.NoError(t, err)
+
+	r, err := s.LoadReplica(server)
+	require.NoError(t, err)
+	assert.Zero(t, r.Cursor)
+	assert.Empty(t, r.Versions)
+
+	r.Cursor = 42
+	r.Versions["app/db"] = 3
+	r.Queue(Op{QueuedAt: time.Now(), Kind: OpWrite, Path: "app/db", Base: 3, Payload: []byte(`{"password":"s3cret"}`)})
+	require.NoError(t, s.SaveReplica(r))
+
+	// Eviction only looks at entries, so the queue survives a full cache.
+	require.NoError(t, s.evict(""))
+	got, err := s.LoadReplica(server)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), got.Cursor)
+	assert.Equal(t, int64(3), got.Versions["app/db"])
+	require.Len(t, got.Pending, 1)
+	assert.Equal(t, `{"password":"s3cret"}`, string(got.Pending[0].Payload))
+
+	data, err := os.ReadFile(s.replicaName(server))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "s3cret")
+
+	other, err := s.LoadReplica("other:8081")
+	require.NoError(t, err)
+	assert.Empty(t, other.Pending)
+
+	require.NoError(t, os.Rename(s.replicaName(server), s.replicaName("other:8081")))
+	_, err = s.LoadReplica("other:8081")
+	assert.ErrorContains(t, err, "does not belong")
+}
+
+func TestStore_ReplicaOtherUser(t *testing.T) {
+	dir := t.TempDir()
+	alice, err := Open(dir, 1, testPassphrase, Options{})
+	require.NoError(t, err)
+	r, err := alice.LoadReplica(server)
+	require.NoError(t, err)
+	r.Queue(Op{Kind: OpDelete, Path: "app/db"})
+	require.NoError(t, alice.SaveReplica(r))
+
+	bob, err := Open(dir, 2, testPassphrase, Options{})
+	require.NoError(t, err)
+	got, err := bob.LoadReplica(server)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), got.UserID)
+	assert.Empty(t, got.Pending, "alice's queue is not bob's")
+
+	assert.ErrorContains(t, bob.SaveReplica(r), "can't be saved")
+}
@@ -1,9 +1,13 @@
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"keeper/internal/config"
 	pb "keeper/internal/proto/v1"
+	"os"
 
 	"google.golang.org/grpc/credentials"
 
@@ -26,20 +30,51 @@ func (dc *GrpcVaultClient) Close() error {
 
 func getGrpcDialOptions(cfg *config.RemoteServer) ([]grpc.DialOption, error) {
 	if cfg.EnableTLS {
-		creds, err := credentials.NewClientTLSFromFile(cfg.CACert, "")
+		tlsConfig, err := clientTLSConfig(cfg)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
 		}
-		return []grpc.DialOption{grpc.WithTransportCredentials(creds)}, nil
+		return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+	}
+	if cfg.ClientCert != "" {
+		return nil, errors.New("a client certificate requires --enable-tls")
 	}
 	return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
 }
 
+// clientTLSConfig trusts CACert, or the system roots when it is empty, and
+// presents the client certificate when one is configured.
+func clientTLSConfig(cfg *config.RemoteServer) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CACert != "" {
+		caPEM, err := os.ReadFile(cfg.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CACert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
 func NewGrpcVaultClient(cfg *config.MainAgentConfig) (*GrpcVaultClient, error) {
 	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
 
 	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
 
@@ -74,6 +109,9 @@ func NewGrpcAuthClient(cfg *config.MainAgentConfig) (*GrpcAuthClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
 
 	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
 
@@ -89,3 +127,262 @@ func NewGrpcAuthClient(cfg *config.MainAgentConfig) (*GrpcAuthClient, error) {
 		AuthServiceClient: client,
 	}, nil
 }
+
+type GrpcTeamClient struct {
+	pb.TeamServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcTeamClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcTeamClient(cfg *config.MainAgentConfig) (*GrpcTeamClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewTeamServiceClient(conn)
+
+	return &GrpcTeamClient{
+		conn:              conn,
+		TeamServiceClient: client,
+	}, nil
+}
+
+type GrpcServiceAccountClient struct {
+	pb.ServiceAccountServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcServiceAccountClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcServiceAccountClient(cfg *config.MainAgentConfig) (*GrpcServiceAccountClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewServiceAccountServiceClient(conn)
+
+	return &GrpcServiceAccountClient{
+		conn:                        conn,
+		ServiceAccountServiceClient: client,
+	}, nil
+}
+
+type GrpcWrapClient struct {
+	pb.WrapServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcWrapClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcWrapClient(cfg *config.MainAgentConfig) (*GrpcWrapClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewWrapServiceClient(conn)
+
+	return &GrpcWrapClient{
+		conn:              conn,
+		WrapServiceClient: client,
+	}, nil
+}
+
+type GrpcDatabaseClient struct {
+	pb.DatabaseServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcDatabaseClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcDatabaseClient(cfg *config.MainAgentConfig) (*GrpcDatabaseClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewDatabaseServiceClient(conn)
+
+	return &GrpcDatabaseClient{
+		conn:                  conn,
+		DatabaseServiceClient: client,
+	}, nil
+}
+
+type GrpcTransitClient struct {
+	pb.TransitServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcTransitClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcTransitClient(cfg *config.MainAgentConfig) (*GrpcTransitClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewTransitServiceClient(conn)
+
+	return &GrpcTransitClient{
+		conn:                 conn,
+		TransitServiceClient: client,
+	}, nil
+}
+
+type GrpcPKIClient struct {
+	pb.PKIServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcPKIClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcPKIClient(cfg *config.MainAgentConfig) (*GrpcPKIClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewPKIServiceClient(conn)
+
+	return &GrpcPKIClient{
+		conn:             conn,
+		PKIServiceClient: client,
+	}, nil
+}
+
+type GrpcSSHClient struct {
+	pb.SSHServiceClient
+	conn *grpc.ClientConn
+}
+
+func (dc *GrpcSSHClient) Close() error {
+	err := dc.conn.Close()
+	if err != nil {
+		return fmt.Errorf("close grpc client: %w", err)
+	}
+	return nil
+}
+
+func NewGrpcSSHClient(cfg *config.MainAgentConfig) (*GrpcSSHClient, error) {
+	opts, err := getGrpcDialOptions(&cfg.RemoteServer)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TokenFile != "" {
+		opts = append(opts, grpc.WithUnaryInterceptor(refreshInterceptor(cfg.TokenFile)))
+	}
+
+	grpcAddress := fmt.Sprintf("%s:%d", cfg.RemoteServer.Address, cfg.RemoteServer.Port)
+
+	conn, err := grpc.NewClient(grpcAddress, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new client: %w", err)
+	}
+
+	client := pb.NewSSHServiceClient(conn)
+
+	return &GrpcSSHClient{
+		conn:             conn,
+		SSHServiceClient: client,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// tokenCredentials sends the access token as "authorization: Bearer" metadata.
+type tokenCredentials string
+
+var _ credentials.PerRPCCredentials = tokenCredentials("")
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity is false so the agent keeps working against a
+// server started without --enable-tls, as it did with the token in the body.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// WithToken authenticates a single call with the given access token.
+func WithToken(token string) grpc.CallOption {
+	return grpc.PerRPCCredentials(tokenCredentials(token))
+}
+
+// replaceToken returns opts with every token set by WithToken swapped for token.
+func replaceToken(opts []grpc.CallOption, token string) []grpc.CallOption {
+	replaced := make([]grpc.CallOption, 0, len(opts))
+	for _, opt := range opts {
+		if creds, ok := opt.(grpc.PerRPCCredsCallOption); ok {
+			if _, ok := creds.Creds.(tokenCredentials); ok {
+				continue
+			}
+		}
+		replaced = append(replaced, opt)
+	}
+	return append(replaced, WithToken(token))
+}
+
+// TokenUserID reads the user an access token was issued to. The signature is
+// not checked, the server does that; the agent only uses the ID to keep the
+// local state of different users apart.
+func TokenUserID(token string) (int64, error) {
+	var claims dto.Claims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return 0, fmt.Errorf("failed to parse token: %w", err)
+	}
+	if claims.UserID == 0 {
+		return 0, errors.New("token names no user")
+	}
+	return claims.UserID, nil
+}
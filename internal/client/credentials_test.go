@@ -0,0 +1,39 @@
+package client
+
+import (
+	"keeper/internal/dto"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestWithToken_Metadata(t *testing.T) {
+	opt, ok := WithToken("abc").(grpc.PerRPCCredsCallOption)
+	require.True(t, ok)
+
+	md, err := opt.Creds.GetRequestMetadata(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Bearer abc"}, md)
+}
+
+func TestReplaceToken(t *testing.T) {
+	other := grpc.WaitForReady(true)
+	opts := replaceToken([]grpc.CallOption{other, WithToken("old")}, "new")
+
+	assert.Equal(t, []grpc.CallOption{other, WithToken("new")}, opts)
+}
+
+func TestTokenUserID(t *testing.T) {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &dto.Claims{UserID: 42}).SignedString([]byte("k"))
+	require.NoError(t, err)
+	id, err := TokenUserID(signed)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+
+	_, err = TokenUserID("kpr_not-a-jwt")
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	permissionTokenFile = 0o600
+	refreshTokenSuffix  = ".refresh"
+)
+
+// noRefresh lists the calls that must not trigger a refresh: they either
+// don't use the access token or are the refresh itself.
+var noRefresh = map[string]bool{
+	pb.AuthService_Login_FullMethodName:                true,
+	pb.AuthService_Register_FullMethodName:             true,
+	pb.AuthService_RefreshToken_FullMethodName:         true,
+	pb.AuthService_VerifyTwoFactor_FullMethodName:      true,
+	pb.AuthService_LoginWithCertificate_FullMethodName: true,
+	pb.WrapService_Unwrap_FullMethodName:               true,
+}
+
+// RefreshTokenPath is where the refresh token is kept, next to the access token file.
+func RefreshTokenPath(tokenFile string) string {
+	return tokenFile + refreshTokenSuffix
+}
+
+// SaveTokens writes the access token to tokenFile and the refresh token next to it.
+func SaveTokens(tokenFile string, tokens dto.AgentTokens) error {
+	if err := os.WriteFile(tokenFile, []byte(tokens.Token), permissionTokenFile); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	if tokens.RefreshToken == "" {
+		return nil
+	}
+	if err := os.WriteFile(RefreshTokenPath(tokenFile), []byte(tokens.RefreshToken), permissionTokenFile); err != nil {
+		return fmt.Errorf("failed to write refresh token file: %w", err)
+	}
+	return nil
+}
+
+// RemoveTokens deletes both token files, missing files are not an error.
+func RemoveTokens(tokenFile string) error {
+	for _, path := range []string{tokenFile, RefreshTokenPath(tokenFile)} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove token file: %w", err)
+		}
+	}
+	return nil
+}
+
+// refreshInterceptor retries a call once with a new access token when the
+// server rejects the current one. The refresh token is read from and the new
+// pair written back to the files next to tokenFile.
+func refreshInterceptor(tokenFile string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unauthenticated || noRefresh[method] {
+			return err
+		}
+
+		tokens, refreshErr := refreshTokens(ctx, cc, tokenFile)
+		if refreshErr != nil {
+			return errors.Join(err, refreshErr)
+		}
+		return invoker(ctx, method, req, reply, cc, replaceToken(opts, tokens.Token)...)
+	}
+}
+
+func refreshTokens(ctx context.Context, cc grpc.ClientConnInterface, tokenFile string) (dto.AgentTokens, error) {
+	data, err := os.ReadFile(RefreshTokenPath(tokenFile))
+	if err != nil {
+		return dto.AgentTokens{}, fmt.Errorf("no refresh token available: %w", err)
+	}
+
+	req := &pbModel.RefreshTokenRequest{}
+	req.SetRefreshToken(strings.TrimSpace(string(data)))
+	resp, err := pb.NewAuthServiceClient(cc).RefreshToken(ctx, req)
+	if err != nil {
+		return dto.AgentTokens{}, fmt.Errorf("failed to refresh token, please log in again: %w", err)
+	}
+
+	tokens := dto.AgentTokens{Token: resp.GetToken(), RefreshToken: resp.GetRefreshToken()}
+	if err := SaveTokens(tokenFile, tokens); err != nil {
+		return dto.AgentTokens{}, err
+	}
+	return tokens, nil
+}
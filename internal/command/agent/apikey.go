@@ -0,0 +1,242 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAccount    = "account"
+	flagName       = "name"
+	flagCapability = "capability"
+	flagAllowIP    = "allow-ip"
+	flagExpires    = "expires"
+	flagKeyID      = flagSessionID
+)
+
+var serviceAccountCmd = &cobra.Command{
+	Use:   "service-account",
+	Short: "Manage service accounts used by CI jobs and other automation",
+}
+
+var serviceAccountCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a service account owned by you or by one of your teams",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString(flagName)
+		description, _ := cmd.Flags().GetString(flagKeyDescription)
+		team, _ := cmd.Flags().GetString(flagTeam)
+		role, _ := cmd.Flags().GetString(flagRole)
+		return runServiceAccountAction(cmd, func(
+			ctx context.Context, accounts service.RemoteServiceAccountService, token string,
+		) error {
+			account, err := accounts.CreateAccount(ctx, token, dto.CreateServiceAccount{
+				Name:        name,
+				Description: description,
+				Team:        team,
+				TeamRole:    role,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create service account: %w", err)
+			}
+			fmt.Printf("✅ Service account created: %s (login %s, owner %s)\n", account.Name, account.Login, account.Owner)
+			return nil
+		})
+	},
+}
+
+var serviceAccountListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List service accounts you can manage",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runServiceAccountAction(cmd, func(
+			ctx context.Context, accounts service.RemoteServiceAccountService, token string,
+		) error {
+			list, err := accounts.ListAccounts(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to list service accounts: %w", err)
+			}
+
+			const accountFormat = "%-24s %-24s %s\n"
+			fmt.Printf(accountFormat, "Name", "Owner", "Description")
+			fmt.Printf(accountFormat, "----", "-----", "-----------")
+			if len(list) == 0 {
+				fmt.Println("No service accounts found.")
+			}
+			for _, a := range list {
+				fmt.Printf(accountFormat, a.Name, a.Owner, a.Description)
+			}
+			return nil
+		})
+	},
+}
+
+var serviceAccountDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a service account together with its keys and secrets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString(flagName)
+		return runServiceAccountAction(cmd, func(
+			ctx context.Context, accounts service.RemoteServiceAccountService, token string,
+		) error {
+			if err := accounts.DeleteAccount(ctx, token, name); err != nil {
+				return fmt.Errorf("failed to delete service account: %w", err)
+			}
+			fmt.Printf("🗑️  Service account deleted: %s\n", name)
+			return nil
+		})
+	},
+}
+
+var apiKeyCmd = &cobra.Command{
+	Use:   "apikey",
+	Short: "Manage API keys of service accounts",
+}
+
+var apiKeyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API key, it is shown only once",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		account, _ := cmd.Flags().GetString(flagAccount)
+		name, _ := cmd.Flags().GetString(flagName)
+		paths, _ := cmd.Flags().GetStringSlice(flagPath)
+		capabilities, _ := cmd.Flags().GetStringSlice(flagCapability)
+		allowIPs, _ := cmd.Flags().GetStringSlice(flagAllowIP)
+		expires, _ := cmd.Flags().GetDuration(flagExpires)
+		return runServiceAccountAction(cmd, func(
+			ctx context.Context, accounts service.RemoteServiceAccountService, token string,
+		) error {
+			plain, key, err := accounts.CreateKey(ctx, token, dto.CreateAPIKey{
+				Account:      account,
+				Name:         name,
+				PathPrefixes: paths,
+				Capabilities: capabilities,
+				AllowedCIDRs: allowIPs,
+				TTL:          expires,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create api key: %w", err)
+			}
+			fmt.Printf("✅ API key %d created for %s\n", key.ID, key.Account)
+			fmt.Println("Store it now, it will not be shown again:")
+			fmt.Println(plain)
+			return nil
+		})
+	},
+}
+
+var apiKeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API keys of a service account",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		account, _ := cmd.Flags().GetString(flagAccount)
+		return runServiceAccountAction(cmd, func(
+			ctx context.Context, accounts service.RemoteServiceAccountService, token string,
+		) error {
+			keys, err := accounts.ListKeys(ctx, token, account)
+			if err != nil {
+				return fmt.Errorf("failed to list api keys: %w", err)
+			}
+
+			const keyFormat = "%-6s %-14s %-16s %-20s %-20s %-20s %s\n"
+			fmt.Printf(keyFormat, "ID", "Prefix", "Name", "Paths", "Capabilities", "Expires", "Status")
+			fmt.Printf(keyFormat, "--", "------", "----", "-----", "------------", "-------", "------")
+			for _, k := range keys {
+				expires := "never"
+				if !k.ExpiresAt.IsZero() {
+					expires = k.ExpiresAt.Local().Format(time.DateTime)
+				}
+				fmt.Printf(keyFormat, fmt.Sprint(k.ID), k.Prefix, k.Name,
+					strings.Join(k.PathPrefixes, ","), strings.Join(k.Capabilities, ","), expires, apiKeyStatus(k))
+			}
+			return nil
+		})
+	},
+}
+
+var apiKeyRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke an API key",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, _ := cmd.Flags().GetInt64(flagKeyID)
+		return runServiceAccountAction(cmd, func(
+			ctx context.Context, accounts service.RemoteServiceAccountService, token string,
+		) error {
+			if err := accounts.RevokeKey(ctx, token, id); err != nil {
+				return fmt.Errorf("failed to revoke api key: %w", err)
+			}
+			fmt.Printf("🔒 API key %d revoked\n", id)
+			return nil
+		})
+	},
+}
+
+func apiKeyStatus(k dto.AgentAPIKey) string {
+	switch {
+	case !k.RevokedAt.IsZero():
+		return "revoked"
+	case !k.ExpiresAt.IsZero() && k.ExpiresAt.Before(time.Now()):
+		return "expired"
+	case k.LastUsedAt.IsZero():
+		return "never used"
+	}
+	return "used " + k.LastUsedAt.Local().Format(time.DateTime) + " from " + k.LastIP
+}
+
+func runServiceAccountAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, accounts service.RemoteServiceAccountService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runWithServiceAccountService(func(accounts service.RemoteServiceAccountService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, accounts, token)
+	})
+}
+
+func init() {
+	serviceAccountCmd.AddCommand(serviceAccountCreateCmd, serviceAccountListCmd, serviceAccountDeleteCmd)
+	apiKeyCmd.AddCommand(apiKeyCreateCmd, apiKeyListCmd, apiKeyRevokeCmd)
+
+	for _, c := range []*cobra.Command{
+		serviceAccountCreateCmd, serviceAccountListCmd, serviceAccountDeleteCmd,
+		apiKeyCreateCmd, apiKeyListCmd, apiKeyRevokeCmd,
+	} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+
+	for _, c := range []*cobra.Command{serviceAccountCreateCmd, serviceAccountDeleteCmd} {
+		c.Flags().String(flagName, "", "Service account name")
+		_ = c.MarkFlagRequired(flagName)
+	}
+	serviceAccountCreateCmd.Flags().String(flagKeyDescription, "", "What the account is used for")
+	serviceAccountCreateCmd.Flags().String(flagTeam, "", "Team that owns the account (you must be its owner)")
+	serviceAccountCreateCmd.Flags().String(flagRole, "viewer", "Role of the account in --team: viewer or editor")
+
+	for _, c := range []*cobra.Command{apiKeyCreateCmd, apiKeyListCmd} {
+		c.Flags().String(flagAccount, "", "Service account name")
+		_ = c.MarkFlagRequired(flagAccount)
+	}
+	apiKeyCreateCmd.Flags().String(flagName, "", "Key name shown in listings")
+	apiKeyCreateCmd.Flags().StringSlice(flagPath, nil, "Path prefix the key may use, repeatable (e.g. ci/)")
+	apiKeyCreateCmd.Flags().StringSlice(flagCapability, []string{"read", "list"},
+		"Allowed capability, repeatable: read, list, create, update, delete, destroy")
+	apiKeyCreateCmd.Flags().StringSlice(flagAllowIP, nil, "Address or CIDR the key may be used from, repeatable")
+	apiKeyCreateCmd.Flags().Duration(flagExpires, 0, "Key lifetime, e.g. 720h (never expires when zero)")
+	_ = apiKeyCreateCmd.MarkFlagRequired(flagPath)
+
+	apiKeyRevokeCmd.Flags().Int64(flagKeyID, 0, "Key ID from keeper-agent apikey list")
+	_ = apiKeyRevokeCmd.MarkFlagRequired(flagKeyID)
+}
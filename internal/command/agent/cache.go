@@ -0,0 +1,265 @@
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"keeper/internal/cache"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	flagCacheDir     = "cache-dir"
+	flagCacheTTL     = "cache-ttl"
+	flagCacheMaxSize = "cache-max-size"
+	flagNoCache      = "no-cache"
+	flagCachePass    = "cache-passphrase"
+	envCachePass     = "KEEPER_CACHE_PASSPHRASE"
+
+	defaultCacheDir     = ".keeper-cache"
+	defaultCacheTTL     = 7 * 24 * time.Hour
+	defaultCacheMaxSize = 64
+	bytesInMiB          = 1 << 20
+)
+
+var (
+	errCacheDisabled     = errors.New("the offline cache is disabled")
+	errNoCachePassphrase = errors.New("the offline cache is off, set --cache-passphrase or " + envCachePass)
+)
+
+// offlineCache is the agent's view of the cache: secrets of the server the
+// agent is configured for. A nil store means caching is off, disabled says
+// why, and every method is then a no-op.
+type offlineCache struct {
+	store    *cache.Store
+	disabled error
+	server   string
+}
+
+// openOfflineCache opens the cache of the user token was issued to, unless
+// --no-cache is set. API keys have no user and no cache. Without a cache
+// passphrase there is no key, so the cache stays off until one is set. A
+// cache that can't be opened only costs the offline fallback, so it is
+// reported and the command goes on without it.
+func openOfflineCache(token string) *offlineCache {
+	c := &offlineCache{server: cacheServer(), disabled: errCacheDisabled}
+	if viper.GetBool(flagNoCache) || strings.HasPrefix(token, service.APIKeyPrefix) {
+		return c
+	}
+	passphrase := cachePassphrase()
+	if passphrase == "" {
+		c.disabled = errNoCachePassphrase
+		return c
+	}
+	userID, err := client.TokenUserID(token)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Offline cache disabled: %v\n", err)
+		return c
+	}
+	store, err := cache.Open(viper.GetString(flagCacheDir), userID, passphrase, cacheOptions())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Offline cache disabled: %v\n", err)
+		c.disabled = fmt.Errorf("failed to open offline cache: %w", err)
+		return c
+	}
+	c.store, c.disabled = store, nil
+	return c
+}
+
+// cachePassphrase reads the environment itself, as AutomaticEnv maps no
+// variable onto a hyphenated key.
+func cachePassphrase() string {
+	if passphrase := viper.GetString(flagCachePass); passphrase != "" {
+		return passphrase
+	}
+	return os.Getenv(envCachePass)
+}
+
+// cacheServer names the configured server in cache entries and replicas.
+func cacheServer() string {
+	cfg := agentConfig()
+	return net.JoinHostPort(cfg.RemoteServer.Address, strconv.Itoa(cfg.RemoteServer.Port))
+}
+
+func cacheOptions() cache.Options {
+	return cache.Options{
+		TTL:     viper.GetDuration(flagCacheTTL),
+		MaxSize: viper.GetInt64(flagCacheMaxSize) * bytesInMiB,
+	}
+}
+
+func (c *offlineCache) save(owner string, secret *dto.AgentGetSecret) {
+	if c.store == nil {
+		return
+	}
+	e := &cache.Entry{
+		CachedAt:    time.Now(),
+		CreatedAt:   secret.CreatedAt,
+		ExpiredAt:   secret.ExpiredAt,
+		Server:      c.server,
+		Owner:       owner,
+		Path:        secret.Path,
+		Description: secret.Description,
+		Payload:     secret.Payload,
+		Version:     secret.Version,
+	}
+	if secret.FilePath != nil {
+		e.FilePath = *secret.FilePath
+	}
+	if err := c.store.Put(e); err != nil && !errors.Is(err, cache.ErrTooLarge) {
+		fmt.Fprintf(os.Stderr, "⚠️  Failed to cache %s: %v\n", secret.Path, err)
+	}
+}
+
+func (c *offlineCache) load(owner, path string) (*cache.Entry, error) {
+	if c.store == nil {
+		return nil, c.disabled
+	}
+	e, err := c.store.Get(c.server, owner, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read offline cache: %w", err)
+	}
+	return e, nil
+}
+
+func (c *offlineCache) forget(owner, path string) {
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Remove(c.server, owner, path); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  %v\n", err)
+	}
+}
+
+// isOffline reports whether a call failed because the server could not be
+// reached, as opposed to the server refusing it.
+func isOffline(err error) bool {
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
+// cachedSecret turns an entry back into what GetSecret returns.
+func cachedSecret(e *cache.Entry) *dto.AgentGetSecret {
+	secret := &dto.AgentGetSecret{
+		ExpiredAt:   e.ExpiredAt,
+		CreatedAt:   e.CreatedAt,
+		Path:        e.Path,
+		Description: e.Description,
+		Payload:     e.Payload,
+		Version:     e.Version,
+	}
+	if e.FilePath != "" {
+		secret.FilePath = &e.FilePath
+	}
+	return secret
+}
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect or clear the encrypted offline cache",
+	Long: "Secrets read from the server are kept in an encrypted local cache, and read falls back to it " +
+		"when the server can't be reached. The key is derived from --cache-passphrase or " + envCachePass +
+		" and is stored nowhere; without a passphrase the cache is off.",
+}
+
+var cacheStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show your cached secrets and how old they are",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := viper.GetString(flagCacheDir)
+		if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+			fmt.Printf("📭 The offline cache %s is empty\n", dir)
+			return nil
+		}
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+		userID, err := client.TokenUserID(token)
+		if err != nil {
+			return fmt.Errorf("failed to open cache: %w", err)
+		}
+		passphrase := cachePassphrase()
+		if passphrase == "" {
+			return errNoCachePassphrase
+		}
+		opts := cacheOptions()
+		store, err := cache.Open(dir, userID, passphrase, opts)
+		if err != nil {
+			return fmt.Errorf("failed to open cache: %w", err)
+		}
+		st, err := store.Status()
+		if err != nil {
+			return fmt.Errorf("failed to read cache: %w", err)
+		}
+
+		fmt.Printf("Cache:    %s\n", dir)
+		fmt.Printf("Entries:  %d, %.1f of %d MiB\n", len(st.Entries), float64(st.Size)/bytesInMiB,
+			opts.MaxSize/bytesInMiB)
+		fmt.Printf("TTL:      %s\n", opts.TTL)
+		if replica, err := store.LoadReplica(cacheServer()); err == nil && !replica.SyncedAt.IsZero() {
+			fmt.Printf("Synced:   %s ago, %d paths in the replica\n",
+				time.Since(replica.SyncedAt).Round(time.Second), len(replica.Versions))
+		}
+		if n := pendingEdits(token); n > 0 {
+			fmt.Printf("📥 %d offline edits wait for keeper-agent sync\n", n)
+		}
+		if st.Unreadable > 0 {
+			fmt.Printf("⚠️  %d entries can't be decrypted and will be evicted\n", st.Unreadable)
+		}
+		if len(st.Entries) == 0 {
+			return nil
+		}
+
+		const cacheFormat = "%-24s %-32s %-12s %-8s %-12s %s\n"
+		fmt.Println()
+		fmt.Printf(cacheFormat, "Server", "Path", "Owner", "Version", "Age", "State")
+		fmt.Printf(cacheFormat, "------", "----", "-----", "-------", "---", "-----")
+		now := time.Now()
+		for _, e := range st.Entries {
+			state := "fresh"
+			if store.Expired(e) {
+				state = "expired"
+			}
+			fmt.Printf(cacheFormat, e.Server, e.Path, e.Owner, strconv.FormatInt(e.Version, 10),
+				e.Age(now).Round(time.Second), state)
+		}
+		return nil
+	},
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Delete the offline cache of every user with its keys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := viper.GetString(flagCacheDir)
+		if token, err := readToken(cmd); err == nil {
+			warnDiscardedEdits(token)
+		}
+		if err := cache.Clear(dir); err != nil {
+			return fmt.Errorf("failed to clear offline cache: %w", err)
+		}
+		fmt.Printf("🧹 Offline cache %s cleared\n", dir)
+		return nil
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{cacheStatusCmd, cacheClearCmd} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+	cacheCmd.AddCommand(cacheStatusCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+}
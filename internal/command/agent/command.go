@@ -17,6 +17,8 @@ const (
 	flagGRPCAddress = "grpc-address"
 	flagEnableTLS   = "enable-tls"
 	flagCACert      = "ca-cert"
+	flagClientCert  = "client-cert"
+	flagClientKey   = "client-key"
 	grpcPort        = 8081
 )
 
@@ -25,6 +27,14 @@ func init() {
 	rootCmd.PersistentFlags().Int(flagGRPCPort, grpcPort, "gRPC server port")
 	rootCmd.PersistentFlags().Bool(flagEnableTLS, false, "Enable TLS when connecting to the server")
 	rootCmd.PersistentFlags().String(flagCACert, "cert/public.cert", "Path to CA certificate file")
+	rootCmd.PersistentFlags().String(flagClientCert, "", "Client certificate for mutual TLS (needs --enable-tls)")
+	rootCmd.PersistentFlags().String(flagClientKey, "", "Private key of the client certificate")
+	rootCmd.PersistentFlags().String(flagCacheDir, defaultCacheDir, "Directory of the encrypted offline cache")
+	rootCmd.PersistentFlags().Duration(flagCacheTTL, defaultCacheTTL, "How long a cached secret is served offline")
+	rootCmd.PersistentFlags().Int64(flagCacheMaxSize, defaultCacheMaxSize, "Size limit of the offline cache in MiB")
+	rootCmd.PersistentFlags().Bool(flagNoCache, false, "Neither use nor update the offline cache")
+	rootCmd.PersistentFlags().String(flagCachePass, "",
+		"Passphrase the offline cache key is derived from (can also be set via "+envCachePass+")")
 
 	viper.SetEnvPrefix("KEEPER")
 	viper.AutomaticEnv()
@@ -33,13 +43,38 @@ func init() {
 	_ = viper.BindPFlag(flagGRPCPort, rootCmd.PersistentFlags().Lookup(flagGRPCPort))
 	_ = viper.BindPFlag(flagEnableTLS, rootCmd.PersistentFlags().Lookup(flagEnableTLS))
 	_ = viper.BindPFlag(flagCACert, rootCmd.PersistentFlags().Lookup(flagCACert))
+	_ = viper.BindPFlag(flagClientCert, rootCmd.PersistentFlags().Lookup(flagClientCert))
+	_ = viper.BindPFlag(flagClientKey, rootCmd.PersistentFlags().Lookup(flagClientKey))
+	_ = viper.BindPFlag(flagCacheDir, rootCmd.PersistentFlags().Lookup(flagCacheDir))
+	_ = viper.BindPFlag(flagCacheTTL, rootCmd.PersistentFlags().Lookup(flagCacheTTL))
+	_ = viper.BindPFlag(flagCacheMaxSize, rootCmd.PersistentFlags().Lookup(flagCacheMaxSize))
+	_ = viper.BindPFlag(flagNoCache, rootCmd.PersistentFlags().Lookup(flagNoCache))
+	_ = viper.BindPFlag(flagCachePass, rootCmd.PersistentFlags().Lookup(flagCachePass))
 
 	rootCmd.AddCommand(registerCmd)
 	rootCmd.AddCommand(loginCmd)
+	rootCmd.AddCommand(logoutCmd)
+	rootCmd.AddCommand(sessionsCmd)
+	rootCmd.AddCommand(twoFactorCmd)
+	rootCmd.AddCommand(changePasswordCmd)
 	rootCmd.AddCommand(writeCmd)
 	rootCmd.AddCommand(readCmd)
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(grantCmd)
+	rootCmd.AddCommand(teamCmd)
+	rootCmd.AddCommand(serviceAccountCmd)
+	rootCmd.AddCommand(apiKeyCmd)
+	rootCmd.AddCommand(shareCmd)
+	rootCmd.AddCommand(unwrapCmd)
+	rootCmd.AddCommand(credsCmd)
+	rootCmd.AddCommand(transitCmd)
+	rootCmd.AddCommand(pkiCmd)
+	rootCmd.AddCommand(sshCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(cacheCmd)
+	rootCmd.AddCommand(syncCmd)
 }
 
 func Execute() error {
@@ -1,24 +1,27 @@
 package agent
 
 import (
+	"errors"
 	"fmt"
 	"keeper/internal/client"
 	"keeper/internal/config"
+	"keeper/internal/dto"
 	"keeper/internal/service"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 const (
-	flagPath            = flagKeyName
-	flagToken           = "token"
-	flagTokenFile       = "token-file"
-	flagKeyName         = "path"
-	envAuthToken        = "TOKEN"
-	permissionTokenFile = 0o600
-	defaultTokenFile    = ".keeper-token"
+	flagPath         = flagKeyName
+	flagToken        = "token"
+	flagTokenFile    = "token-file"
+	flagKeyName      = "path"
+	envAuthToken     = "TOKEN"
+	defaultTokenFile = ".keeper-token"
 
 	flagTokenDescription     = "User token (can also be set via TOKEN)"
 	flagTokenFileDescription = "Path to file with token (used if --token and TOKEN are unset)"
@@ -32,6 +35,9 @@ const (
 
 	flagGrpcAddress = "grpc-address"
 	flagGrpcPort    = "grpc-port"
+
+	flagOwner            = "owner"
+	flagOwnerDescription = "Login of the user who shared the secret (defaults to your own vault)"
 )
 
 func runWithAuthService(action func(service.RemoteAuthService, time.Duration) error) error {
@@ -66,10 +72,133 @@ func runWithVaultService(action func(service.RemoteVaultService, time.Duration)
 	return action(auth, cfg.RemoteServer.Timeout)
 }
 
-func initGrpcAuthClient() (*client.GrpcAuthClient, *config.MainAgentConfig, error) {
+func runWithTeamService(action func(service.RemoteTeamService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcTeamClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcTeamClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	teams := service.NewRemoteTeamService(grpcClient)
+	return action(teams, cfg.RemoteServer.Timeout)
+}
+
+func runWithServiceAccountService(action func(service.RemoteServiceAccountService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcServiceAccountClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcServiceAccountClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	accounts := service.NewRemoteServiceAccountService(grpcClient)
+	return action(accounts, cfg.RemoteServer.Timeout)
+}
+
+func runWithWrapService(action func(service.RemoteWrapService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcWrapClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcWrapClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	wrap := service.NewRemoteWrapService(grpcClient)
+	return action(wrap, cfg.RemoteServer.Timeout)
+}
+
+func runWithDatabaseService(action func(service.RemoteDatabaseService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcDatabaseClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcDatabaseClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	database := service.NewRemoteDatabaseService(grpcClient)
+	return action(database, cfg.RemoteServer.Timeout)
+}
+
+func runWithTransitService(action func(service.RemoteTransitService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcTransitClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcTransitClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	transit := service.NewRemoteTransitService(grpcClient)
+	return action(transit, cfg.RemoteServer.Timeout)
+}
+
+func runWithPKIService(action func(service.RemotePKIService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcPKIClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcPKIClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	pki := service.NewRemotePKIService(grpcClient)
+	return action(pki, cfg.RemoteServer.Timeout)
+}
+
+func runWithSSHService(action func(service.RemoteSSHService, time.Duration) error) error {
+	grpcClient, cfg, err := initGrpcSSHClient()
+	if err != nil {
+		return fmt.Errorf(errorConnectGrpc, err)
+	}
+	defer func(grpcClient *client.GrpcSSHClient) {
+		err := grpcClient.Close()
+		if err != nil {
+			fmt.Printf("failed to close gRPC client connection: %v", err)
+		}
+	}(grpcClient)
+
+	ssh := service.NewRemoteSSHService(grpcClient)
+	return action(ssh, cfg.RemoteServer.Timeout)
+}
+
+// agentConfig reads the connection settings shared by all commands.
+func agentConfig() *config.MainAgentConfig {
 	cfg := config.NewAgentConfig()
 	cfg.RemoteServer.Address = viper.GetString(flagGrpcAddress)
 	cfg.RemoteServer.Port = viper.GetInt(flagGrpcPort)
+	cfg.RemoteServer.EnableTLS = viper.GetBool(flagEnableTLS)
+	cfg.RemoteServer.CACert = viper.GetString(flagCACert)
+	cfg.RemoteServer.ClientCert = viper.GetString(flagClientCert)
+	cfg.RemoteServer.ClientKey = viper.GetString(flagClientKey)
+	cfg.TokenFile = viper.GetString(flagTokenFile)
+	return cfg
+}
+
+func initGrpcAuthClient() (*client.GrpcAuthClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
 
 	grpcClient, err := client.NewGrpcAuthClient(cfg)
 	if err != nil {
@@ -80,9 +209,7 @@ func initGrpcAuthClient() (*client.GrpcAuthClient, *config.MainAgentConfig, erro
 }
 
 func initGrpcVaultClient() (*client.GrpcVaultClient, *config.MainAgentConfig, error) {
-	cfg := config.NewAgentConfig()
-	cfg.RemoteServer.Address = viper.GetString(flagGrpcAddress)
-	cfg.RemoteServer.Port = viper.GetInt(flagGrpcPort)
+	cfg := agentConfig()
 
 	grpcClient, err := client.NewGrpcVaultClient(cfg)
 	if err != nil {
@@ -92,9 +219,110 @@ func initGrpcVaultClient() (*client.GrpcVaultClient, *config.MainAgentConfig, er
 	return grpcClient, cfg, nil
 }
 
-func saveTokenAndPrintInfo(token string, tokenFilePath string) error {
-	if err := os.WriteFile(tokenFilePath, []byte(token), permissionTokenFile); err != nil {
-		return fmt.Errorf("failed to write token file: %w", err)
+// readToken resolves the auth token from --token, the TOKEN env var or --token-file.
+func readToken(cmd *cobra.Command) (string, error) {
+	token, _ := cmd.Flags().GetString(flagToken)
+	tokenFile, _ := cmd.Flags().GetString(flagTokenFile)
+
+	if token == "" {
+		token = os.Getenv(envAuthToken)
+	}
+	if token == "" && tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf(errorReadTokenFile, tokenFile, err)
+		}
+		token = strings.TrimSpace(string(data))
+		// Lets the gRPC client refresh the token in place once it expires.
+		viper.Set(flagTokenFile, tokenFile)
+	}
+
+	if token == "" {
+		return "", errors.New(errorTokenRequired)
+	}
+	return token, nil
+}
+
+func initGrpcTeamClient() (*client.GrpcTeamClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcTeamClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func initGrpcServiceAccountClient() (*client.GrpcServiceAccountClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcServiceAccountClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func initGrpcWrapClient() (*client.GrpcWrapClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcWrapClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func initGrpcDatabaseClient() (*client.GrpcDatabaseClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcDatabaseClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func initGrpcTransitClient() (*client.GrpcTransitClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcTransitClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func initGrpcPKIClient() (*client.GrpcPKIClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcPKIClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func initGrpcSSHClient() (*client.GrpcSSHClient, *config.MainAgentConfig, error) {
+	cfg := agentConfig()
+
+	grpcClient, err := client.NewGrpcSSHClient(cfg)
+	if err != nil {
+		return nil, cfg, fmt.Errorf(errorConnectGrpc, err)
+	}
+
+	return grpcClient, cfg, nil
+}
+
+func saveTokenAndPrintInfo(tokens dto.AgentTokens, tokenFilePath string) error {
+	if err := client.SaveTokens(tokenFilePath, tokens); err != nil {
+		return fmt.Errorf("%w", err)
 	}
 
 	return nil
@@ -0,0 +1,104 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/service"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var credsCmd = &cobra.Command{
+	Use:   "creds",
+	Short: "Get short-lived credentials generated by the server",
+}
+
+var credsDBCmd = &cobra.Command{
+	Use:   "db <role>",
+	Short: "Create a temporary PostgreSQL user for a role",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ttl, _ := cmd.Flags().GetDuration(flagTTL)
+		return runDatabaseAction(cmd, func(ctx context.Context, db service.RemoteDatabaseService, token string) error {
+			creds, err := db.GenerateCredentials(ctx, token, args[0], ttl)
+			if err != nil {
+				return fmt.Errorf("failed to get database credentials: %w", err)
+			}
+
+			const credsFormat = "%-12s %s\n"
+			fmt.Printf(credsFormat, "Key", "Value")
+			fmt.Printf(credsFormat, "---", "-----")
+			fmt.Printf(credsFormat, "lease_id", creds.LeaseID)
+			fmt.Printf(credsFormat, "expires_at", creds.ExpiresAt.Local().Format(time.DateTime))
+			fmt.Printf(credsFormat, "username", creds.Username)
+			fmt.Printf(credsFormat, "password", creds.Password)
+			return nil
+		})
+	},
+}
+
+var credsLeasesCmd = &cobra.Command{
+	Use:   "leases",
+	Short: "List your active credential leases",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDatabaseAction(cmd, func(ctx context.Context, db service.RemoteDatabaseService, token string) error {
+			leases, err := db.ListLeases(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to list leases: %w", err)
+			}
+
+			const leaseFormat = "%-48s %-36s %s\n"
+			fmt.Printf(leaseFormat, "Lease", "Username", "Expires")
+			fmt.Printf(leaseFormat, "-----", "--------", "-------")
+			if len(leases) == 0 {
+				fmt.Println("No active leases.")
+			}
+			for _, l := range leases {
+				fmt.Printf(leaseFormat, l.LeaseID, l.Username, l.ExpiresAt.Local().Format(time.DateTime))
+			}
+			return nil
+		})
+	},
+}
+
+var credsRevokeCmd = &cobra.Command{
+	Use:   "revoke <lease-id>",
+	Short: "Drop the user of a lease before it expires",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDatabaseAction(cmd, func(ctx context.Context, db service.RemoteDatabaseService, token string) error {
+			if err := db.RevokeLease(ctx, token, args[0]); err != nil {
+				return fmt.Errorf("failed to revoke lease: %w", err)
+			}
+			fmt.Printf("✅ Lease revoked: %s\n", args[0])
+			return nil
+		})
+	},
+}
+
+func runDatabaseAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, db service.RemoteDatabaseService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runWithDatabaseService(func(db service.RemoteDatabaseService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, db, token)
+	})
+}
+
+func init() {
+	credsCmd.AddCommand(credsDBCmd, credsLeasesCmd, credsRevokeCmd)
+
+	credsDBCmd.Flags().Duration(flagTTL, 0, "Lease length (default: the role's ttl, capped at its max)")
+	for _, c := range []*cobra.Command{credsDBCmd, credsLeasesCmd, credsRevokeCmd} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+}
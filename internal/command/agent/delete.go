@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"keeper/internal/cache"
 	"keeper/internal/service"
 	"os"
 	"strings"
@@ -23,6 +24,7 @@ var deleteCmd = &cobra.Command{
 		metadata, _ := cmd.Flags().GetBool("metadata")
 		undelete, _ := cmd.Flags().GetBool("undelete")
 		version, _ := cmd.Flags().GetInt("version")
+		owner, _ := cmd.Flags().GetString(flagOwner)
 
 		if token == "" {
 			token = os.Getenv(envAuthToken)
@@ -43,34 +45,47 @@ var deleteCmd = &cobra.Command{
 			return errors.New("--undelete requires --version to be set to a positive integer")
 		}
 
+		offline := openOfflineCache(token)
 		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
 
 			switch {
 			case metadata:
-				if err := vault.DeleteMetadata(ctx, token, path); err != nil {
+				if err := vault.DeleteMetadata(ctx, token, owner, path); err != nil {
 					return fmt.Errorf("failed to delete metadata: %w", err)
 				}
 				fmt.Printf("❌ Metadata deleted for secret: %s\n", path)
+				offline.forget(owner, path)
 
 			case destroy:
-				if err := vault.DestroySecret(ctx, token, path); err != nil {
+				if err := vault.DestroySecret(ctx, token, owner, path); err != nil {
 					return fmt.Errorf("failed to destroy secret: %w", err)
 				}
 				fmt.Printf("🔥 Secret destroyed: %s\n", path)
+				offline.forget(owner, path)
 
 			case undelete:
-				if err := vault.UndeleteSecret(ctx, token, path, int64(version)); err != nil {
+				if err := vault.UndeleteSecret(ctx, token, owner, path, int64(version)); err != nil {
 					return fmt.Errorf("failed to undelete version %d: %w", version, err)
 				}
 				fmt.Printf("♻️  Secret version %d restored at: %s\n", version, path)
 
 			default:
-				if err := vault.DeleteSecret(ctx, token, path); err != nil {
+				err := vault.DeleteSecret(ctx, token, owner, path)
+				if isOffline(err) && syncable(owner, path) {
+					if queueErr := offline.queue(cache.Op{Kind: cache.OpDelete, Path: path}); queueErr != nil {
+						return fmt.Errorf("failed to delete secret: %w (%w)", err, queueErr)
+					}
+					fmt.Printf("📥 Server unreachable, the delete of %s is queued; run keeper-agent sync to push it\n",
+						path)
+					return nil
+				}
+				if err != nil {
 					return fmt.Errorf("failed to delete secret: %w", err)
 				}
 				fmt.Printf("🗑️  Secret deleted from: %s\n", path)
+				offline.forget(owner, path)
 			}
 			return nil
 		})
@@ -81,6 +96,7 @@ func init() {
 	deleteCmd.Flags().String(flagPath, "", "Path of the secret to delete")
 	deleteCmd.Flags().String(flagToken, "", flagTokenDescription)
 	deleteCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	deleteCmd.Flags().String(flagOwner, "", flagOwnerDescription)
 
 	deleteCmd.Flags().Bool("destroy", false, "Permanently destroy the secret")
 	deleteCmd.Flags().Bool("metadata", false, "Delete metadata for the secret")
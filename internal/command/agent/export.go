@@ -0,0 +1,265 @@
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"keeper/internal/dto"
+	"keeper/internal/importer"
+	"keeper/internal/kpx"
+	"keeper/internal/service"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagExportOut   = "out"
+	flagAllVersions = "all-versions"
+	flagPassphrase  = "passphrase"
+	flagOnConflict  = "on-conflict"
+	flagFrom        = "from"
+	flagMap         = "map"
+	flagDryRun      = "dry-run"
+	envPassphrase   = "KPX_PASSPHRASE"
+
+	flagPassphraseDescription = "Passphrase of the .kpx file (can also be set via KPX_PASSPHRASE)"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export your secrets to a passphrase-encrypted .kpx file",
+	Long: "Export every secret you can list, file secrets included, to a .kpx file encrypted with a key " +
+		"derived from the passphrase. Only the latest version of each secret is exported unless " +
+		"--all-versions is set; deleted and destroyed versions are left out.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out, _ := cmd.Flags().GetString(flagExportOut)
+		allVersions, _ := cmd.Flags().GetBool(flagAllVersions)
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+		passphrase, err := readPassphrase(cmd)
+		if err != nil {
+			return err
+		}
+
+		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
+			transfer := service.NewVaultTransferService(vault, timeout)
+			header := kpx.Header{ExportedAt: time.Now().UTC(), Source: viper.GetString(flagGrpcAddress)}
+
+			// The file only appears under its name once it is complete.
+			partial := out + ".partial"
+			f, err := os.OpenFile(partial, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, permissionOutFile)
+			if err != nil {
+				return fmt.Errorf("failed to create export file: %w", err)
+			}
+			stats, err := exportTo(f, passphrase, header, func(w *kpx.Writer) (dto.VaultTransferStats, error) {
+				return transfer.Export(cmd.Context(), token, w, allVersions)
+			})
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(partial)
+				return fmt.Errorf("failed to export: %w", err)
+			}
+			if err := os.Rename(partial, out); err != nil {
+				return fmt.Errorf("failed to move export file into place: %w", err)
+			}
+
+			fmt.Printf("✅ Exported %d secrets (%d versions) to %s\n", stats.Secrets, stats.Versions, out)
+			if stats.Skipped > 0 {
+				fmt.Printf("📭 %d paths had no live versions and were left out\n", stats.Skipped)
+			}
+			return nil
+		})
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import secrets from a .kpx file or another password manager",
+	Long: "Recreate the secrets of a .kpx file on this server, oldest version first. With --from the file " +
+		"is an export of another password manager instead; its folders and titles become paths, which " +
+		"--map rewrites. A path that already exists is skipped, overwritten after destroying its " +
+		"versions, or given the imported versions on top of its own, depending on --on-conflict.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		strategy, _ := cmd.Flags().GetString(flagOnConflict)
+		from, _ := cmd.Flags().GetString(flagFrom)
+		mapRules, _ := cmd.Flags().GetStringArray(flagMap)
+		dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to open import file: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+
+		var (
+			source      service.SecretSource
+			unconverted []importer.Unconverted
+		)
+		if from == "" {
+			passphrase, err := readPassphrase(cmd)
+			if err != nil {
+				return err
+			}
+			r, err := kpx.NewReader(f, passphrase)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+			header := r.Header()
+			fmt.Printf("📜 Export of %s from %s\n", header.Source, header.ExportedAt.Local().Format(time.DateTime))
+			source = r
+		} else {
+			rules := make([]importer.Rule, 0, len(mapRules))
+			for _, m := range mapRules {
+				rule, err := importer.ParseRule(m)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				rules = append(rules, rule)
+			}
+			parsed, err := importer.Parse(from, f)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+			var secrets []*kpx.Secret
+			secrets, unconverted = importer.Convert(parsed, from, rules)
+			source = service.SliceSource(secrets)
+		}
+
+		if dryRun {
+			err := printImportPreview(source)
+			printUnconverted(unconverted)
+			return err
+		}
+
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
+			stats, err := service.NewVaultTransferService(vault, timeout).Import(cmd.Context(), token, source, strategy)
+			if stats.Secrets > 0 || err == nil {
+				fmt.Printf("✅ Imported %d secrets (%d versions)\n", stats.Secrets, stats.Versions)
+			}
+			if stats.Skipped > 0 {
+				fmt.Printf("📭 %d existing paths were skipped\n", stats.Skipped)
+			}
+			printUnconverted(unconverted)
+			if err != nil {
+				return fmt.Errorf("failed to import: %w", err)
+			}
+			return nil
+		})
+	},
+}
+
+func printImportPreview(source service.SecretSource) error {
+	const previewFormat = "%-40s %s\n"
+	fmt.Printf(previewFormat, "Path", "Content")
+	fmt.Printf(previewFormat, "----", "-------")
+	count := 0
+	for {
+		secret, err := source.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w", err)
+		}
+		count++
+		for _, v := range secret.Versions {
+			content := fmt.Sprintf("file %s (%d bytes)", v.FileName, len(v.Data))
+			if v.FileName == "" {
+				var value map[string]any
+				if err := json.Unmarshal(v.Data, &value); err != nil {
+					content = "value"
+				} else {
+					content = strings.Join(slices.Sorted(maps.Keys(value)), ", ")
+				}
+			}
+			if len(secret.Versions) > 1 {
+				content = fmt.Sprintf("v%d: %s", v.Version, content)
+			}
+			fmt.Printf(previewFormat, secret.Path, content)
+		}
+	}
+	fmt.Printf("\n🔍 Dry run: %d secrets would be imported\n", count)
+	return nil
+}
+
+func printUnconverted(unconverted []importer.Unconverted) {
+	if len(unconverted) == 0 {
+		return
+	}
+	fmt.Printf("📭 %d items could not be converted:\n", len(unconverted))
+	for _, u := range unconverted {
+		fmt.Printf("  %s: %s\n", u.Item, u.Reason)
+	}
+}
+
+// exportTo seals the file only after fn succeeds, so a failed export never
+// leaves something that reads as a complete one.
+func exportTo(
+	f *os.File,
+	passphrase string,
+	header kpx.Header,
+	fn func(w *kpx.Writer) (dto.VaultTransferStats, error),
+) (dto.VaultTransferStats, error) {
+	w, err := kpx.NewWriter(f, passphrase, header)
+	if err != nil {
+		return dto.VaultTransferStats{}, fmt.Errorf("%w", err)
+	}
+	stats, err := fn(w)
+	if err != nil {
+		return stats, err
+	}
+	if err := w.Close(); err != nil {
+		return stats, fmt.Errorf("%w", err)
+	}
+	if err := f.Sync(); err != nil {
+		return stats, fmt.Errorf("%w", err)
+	}
+	return stats, nil
+}
+
+func readPassphrase(cmd *cobra.Command) (string, error) {
+	passphrase, _ := cmd.Flags().GetString(flagPassphrase)
+	if passphrase == "" {
+		passphrase = os.Getenv(envPassphrase)
+	}
+	if passphrase == "" {
+		return "", errors.New("passphrase is required (--passphrase or KPX_PASSPHRASE)")
+	}
+	return passphrase, nil
+}
+
+func init() {
+	exportCmd.Flags().String(flagExportOut, "", "File to write the export to")
+	exportCmd.Flags().Bool(flagAllVersions, false, "Export every live version instead of only the latest")
+	exportCmd.Flags().String(flagPassphrase, "", flagPassphraseDescription)
+	exportCmd.Flags().String(flagToken, "", flagTokenDescription)
+	exportCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	_ = exportCmd.MarkFlagRequired(flagExportOut)
+
+	importCmd.Flags().String(flagOnConflict, service.ImportSkip,
+		"What to do with paths that already exist: skip, overwrite or new-version")
+	importCmd.Flags().String(flagFrom, "",
+		"Read an export of another password manager: keepass (XML), bitwarden (JSON), 1password or csv")
+	importCmd.Flags().StringArray(flagMap, nil,
+		"Rewrite paths starting with FROM to start with TO, as FROM=TO; first match wins, an empty FROM matches all")
+	importCmd.Flags().Bool(flagDryRun, false, "Show what would be imported without writing anything")
+	importCmd.Flags().String(flagPassphrase, "", flagPassphraseDescription)
+	importCmd.Flags().String(flagToken, "", flagTokenDescription)
+	importCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+}
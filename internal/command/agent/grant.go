@@ -0,0 +1,124 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/service"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagGrantee = "user"
+	flagAccess  = "access"
+)
+
+var grantCmd = &cobra.Command{
+	Use:   "grant",
+	Short: "Share secrets with other users",
+}
+
+var grantAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Grant a user read or write access to a secret or path prefix",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		grantee, _ := cmd.Flags().GetString(flagGrantee)
+		path, _ := cmd.Flags().GetString(flagPath)
+		access, _ := cmd.Flags().GetString(flagAccess)
+
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+
+		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			if err := vault.GrantAccess(ctx, token, grantee, path, access); err != nil {
+				return fmt.Errorf("failed to grant access: %w", err)
+			}
+			fmt.Printf("✅ %s now has %s access to: %s\n", grantee, access, path)
+			return nil
+		})
+	},
+}
+
+var grantRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke access previously granted to a user",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		grantee, _ := cmd.Flags().GetString(flagGrantee)
+		path, _ := cmd.Flags().GetString(flagPath)
+
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+
+		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			if err := vault.RevokeAccess(ctx, token, grantee, path); err != nil {
+				return fmt.Errorf("failed to revoke access: %w", err)
+			}
+			fmt.Printf("🔒 Access for %s revoked on: %s\n", grantee, path)
+			return nil
+		})
+	},
+}
+
+var grantListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List granted and received access",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+
+		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			grants, err := vault.ListGrants(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to list grants: %w", err)
+			}
+
+			const grantFormat = "%-16s %-8s %s\n"
+			fmt.Println("====== Granted by me ======")
+			fmt.Printf(grantFormat, "User", "Access", "Path")
+			fmt.Printf(grantFormat, "----", "------", "----")
+			for _, g := range grants.Granted {
+				fmt.Printf(grantFormat, g.Grantee, g.Access, g.Path)
+			}
+
+			fmt.Println("\n====== Shared with me ======")
+			fmt.Printf(grantFormat, "Owner", "Access", "Path")
+			fmt.Printf(grantFormat, "-----", "------", "----")
+			for _, g := range grants.Received {
+				fmt.Printf(grantFormat, g.Owner, g.Access, g.Path)
+			}
+			return nil
+		})
+	},
+}
+
+func init() {
+	for _, c := range []*cobra.Command{grantAddCmd, grantRevokeCmd, grantListCmd} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+		grantCmd.AddCommand(c)
+	}
+
+	for _, c := range []*cobra.Command{grantAddCmd, grantRevokeCmd} {
+		c.Flags().String(flagGrantee, "", "Login of the user to share with")
+		c.Flags().String(flagPath, "", "Secret path or path prefix (e.g. db/)")
+		_ = c.MarkFlagRequired(flagGrantee)
+		_ = c.MarkFlagRequired(flagPath)
+	}
+	grantAddCmd.Flags().String(flagAccess, "read", "Access level: read or write")
+}
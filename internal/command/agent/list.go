@@ -38,7 +38,7 @@ var listCmd = &cobra.Command{
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
 
-			paths, err := vault.ListSecretPaths(ctx, token)
+			list, err := vault.ListSecretPaths(ctx, token)
 			if err != nil {
 				return fmt.Errorf("failed to list secrets: %w", err)
 			}
@@ -46,14 +46,25 @@ var listCmd = &cobra.Command{
 			fmt.Println("Keys")
 			fmt.Println("----")
 
-			if len(paths) == 0 {
+			if len(list.Paths) == 0 {
 				fmt.Println("No secrets found.")
-				return nil
 			}
 
-			for _, path := range paths {
+			for _, path := range list.Paths {
 				fmt.Println(path)
 			}
+
+			if len(list.Shared) == 0 {
+				return nil
+			}
+
+			const sharedFormat = "%-16s %-8s %s\n"
+			fmt.Println("\n====== Shared with me ======")
+			fmt.Printf(sharedFormat, "Owner", "Access", "Key")
+			fmt.Printf(sharedFormat, "-----", "------", "---")
+			for _, item := range list.Shared {
+				fmt.Printf(sharedFormat, item.Owner, item.Access, item.Path)
+			}
 			return nil
 		})
 	},
@@ -2,12 +2,14 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"keeper/internal/dto"
 	"keeper/internal/service"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var loginCmd = &cobra.Command{
@@ -17,11 +19,29 @@ var loginCmd = &cobra.Command{
 		login, _ := cmd.Flags().GetString(flagLogin)
 		password, _ := cmd.Flags().GetString(flagPassword)
 		tokenFilePath, _ := cmd.Flags().GetString(flagTokenFile)
+		device, _ := cmd.Flags().GetString(flagDevice)
+		code, _ := cmd.Flags().GetString(flagCode)
+
+		// Without --login the client certificate is the credential.
+		withCertificate := login == "" && viper.GetString(flagClientCert) != ""
+		if !withCertificate && (login == "" || password == "") {
+			return errors.New("--login and --password are required unless --client-cert is set")
+		}
 
 		return runWithAuthService(func(auth service.RemoteAuthService, timeout time.Duration) error {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
-			defer cancel()
-			token, err := auth.Login(ctx, dto.LoginUser{Login: login, Password: password})
+			var token dto.AgentTokens
+			var err error
+			if withCertificate {
+				token, err = auth.LoginWithCertificate(ctx, device)
+			} else {
+				token, err = auth.Login(ctx, dto.LoginUser{Login: login, Password: password, DeviceName: device})
+			}
+			cancel()
+			if err != nil {
+				return fmt.Errorf("login failed: %w", err)
+			}
+			token, err = completeTwoFactor(auth, timeout, token, code)
 			if err != nil {
 				return fmt.Errorf("login failed: %w", err)
 			}
@@ -36,10 +56,9 @@ var loginCmd = &cobra.Command{
 }
 
 func init() {
-	loginCmd.Flags().String(flagLogin, "", "User login")
+	loginCmd.Flags().String(flagLogin, "", "User login (omit to log in with --client-cert)")
 	loginCmd.Flags().String(flagPassword, "", "User password")
 	loginCmd.Flags().String(flagTokenFile, defaultTokenFile, "Path to token file")
-
-	_ = loginCmd.MarkFlagRequired(flagLogin)
-	_ = loginCmd.MarkFlagRequired(flagPassword)
+	loginCmd.Flags().String(flagDevice, defaultDeviceName(), "Device name shown in keeper-agent sessions")
+	loginCmd.Flags().String(flagCode, "", "Two-factor or recovery code (prompted if 2FA is enabled and this is empty)")
 }
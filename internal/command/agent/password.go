@@ -0,0 +1,41 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/service"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagOldPassword = "old-password"
+	flagNewPassword = "new-password"
+)
+
+var changePasswordCmd = &cobra.Command{
+	Use:   "change-password",
+	Short: "Change your password and sign out all other sessions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldPassword, _ := cmd.Flags().GetString(flagOldPassword)
+		newPassword, _ := cmd.Flags().GetString(flagNewPassword)
+		return runAuthAction(cmd, func(ctx context.Context, auth service.RemoteAuthService, token string) error {
+			revoked, err := auth.ChangePassword(ctx, token, oldPassword, newPassword)
+			if err != nil {
+				return fmt.Errorf("failed to change password: %w", err)
+			}
+			fmt.Printf("🔑 Password changed, %d other session(s) signed out\n", revoked)
+			return nil
+		})
+	},
+}
+
+func init() {
+	changePasswordCmd.Flags().String(flagToken, "", flagTokenDescription)
+	changePasswordCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	changePasswordCmd.Flags().String(flagOldPassword, "", "Current password")
+	changePasswordCmd.Flags().String(flagNewPassword, "", "New password")
+
+	_ = changePasswordCmd.MarkFlagRequired(flagOldPassword)
+	_ = changePasswordCmd.MarkFlagRequired(flagNewPassword)
+}
@@ -0,0 +1,124 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCommonName = "common-name"
+	flagAltNames   = "alt-names"
+	flagIPSANs     = "ip-sans"
+	flagOutDir     = "out-dir"
+
+	permissionCertFile = 0o644
+)
+
+var pkiCmd = &cobra.Command{
+	Use:   "pki",
+	Short: "Get X.509 certificates from the server's certificate authority",
+}
+
+var pkiIssueCmd = &cobra.Command{
+	Use:   "issue <role>",
+	Short: "Issue a certificate and write its key and CA chain next to it",
+	Long: "Issue a certificate through a role. Writes <name>.crt, <name>.key and <name>-chain.crt " +
+		"to the output directory; the name defaults to the common name.",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := dto.IssueCertificate{Role: args[0]}
+		req.CommonName, _ = cmd.Flags().GetString(flagCommonName)
+		req.AltNames, _ = cmd.Flags().GetStringSlice(flagAltNames)
+		req.IPSANs, _ = cmd.Flags().GetStringSlice(flagIPSANs)
+		req.KeyType, _ = cmd.Flags().GetString(flagKeyType)
+		req.TTL, _ = cmd.Flags().GetDuration(flagTTL)
+		outDir, _ := cmd.Flags().GetString(flagOutDir)
+		name, _ := cmd.Flags().GetString(flagName)
+		if name == "" {
+			name = strings.ReplaceAll(req.CommonName, "*", "wildcard")
+		}
+		return runPKIAction(cmd, func(ctx context.Context, pki service.RemotePKIService, token string) error {
+			issued, err := pki.IssueCertificate(ctx, token, req)
+			if err != nil {
+				return fmt.Errorf("failed to issue certificate: %w", err)
+			}
+
+			files := []struct {
+				path string
+				data string
+				perm os.FileMode
+			}{
+				{filepath.Join(outDir, name+".key"), issued.PrivateKey, permissionOutFile},
+				{filepath.Join(outDir, name+".crt"), issued.Certificate, permissionCertFile},
+				{filepath.Join(outDir, name+"-chain.crt"), issued.Chain, permissionCertFile},
+			}
+			for _, f := range files {
+				if err := os.WriteFile(f.path, []byte(f.data), f.perm); err != nil {
+					return fmt.Errorf("failed to write %s: %w", f.path, err)
+				}
+			}
+			fmt.Printf("✅ Certificate issued: serial %s, expires %s\n",
+				issued.Serial, issued.ExpiresAt.Local().Format(time.DateTime))
+			for _, f := range files {
+				fmt.Printf("   %s\n", f.path)
+			}
+			return nil
+		})
+	},
+}
+
+var pkiRevokeCmd = &cobra.Command{
+	Use:   "revoke <serial>",
+	Short: "Revoke a certificate you were issued",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPKIAction(cmd, func(ctx context.Context, pki service.RemotePKIService, token string) error {
+			if err := pki.RevokeCertificate(ctx, token, args[0]); err != nil {
+				return fmt.Errorf("failed to revoke certificate: %w", err)
+			}
+			fmt.Printf("🔒 Certificate revoked: %s\n", args[0])
+			return nil
+		})
+	},
+}
+
+func runPKIAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, pki service.RemotePKIService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runWithPKIService(func(pki service.RemotePKIService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, pki, token)
+	})
+}
+
+func init() {
+	pkiCmd.AddCommand(pkiIssueCmd, pkiRevokeCmd)
+
+	pkiIssueCmd.Flags().String(flagCommonName, "", "Subject common name, also added as a SAN")
+	pkiIssueCmd.Flags().StringSlice(flagAltNames, nil, "Additional DNS names")
+	pkiIssueCmd.Flags().StringSlice(flagIPSANs, nil, "IP address SANs")
+	pkiIssueCmd.Flags().String(flagKeyType, "", "Key type: ec, rsa or ed25519 (default: the role's first)")
+	pkiIssueCmd.Flags().Duration(flagTTL, 0, "Certificate lifetime (default: the role's ttl, capped at its max)")
+	pkiIssueCmd.Flags().String(flagOutDir, ".", "Directory to write the files to")
+	pkiIssueCmd.Flags().String(flagName, "", "Base name of the files (default: the common name)")
+	_ = pkiIssueCmd.MarkFlagRequired(flagCommonName)
+	for _, c := range []*cobra.Command{pkiIssueCmd, pkiRevokeCmd} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+}
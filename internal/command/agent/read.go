@@ -6,12 +6,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"keeper/internal/cache"
+	"keeper/internal/dto"
 	"keeper/internal/service"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -27,6 +31,7 @@ var readCmd = &cobra.Command{
 		token, _ := cmd.Flags().GetString(flagToken)
 		tokenFile, _ := cmd.Flags().GetString(flagTokenFile)
 		outFile, _ := cmd.Flags().GetString(flagOutFile)
+		owner, _ := cmd.Flags().GetString(flagOwner)
 
 		if token == "" {
 			token = os.Getenv(envAuthToken)
@@ -43,97 +48,128 @@ var readCmd = &cobra.Command{
 			return errors.New(errorTokenRequired)
 		}
 
+		offline := openOfflineCache(token)
 		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
 
-			secret, err := vault.GetSecret(ctx, token, path)
-			if err != nil {
-				return fmt.Errorf("failed to read secret: %w", err)
-			}
-
-			var deletionTime string
-			var destroyed bool
-
-			if secret.DeletedAt != nil {
-				deletionTime = secret.DeletedAt.Format(time.RFC3339)
-				destroyed = true
-			} else {
-				deletionTime = "n/a"
-				destroyed = false
-			}
-
-			createdAt := "n/a"
-			if !secret.CreatedAt.IsZero() {
-				createdAt = secret.CreatedAt.Format(time.RFC3339)
-			}
-			const separator1 = "%-16s %s\n"
-			const separator2 = "%-16s %v\n"
-			const separator3 = "%-12s %s\n"
-			const separator4 = "%-12s %v\n"
-
-			fmt.Println("====== Metadata ======")
-			fmt.Printf(separator1, "Key", "Value")
-			fmt.Printf(separator1, "---", "-----")
-			fmt.Printf(separator1, "created_time", createdAt)
-			fmt.Printf(separator1, "deletion_time", deletionTime)
-			fmt.Printf(separator2, "destroyed", destroyed)
-			fmt.Printf(separator2, "version", secret.Version)
-
-			if secret.FilePath != nil {
-				fmt.Printf(separator1, "Key", "Value")
-				fmt.Printf(separator1, "---", "-----")
-				fmt.Printf(separator4, *secret.FilePath, "FILE-UPLOADED")
-
-				if outFile != "" {
-					if err := os.WriteFile(outFile, secret.Payload, permissionOutFile); err != nil {
-						return fmt.Errorf("failed to write to file: %w", err)
-					}
-					fmt.Printf("\n✅ Secret written to file: %s\n", outFile)
-					return nil
+			secret, err := vault.GetSecret(ctx, token, owner, path)
+			switch {
+			case err == nil:
+				offline.save(owner, secret)
+			case isOffline(err) && syncable(owner, path) && offline.pending(path) != nil:
+				op := offline.pending(path)
+				if op.Kind == cache.OpDelete {
+					return fmt.Errorf("%s was deleted offline, the delete is not synced yet", path)
 				}
-				return nil
-			}
-
-			var encoded string
-			if err := json.Unmarshal(secret.Payload, &encoded); err != nil {
-				return fmt.Errorf("failed to unmarshal payload as string: %w", err)
-			}
-
-			decoded, err := base64.StdEncoding.DecodeString(encoded)
-			if err != nil {
-				return fmt.Errorf("failed to decode base64 payload: %w", err)
-			}
-
-			if outFile != "" {
-				if err := os.WriteFile(outFile, decoded, permissionOutFile); err != nil {
-					return fmt.Errorf("failed to write to file: %w", err)
+				fmt.Printf("⚠️  Server unreachable, showing your offline edit queued at %s, not synced yet\n\n",
+					op.QueuedAt.Local().Format(time.DateTime))
+				if secret, err = queuedSecret(op); err != nil {
+					return err
 				}
-				fmt.Printf("\n✅ Secret written to file: %s\n", outFile)
-				return nil
-			}
-
-			var data map[string]interface{}
-			if err := json.Unmarshal(decoded, &data); err != nil {
-				return fmt.Errorf("failed to decode JSON string payload: %w", err)
+			case isOffline(err):
+				entry, cacheErr := offline.load(owner, path)
+				if cacheErr != nil {
+					return fmt.Errorf("failed to read secret: %w (%w)", err, cacheErr)
+				}
+				fmt.Printf("⚠️  Server unreachable, showing a STALE cached copy: version %d, cached %s ago (%s)\n\n",
+					entry.Version, entry.Age(time.Now()).Round(time.Second), entry.CachedAt.Local().Format(time.DateTime))
+				secret = cachedSecret(entry)
+			default:
+				// The server refused the read, so a copy it may no longer
+				// allow is not kept. An expired login says nothing about it.
+				if status.Code(err) != codes.Unauthenticated {
+					offline.forget(owner, path)
+				}
+				return fmt.Errorf("failed to read secret: %w", err)
 			}
+			return printSecret(secret, outFile)
+		})
+	},
+}
 
-			fmt.Println("\n====== Data ======")
-			fmt.Printf(separator3, "Key", "Value")
-			fmt.Printf(separator3, "---", "-----")
-			for k, v := range data {
-				fmt.Printf(separator4, k, v)
+func printSecret(secret *dto.AgentGetSecret, outFile string) error {
+	var deletionTime string
+	var destroyed bool
+
+	if secret.DeletedAt != nil {
+		deletionTime = secret.DeletedAt.Format(time.RFC3339)
+		destroyed = true
+	} else {
+		deletionTime = "n/a"
+		destroyed = false
+	}
+
+	createdAt := "n/a"
+	if !secret.CreatedAt.IsZero() {
+		createdAt = secret.CreatedAt.Format(time.RFC3339)
+	}
+	const separator1 = "%-16s %s\n"
+	const separator2 = "%-16s %v\n"
+	const separator3 = "%-12s %s\n"
+	const separator4 = "%-12s %v\n"
+
+	fmt.Println("====== Metadata ======")
+	fmt.Printf(separator1, "Key", "Value")
+	fmt.Printf(separator1, "---", "-----")
+	fmt.Printf(separator1, "created_time", createdAt)
+	fmt.Printf(separator1, "deletion_time", deletionTime)
+	fmt.Printf(separator2, "destroyed", destroyed)
+	fmt.Printf(separator2, "version", secret.Version)
+
+	if secret.FilePath != nil {
+		fmt.Printf(separator1, "Key", "Value")
+		fmt.Printf(separator1, "---", "-----")
+		fmt.Printf(separator4, *secret.FilePath, "FILE-UPLOADED")
+
+		if outFile != "" {
+			if err := os.WriteFile(outFile, secret.Payload, permissionOutFile); err != nil {
+				return fmt.Errorf("failed to write to file: %w", err)
 			}
-
+			fmt.Printf("\n✅ Secret written to file: %s\n", outFile)
 			return nil
-		})
-	},
+		}
+		return nil
+	}
+
+	var encoded string
+	if err := json.Unmarshal(secret.Payload, &encoded); err != nil {
+		return fmt.Errorf("failed to unmarshal payload as string: %w", err)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to decode base64 payload: %w", err)
+	}
+
+	if outFile != "" {
+		if err := os.WriteFile(outFile, decoded, permissionOutFile); err != nil {
+			return fmt.Errorf("failed to write to file: %w", err)
+		}
+		fmt.Printf("\n✅ Secret written to file: %s\n", outFile)
+		return nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(decoded, &data); err != nil {
+		return fmt.Errorf("failed to decode JSON string payload: %w", err)
+	}
+
+	fmt.Println("\n====== Data ======")
+	fmt.Printf(separator3, "Key", "Value")
+	fmt.Printf(separator3, "---", "-----")
+	for k, v := range data {
+		fmt.Printf(separator4, k, v)
+	}
+
+	return nil
 }
 
 func init() {
 	readCmd.Flags().String(flagKeyName, "", "Path of the secret to read")
 	readCmd.Flags().String(flagToken, "", flagTokenDescription)
 	readCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	readCmd.Flags().String(flagOwner, "", flagOwnerDescription)
 	readCmd.Flags().String(flagOutFile, "", "Optional path to write the secret payload to a file")
 
 	_ = readCmd.MarkFlagRequired(flagKeyName)
@@ -17,11 +17,12 @@ var registerCmd = &cobra.Command{
 		login, _ := cmd.Flags().GetString(flagLogin)
 		password, _ := cmd.Flags().GetString(flagPassword)
 		tokenFilePath, _ := cmd.Flags().GetString("token-file")
+		device, _ := cmd.Flags().GetString(flagDevice)
 
 		return runWithAuthService(func(auth service.RemoteAuthService, timeout time.Duration) error {
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
-			token, err := auth.Register(ctx, dto.RegisterUser{Login: login, Password: password})
+			token, err := auth.Register(ctx, dto.RegisterUser{Login: login, Password: password, DeviceName: device})
 			if err != nil {
 				return fmt.Errorf("registration failed: %w", err)
 			}
@@ -39,6 +40,7 @@ func init() {
 	registerCmd.Flags().String(flagLogin, "", "Login for new user")
 	registerCmd.Flags().String(flagPassword, "", "Password for new user")
 	registerCmd.Flags().String(flagTokenFile, defaultTokenFile, "Path to token file")
+	registerCmd.Flags().String(flagDevice, defaultDeviceName(), "Device name shown in keeper-agent sessions")
 
 	_ = registerCmd.MarkFlagRequired(flagLogin)
 	_ = registerCmd.MarkFlagRequired(flagPassword)
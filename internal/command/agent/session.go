@@ -0,0 +1,125 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/cache"
+	"keeper/internal/client"
+	"keeper/internal/service"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagDevice    = "device"
+	flagSessionID = "id"
+)
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Revoke the current token on the server and remove the token file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tokenFilePath, _ := cmd.Flags().GetString(flagTokenFile)
+		return runAuthAction(cmd, func(ctx context.Context, auth service.RemoteAuthService, token string) error {
+			// The local state goes even if the server can't be told, so a
+			// failed logout doesn't leave the secrets of this user behind.
+			logoutErr := auth.Logout(ctx, token)
+			if err := client.RemoveTokens(tokenFilePath); err != nil {
+				return fmt.Errorf("failed to remove tokens: %w", err)
+			}
+			// Cached secrets belong to the session that read them.
+			if userID, err := client.TokenUserID(token); err == nil {
+				warnDiscardedEdits(token)
+				if err := cache.ClearUser(viper.GetString(flagCacheDir), userID); err != nil {
+					return fmt.Errorf("failed to clear offline cache: %w", err)
+				}
+			}
+			if logoutErr != nil {
+				return fmt.Errorf("logout failed, local tokens and cache removed: %w", logoutErr)
+			}
+			fmt.Println("👋 Logged out.")
+			return nil
+		})
+	},
+}
+
+var sessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "List your active sessions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runAuthAction(cmd, func(ctx context.Context, auth service.RemoteAuthService, token string) error {
+			sessions, err := auth.ListSessions(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to list sessions: %w", err)
+			}
+
+			const sessionFormat = "%-6s %-24s %-16s %-20s %s\n"
+			fmt.Printf(sessionFormat, "ID", "Device", "Last IP", "Last used", "")
+			fmt.Printf(sessionFormat, "--", "------", "-------", "---------", "")
+			for _, s := range sessions {
+				lastUsed := "never"
+				if !s.LastUsedAt.IsZero() {
+					lastUsed = s.LastUsedAt.Local().Format(time.DateTime)
+				}
+				current := ""
+				if s.Current {
+					current = "(current)"
+				}
+				fmt.Printf(sessionFormat, fmt.Sprint(s.ID), s.DeviceName, s.LastIP, lastUsed, current)
+			}
+			return nil
+		})
+	},
+}
+
+var sessionsRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke one of your sessions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sessionID, _ := cmd.Flags().GetInt64(flagSessionID)
+		return runAuthAction(cmd, func(ctx context.Context, auth service.RemoteAuthService, token string) error {
+			if err := auth.RevokeSession(ctx, token, sessionID); err != nil {
+				return fmt.Errorf("failed to revoke session: %w", err)
+			}
+			fmt.Printf("🔒 Session %d revoked\n", sessionID)
+			return nil
+		})
+	},
+}
+
+func runAuthAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, auth service.RemoteAuthService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+	return runWithAuthService(func(auth service.RemoteAuthService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, auth, token)
+	})
+}
+
+// defaultDeviceName labels new sessions with the machine's hostname.
+func defaultDeviceName() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return hostname
+}
+
+func init() {
+	for _, c := range []*cobra.Command{logoutCmd, sessionsCmd, sessionsRevokeCmd} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+	sessionsRevokeCmd.Flags().Int64(flagSessionID, 0, "Session ID from keeper-agent sessions")
+	_ = sessionsRevokeCmd.MarkFlagRequired(flagSessionID)
+	sessionsCmd.AddCommand(sessionsRevokeCmd)
+}
@@ -0,0 +1,128 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagTTL         = "ttl"
+	unwrapURLMarker = "/unwrap/"
+)
+
+var shareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Create a single-use link to a secret or to an ad-hoc value",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString(flagPath)
+		owner, _ := cmd.Flags().GetString(flagOwner)
+		value, _ := cmd.Flags().GetString(flagKeyValue)
+		ttl, _ := cmd.Flags().GetDuration(flagTTL)
+		if (path == "") == (value == "") {
+			return fmt.Errorf("exactly one of --%s and --%s is required", flagPath, flagKeyValue)
+		}
+
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+
+		return runWithWrapService(func(wrap service.RemoteWrapService, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			wrapped, err := wrap.Wrap(ctx, token, dto.WrapSecret{
+				Owner: owner,
+				Path:  path,
+				Value: []byte(value),
+				TTL:   ttl,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to share secret: %w", err)
+			}
+
+			fmt.Printf("✅ Wrapping token: %s\n", wrapped.Token)
+			fmt.Printf("Expires at: %s\n", wrapped.ExpiresAt.Local().Format(time.DateTime))
+			if wrapped.URL != "" {
+				fmt.Printf("Link: %s\n", wrapped.URL)
+			}
+			fmt.Printf("It can be opened once with: keeper-agent unwrap %s\n", wrapped.Token)
+			return nil
+		})
+	},
+}
+
+var unwrapCmd = &cobra.Command{
+	Use:   "unwrap <token>",
+	Short: "Reveal a shared secret; the token stops working afterwards",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		outFile, _ := cmd.Flags().GetString(flagOutFile)
+		token := args[0]
+		// Accept the whole share link as well as the bare token.
+		if i := strings.LastIndex(token, unwrapURLMarker); i >= 0 {
+			token = token[i+len(unwrapURLMarker):]
+		}
+
+		return runWithWrapService(func(wrap service.RemoteWrapService, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			secret, err := wrap.Unwrap(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to unwrap: %w", err)
+			}
+
+			const separator = "%-12s %v\n"
+			if secret.Path != "" {
+				fmt.Printf(separator, "path", secret.Path)
+			}
+			if secret.Description != "" {
+				fmt.Printf(separator, "description", secret.Description)
+			}
+
+			if outFile != "" || secret.FileName != "" {
+				if outFile == "" {
+					outFile = secret.FileName
+				}
+				if err := os.WriteFile(outFile, secret.Value, permissionOutFile); err != nil {
+					return fmt.Errorf("failed to write to file: %w", err)
+				}
+				fmt.Printf("✅ Secret written to file: %s\n", outFile)
+				return nil
+			}
+
+			var data map[string]interface{}
+			if err := json.Unmarshal(secret.Value, &data); err != nil {
+				fmt.Println(string(secret.Value))
+				return nil
+			}
+			fmt.Println("\n====== Data ======")
+			fmt.Printf(separator, "Key", "Value")
+			fmt.Printf(separator, "---", "-----")
+			for k, v := range data {
+				fmt.Printf(separator, k, v)
+			}
+			return nil
+		})
+	},
+}
+
+func init() {
+	shareCmd.Flags().String(flagPath, "", "Path of the secret to share")
+	shareCmd.Flags().String(flagOwner, "", flagOwnerDescription)
+	shareCmd.Flags().String(flagKeyValue, "", "Share this value instead of a stored secret")
+	shareCmd.Flags().Duration(flagTTL, service.DefaultWrapTTL, "How long the link stays valid (at most 168h)")
+	shareCmd.Flags().String(flagToken, "", flagTokenDescription)
+	shareCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+
+	unwrapCmd.Flags().String(flagOutFile, "", "Write the secret to this file instead of printing it")
+}
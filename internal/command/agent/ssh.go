@@ -0,0 +1,104 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPubkey     = "pubkey"
+	flagPrincipals = "principals"
+
+	defaultSSHRole = "default"
+)
+
+var sshCmd = &cobra.Command{
+	Use:   "ssh",
+	Short: "Get short-lived SSH certificates signed by the server",
+}
+
+var sshSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign a public key and write the -cert.pub file next to it",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pubkeyFile, _ := cmd.Flags().GetString(flagPubkey)
+		outFile, _ := cmd.Flags().GetString(flagOutFile)
+		if outFile == "" {
+			outFile = strings.TrimSuffix(pubkeyFile, ".pub") + "-cert.pub"
+		}
+		pubkey, err := os.ReadFile(pubkeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read public key: %w", err)
+		}
+		req := dto.SignSSHKey{PublicKey: string(pubkey)}
+		req.Role, _ = cmd.Flags().GetString(flagRole)
+		req.Principals, _ = cmd.Flags().GetStringSlice(flagPrincipals)
+		req.TTL, _ = cmd.Flags().GetDuration(flagTTL)
+		return runSSHAction(cmd, func(ctx context.Context, ssh service.RemoteSSHService, token string) error {
+			signed, err := ssh.SignKey(ctx, token, req)
+			if err != nil {
+				return fmt.Errorf("failed to sign key: %w", err)
+			}
+			if err := os.WriteFile(outFile, []byte(signed.Certificate), permissionCertFile); err != nil {
+				return fmt.Errorf("failed to write %s: %w", outFile, err)
+			}
+			fmt.Printf("✅ Certificate written: %s\n", outFile)
+			fmt.Printf("   principals %s, valid until %s\n",
+				strings.Join(signed.Principals, ", "), signed.ExpiresAt.Local().Format(time.DateTime))
+			return nil
+		})
+	},
+}
+
+var sshCACmd = &cobra.Command{
+	Use:   "ca",
+	Short: "Print the CA public key for sshd's TrustedUserCAKeys",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSSHAction(cmd, func(ctx context.Context, ssh service.RemoteSSHService, token string) error {
+			key, err := ssh.CAPublicKey(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to get ca key: %w", err)
+			}
+			fmt.Println(key)
+			return nil
+		})
+	},
+}
+
+func runSSHAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, ssh service.RemoteSSHService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runWithSSHService(func(ssh service.RemoteSSHService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, ssh, token)
+	})
+}
+
+func init() {
+	sshCmd.AddCommand(sshSignCmd, sshCACmd)
+
+	sshSignCmd.Flags().String(flagPubkey, "", "Public key to sign, e.g. ~/.ssh/id_ed25519.pub")
+	sshSignCmd.Flags().String(flagRole, defaultSSHRole, "Role to sign through")
+	sshSignCmd.Flags().StringSlice(flagPrincipals, nil, "Principals to request (default: the role's defaults)")
+	sshSignCmd.Flags().Duration(flagTTL, 0, "Validity (default: the role's ttl, capped at its max)")
+	sshSignCmd.Flags().String(flagOutFile, "", "Certificate file (default: the key file with -cert.pub)")
+	_ = sshSignCmd.MarkFlagRequired(flagPubkey)
+	for _, c := range []*cobra.Command{sshSignCmd, sshCACmd} {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+}
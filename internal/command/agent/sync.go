@@ -0,0 +1,464 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeper/internal/cache"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	flagFullSync = "full"
+
+	conflictServer   = "server"
+	conflictLocal    = "local"
+	conflictKeepBoth = "keep-both"
+	conflictAsk      = "ask"
+)
+
+var conflictPolicies = []string{conflictServer, conflictLocal, conflictKeepBoth, conflictAsk}
+
+// syncable reports whether edits of path are replicated: sync covers the
+// caller's own vault, not secrets shared by others or team namespaces.
+func syncable(owner, path string) bool {
+	return owner == "" && !strings.HasPrefix(path, service.TeamPathPrefix)
+}
+
+// queue records an edit made while the server is unreachable, based on the
+// latest version the replica knows, or failing that the cached one.
+func (c *offlineCache) queue(op cache.Op) error {
+	if c.store == nil {
+		return c.disabled
+	}
+	replica, err := c.store.LoadReplica(c.server)
+	if err != nil {
+		return fmt.Errorf("failed to queue edit: %w", err)
+	}
+	op.QueuedAt = time.Now()
+	op.Base = replica.Base(op.Path)
+	if op.Base == 0 {
+		if e, err := c.store.Get(c.server, "", op.Path); err == nil {
+			op.Base = e.Version
+		}
+	}
+	replica.Queue(op)
+	if err := c.store.SaveReplica(replica); err != nil {
+		return fmt.Errorf("failed to queue edit: %w", err)
+	}
+	return nil
+}
+
+// pending returns the last edit of path still waiting for sync, if any.
+func (c *offlineCache) pending(path string) *cache.Op {
+	if c.store == nil {
+		return nil
+	}
+	replica, err := c.store.LoadReplica(c.server)
+	if err != nil {
+		return nil
+	}
+	for i := len(replica.Pending) - 1; i >= 0; i-- {
+		if replica.Pending[i].Path == path {
+			return &replica.Pending[i]
+		}
+	}
+	return nil
+}
+
+// pendingEdits counts the edits of the token's user not yet pushed to the
+// configured server.
+func pendingEdits(token string) int {
+	if _, err := os.Stat(viper.GetString(flagCacheDir)); err != nil {
+		return 0
+	}
+	offline := openOfflineCache(token)
+	if offline.store == nil {
+		return 0
+	}
+	replica, err := offline.store.LoadReplica(offline.server)
+	if err != nil {
+		return 0
+	}
+	return len(replica.Pending)
+}
+
+// warnDiscardedEdits is called before the cache is cleared, which drops the
+// edits that were never synced along with everything else.
+func warnDiscardedEdits(token string) {
+	if n := pendingEdits(token); n > 0 {
+		fmt.Fprintf(os.Stderr, "⚠️  Discarding %d offline edits that were never synced\n", n)
+	}
+}
+
+// queuedSecret shows a queued write the way read shows a secret.
+func queuedSecret(op *cache.Op) (*dto.AgentGetSecret, error) {
+	payload, err := json.Marshal(op.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode queued edit: %w", err)
+	}
+	return &dto.AgentGetSecret{
+		ExpiredAt:   op.ExpiredAt,
+		Path:        op.Path,
+		Description: op.Description,
+		Payload:     payload,
+		Version:     op.Base,
+	}, nil
+}
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Push edits made offline and pull changes into the local replica",
+	Long: "Keeps a local replica of your own vault in the offline cache. Edits made with write or delete " +
+		"while the server is unreachable are queued and pushed here, each checked against the version it " +
+		"was based on. When the server changed the same path since, the conflict is resolved by " +
+		"--on-conflict: server keeps the server's version, local puts yours on top and soft-deletes the " +
+		"server's newer versions, keep-both puts yours on top and keeps the server's versions live, and " +
+		"ask prompts for each conflict. Then every change since the last sync is pulled.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+		policy, _ := cmd.Flags().GetString(flagOnConflict)
+		if !slices.Contains(conflictPolicies, policy) {
+			return fmt.Errorf("unknown --%s %q, use one of %s", flagOnConflict, policy,
+				strings.Join(conflictPolicies, ", "))
+		}
+		full, _ := cmd.Flags().GetBool(flagFullSync)
+
+		offline := openOfflineCache(token)
+		if offline.store == nil {
+			return fmt.Errorf("sync keeps the replica in the offline cache: %w", offline.disabled)
+		}
+		replica, err := offline.store.LoadReplica(offline.server)
+		if err != nil {
+			return fmt.Errorf("failed to open replica: %w", err)
+		}
+		if full {
+			replica.Cursor = 0
+			replica.Versions = map[string]int64{}
+		}
+
+		return runWithVaultService(func(vault service.RemoteVaultService, timeout time.Duration) error {
+			s := &syncer{
+				vault:   vault,
+				offline: offline,
+				replica: replica,
+				in:      bufio.NewReader(os.Stdin),
+				policy:  policy,
+				token:   token,
+				timeout: timeout,
+			}
+			if err := s.push(); err != nil {
+				return err
+			}
+			if err := s.pull(); err != nil {
+				return err
+			}
+			fmt.Printf("🔄 Synced with %s: %d pushed, %d conflicts, %d changes pulled\n",
+				offline.server, s.pushed, s.conflicts, s.pulled)
+			if s.failed > 0 {
+				return fmt.Errorf("%d offline edits could not be pushed and stay queued, cache clear discards them",
+					s.failed)
+			}
+			return nil
+		})
+	},
+}
+
+type syncer struct {
+	vault   service.RemoteVaultService
+	offline *offlineCache
+	replica *cache.Replica
+	in      *bufio.Reader
+	policy  string
+	token   string
+	timeout time.Duration
+
+	pushed    int
+	conflicts int
+	pulled    int
+	failed    int
+}
+
+func (s *syncer) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
+
+// push sends the queued edits, the last one of every path. An edit that is
+// pushed or resolved is dropped from the queue right away, so an interrupted
+// sync doesn't push it twice.
+func (s *syncer) push() error {
+	// The token decides whose vault the edits land in, so a queue recorded
+	// for anyone else is never pushed with it.
+	userID, err := client.TokenUserID(s.token)
+	if err != nil {
+		return fmt.Errorf("failed to check the owner of the queue: %w", err)
+	}
+	if len(s.replica.Pending) > 0 && s.replica.UserID != userID {
+		return fmt.Errorf("the offline edits were queued by user %d, log in as them to push", s.replica.UserID)
+	}
+	for _, op := range s.replica.Coalesced() {
+		var err error
+		if op.Kind == cache.OpDelete {
+			err = s.pushDelete(op)
+		} else {
+			err = s.pushWrite(op)
+		}
+		if isOffline(err) {
+			return fmt.Errorf("server unreachable, %d offline edits stay queued: %w",
+				len(s.replica.Coalesced()), err)
+		}
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", op.Path, err)
+			s.failed++
+			continue
+		}
+		s.replica.Done(op.Path)
+		if err := s.offline.store.SaveReplica(s.replica); err != nil {
+			return fmt.Errorf("failed to update replica: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *syncer) save(op cache.Op, cas int64) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	err := s.vault.SaveSecret(ctx, &dto.AgentCreateSecret{
+		Token:       s.token,
+		Path:        op.Path,
+		Description: op.Description,
+		Payload:     op.Payload,
+		ExpiredAt:   op.ExpiredAt,
+		CAS:         &cas,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save %s: %w", op.Path, err)
+	}
+	return nil
+}
+
+func (s *syncer) versions(path string) ([]dto.AgentSecretVersion, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	versions, err := s.vault.ListSecretVersions(ctx, s.token, "", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", path, err)
+	}
+	return versions, nil
+}
+
+func (s *syncer) deleteVersions(path string, versions []int64) error {
+	if len(versions) == 0 {
+		return nil
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	if err := s.vault.DeleteVersions(ctx, s.token, "", path, versions); err != nil {
+		return fmt.Errorf("failed to delete versions of %s: %w", path, err)
+	}
+	return nil
+}
+
+// pushWrite writes op only if the server is still at op.Base, which is how
+// a conflict shows up.
+func (s *syncer) pushWrite(op cache.Op) error {
+	err := s.save(op, op.Base)
+	if err == nil {
+		fmt.Printf("⬆️  %s: pushed your offline edit\n", op.Path)
+		s.pushed++
+		return nil
+	}
+	if status.Code(err) != codes.FailedPrecondition {
+		return err
+	}
+
+	versions, err := s.versions(op.Path)
+	if err != nil {
+		return err
+	}
+	latest := latestVersion(versions)
+	choice, err := s.resolve(op, latest)
+	if err != nil {
+		return err
+	}
+	if choice == conflictServer {
+		fmt.Printf("↩️  %s: kept the server's version %d, dropped your offline edit\n", op.Path, latest)
+		return nil
+	}
+
+	// On top of the version just seen; a write in between is a new conflict
+	// for the next sync rather than something to overwrite.
+	if err := s.save(op, latest); err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return errors.New("changed again on the server during sync, run sync again")
+		}
+		return err
+	}
+	s.pushed++
+	if choice == conflictKeepBoth {
+		fmt.Printf("🔀 %s: kept both, the server's version %d and yours as version %d\n",
+			op.Path, latest, latest+1)
+		return nil
+	}
+	newer := liveVersions(versions, func(v int64) bool { return v > op.Base })
+	if err := s.deleteVersions(op.Path, newer); err != nil {
+		return err
+	}
+	fmt.Printf("⬆️  %s: your offline edit is version %d, the server's versions %v are soft-deleted\n",
+		op.Path, latest+1, newer)
+	return nil
+}
+
+// pushDelete deletes every live version if the server is still at op.Base.
+func (s *syncer) pushDelete(op cache.Op) error {
+	versions, err := s.versions(op.Path)
+	if err != nil {
+		return err
+	}
+	live := liveVersions(versions, func(int64) bool { return true })
+	if len(live) == 0 {
+		fmt.Printf("🗑️  %s: already deleted on the server\n", op.Path)
+		return nil
+	}
+
+	latest := latestVersion(versions)
+	choice := conflictLocal
+	if latest != op.Base {
+		if choice, err = s.resolve(op, latest); err != nil {
+			return err
+		}
+	}
+	switch choice {
+	case conflictServer:
+		fmt.Printf("↩️  %s: kept the server's version %d, dropped your offline delete\n", op.Path, latest)
+		return nil
+	case conflictKeepBoth:
+		// Delete what the offline edit saw and keep what came after it.
+		live = liveVersions(versions, func(v int64) bool { return v <= op.Base })
+	}
+	if err := s.deleteVersions(op.Path, live); err != nil {
+		return err
+	}
+	fmt.Printf("🗑️  %s: deleted versions %v\n", op.Path, live)
+	s.pushed++
+	return nil
+}
+
+// resolve picks how a conflict on op is settled, asking when --on-conflict
+// is ask.
+func (s *syncer) resolve(op cache.Op, latest int64) (string, error) {
+	s.conflicts++
+	if s.policy != conflictAsk {
+		return s.policy, nil
+	}
+	for {
+		fmt.Printf("⚡ Conflict on %s: you queued a %s at %s based on version %d, the server is at version %d.\n",
+			op.Path, op.Kind, op.QueuedAt.Local().Format(time.DateTime), op.Base, latest)
+		fmt.Print("   Keep [s]erver, [l]ocal or [b]oth? ")
+		line, err := s.in.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "s", conflictServer:
+			return conflictServer, nil
+		case "l", conflictLocal:
+			return conflictLocal, nil
+		case "b", "both", conflictKeepBoth:
+			return conflictKeepBoth, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read the conflict resolution: %w", err)
+		}
+	}
+}
+
+// pull applies every change since the replica's cursor to the replica and
+// the cached values, and moves the cursor once the last page is in.
+func (s *syncer) pull() error {
+	page := dto.ChangesPage{Cursor: s.replica.Cursor}
+	for {
+		ctx, cancel := s.context()
+		changes, err := s.vault.GetChangesSince(ctx, s.token, page)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to pull changes: %w", err)
+		}
+		for i := range changes.Changes {
+			s.apply(&changes.Changes[i])
+		}
+		if !changes.HasMore {
+			s.replica.Cursor = changes.Cursor
+			break
+		}
+		page = dto.ChangesPage{Cursor: page.Cursor, Horizon: changes.Horizon, AfterID: changes.AfterID}
+	}
+	s.replica.SyncedAt = time.Now()
+	if err := s.offline.store.SaveReplica(s.replica); err != nil {
+		return fmt.Errorf("failed to update replica: %w", err)
+	}
+	return nil
+}
+
+func (s *syncer) apply(c *dto.AgentSecretChange) {
+	s.pulled++
+	s.replica.Versions[c.Path] = c.LatestVersion
+	switch {
+	case c.Deleted:
+		s.offline.forget("", c.Path)
+	case c.FilePath != "":
+		// Files are not part of the feed; a stale copy is dropped and the
+		// next read caches the new one.
+		if e, err := s.offline.load("", c.Path); err == nil && e.Version != c.Version {
+			s.offline.forget("", c.Path)
+		}
+	default:
+		s.offline.save("", &dto.AgentGetSecret{
+			ExpiredAt:   c.ExpiredAt,
+			CreatedAt:   c.CreatedAt,
+			Path:        c.Path,
+			Description: c.Description,
+			Payload:     c.Payload,
+			Version:     c.Version,
+		})
+	}
+}
+
+func latestVersion(versions []dto.AgentSecretVersion) int64 {
+	var latest int64
+	for _, v := range versions {
+		latest = max(latest, v.Version)
+	}
+	return latest
+}
+
+func liveVersions(versions []dto.AgentSecretVersion, keep func(int64) bool) []int64 {
+	var live []int64
+	for _, v := range versions {
+		if v.DeletedAt == nil && !v.Destroyed && keep(v.Version) {
+			live = append(live, v.Version)
+		}
+	}
+	return live
+}
+
+func init() {
+	syncCmd.Flags().String(flagToken, "", flagTokenDescription)
+	syncCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	syncCmd.Flags().String(flagOnConflict, conflictAsk,
+		"How to settle a path changed on both sides: server, local, keep-both or ask")
+	syncCmd.Flags().Bool(flagFullSync, false, "Pull the whole vault instead of the changes since the last sync")
+}
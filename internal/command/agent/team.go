@@ -0,0 +1,170 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/service"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagTeam   = "team"
+	flagMember = "user"
+	flagRole   = "role"
+)
+
+var teamCmd = &cobra.Command{
+	Use:   "team",
+	Short: "Manage teams and their shared team/<name>/ namespace",
+}
+
+var teamCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a team, you become its owner",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		team, _ := cmd.Flags().GetString(flagTeam)
+		return runTeamAction(cmd, func(ctx context.Context, teams service.RemoteTeamService, token string) error {
+			if err := teams.CreateTeam(ctx, token, team); err != nil {
+				return fmt.Errorf("failed to create team: %w", err)
+			}
+			fmt.Printf("✅ Team created: %s (secrets live under team/%s/)\n", team, team)
+			return nil
+		})
+	},
+}
+
+var teamDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a team together with all of its secrets",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		team, _ := cmd.Flags().GetString(flagTeam)
+		return runTeamAction(cmd, func(ctx context.Context, teams service.RemoteTeamService, token string) error {
+			if err := teams.DeleteTeam(ctx, token, team); err != nil {
+				return fmt.Errorf("failed to delete team: %w", err)
+			}
+			fmt.Printf("🗑️  Team deleted: %s\n", team)
+			return nil
+		})
+	},
+}
+
+var teamAddMemberCmd = &cobra.Command{
+	Use:   "add-member",
+	Short: "Add a member to a team or change their role",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		team, _ := cmd.Flags().GetString(flagTeam)
+		login, _ := cmd.Flags().GetString(flagMember)
+		role, _ := cmd.Flags().GetString(flagRole)
+		return runTeamAction(cmd, func(ctx context.Context, teams service.RemoteTeamService, token string) error {
+			if err := teams.AddMember(ctx, token, team, login, role); err != nil {
+				return fmt.Errorf("failed to add member: %w", err)
+			}
+			fmt.Printf("✅ %s is now %s of team %s\n", login, role, team)
+			return nil
+		})
+	},
+}
+
+var teamRemoveMemberCmd = &cobra.Command{
+	Use:   "remove-member",
+	Short: "Remove a member from a team",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		team, _ := cmd.Flags().GetString(flagTeam)
+		login, _ := cmd.Flags().GetString(flagMember)
+		return runTeamAction(cmd, func(ctx context.Context, teams service.RemoteTeamService, token string) error {
+			if err := teams.RemoveMember(ctx, token, team, login); err != nil {
+				return fmt.Errorf("failed to remove member: %w", err)
+			}
+			fmt.Printf("🔒 %s removed from team %s\n", login, team)
+			return nil
+		})
+	},
+}
+
+var teamListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your teams",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTeamAction(cmd, func(ctx context.Context, teams service.RemoteTeamService, token string) error {
+			list, err := teams.ListTeams(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to list teams: %w", err)
+			}
+
+			const teamFormat = "%-24s %s\n"
+			fmt.Printf(teamFormat, "Team", "Role")
+			fmt.Printf(teamFormat, "----", "----")
+			if len(list) == 0 {
+				fmt.Println("No teams found.")
+			}
+			for _, t := range list {
+				fmt.Printf(teamFormat, t.Name, t.Role)
+			}
+			return nil
+		})
+	},
+}
+
+var teamMembersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "List members of a team",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		team, _ := cmd.Flags().GetString(flagTeam)
+		return runTeamAction(cmd, func(ctx context.Context, teams service.RemoteTeamService, token string) error {
+			members, err := teams.ListMembers(ctx, token, team)
+			if err != nil {
+				return fmt.Errorf("failed to list members: %w", err)
+			}
+
+			const memberFormat = "%-24s %s\n"
+			fmt.Printf(memberFormat, "User", "Role")
+			fmt.Printf(memberFormat, "----", "----")
+			for _, m := range members {
+				fmt.Printf(memberFormat, m.Login, m.Role)
+			}
+			return nil
+		})
+	},
+}
+
+func runTeamAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, teams service.RemoteTeamService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runWithTeamService(func(teams service.RemoteTeamService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, teams, token)
+	})
+}
+
+func init() {
+	subcommands := []*cobra.Command{
+		teamCreateCmd, teamDeleteCmd, teamAddMemberCmd, teamRemoveMemberCmd, teamListCmd, teamMembersCmd,
+	}
+	for _, c := range subcommands {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+		teamCmd.AddCommand(c)
+	}
+
+	for _, c := range []*cobra.Command{
+		teamCreateCmd, teamDeleteCmd, teamAddMemberCmd, teamRemoveMemberCmd, teamMembersCmd,
+	} {
+		c.Flags().String(flagTeam, "", "Team name")
+		_ = c.MarkFlagRequired(flagTeam)
+	}
+
+	for _, c := range []*cobra.Command{teamAddMemberCmd, teamRemoveMemberCmd} {
+		c.Flags().String(flagMember, "", "Login of the member")
+		_ = c.MarkFlagRequired(flagMember)
+	}
+	teamAddMemberCmd.Flags().String(flagRole, "viewer", "Member role: owner, editor or viewer")
+}
@@ -0,0 +1,186 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/service"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagKeyType    = "type"
+	flagPlaintext  = "plaintext"
+	flagInFile     = "in-file"
+	flagCiphertext = "ciphertext"
+)
+
+var transitCmd = &cobra.Command{
+	Use:   "transit",
+	Short: "Encrypt and decrypt data with keys kept on the server",
+}
+
+var transitCreateKeyCmd = &cobra.Command{
+	Use:   "create-key",
+	Short: "Create a named key for you or for one of your teams",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		keyType, _ := cmd.Flags().GetString(flagKeyType)
+		ref := transitRef(cmd)
+		return runTransitAction(cmd, func(ctx context.Context, transit service.RemoteTransitService, token string) error {
+			key, err := transit.CreateKey(ctx, token, ref, keyType)
+			if err != nil {
+				return fmt.Errorf("failed to create key: %w", err)
+			}
+			fmt.Printf("✅ Key created: %s (%s, owner %s)\n", key.Name, key.Type, key.Owner)
+			return nil
+		})
+	},
+}
+
+var transitRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Add a new key version; older data still decrypts",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ref := transitRef(cmd)
+		return runTransitAction(cmd, func(ctx context.Context, transit service.RemoteTransitService, token string) error {
+			key, err := transit.RotateKey(ctx, token, ref)
+			if err != nil {
+				return fmt.Errorf("failed to rotate key: %w", err)
+			}
+			fmt.Printf("✅ Key %s rotated to version %d\n", key.Name, key.LatestVersion)
+			return nil
+		})
+	},
+}
+
+var transitKeysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "List your keys and the keys of your teams",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTransitAction(cmd, func(ctx context.Context, transit service.RemoteTransitService, token string) error {
+			keys, err := transit.ListKeys(ctx, token)
+			if err != nil {
+				return fmt.Errorf("failed to list keys: %w", err)
+			}
+
+			const keyFormat = "%-24s %-24s %-12s %-8s %s\n"
+			fmt.Printf(keyFormat, "Name", "Owner", "Type", "Version", "Updated")
+			fmt.Printf(keyFormat, "----", "-----", "----", "-------", "-------")
+			if len(keys) == 0 {
+				fmt.Println("No keys found.")
+			}
+			for _, k := range keys {
+				fmt.Printf(keyFormat, k.Name, k.Owner, k.Type,
+					fmt.Sprintf("v%d", k.LatestVersion), k.UpdatedAt.Local().Format(time.DateTime))
+			}
+			return nil
+		})
+	},
+}
+
+var transitEncryptCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt data and print the ciphertext",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		plaintext, _ := cmd.Flags().GetString(flagPlaintext)
+		inFile, _ := cmd.Flags().GetString(flagInFile)
+		if (plaintext == "") == (inFile == "") {
+			return fmt.Errorf("exactly one of --%s and --%s is required", flagPlaintext, flagInFile)
+		}
+		data := []byte(plaintext)
+		if inFile != "" {
+			var err error
+			if data, err = os.ReadFile(inFile); err != nil {
+				return fmt.Errorf("failed to read file: %w", err)
+			}
+		}
+
+		ref := transitRef(cmd)
+		return runTransitAction(cmd, func(ctx context.Context, transit service.RemoteTransitService, token string) error {
+			ciphertext, err := transit.Encrypt(ctx, token, ref, data)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt: %w", err)
+			}
+			fmt.Println(ciphertext)
+			return nil
+		})
+	},
+}
+
+var transitDecryptCmd = &cobra.Command{
+	Use:   "decrypt",
+	Short: "Decrypt a ciphertext produced by encrypt",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ciphertext, _ := cmd.Flags().GetString(flagCiphertext)
+		outFile, _ := cmd.Flags().GetString(flagOutFile)
+		if ciphertext == "" {
+			return errors.New("--" + flagCiphertext + " is required")
+		}
+
+		ref := transitRef(cmd)
+		return runTransitAction(cmd, func(ctx context.Context, transit service.RemoteTransitService, token string) error {
+			plaintext, err := transit.Decrypt(ctx, token, ref, ciphertext)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt: %w", err)
+			}
+			if outFile != "" {
+				if err := os.WriteFile(outFile, plaintext, permissionOutFile); err != nil {
+					return fmt.Errorf("failed to write to file: %w", err)
+				}
+				fmt.Printf("✅ Plaintext written to file: %s\n", outFile)
+				return nil
+			}
+			fmt.Println(string(plaintext))
+			return nil
+		})
+	},
+}
+
+func transitRef(cmd *cobra.Command) dto.TransitKeyRef {
+	name, _ := cmd.Flags().GetString(flagName)
+	team, _ := cmd.Flags().GetString(flagTeam)
+	return dto.TransitKeyRef{Name: name, Team: team}
+}
+
+func runTransitAction(
+	cmd *cobra.Command,
+	action func(ctx context.Context, transit service.RemoteTransitService, token string) error,
+) error {
+	token, err := readToken(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runWithTransitService(func(transit service.RemoteTransitService, timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return action(ctx, transit, token)
+	})
+}
+
+func init() {
+	keyCommands := []*cobra.Command{transitCreateKeyCmd, transitRotateKeyCmd, transitEncryptCmd, transitDecryptCmd}
+	transitCmd.AddCommand(transitKeysCmd)
+	transitCmd.AddCommand(keyCommands...)
+
+	for _, c := range append(keyCommands, transitKeysCmd) {
+		c.Flags().String(flagToken, "", flagTokenDescription)
+		c.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	}
+	for _, c := range keyCommands {
+		c.Flags().String(flagName, "", "Key name")
+		c.Flags().String(flagTeam, "", "Use a key of this team instead of your own")
+		_ = c.MarkFlagRequired(flagName)
+	}
+
+	transitCreateKeyCmd.Flags().String(flagKeyType, entity.TransitKeyAES256GCM, "Key type: aes256-gcm or ed25519")
+	transitEncryptCmd.Flags().String(flagPlaintext, "", "Data to encrypt")
+	transitEncryptCmd.Flags().String(flagInFile, "", "Encrypt the contents of this file")
+	transitDecryptCmd.Flags().String(flagCiphertext, "", "Ciphertext in the keeper:v<version>:... form")
+	transitDecryptCmd.Flags().String(flagOutFile, "", "Write the plaintext to this file instead of printing it")
+}
@@ -0,0 +1,118 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const flagCode = "code"
+
+var twoFactorCmd = &cobra.Command{
+	Use:   "2fa",
+	Short: "Manage two-factor authentication",
+}
+
+var twoFactorEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Enrol an authenticator app and print recovery codes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		token, err := readToken(cmd)
+		if err != nil {
+			return err
+		}
+		code, _ := cmd.Flags().GetString(flagCode)
+
+		return runWithAuthService(func(auth service.RemoteAuthService, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			enrollment, err := auth.EnrollTOTP(ctx, token)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("failed to enable 2FA: %w", err)
+			}
+
+			fmt.Println("Add this account to your authenticator app:")
+			fmt.Printf("  URI:    %s\n", enrollment.URI)
+			fmt.Printf("  Secret: %s\n", enrollment.Secret)
+
+			if code == "" {
+				code, err = promptTwoFactorCode()
+				if err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel = context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			recoveryCodes, err := auth.ConfirmTOTP(ctx, token, code)
+			if err != nil {
+				return fmt.Errorf("failed to enable 2FA: %w", err)
+			}
+
+			fmt.Println("🔐 Two-factor authentication enabled.")
+			fmt.Println("Recovery codes, each works once. Store them somewhere safe, they won't be shown again:")
+			for _, c := range recoveryCodes {
+				fmt.Printf("  %s\n", c)
+			}
+			return nil
+		})
+	},
+}
+
+// completeTwoFactor finishes a login that the server answered with a
+// challenge, asking for the code unless it was passed with --code.
+func completeTwoFactor(
+	auth service.RemoteAuthService,
+	timeout time.Duration,
+	tokens dto.AgentTokens,
+	code string,
+) (dto.AgentTokens, error) {
+	if tokens.TwoFactorChallenge == "" {
+		return tokens, nil
+	}
+	if code == "" {
+		var err error
+		code, err = promptTwoFactorCode()
+		if err != nil {
+			return dto.AgentTokens{}, err
+		}
+	}
+
+	// The prompt may take longer than the RPC timeout, so verification gets
+	// its own deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	verified, err := auth.VerifyTwoFactor(ctx, tokens.TwoFactorChallenge, code)
+	if err != nil {
+		return dto.AgentTokens{}, fmt.Errorf("%w", err)
+	}
+	return verified, nil
+}
+
+func promptTwoFactorCode() (string, error) {
+	fmt.Print("Two-factor code (or recovery code): ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	code := strings.TrimSpace(line)
+	if code == "" {
+		if err != nil {
+			return "", fmt.Errorf("failed to read two-factor code: %w", err)
+		}
+		return "", errors.New("two-factor code is required")
+	}
+	return code, nil
+}
+
+func init() {
+	twoFactorEnableCmd.Flags().String(flagToken, "", flagTokenDescription)
+	twoFactorEnableCmd.Flags().String(flagTokenFile, defaultTokenFile, flagTokenFileDescription)
+	twoFactorEnableCmd.Flags().String(flagCode, "", "Code from the authenticator app (prompted if empty)")
+	twoFactorCmd.AddCommand(twoFactorEnableCmd)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"keeper/internal/cache"
 	"keeper/internal/dto"
 	"keeper/internal/service"
 	"os"
@@ -32,6 +33,7 @@ var writeCmd = &cobra.Command{
 		expired, _ := cmd.Flags().GetInt(flagKeyMaxTTL)
 		token, _ := cmd.Flags().GetString(flagToken)
 		tokenFile, _ := cmd.Flags().GetString(flagTokenFile)
+		owner, _ := cmd.Flags().GetString(flagOwner)
 
 		if token == "" {
 			token = os.Getenv(envAuthToken)
@@ -95,12 +97,27 @@ var writeCmd = &cobra.Command{
 
 			err := vault.SaveSecret(ctx, &dto.AgentCreateSecret{
 				Token:       token,
+				Owner:       owner,
 				Path:        path,
 				Description: description,
 				FilePath:    storedName,
 				Payload:     payload,
 				ExpiredAt:   expiredAt,
 			})
+			if isOffline(err) && storedName == nil && syncable(owner, path) {
+				queueErr := openOfflineCache(token).queue(cache.Op{
+					Kind:        cache.OpWrite,
+					Path:        path,
+					Description: description,
+					Payload:     payload,
+					ExpiredAt:   expiredAt,
+				})
+				if queueErr != nil {
+					return fmt.Errorf("failed to store secret: %w (%w)", err, queueErr)
+				}
+				fmt.Printf("📥 Server unreachable, the write of %s is queued; run keeper-agent sync to push it\n", path)
+				return nil
+			}
 			if err != nil {
 				return fmt.Errorf("failed to store secret: %w", err)
 			}
@@ -121,6 +138,7 @@ func init() {
 		flagTokenFile,
 		defaultTokenFile,
 		flagTokenFileDescription)
+	writeCmd.Flags().String(flagOwner, "", flagOwnerDescription)
 	writeCmd.Flags().String(flagKeyFile, "", "Path to a file to use as secret value")
 
 	_ = writeCmd.MarkFlagRequired(flagKeyName)
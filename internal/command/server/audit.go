@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAuditLines  = "lines"
+	flagAuditFollow = "follow"
+
+	defaultAuditLines   = 20
+	auditFollowInterval = time.Second
+	auditFollowBatch    = 100
+	auditHashPreview    = 12
+)
+
+func auditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the hash-chained audit log",
+	}
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check that no audit record was altered or removed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithAuditService(cmd, func(ctx context.Context, audit service.AuditService) error {
+				checked, err := audit.Verify(ctx)
+				if err != nil {
+					return fmt.Errorf("%w (%d records verified before the break)", err, checked)
+				}
+				fmt.Printf("✅ Audit chain intact: %d records verified\n", checked)
+				return nil
+			})
+		},
+	}
+
+	tailCmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Print recent audit events, optionally following new ones",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			lines, _ := cmd.Flags().GetInt(flagAuditLines)
+			follow, _ := cmd.Flags().GetBool(flagAuditFollow)
+			return runWithAuditService(cmd, func(ctx context.Context, audit service.AuditService) error {
+				events, err := audit.Recent(ctx, lines)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				var lastID int64
+				for _, e := range events {
+					printAuditEvent(e)
+					lastID = e.ID
+				}
+				if !follow {
+					return nil
+				}
+
+				ticker := time.NewTicker(auditFollowInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return nil
+					case <-ticker.C:
+					}
+					events, err := audit.After(ctx, lastID, auditFollowBatch)
+					if err != nil {
+						return fmt.Errorf("%w", err)
+					}
+					for _, e := range events {
+						printAuditEvent(e)
+						lastID = e.ID
+					}
+				}
+			})
+		},
+	}
+	tailCmd.Flags().IntP(flagAuditLines, "n", defaultAuditLines, "Number of recent events to print")
+	tailCmd.Flags().BoolP(flagAuditFollow, "f", false, "Keep printing new events as they are written")
+
+	cmd.AddCommand(verifyCmd, tailCmd)
+	return cmd
+}
+
+func printAuditEvent(e entity.AuditEvent) {
+	path := "-"
+	if e.PathHMAC != "" {
+		path = e.PathHMAC[:auditHashPreview]
+	}
+	fmt.Printf("%d  %s  user=%d  %s  %s  path=%s  v=%d  req=%s\n",
+		e.ID, e.CreatedAt.Local().Format(time.RFC3339), e.UserID, e.Method, e.Result, path, e.Version, e.RequestID)
+}
+
+func runWithAuditService(
+	cmd *cobra.Command,
+	fn func(ctx context.Context, audit service.AuditService) error,
+) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	// Reading and verifying the log doesn't need the HMAC key.
+	auditService := service.NewAuditService(repository.NewAuditRepository(database.Pool), "", nil, nil)
+	return fn(cmd.Context(), auditService)
+}
@@ -0,0 +1,283 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"keeper/internal/backup"
+	"keeper/internal/config"
+	"keeper/internal/store"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagBackupOutFile     = "out-file"
+	flagBackupKeyFile     = "key-file"
+	flagBackupIncremental = "incremental-from"
+	flagBackupInFile      = "in-file"
+	flagBackupVerifyOnly  = "verify-only"
+
+	backupKeySize = 32
+)
+
+func backupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Write an encrypted archive of the database and file objects",
+		Long: "Write an encrypted archive of every table, read from one consistent snapshot, and of the " +
+			"file objects the secrets refer to. With --incremental-from only objects that changed since " +
+			"the given archive are stored; tables are always dumped in full.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, _ := cmd.Flags().GetString(flagBackupOutFile)
+			keyFile, _ := cmd.Flags().GetString(flagBackupKeyFile)
+			baseFile, _ := cmd.Flags().GetString(flagBackupIncremental)
+			key, err := readBackupKey(keyFile)
+			if err != nil {
+				return err
+			}
+
+			var base *backup.Manifest
+			if baseFile != "" {
+				base, err = readBaseManifest(baseFile, key)
+				if err != nil {
+					return err
+				}
+			}
+
+			database, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer database.Pool.Close()
+			objects, err := openObjectStore(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			m, err := writeBackup(cmd.Context(), out, func(ctx context.Context, f *os.File) (*backup.Manifest, error) {
+				return backup.Create(ctx, backup.NewPostgres(database.Pool), objects, f, key, base)
+			})
+			if err != nil {
+				return err
+			}
+			printManifest(m)
+			fmt.Printf("✅ Backup %s written to %s\n", m.ID, out)
+			if len(m.Missing) > 0 {
+				fmt.Printf("📭 %d referenced objects were missing from the bucket: %s\n",
+					len(m.Missing), strings.Join(m.Missing, ", "))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagBackupOutFile, "", "Archive to write")
+	cmd.Flags().String(flagBackupKeyFile, "", "File with the hex backup key from 'backup keygen'")
+	cmd.Flags().String(flagBackupIncremental, "", "Previous archive to make an incremental backup on top of")
+	_ = cmd.MarkFlagRequired(flagBackupOutFile)
+	_ = cmd.MarkFlagRequired(flagBackupKeyFile)
+
+	keygenCmd := &cobra.Command{
+		Use:   "keygen",
+		Short: "Generate a backup key; keep it away from the server it protects",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, _ := cmd.Flags().GetString(flagBackupOutFile)
+			key := make([]byte, backupKeySize)
+			if _, err := rand.Read(key); err != nil {
+				return fmt.Errorf("failed to generate key: %w", err)
+			}
+			f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, certFilePerm)
+			if err != nil {
+				return fmt.Errorf("failed to create key file: %w", err)
+			}
+			if _, err := fmt.Fprintln(f, hex.EncodeToString(key)); err != nil {
+				_ = f.Close()
+				return fmt.Errorf("failed to write key file: %w", err)
+			}
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write key file: %w", err)
+			}
+			fmt.Printf("🔑 Backup key written to %s\n", out)
+			return nil
+		},
+	}
+	keygenCmd.Flags().String(flagBackupOutFile, "", "Key file to create")
+	_ = keygenCmd.MarkFlagRequired(flagBackupOutFile)
+
+	cmd.AddCommand(keygenCmd)
+	return cmd
+}
+
+func restoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Verify archives and replay them into an empty database and bucket",
+		Long: "Verify archives and replay them into an empty database and bucket. Pass the archive to " +
+			"restore last and, for an incremental one, the earlier archives of its chain before it. " +
+			"Every archive is checked before anything is written. A database without a schema is " +
+			"migrated to the backup's version, loaded and then migrated to the current one.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, _ := cmd.Flags().GetStringArray(flagBackupInFile)
+			keyFile, _ := cmd.Flags().GetString(flagBackupKeyFile)
+			verifyOnly, _ := cmd.Flags().GetBool(flagBackupVerifyOnly)
+			key, err := readBackupKey(keyFile)
+			if err != nil {
+				return err
+			}
+
+			sources := make([]backup.Source, 0, len(files))
+			for _, file := range files {
+				sources = append(sources, backup.FileSource(file))
+			}
+			plan, err := backup.Verify(key, sources...)
+			if err != nil {
+				return fmt.Errorf("failed to verify backup: %w", err)
+			}
+			m := plan.Manifest
+			printManifest(m)
+			fmt.Printf("🔒 Verified %d archives, backup %s needs %d\n", len(files), m.ID, len(m.Archives()))
+			if verifyOnly {
+				return nil
+			}
+
+			if err := restoreBackup(cmd, plan); err != nil {
+				return err
+			}
+			fmt.Printf("✅ Backup %s restored\n", m.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringArray(flagBackupInFile, nil, "Archive to read, repeat for an incremental chain")
+	cmd.Flags().String(flagBackupKeyFile, "", "File with the hex backup key")
+	cmd.Flags().Bool(flagBackupVerifyOnly, false, "Only check the archives")
+	_ = cmd.MarkFlagRequired(flagBackupInFile)
+	_ = cmd.MarkFlagRequired(flagBackupKeyFile)
+	return cmd
+}
+
+func restoreBackup(cmd *cobra.Command, plan *backup.Plan) error {
+	ctx := cmd.Context()
+	dsn, err := databaseDSN(cmd)
+	if err != nil {
+		return err
+	}
+	database, err := store.OpenDB(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	defer database.Pool.Close()
+
+	pg := backup.NewPostgres(database.Pool)
+	version, err := pg.SchemaVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	switch version {
+	case 0:
+		if err := store.MigrateTo(dsn, plan.Manifest.SchemaVersion); err != nil {
+			return fmt.Errorf("failed to create schema: %w", err)
+		}
+	case plan.Manifest.SchemaVersion:
+	default:
+		return fmt.Errorf("%w: database is at %d, backup at %d; restore into a database without a schema",
+			backup.ErrSchemaVersion, version, plan.Manifest.SchemaVersion)
+	}
+
+	objects, err := openObjectStore(ctx)
+	if err != nil {
+		return err
+	}
+	if err := plan.Restore(ctx, pg, objects); err != nil {
+		return fmt.Errorf("failed to restore backup: %w", err)
+	}
+
+	migrated, err := store.NewDB(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("database error: %w", err)
+	}
+	migrated.Pool.Close()
+	return nil
+}
+
+// writeBackup creates the archive next to path and moves it into place only
+// once it is complete, so a failed run never leaves a truncated archive.
+func writeBackup(
+	ctx context.Context, path string, fn func(ctx context.Context, f *os.File) (*backup.Manifest, error),
+) (*backup.Manifest, error) {
+	partial := path + ".partial"
+	f, err := os.OpenFile(partial, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, certFilePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive: %w", err)
+	}
+	m, err := fn(ctx, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(partial)
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := os.Rename(partial, path); err != nil {
+		return nil, fmt.Errorf("failed to move archive into place: %w", err)
+	}
+	return m, nil
+}
+
+func readBaseManifest(path string, key []byte) (*backup.Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", flagBackupIncremental, err)
+	}
+	defer func() { _ = f.Close() }()
+	m, err := backup.ReadManifest(f, key)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+func readBackupKey(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", flagBackupKeyFile, err)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(raw)))
+	if err != nil || len(key) != backupKeySize {
+		return nil, errors.New("backup key must be 32 hex-encoded bytes, see 'backup keygen'")
+	}
+	return key, nil
+}
+
+func openObjectStore(ctx context.Context) (*backup.MinIO, error) {
+	cfg := config.NewServerConfig().FileStorageConfig
+	fileStorage, err := store.NewFileStorage(ctx, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("file storage error: %w", err)
+	}
+	return backup.NewMinIO(fileStorage.FileClient, cfg.BucketName), nil
+}
+
+func printManifest(m *backup.Manifest) {
+	var rows int64
+	for _, t := range m.Tables {
+		rows += t.Rows
+	}
+	stored := 0
+	for _, o := range m.Objects {
+		if o.Archive == m.ID {
+			stored++
+		}
+	}
+	fmt.Printf("📜 %s  schema %d  %d tables, %d rows  %d objects (%d in this archive)\n",
+		m.CreatedAt.Local().Format(time.DateTime), m.SchemaVersion, len(m.Tables), rows, len(m.Objects), stored)
+	if m.Base != "" {
+		fmt.Printf("📜 incremental on top of %s\n", m.Base)
+	}
+}
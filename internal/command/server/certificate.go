@@ -7,11 +7,13 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
 	"net"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -29,6 +31,17 @@ const (
 	dirPerm            = 0o750
 	certFilePerm       = 0o600
 	privateKeyFilePerm = 0o600
+
+	clientCACertFile   = "cert/client-ca.cert"
+	clientCAKeyFile    = "cert/client-ca.key"
+	clientOrganization = "Keeper"
+	defaultClientDays  = 365
+	serialBits         = 128
+
+	flagClientName   = "name"
+	flagClientCACert = "ca-cert"
+	flagClientCAKey  = "ca-key"
+	flagClientDays   = "days"
 )
 
 func generateCert() error {
@@ -56,6 +69,173 @@ func generateCert() error {
 		return fmt.Errorf("failed to create certificate: %w", err)
 	}
 
+	if err := writeKeyPair(certFile, privKeyFile, certBytes, privateKey); err != nil {
+		return err
+	}
+
+	log.Printf("TLS certificate and private key generated:\n - cert: %s\n - key: %s\n", certFile, privKeyFile)
+	return nil
+}
+
+func genCertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen-cert",
+		Short: "Generate self-signed TLS certificate and key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return generateCert()
+		},
+	}
+
+	caCmd := &cobra.Command{
+		Use:   "ca",
+		Short: "Generate a CA for client certificates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return generateClientCA()
+		},
+	}
+
+	clientCmd := &cobra.Command{
+		Use:   "client",
+		Short: "Issue a client certificate signed by the client CA",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagClientName)
+			caCert, _ := cmd.Flags().GetString(flagClientCACert)
+			caKey, _ := cmd.Flags().GetString(flagClientCAKey)
+			days, _ := cmd.Flags().GetInt(flagClientDays)
+			if name == "" {
+				return errors.New("--name is required")
+			}
+			if filepath.Base(name) != name || name == ".." {
+				return fmt.Errorf("invalid client name %q", name)
+			}
+			return generateClientCert(name, caCert, caKey, days)
+		},
+	}
+	clientCmd.Flags().String(flagClientName, "", "Common name of the client, e.g. ci-runner")
+	clientCmd.Flags().String(flagClientCACert, clientCACertFile, "Client CA certificate")
+	clientCmd.Flags().String(flagClientCAKey, clientCAKeyFile, "Client CA private key")
+	clientCmd.Flags().Int(flagClientDays, defaultClientDays, "Validity of the certificate in days")
+
+	cmd.AddCommand(caCmd, clientCmd)
+	return cmd
+}
+
+// generateClientCA writes the CA passed to the server as --client-ca-file.
+func generateClientCA() error {
+	serial, err := randomSerial()
+	if err != nil {
+		return err
+	}
+	ca := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   "Keeper client CA",
+			Organization: []string{clientOrganization},
+		},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(certValidity, 0, 0),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLenZero:        true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return fmt.Errorf("failed to generate private key: %w", err)
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, ca, ca, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate: %w", err)
+	}
+	if err := writeKeyPair(clientCACertFile, clientCAKeyFile, certBytes, privateKey); err != nil {
+		return err
+	}
+
+	log.Printf("Client CA generated:\n - cert: %s\n - key: %s\n", clientCACertFile, clientCAKeyFile)
+	return nil
+}
+
+// generateClientCert issues cert/<name>.cert and cert/<name>.key. The printed
+// subject is what client-cert bind expects.
+func generateClientCert(name, caCertPath, caKeyPath string, days int) error {
+	caCert, caKey, err := loadCA(caCertPath, caKeyPath)
+	if err != nil {
+		return err
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return err
+	}
+	cert := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   name,
+			Organization: []string{clientOrganization},
+		},
+		NotBefore:   time.Now(),
+		NotAfter:    time.Now().AddDate(0, 0, days),
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return fmt.Errorf("failed to generate private key: %w", err)
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, cert, caCert, &privateKey.PublicKey, caKey)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate: %w", err)
+	}
+	certPath := filepath.Join(certDir, name+".cert")
+	keyPath := filepath.Join(certDir, name+".key")
+	if err := writeKeyPair(certPath, keyPath, certBytes, privateKey); err != nil {
+		return err
+	}
+
+	log.Printf("Client certificate generated:\n - cert: %s\n - key: %s\n - subject: %s\n",
+		certPath, keyPath, cert.Subject.String())
+	return nil
+}
+
+func loadCA(certPath, keyPath string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM data in %s", certPath)
+	}
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CA key: %w", err)
+	}
+	block, _ = pem.Decode(keyPEM)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM data in %s", keyPath)
+	}
+	caKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA key: %w", err)
+	}
+	return caCert, caKey, nil
+}
+
+func randomSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialBits))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	return serial, nil
+}
+
+func writeKeyPair(certPath, keyPath string, certBytes []byte, privateKey *rsa.PrivateKey) error {
 	var certPEM bytes.Buffer
 	if err := pem.Encode(&certPEM, &pem.Block{
 		Type:  "CERTIFICATE",
@@ -72,31 +252,19 @@ func generateCert() error {
 		return fmt.Errorf("failed to encode private key: %w", err)
 	}
 
-	err = os.MkdirAll(certDir, dirPerm)
+	err := os.MkdirAll(filepath.Dir(certPath), dirPerm)
 	if err != nil {
 		return fmt.Errorf("failed to create cert directory: %w", err)
 	}
 
-	err = os.WriteFile(certFile, certPEM.Bytes(), certFilePerm)
+	err = os.WriteFile(certPath, certPEM.Bytes(), certFilePerm)
 	if err != nil {
 		return fmt.Errorf("failed to write cert file: %w", err)
 	}
 
-	err = os.WriteFile(privKeyFile, privateKeyPEM.Bytes(), privateKeyFilePerm)
+	err = os.WriteFile(keyPath, privateKeyPEM.Bytes(), privateKeyFilePerm)
 	if err != nil {
 		return fmt.Errorf("failed to write private key file: %w", err)
 	}
-
-	log.Printf("TLS certificate and private key generated:\n - cert: %s\n - key: %s\n", certFile, privKeyFile)
 	return nil
 }
-
-func genCertCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "gen-cert",
-		Short: "Generate self-signed TLS certificate and key",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			return generateCert()
-		},
-	}
-}
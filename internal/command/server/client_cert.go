@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagClientCertSubject = "subject"
+	flagClientCertLogin   = "login"
+)
+
+func clientCertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-cert",
+		Short: "Bind client certificate subjects to users for certificate login",
+	}
+
+	bindCmd := &cobra.Command{
+		Use:   "bind",
+		Short: "Allow a client certificate subject to log in as a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			subject, _ := cmd.Flags().GetString(flagClientCertSubject)
+			login, _ := cmd.Flags().GetString(flagClientCertLogin)
+			if subject == "" || login == "" {
+				return errors.New("--subject and --login are required")
+			}
+			return runWithClientCertService(cmd, func(ctx context.Context, certs service.ClientCertificateService) error {
+				if err := certs.Bind(ctx, subject, login); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ %s can now log in as %s\n", subject, login)
+				return nil
+			})
+		},
+	}
+	bindCmd.Flags().String(flagClientCertSubject, "", "Certificate subject as printed by gen-cert client, e.g. CN=ci-runner,O=Keeper")
+	bindCmd.Flags().String(flagClientCertLogin, "", "Login of the user")
+
+	unbindCmd := &cobra.Command{
+		Use:   "unbind",
+		Short: "Remove a client certificate binding",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			subject, _ := cmd.Flags().GetString(flagClientCertSubject)
+			if subject == "" {
+				return errors.New("--subject is required")
+			}
+			return runWithClientCertService(cmd, func(ctx context.Context, certs service.ClientCertificateService) error {
+				if err := certs.Unbind(ctx, subject); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Println("✅ Binding removed")
+				return nil
+			})
+		},
+	}
+	unbindCmd.Flags().String(flagClientCertSubject, "", "Certificate subject to unbind")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Print client certificate bindings",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithClientCertService(cmd, func(ctx context.Context, certs service.ClientCertificateService) error {
+				bindings, err := certs.List(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				const bindingFormat = "%-20s  %-20s  %s\n"
+				fmt.Printf(bindingFormat, "Created", "Login", "Subject")
+				for _, b := range bindings {
+					fmt.Printf(bindingFormat, b.CreatedAt.Local().Format(time.DateTime), b.Login, b.Subject)
+				}
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(bindCmd, unbindCmd, listCmd)
+	return cmd
+}
+
+func runWithClientCertService(
+	cmd *cobra.Command,
+	fn func(ctx context.Context, certs service.ClientCertificateService) error,
+) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	certs := service.NewClientCertificateService(repository.NewClientCertificateRepository(database.Pool))
+	return fn(cmd.Context(), certs)
+}
@@ -29,6 +29,10 @@ func Execute() error {
 	// HTTP flags
 	cmd.Flags().StringVar(&cfg.Server.Address, "address", cfg.Server.Address, "Address to bind the server")
 	cmd.Flags().IntVar(&cfg.Server.Port, "port", cfg.Server.Port, "Port to bind the server")
+	cmd.Flags().StringVar(
+		&cfg.Server.PublicURL,
+		"public-url", cfg.Server.PublicURL,
+		"Base URL of the HTTP server used in share links, e.g. https://keeper.example.com")
 
 	// gRPC flags
 	cmd.Flags().StringVar(
@@ -43,7 +47,46 @@ func Execute() error {
 		"Port to bind the gRPC server")
 
 	// Database
-	cmd.Flags().StringVar(&cfg.Database.DSN, "dsn", cfg.Database.DSN, "Database DSN")
+	cmd.PersistentFlags().StringVar(&cfg.Database.DSN, "dsn", cfg.Database.DSN, "Database DSN")
+	// Audit
+	cmd.Flags().StringVar(
+		&cfg.Audit.HMACKey,
+		"audit-hmac-key", cfg.Audit.HMACKey,
+		"Key used to HMAC paths and client addresses in the audit log")
+	cmd.Flags().StringVar(
+		&cfg.Audit.File.Path,
+		"audit-file", cfg.Audit.File.Path,
+		"Copy audit records to a rotated JSONL file")
+	cmd.Flags().StringVar(
+		&cfg.Audit.Syslog.Network,
+		"audit-syslog-network", cfg.Audit.Syslog.Network,
+		"Syslog socket type: unixgram or udp")
+	cmd.Flags().StringVar(
+		&cfg.Audit.Syslog.Address,
+		"audit-syslog-address", cfg.Audit.Syslog.Address,
+		"Copy audit records to syslog at this socket path or host:port")
+	cmd.Flags().StringVar(
+		&cfg.Audit.Webhook.URL,
+		"audit-webhook-url", cfg.Audit.Webhook.URL,
+		"Copy audit records to this HTTP endpoint")
+	// Password hashing
+	cmd.Flags().Uint32Var(
+		&cfg.Security.Argon2.Memory,
+		"argon2-memory", cfg.Security.Argon2.Memory,
+		"Argon2id memory cost in KiB for new password hashes")
+	cmd.Flags().Uint32Var(
+		&cfg.Security.Argon2.Iterations,
+		"argon2-iterations", cfg.Security.Argon2.Iterations,
+		"Argon2id number of passes for new password hashes")
+	cmd.Flags().Uint8Var(
+		&cfg.Security.Argon2.Parallelism,
+		"argon2-parallelism", cfg.Security.Argon2.Parallelism,
+		"Argon2id number of lanes for new password hashes")
+	// Access tokens
+	cmd.Flags().StringVar(
+		&cfg.Security.JWTAlgorithm,
+		"jwt-algorithm", cfg.Security.JWTAlgorithm,
+		"Algorithm of the first access token signing key: EdDSA or ES256")
 	// TLS for gRPC
 	cmd.Flags().BoolVar(
 		&cfg.GrpcServerConfig.EnableTLS,
@@ -57,11 +100,29 @@ func Execute() error {
 		&cfg.GrpcServerConfig.KeyFile,
 		"key-file", cfg.GrpcServerConfig.KeyFile,
 		"Path to TLS private key file")
+	cmd.Flags().StringVar(
+		&cfg.GrpcServerConfig.ClientCAFile,
+		"client-ca-file", cfg.GrpcServerConfig.ClientCAFile,
+		"CA certificate used to verify client certificates (enables mutual TLS)")
+	cmd.Flags().BoolVar(
+		&cfg.GrpcServerConfig.RequireClientCert,
+		"require-client-cert", cfg.GrpcServerConfig.RequireClientCert,
+		"Reject gRPC connections without a valid client certificate")
 
 	viper.SetEnvPrefix("KEEPER")
 	viper.AutomaticEnv()
 
 	cmd.AddCommand(genCertCmd())
+	cmd.AddCommand(policyCmd())
+	cmd.AddCommand(auditCmd())
+	cmd.AddCommand(lockoutCmd())
+	cmd.AddCommand(clientCertCmd())
+	cmd.AddCommand(jwtCmd())
+	cmd.AddCommand(databaseCmd())
+	cmd.AddCommand(pkiCmd())
+	cmd.AddCommand(sshCmd())
+	cmd.AddCommand(backupCmd())
+	cmd.AddCommand(restoreCmd())
 
 	err := cmd.Execute()
 	if err != nil {
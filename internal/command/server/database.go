@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagDBName           = "name"
+	flagDBURL            = "url"
+	flagDBConnection     = "connection"
+	flagDBCreationFile   = "creation-file"
+	flagDBRevocationFile = "revocation-file"
+	flagDBDefaultTTL     = "default-ttl"
+	flagDBMaxTTL         = "max-ttl"
+	flagDBLeaseID        = "id"
+)
+
+func databaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "database",
+		Short: "Manage the dynamic PostgreSQL credentials engine",
+	}
+	cmd.AddCommand(databaseConnectionCmd(), databaseRoleCmd(), databaseLeaseCmd())
+	return cmd
+}
+
+func databaseConnectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection",
+		Short: "Manage target PostgreSQL servers",
+	}
+
+	writeCmd := &cobra.Command{
+		Use:   "write",
+		Short: "Register a target server or replace its connection URL",
+		Long: "Register a target server or replace its connection URL. The URL needs a user " +
+			"allowed to create roles; it is checked before saving and stored encrypted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagDBName)
+			url, _ := cmd.Flags().GetString(flagDBURL)
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				if err := db.WriteConnection(ctx, name, url); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ Connection written: %s\n", name)
+				return nil
+			})
+		},
+	}
+	writeCmd.Flags().String(flagDBName, "", "Connection name")
+	writeCmd.Flags().String(flagDBURL, "", "PostgreSQL URL, e.g. postgres://admin:pass@db:5432/app")
+	_ = writeCmd.MarkFlagRequired(flagDBName)
+	_ = writeCmd.MarkFlagRequired(flagDBURL)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List target servers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				conns, err := db.ListConnections(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if len(conns) == 0 {
+					fmt.Println("📭 No connections defined")
+					return nil
+				}
+				for _, c := range conns {
+					fmt.Printf("🗄  %s  updated %s\n", c.Name, c.UpdatedAt.Local().Format(time.DateTime))
+				}
+				return nil
+			})
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a target server that no role uses",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagDBName)
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				if err := db.DeleteConnection(ctx, name); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🗑  Connection deleted: %s\n", name)
+				return nil
+			})
+		},
+	}
+	deleteCmd.Flags().String(flagDBName, "", "Connection name")
+	_ = deleteCmd.MarkFlagRequired(flagDBName)
+
+	cmd.AddCommand(writeCmd, listCmd, deleteCmd)
+	return cmd
+}
+
+func databaseRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Manage role templates for generated users",
+	}
+
+	writeCmd := &cobra.Command{
+		Use:   "write",
+		Short: "Create or replace a role template",
+		Long: "Create or replace a role template. The SQL files may use {{name}}, {{password}} and " +
+			"{{expiration}}. Users need a policy granting read on database/creds/<role>.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role := &entity.DBRole{}
+			role.Name, _ = cmd.Flags().GetString(flagDBName)
+			role.Connection, _ = cmd.Flags().GetString(flagDBConnection)
+			role.DefaultTTL, _ = cmd.Flags().GetDuration(flagDBDefaultTTL)
+			role.MaxTTL, _ = cmd.Flags().GetDuration(flagDBMaxTTL)
+			for flag, dst := range map[string]*string{
+				flagDBCreationFile:   &role.CreationStatements,
+				flagDBRevocationFile: &role.RevocationStatements,
+			} {
+				file, _ := cmd.Flags().GetString(flag)
+				if file == "" {
+					continue
+				}
+				raw, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read %s: %w", flag, err)
+				}
+				*dst = string(raw)
+			}
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				if err := db.WriteRole(ctx, role); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ Role written: %s (ttl %s, max %s)\n", role.Name, role.DefaultTTL, role.MaxTTL)
+				return nil
+			})
+		},
+	}
+	writeCmd.Flags().String(flagDBName, "", "Role name")
+	writeCmd.Flags().String(flagDBConnection, "", "Connection the users are created on")
+	writeCmd.Flags().String(flagDBCreationFile, "", "SQL that creates the user (default: login only)")
+	writeCmd.Flags().String(flagDBRevocationFile, "", "SQL that drops the user (default: drop owned objects and role)")
+	writeCmd.Flags().Duration(flagDBDefaultTTL, service.DefaultDBRoleTTL, "Lease length when none is requested")
+	writeCmd.Flags().Duration(flagDBMaxTTL, service.DefaultDBRoleMax, "Longest lease a user may request")
+	_ = writeCmd.MarkFlagRequired(flagDBName)
+	_ = writeCmd.MarkFlagRequired(flagDBConnection)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List role templates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				roles, err := db.ListRoles(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if len(roles) == 0 {
+					fmt.Println("📭 No roles defined")
+					return nil
+				}
+				for _, r := range roles {
+					fmt.Printf("🔑 %s  on %s  ttl %s, max %s\n", r.Name, r.Connection, r.DefaultTTL, r.MaxTTL)
+				}
+				return nil
+			})
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a role template without active leases",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagDBName)
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				if err := db.DeleteRole(ctx, name); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🗑  Role deleted: %s\n", name)
+				return nil
+			})
+		},
+	}
+	deleteCmd.Flags().String(flagDBName, "", "Role name")
+	_ = deleteCmd.MarkFlagRequired(flagDBName)
+
+	cmd.AddCommand(writeCmd, listCmd, deleteCmd)
+	return cmd
+}
+
+func databaseLeaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lease",
+		Short: "Inspect and revoke leases of generated users",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List active leases of all users",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				leases, err := db.ListLeases(ctx, 0)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if len(leases) == 0 {
+					fmt.Println("📭 No active leases")
+					return nil
+				}
+				for _, l := range leases {
+					fmt.Printf("%s  %s  expires %s\n",
+						l.LeaseID, l.Username, l.ExpiresAt.Local().Format(time.DateTime))
+				}
+				return nil
+			})
+		},
+	}
+
+	revokeCmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Drop the user of a lease now",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, _ := cmd.Flags().GetString(flagDBLeaseID)
+			return runWithDatabaseService(cmd, func(ctx context.Context, db service.DatabaseCredentialsService) error {
+				if err := db.RevokeLease(ctx, 0, id); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🔒 Lease revoked: %s\n", id)
+				return nil
+			})
+		},
+	}
+	revokeCmd.Flags().String(flagDBLeaseID, "", "Lease id")
+	_ = revokeCmd.MarkFlagRequired(flagDBLeaseID)
+
+	cmd.AddCommand(listCmd, revokeCmd)
+	return cmd
+}
+
+func runWithDatabaseService(
+	cmd *cobra.Command,
+	fn func(ctx context.Context, db service.DatabaseCredentialsService) error,
+) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	cryptoService, err := service.NewCryptoService(config.NewServerConfig().Security)
+	if err != nil {
+		return fmt.Errorf("failed to init crypto service: %w", err)
+	}
+	policyService := service.NewPolicyService(
+		repository.NewPolicyRepository(database.Pool),
+		repository.NewUserRepository(database.Pool),
+		repository.NewTeamRepository(database.Pool),
+	)
+	db := service.NewDatabaseCredentialsService(
+		repository.NewDatabaseEngineRepository(database.Pool), policyService, cryptoService, nil,
+	)
+	return fn(cmd.Context(), db)
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"fmt"
 	"keeper/internal/config"
+	"keeper/internal/store"
 	"log"
 
 	"github.com/spf13/cobra"
@@ -9,7 +11,11 @@ import (
 )
 
 func bindFlags(cfg *config.MainServerConfig, cmd *cobra.Command) {
-	for _, name := range []string{"address", "port", "grpc-address", "grpc-port", "dsn"} {
+	for _, name := range []string{
+		"address", "port", "public-url", "grpc-address", "grpc-port", "dsn",
+		"audit-hmac-key", "audit-file", "audit-syslog-network", "audit-syslog-address", "audit-webhook-url",
+		"argon2-memory", "argon2-iterations", "argon2-parallelism", "jwt-algorithm",
+	} {
 		if err := viper.BindPFlag(name, cmd.Flags().Lookup(name)); err != nil {
 			log.Fatalf("failed to bind flag '%s': %v", name, err)
 		}
@@ -17,8 +23,39 @@ func bindFlags(cfg *config.MainServerConfig, cmd *cobra.Command) {
 
 	cfg.Server.Address = viper.GetString("address")
 	cfg.Server.Port = viper.GetInt("port")
+	cfg.Server.PublicURL = viper.GetString("public-url")
 	cfg.GrpcServerConfig.Address = viper.GetString("grpc-address")
 	cfg.GrpcServerConfig.Port = viper.GetInt("grpc-port")
 	cfg.Database.DSN = viper.GetString("dsn")
+	cfg.Audit.HMACKey = viper.GetString("audit-hmac-key")
+	cfg.Audit.File.Path = viper.GetString("audit-file")
+	cfg.Audit.Syslog.Network = viper.GetString("audit-syslog-network")
+	cfg.Audit.Syslog.Address = viper.GetString("audit-syslog-address")
+	cfg.Audit.Webhook.URL = viper.GetString("audit-webhook-url")
+	cfg.Security.Argon2.Memory = viper.GetUint32("argon2-memory")
+	cfg.Security.Argon2.Iterations = viper.GetUint32("argon2-iterations")
+	cfg.Security.Argon2.Parallelism = viper.GetUint8("argon2-parallelism")
+	cfg.Security.JWTAlgorithm = viper.GetString("jwt-algorithm")
 	cfg.GrpcServerConfig.Address = "0.0.0.0" // жёстко задано
 }
+
+// openDB connects admin subcommands to the database given by --dsn or
+// KEEPER_DSN.
+func openDB(cmd *cobra.Command) (*store.DB, error) {
+	dsn, err := databaseDSN(cmd)
+	if err != nil {
+		return nil, err
+	}
+	database, err := store.NewDB(cmd.Context(), dsn)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	return database, nil
+}
+
+func databaseDSN(cmd *cobra.Command) (string, error) {
+	if err := viper.BindPFlag("dsn", cmd.Flags().Lookup("dsn")); err != nil {
+		return "", fmt.Errorf("failed to bind flag 'dsn': %w", err)
+	}
+	return viper.GetString("dsn"), nil
+}
@@ -2,6 +2,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"keeper/internal/config"
 	"keeper/internal/handler"
@@ -11,6 +14,7 @@ import (
 	"keeper/internal/service"
 	"log"
 	"net"
+	"os"
 	"strconv"
 
 	"google.golang.org/grpc/credentials"
@@ -28,23 +32,37 @@ func initGRPCServer(
 	l *logger.ZapLogger,
 	authHandler *handler.AuthServerHandler,
 	vaultHandler *handler.VaultServerHandler,
+	teamHandler *handler.TeamServerHandler,
+	serviceAccountHandler *handler.ServiceAccountServerHandler,
+	wrapHandler *handler.WrapServerHandler,
+	databaseHandler *handler.DatabaseServerHandler,
+	transitHandler *handler.TransitServerHandler,
+	pkiHandler *handler.PKIServerHandler,
+	sshHandler *handler.SSHServerHandler,
+	unaryInterceptor grpc.UnaryServerInterceptor,
 	jwtService service.JwtService,
+	sessionService service.SessionService,
 ) {
 	var grpcServer *grpc.Server
 
-	authInterceptor := interceptor.AuthInterceptor(jwtService)
-
 	var opts []grpc.ServerOption
-	opts = append(opts, grpc.UnaryInterceptor(authInterceptor))
+	opts = append(opts, grpc.UnaryInterceptor(unaryInterceptor))
+	opts = append(opts, grpc.ChainStreamInterceptor(interceptor.AuthStreamInterceptor(jwtService, sessionService)))
 
 	if cfg.GrpcServerConfig.EnableTLS {
-		creds, err := credentials.NewServerTLSFromFile(cfg.GrpcServerConfig.CertFile, cfg.GrpcServerConfig.KeyFile)
+		tlsConfig, err := serverTLSConfig(cfg.GrpcServerConfig)
 		if err != nil {
 			log.Fatalf("failed to load TLS credentials: %v", err)
 		}
-		opts = append(opts, grpc.Creds(creds))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 		l.InfoCtx(ctx, "TLS is enabled for gRPC server")
+		if cfg.GrpcServerConfig.ClientCAFile != "" {
+			l.InfoCtx(ctx, "client certificate authentication is enabled for gRPC server")
+		}
 	} else {
+		if cfg.GrpcServerConfig.ClientCAFile != "" {
+			log.Fatalf("--client-ca-file requires --enable-tls")
+		}
 		l.InfoCtx(ctx, "TLS is disabled for gRPC server")
 	}
 
@@ -59,6 +77,13 @@ func initGRPCServer(
 
 		pb.RegisterAuthServiceServer(grpcServer, authHandler)
 		pb.RegisterDataServiceServer(grpcServer, vaultHandler)
+		pb.RegisterTeamServiceServer(grpcServer, teamHandler)
+		pb.RegisterServiceAccountServiceServer(grpcServer, serviceAccountHandler)
+		pb.RegisterWrapServiceServer(grpcServer, wrapHandler)
+		pb.RegisterDatabaseServiceServer(grpcServer, databaseHandler)
+		pb.RegisterTransitServiceServer(grpcServer, transitHandler)
+		pb.RegisterPKIServiceServer(grpcServer, pkiHandler)
+		pb.RegisterSSHServiceServer(grpcServer, sshHandler)
 
 		reflection.Register(grpcServer)
 		err = grpcServer.Serve(lis)
@@ -81,3 +106,37 @@ func initGRPCServer(
 		return nil
 	})
 }
+
+// serverTLSConfig loads the server key pair and, when a client CA is set,
+// verifies client certificates against it.
+func serverTLSConfig(cfg config.GrpcServerConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server key pair: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile == "" {
+		if cfg.RequireClientCert {
+			return nil, errors.New("--require-client-cert needs --client-ca-file")
+		}
+		return tlsConfig, nil
+	}
+
+	caPEM, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", cfg.ClientCAFile)
+	}
+	tlsConfig.ClientCAs = pool
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if cfg.RequireClientCert {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+
+	"github.com/spf13/cobra"
+)
+
+const flagJWTAlgorithm = "algorithm"
+
+func jwtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jwt",
+		Short: "Manage the access token signing keys",
+	}
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Start signing access tokens with a new key",
+		Long: "Start signing access tokens with a new key. The previous key keeps verifying tokens " +
+			"until they expire; running servers switch to the new key within a minute.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			algorithm, _ := cmd.Flags().GetString(flagJWTAlgorithm)
+			return runWithJwtService(cmd, func(ctx context.Context, jwt service.JwtService) error {
+				key, err := jwt.Rotate(ctx, algorithm)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ New %s signing key: %s\n", key.Algorithm, key.KID)
+				return nil
+			})
+		},
+	}
+	rotateCmd.Flags().String(flagJWTAlgorithm, entity.JWTAlgorithmEdDSA, "Algorithm of the new key: EdDSA or ES256")
+
+	cmd.AddCommand(rotateCmd)
+	return cmd
+}
+
+func runWithJwtService(
+	cmd *cobra.Command,
+	fn func(ctx context.Context, jwt service.JwtService) error,
+) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	cfg := config.NewServerConfig().Security
+	cryptoService, err := service.NewCryptoService(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to init crypto service: %w", err)
+	}
+	jwtService := service.NewJwtService(cfg, repository.NewJWTKeyRepository(database.Pool), cryptoService)
+	return fn(cmd.Context(), jwtService)
+}
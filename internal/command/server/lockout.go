@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagLockoutLines = "lines"
+	flagLockoutLogin = "login"
+	flagLockoutIP    = "ip"
+
+	defaultLockoutLines = 20
+)
+
+func lockoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lockout",
+		Short: "Inspect and clear login lockouts",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "Print recent lockout events",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			lines, _ := cmd.Flags().GetInt(flagLockoutLines)
+			return runWithThrottleService(cmd, func(ctx context.Context, throttle service.ThrottleService) error {
+				events, err := throttle.ListLockouts(ctx, lines)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				const lockoutFormat = "%-20s  %-32s  %-8s  %-16s  %s\n"
+				fmt.Printf(lockoutFormat, "Time", "Key", "Failures", "Client IP", "Locked until")
+				for _, e := range events {
+					fmt.Printf(lockoutFormat,
+						e.CreatedAt.Local().Format(time.DateTime),
+						e.Key,
+						fmt.Sprint(e.Failures),
+						e.ClientIP,
+						e.LockedUntil.Local().Format(time.DateTime))
+				}
+				return nil
+			})
+		},
+	}
+	listCmd.Flags().IntP(flagLockoutLines, "n", defaultLockoutLines, "Number of recent events to print")
+
+	clearCmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Unlock an account or a client address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			login, _ := cmd.Flags().GetString(flagLockoutLogin)
+			ip, _ := cmd.Flags().GetString(flagLockoutIP)
+			if login == "" && ip == "" {
+				return errors.New("either --login or --ip is required")
+			}
+			return runWithThrottleService(cmd, func(ctx context.Context, throttle service.ThrottleService) error {
+				var keys []string
+				if login != "" {
+					keys = append(keys, service.ThrottleAccountPrefix+login)
+				}
+				if ip != "" {
+					keys = append(keys, service.ThrottleIPPrefix+ip, service.ThrottleRegisterPrefix+ip)
+				}
+				for _, key := range keys {
+					if err := throttle.Unlock(ctx, key); err != nil {
+						return fmt.Errorf("%w", err)
+					}
+				}
+				fmt.Println("✅ Lockout cleared")
+				return nil
+			})
+		},
+	}
+	clearCmd.Flags().String(flagLockoutLogin, "", "Account login to unlock")
+	clearCmd.Flags().String(flagLockoutIP, "", "Client address to unlock")
+
+	cmd.AddCommand(listCmd, clearCmd)
+	return cmd
+}
+
+func runWithThrottleService(
+	cmd *cobra.Command,
+	fn func(ctx context.Context, throttle service.ThrottleService) error,
+) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	throttleService := service.NewThrottleService(
+		repository.NewThrottleRepository(database.Pool),
+		config.NewServerConfig().Security.Lockout,
+		nil,
+	)
+	return fn(cmd.Context(), throttleService)
+}
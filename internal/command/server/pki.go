@@ -0,0 +1,260 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPKICommonName       = "common-name"
+	flagPKIKeyType          = "key-type"
+	flagPKITTL              = "ttl"
+	flagPKIOutFile          = "out-file"
+	flagPKICertFile         = "cert-file"
+	flagPKIKeyFile          = "key-file"
+	flagPKIChainFile        = "chain-file"
+	flagPKIName             = "name"
+	flagPKIAllowedDomains   = "allowed-domains"
+	flagPKIAllowBareDomains = "allow-bare-domains"
+	flagPKIAllowSubdomains  = "allow-subdomains"
+	flagPKIAllowIPSANs      = "allow-ip-sans"
+	flagPKIKeyTypes         = "key-types"
+	flagPKIServerAuth       = "server"
+	flagPKIClientAuth       = "client"
+	flagPKIDefaultTTL       = "default-ttl"
+	flagPKIMaxTTL           = "max-ttl"
+	flagPKISerial           = "serial"
+)
+
+func pkiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pki",
+		Short: "Manage the internal X.509 certificate authority",
+	}
+	cmd.AddCommand(pkiCACmd(), pkiRoleCmd(), pkiRevokeCmd())
+	return cmd
+}
+
+func pkiCACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ca",
+		Short: "Set up the issuing CA",
+	}
+
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a self-signed root CA inside the vault",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commonName, _ := cmd.Flags().GetString(flagPKICommonName)
+			keyType, _ := cmd.Flags().GetString(flagPKIKeyType)
+			ttl, _ := cmd.Flags().GetDuration(flagPKITTL)
+			outFile, _ := cmd.Flags().GetString(flagPKIOutFile)
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				certPEM, err := pki.GenerateRoot(ctx, commonName, keyType, ttl)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if outFile == "" {
+					fmt.Print(certPEM)
+					return nil
+				}
+				if err := os.WriteFile(outFile, []byte(certPEM), certFilePerm); err != nil {
+					return fmt.Errorf("failed to write %s: %w", outFile, err)
+				}
+				fmt.Printf("✅ Root CA generated: %s\n", outFile)
+				return nil
+			})
+		},
+	}
+	generateCmd.Flags().String(flagPKICommonName, "", "Subject common name of the CA")
+	generateCmd.Flags().String(flagPKIKeyType, entity.PKIKeyEC, "Key type: ec, rsa or ed25519")
+	generateCmd.Flags().Duration(flagPKITTL, service.DefaultPKICATTL, "Lifetime of the CA certificate")
+	generateCmd.Flags().String(flagPKIOutFile, "", "Write the CA certificate here instead of stdout")
+	_ = generateCmd.MarkFlagRequired(flagPKICommonName)
+
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an intermediate CA signed by an external root",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files := map[string]string{}
+			for _, flag := range []string{flagPKICertFile, flagPKIKeyFile, flagPKIChainFile} {
+				file, _ := cmd.Flags().GetString(flag)
+				if file == "" {
+					continue
+				}
+				raw, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read %s: %w", flag, err)
+				}
+				files[flag] = string(raw)
+			}
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				err := pki.ImportCA(ctx, files[flagPKICertFile], files[flagPKIKeyFile], files[flagPKIChainFile])
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Println("✅ CA imported")
+				return nil
+			})
+		},
+	}
+	importCmd.Flags().String(flagPKICertFile, "", "PEM certificate of the CA")
+	importCmd.Flags().String(flagPKIKeyFile, "", "PEM private key of the CA")
+	importCmd.Flags().String(flagPKIChainFile, "", "PEM certificates of the issuers above the CA")
+	_ = importCmd.MarkFlagRequired(flagPKICertFile)
+	_ = importCmd.MarkFlagRequired(flagPKIKeyFile)
+
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the CA certificate and its issuers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				chain, err := pki.CAChain(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Print(chain)
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(generateCmd, importCmd, showCmd)
+	return cmd
+}
+
+func pkiRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Manage roles that certificates are issued through",
+	}
+
+	writeCmd := &cobra.Command{
+		Use:   "write",
+		Short: "Create or replace a role",
+		Long: "Create or replace a role. Users need a policy granting create on pki/issue/<role> " +
+			"to get certificates from it.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role := &entity.PKIRole{}
+			role.Name, _ = cmd.Flags().GetString(flagPKIName)
+			role.AllowedDomains, _ = cmd.Flags().GetStringSlice(flagPKIAllowedDomains)
+			role.AllowBareDomains, _ = cmd.Flags().GetBool(flagPKIAllowBareDomains)
+			role.AllowSubdomains, _ = cmd.Flags().GetBool(flagPKIAllowSubdomains)
+			role.AllowIPSANs, _ = cmd.Flags().GetBool(flagPKIAllowIPSANs)
+			role.KeyTypes, _ = cmd.Flags().GetStringSlice(flagPKIKeyTypes)
+			role.ServerAuth, _ = cmd.Flags().GetBool(flagPKIServerAuth)
+			role.ClientAuth, _ = cmd.Flags().GetBool(flagPKIClientAuth)
+			role.DefaultTTL, _ = cmd.Flags().GetDuration(flagPKIDefaultTTL)
+			role.MaxTTL, _ = cmd.Flags().GetDuration(flagPKIMaxTTL)
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				if err := pki.WriteRole(ctx, role); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ Role written: %s (ttl %s, max %s)\n", role.Name, role.DefaultTTL, role.MaxTTL)
+				return nil
+			})
+		},
+	}
+	writeCmd.Flags().String(flagPKIName, "", "Role name")
+	writeCmd.Flags().StringSlice(flagPKIAllowedDomains, nil, "Domains names must equal or be below")
+	writeCmd.Flags().Bool(flagPKIAllowBareDomains, false, "Allow the allowed domains themselves")
+	writeCmd.Flags().Bool(flagPKIAllowSubdomains, true, "Allow names below the allowed domains, wildcards included")
+	writeCmd.Flags().Bool(flagPKIAllowIPSANs, false, "Allow IP address SANs")
+	writeCmd.Flags().StringSlice(flagPKIKeyTypes, []string{entity.PKIKeyEC}, "Allowed key types: ec, rsa, ed25519")
+	writeCmd.Flags().Bool(flagPKIServerAuth, true, "Certificates may authenticate servers")
+	writeCmd.Flags().Bool(flagPKIClientAuth, false, "Certificates may authenticate clients")
+	writeCmd.Flags().Duration(flagPKIDefaultTTL, service.DefaultPKIRoleTTL, "Lifetime when none is requested")
+	writeCmd.Flags().Duration(flagPKIMaxTTL, service.DefaultPKIRoleMax, "Longest lifetime a user may request")
+	_ = writeCmd.MarkFlagRequired(flagPKIName)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List roles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				roles, err := pki.ListRoles(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if len(roles) == 0 {
+					fmt.Println("📭 No roles defined")
+					return nil
+				}
+				for _, r := range roles {
+					fmt.Printf("📜 %s  domains [%s]  keys [%s]  ttl %s, max %s\n",
+						r.Name, strings.Join(r.AllowedDomains, ", "), strings.Join(r.KeyTypes, ", "),
+						r.DefaultTTL, r.MaxTTL)
+				}
+				return nil
+			})
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a role; issued certificates stay valid",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagPKIName)
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				if err := pki.DeleteRole(ctx, name); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🗑  Role deleted: %s\n", name)
+				return nil
+			})
+		},
+	}
+	deleteCmd.Flags().String(flagPKIName, "", "Role name")
+	_ = deleteCmd.MarkFlagRequired(flagPKIName)
+
+	cmd.AddCommand(writeCmd, listCmd, deleteCmd)
+	return cmd
+}
+
+func pkiRevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke any issued certificate and add it to the CRL",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serial, _ := cmd.Flags().GetString(flagPKISerial)
+			return runWithPKIService(cmd, func(ctx context.Context, pki service.PKIService) error {
+				if err := pki.RevokeCertificate(ctx, 0, serial); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🔒 Certificate revoked: %s\n", serial)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().String(flagPKISerial, "", "Serial number in hex, colons allowed")
+	_ = cmd.MarkFlagRequired(flagPKISerial)
+	return cmd
+}
+
+func runWithPKIService(cmd *cobra.Command, fn func(ctx context.Context, pki service.PKIService) error) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	cryptoService, err := service.NewCryptoService(config.NewServerConfig().Security)
+	if err != nil {
+		return fmt.Errorf("failed to init crypto service: %w", err)
+	}
+	policyService := service.NewPolicyService(
+		repository.NewPolicyRepository(database.Pool),
+		repository.NewUserRepository(database.Pool),
+		repository.NewTeamRepository(database.Pool),
+	)
+	pki := service.NewPKIService(repository.NewPKIRepository(database.Pool), policyService, cryptoService, "")
+	return fn(cmd.Context(), pki)
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPolicyName = "name"
+	flagPolicyFile = "file"
+	flagUser       = "user"
+	flagTeam       = "team"
+	flagTokenID    = "token-id"
+)
+
+// policyDocument is the JSON accepted by `policy write --file`.
+type policyDocument struct {
+	Description string              `json:"description"`
+	Rules       []entity.PolicyRule `json:"rules"`
+}
+
+func policyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Manage ACL policies enforced on secret paths",
+	}
+
+	writeCmd := &cobra.Command{
+		Use:   "write",
+		Short: "Create or replace a policy from a JSON file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagPolicyName)
+			file, _ := cmd.Flags().GetString(flagPolicyFile)
+			raw, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read policy file: %w", err)
+			}
+			var doc policyDocument
+			if err := json.Unmarshal(raw, &doc); err != nil {
+				return fmt.Errorf("failed to parse policy file: %w", err)
+			}
+			return runWithPolicyService(cmd, func(ctx context.Context, policies service.PolicyService) error {
+				policy := &entity.Policy{Name: name, Description: doc.Description, Rules: doc.Rules}
+				if err := policies.WritePolicy(ctx, policy); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ Policy written: %s\n", name)
+				return nil
+			})
+		},
+	}
+	writeCmd.Flags().String(flagPolicyName, "", "Policy name")
+	writeCmd.Flags().String(flagPolicyFile, "", "Path to the policy JSON file")
+	_ = writeCmd.MarkFlagRequired(flagPolicyName)
+	_ = writeCmd.MarkFlagRequired(flagPolicyFile)
+
+	readCmd := &cobra.Command{
+		Use:   "read",
+		Short: "Show a policy with its rules and attachments",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagPolicyName)
+			return runWithPolicyService(cmd, func(ctx context.Context, policies service.PolicyService) error {
+				policy, attachments, err := policies.GetPolicy(ctx, name)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("📜 %s  %s\n", policy.Name, policy.Description)
+				for _, rule := range policy.Rules {
+					fmt.Printf("  %s → %s\n", rule.Path, strings.Join(rule.Capabilities, ", "))
+				}
+				if len(attachments) > 0 {
+					fmt.Println("Attached to:")
+					for _, a := range attachments {
+						fmt.Printf("  %s %s\n", a.SubjectType, a.SubjectName)
+					}
+				}
+				return nil
+			})
+		},
+	}
+	readCmd.Flags().String(flagPolicyName, "", "Policy name")
+	_ = readCmd.MarkFlagRequired(flagPolicyName)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List policies",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithPolicyService(cmd, func(ctx context.Context, policies service.PolicyService) error {
+				list, err := policies.ListPolicies(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if len(list) == 0 {
+					fmt.Println("📭 No policies defined")
+					return nil
+				}
+				for _, p := range list {
+					fmt.Printf("📜 %s  %s\n", p.Name, p.Description)
+				}
+				return nil
+			})
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a policy and its attachments",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagPolicyName)
+			return runWithPolicyService(cmd, func(ctx context.Context, policies service.PolicyService) error {
+				if err := policies.DeletePolicy(ctx, name); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🗑️  Policy deleted: %s\n", name)
+				return nil
+			})
+		},
+	}
+	deleteCmd.Flags().String(flagPolicyName, "", "Policy name")
+	_ = deleteCmd.MarkFlagRequired(flagPolicyName)
+
+	attachCmd := &cobra.Command{
+		Use:   "attach",
+		Short: "Attach a policy to a user, team or access token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagPolicyName)
+			subjectType, subject, err := policySubject(cmd)
+			if err != nil {
+				return err
+			}
+			return runWithPolicyService(cmd, func(ctx context.Context, policies service.PolicyService) error {
+				if err := policies.Attach(ctx, name, subjectType, subject); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ Policy %s attached to %s %s\n", name, subjectType, subject)
+				return nil
+			})
+		},
+	}
+
+	detachCmd := &cobra.Command{
+		Use:   "detach",
+		Short: "Detach a policy from a user, team or access token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagPolicyName)
+			subjectType, subject, err := policySubject(cmd)
+			if err != nil {
+				return err
+			}
+			return runWithPolicyService(cmd, func(ctx context.Context, policies service.PolicyService) error {
+				if err := policies.Detach(ctx, name, subjectType, subject); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🔒 Policy %s detached from %s %s\n", name, subjectType, subject)
+				return nil
+			})
+		},
+	}
+
+	for _, c := range []*cobra.Command{attachCmd, detachCmd} {
+		c.Flags().String(flagPolicyName, "", "Policy name")
+		c.Flags().String(flagUser, "", "User login")
+		c.Flags().String(flagTeam, "", "Team name")
+		c.Flags().String(flagTokenID, "", "Access token id")
+		_ = c.MarkFlagRequired(flagPolicyName)
+		c.MarkFlagsMutuallyExclusive(flagUser, flagTeam, flagTokenID)
+	}
+
+	cmd.AddCommand(writeCmd, readCmd, listCmd, deleteCmd, attachCmd, detachCmd)
+	return cmd
+}
+
+// policySubject returns the subject selected by --user, --team or --token-id.
+func policySubject(cmd *cobra.Command) (subjectType, subject string, err error) {
+	for flag, kind := range map[string]string{
+		flagUser:    entity.SubjectUser,
+		flagTeam:    entity.SubjectTeam,
+		flagTokenID: entity.SubjectToken,
+	} {
+		if value, _ := cmd.Flags().GetString(flag); value != "" {
+			return kind, value, nil
+		}
+	}
+	return "", "", errors.New("one of --user, --team or --token-id is required")
+}
+
+func runWithPolicyService(
+	cmd *cobra.Command,
+	fn func(ctx context.Context, policies service.PolicyService) error,
+) error {
+	ctx := cmd.Context()
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	policyService := service.NewPolicyService(
+		repository.NewPolicyRepository(database.Pool),
+		repository.NewUserRepository(database.Pool),
+		repository.NewTeamRepository(database.Pool),
+	)
+	return fn(ctx, policyService)
+}
@@ -3,9 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"keeper/internal/audit"
 	"keeper/internal/config"
 	"keeper/internal/handler"
 	"keeper/internal/handler/web"
+	"keeper/internal/interceptor"
 	"keeper/internal/repository"
 	"keeper/internal/service"
 	"keeper/internal/store"
@@ -53,6 +55,21 @@ func run(cfg *config.MainServerConfig) error {
 	userRepo := repository.NewUserRepository(database.Pool)
 	vaultRepo := repository.NewVaultRepository(database.Pool)
 	accessRepo := repository.NewAccessRepository(database.Pool)
+	refreshRepo := repository.NewRefreshTokenRepository(database.Pool)
+	twoFactorRepo := repository.NewTwoFactorRepository(database.Pool)
+	throttleRepo := repository.NewThrottleRepository(database.Pool)
+	certRepo := repository.NewClientCertificateRepository(database.Pool)
+	grantRepo := repository.NewGrantRepository(database.Pool)
+	teamRepo := repository.NewTeamRepository(database.Pool)
+	policyRepo := repository.NewPolicyRepository(database.Pool)
+	auditRepo := repository.NewAuditRepository(database.Pool)
+	serviceAccountRepo := repository.NewServiceAccountRepository(database.Pool)
+	jwtKeyRepo := repository.NewJWTKeyRepository(database.Pool)
+	wrapRepo := repository.NewWrapRepository(database.Pool)
+	databaseEngineRepo := repository.NewDatabaseEngineRepository(database.Pool)
+	transitKeyRepo := repository.NewTransitKeyRepository(database.Pool)
+	pkiRepo := repository.NewPKIRepository(database.Pool)
+	sshRepo := repository.NewSSHRepository(database.Pool)
 	var fileRepo *repository.MinIORepository
 	if minioClient != nil {
 		fileRepo = repository.NewMinIORepository(
@@ -62,34 +79,92 @@ func run(cfg *config.MainServerConfig) error {
 		)
 	}
 	// Init services
-	jwtService := service.NewJwtService(cfg.Security)
-	authService := service.NewAuthService(database.Pool, userRepo, accessRepo, jwtService, cfg.Security, l)
+	sessionService := service.NewSessionService(accessRepo, cfg.Security)
 	cryptoService, err := service.NewCryptoService(cfg.Security)
 	if err != nil {
 		return fmt.Errorf("failed to init crypto service: %w", err)
 	}
-	vaultService := service.NewVaultService(vaultRepo, cryptoService, fileRepo)
+	jwtService := service.NewJwtService(cfg.Security, jwtKeyRepo, cryptoService)
+	twoFactorService := service.NewTwoFactorService(database.Pool, twoFactorRepo, userRepo, cryptoService)
+	throttleService := service.NewThrottleService(throttleRepo, cfg.Security.Lockout, l)
+	authService := service.NewAuthService(
+		database.Pool, userRepo, accessRepo, refreshRepo, certRepo, sessionService,
+		jwtService, twoFactorService, throttleService, cfg.Security, l,
+	)
+	vaultService := service.NewVaultService(vaultRepo, grantRepo, teamRepo, userRepo, cryptoService, fileRepo)
+	grantService := service.NewGrantService(grantRepo, userRepo)
+	teamService := service.NewTeamService(teamRepo, userRepo)
+	serviceAccountService := service.NewServiceAccountService(serviceAccountRepo, teamRepo)
+	wrapService := service.NewWrapService(wrapRepo, vaultService, cryptoService)
+	transitService := service.NewTransitService(transitKeyRepo, teamRepo, cryptoService)
+	policyService := service.NewPolicyService(policyRepo, userRepo, teamRepo)
+	databaseCredsService := service.NewDatabaseCredentialsService(databaseEngineRepo, policyService, cryptoService, l)
+	pkiService := service.NewPKIService(pkiRepo, policyService, cryptoService, cfg.Server.PublicURL)
+	sshService := service.NewSSHService(sshRepo, userRepo, policyService, cryptoService)
+	auditSinks, err := audit.NewSinks(cfg.Audit)
+	if err != nil {
+		return fmt.Errorf("failed to init audit sinks: %w", err)
+	}
+	defer func() {
+		if err := audit.CloseAll(auditSinks); err != nil {
+			log.Printf("failed to close audit sinks: %v", err)
+		}
+	}()
+	auditService := service.NewAuditService(auditRepo, cfg.Audit.HMACKey, auditSinks, l)
+
+	// Interceptors shared by the gRPC server and the Vault compatible API.
+	// Audit comes first so that requests failing authentication are recorded.
+	unaryInterceptor := interceptor.Chain(
+		interceptor.AuditInterceptor(auditService),
+		interceptor.AuthInterceptor(jwtService, sessionService, serviceAccountService),
+		interceptor.PolicyInterceptor(policyService, vaultService),
+	)
+
+	// GRPC handlers.
+	authHandler := handler.NewAuthHandler(l, authService, sessionService, twoFactorService)
+	vaultHandler := handler.NewVaultHandler(l, vaultService, grantService)
+	teamHandler := handler.NewTeamHandler(l, teamService)
+	serviceAccountHandler := handler.NewServiceAccountHandler(l, serviceAccountService)
+	wrapHandler := handler.NewWrapHandler(l, wrapService, cfg.Server.PublicURL)
+	databaseHandler := handler.NewDatabaseHandler(l, databaseCredsService)
+	transitHandler := handler.NewTransitHandler(l, transitService)
+	pkiHandler := handler.NewPKIHandler(l, pkiService)
+	sshHandler := handler.NewSSHHandler(l, sshService)
 
-	// Init handlers
 	// WEB handlers.
 	staticHandler := web.NewStaticPageHandler(l)
 	fileHandler := web.NewFileServerHandler(l, cfg)
 	downloadHandler := web.NewDownloadHandler(l, cfg)
+	jwksHandler := web.NewJWKSHandler(l, jwtService)
+	unwrapHandler := web.NewUnwrapHandler(l, wrapService)
+	pkiWebHandler := web.NewPKIHandler(l, pkiService)
+	sshWebHandler := web.NewSSHHandler(l, sshService)
+	vaultKVHandler := web.NewVaultKVHandler(l, vaultHandler, unaryInterceptor)
 
 	router := chi.NewRouter()
 	router.Handle("/downloads/*", fileHandler.FileServerHandler(ctx))
 	router.Get("/download", downloadHandler.DownloadHandler())
+	router.Get("/.well-known/jwks.json", jwksHandler.KeySetHandler())
+	router.Get(handler.UnwrapPagePath+"{token}", unwrapHandler.ConfirmHandler())
+	router.Post(handler.UnwrapPagePath+"{token}", unwrapHandler.RevealHandler())
+	router.Get(service.PKICRLPath, pkiWebHandler.CRLHandler())
+	router.Get(service.PKICAPath, pkiWebHandler.CAHandler())
+	router.Get(service.SSHCAPath, sshWebHandler.CAHandler())
+	router.Mount(web.VaultAPIPrefix, vaultKVHandler.Routes())
 	router.NotFound(staticHandler.NotFoundHandler(context.Background()))
 
-	// GRPC handlers.
-	authHandler := handler.NewAuthHandler(l, authService)
-	vaultHandler := handler.NewVaultHandler(l, vaultService)
-
 	// Start HTTP server
 	initHTTPServer(ctx, g, cfg, router, l)
 
 	// Start Grpc Server
-	initGRPCServer(ctx, g, cfg, l, authHandler, vaultHandler, jwtService)
+	initGRPCServer(ctx, g, cfg, l, authHandler, vaultHandler, teamHandler, serviceAccountHandler, wrapHandler,
+		databaseHandler, transitHandler, pkiHandler, sshHandler, unaryInterceptor, jwtService, sessionService)
+
+	// Drop database users whose leases expired
+	g.Go(func() error {
+		databaseCredsService.Run(ctx)
+		return nil
+	})
 
 	err = g.Wait()
 	if err != nil {
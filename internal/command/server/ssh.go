@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/service"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagSSHKeyType           = "key-type"
+	flagSSHKeyFile           = "key-file"
+	flagSSHName              = "name"
+	flagSSHAllowedPrincipals = "allowed-principals"
+	flagSSHDefaultPrincipals = "default-principals"
+	flagSSHExtensions        = "extensions"
+	flagSSHDefaultTTL        = "default-ttl"
+	flagSSHMaxTTL            = "max-ttl"
+)
+
+func sshCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ssh",
+		Short: "Manage the SSH user certificate authority",
+	}
+	cmd.AddCommand(sshCACmd(), sshRoleCmd())
+	return cmd
+}
+
+func sshCACmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ca",
+		Short: "Set up the SSH CA key",
+	}
+
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a CA key inside the vault and print its public key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyType, _ := cmd.Flags().GetString(flagSSHKeyType)
+			return runWithSSHService(cmd, func(ctx context.Context, ssh service.SSHService) error {
+				key, err := ssh.GenerateCA(ctx, keyType)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Println(key)
+				return nil
+			})
+		},
+	}
+	generateCmd.Flags().String(flagSSHKeyType, entity.PKIKeyEd25519, "Key type: ed25519, ec or rsa")
+
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an existing unencrypted CA private key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, _ := cmd.Flags().GetString(flagSSHKeyFile)
+			raw, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", flagSSHKeyFile, err)
+			}
+			return runWithSSHService(cmd, func(ctx context.Context, ssh service.SSHService) error {
+				key, err := ssh.ImportCA(ctx, string(raw))
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Println(key)
+				return nil
+			})
+		},
+	}
+	importCmd.Flags().String(flagSSHKeyFile, "", "OpenSSH or PEM private key of the CA")
+	_ = importCmd.MarkFlagRequired(flagSSHKeyFile)
+
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the CA public key for TrustedUserCAKeys",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithSSHService(cmd, func(ctx context.Context, ssh service.SSHService) error {
+				key, err := ssh.CAPublicKey(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Println(key)
+				return nil
+			})
+		},
+	}
+
+	cmd.AddCommand(generateCmd, importCmd, showCmd)
+	return cmd
+}
+
+func sshRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Manage roles that keys are signed through",
+	}
+
+	writeCmd := &cobra.Command{
+		Use:   "write",
+		Short: "Create or replace a role",
+		Long: "Create or replace a role. Principals may use {{login}} for the requesting user's login. " +
+			"Users need a policy granting create on ssh/sign/<role> to get certificates from it.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role := &entity.SSHRole{}
+			role.Name, _ = cmd.Flags().GetString(flagSSHName)
+			role.AllowedPrincipals, _ = cmd.Flags().GetStringSlice(flagSSHAllowedPrincipals)
+			role.DefaultPrincipals, _ = cmd.Flags().GetStringSlice(flagSSHDefaultPrincipals)
+			role.Extensions, _ = cmd.Flags().GetStringSlice(flagSSHExtensions)
+			role.DefaultTTL, _ = cmd.Flags().GetDuration(flagSSHDefaultTTL)
+			role.MaxTTL, _ = cmd.Flags().GetDuration(flagSSHMaxTTL)
+			return runWithSSHService(cmd, func(ctx context.Context, ssh service.SSHService) error {
+				if err := ssh.WriteRole(ctx, role); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("✅ Role written: %s (ttl %s, max %s)\n", role.Name, role.DefaultTTL, role.MaxTTL)
+				return nil
+			})
+		},
+	}
+	writeCmd.Flags().String(flagSSHName, "", "Role name")
+	writeCmd.Flags().StringSlice(flagSSHAllowedPrincipals, []string{entity.SSHPrincipalLogin},
+		"Principals users may request, * for any")
+	writeCmd.Flags().StringSlice(flagSSHDefaultPrincipals, []string{entity.SSHPrincipalLogin},
+		"Principals used when none are requested")
+	writeCmd.Flags().StringSlice(flagSSHExtensions,
+		[]string{"permit-pty", "permit-agent-forwarding", "permit-port-forwarding"},
+		"Extensions granted to certificates")
+	writeCmd.Flags().Duration(flagSSHDefaultTTL, service.DefaultSSHRoleTTL, "Validity when none is requested")
+	writeCmd.Flags().Duration(flagSSHMaxTTL, service.DefaultSSHRoleMax, "Longest validity a user may request")
+	_ = writeCmd.MarkFlagRequired(flagSSHName)
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List roles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWithSSHService(cmd, func(ctx context.Context, ssh service.SSHService) error {
+				roles, err := ssh.ListRoles(ctx)
+				if err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				if len(roles) == 0 {
+					fmt.Println("📭 No roles defined")
+					return nil
+				}
+				for _, r := range roles {
+					fmt.Printf("🔑 %s  principals [%s]  ttl %s, max %s\n",
+						r.Name, strings.Join(r.AllowedPrincipals, ", "), r.DefaultTTL, r.MaxTTL)
+				}
+				return nil
+			})
+		},
+	}
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a role; signed certificates stay valid until they expire",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, _ := cmd.Flags().GetString(flagSSHName)
+			return runWithSSHService(cmd, func(ctx context.Context, ssh service.SSHService) error {
+				if err := ssh.DeleteRole(ctx, name); err != nil {
+					return fmt.Errorf("%w", err)
+				}
+				fmt.Printf("🗑  Role deleted: %s\n", name)
+				return nil
+			})
+		},
+	}
+	deleteCmd.Flags().String(flagSSHName, "", "Role name")
+	_ = deleteCmd.MarkFlagRequired(flagSSHName)
+
+	cmd.AddCommand(writeCmd, listCmd, deleteCmd)
+	return cmd
+}
+
+func runWithSSHService(cmd *cobra.Command, fn func(ctx context.Context, ssh service.SSHService) error) error {
+	database, err := openDB(cmd)
+	if err != nil {
+		return err
+	}
+	defer database.Pool.Close()
+
+	cryptoService, err := service.NewCryptoService(config.NewServerConfig().Security)
+	if err != nil {
+		return fmt.Errorf("failed to init crypto service: %w", err)
+	}
+	userRepo := repository.NewUserRepository(database.Pool)
+	policyService := service.NewPolicyService(
+		repository.NewPolicyRepository(database.Pool),
+		userRepo,
+		repository.NewTeamRepository(database.Pool),
+	)
+	ssh := service.NewSSHService(repository.NewSSHRepository(database.Pool), userRepo, policyService, cryptoService)
+	return fn(cmd.Context(), ssh)
+}
@@ -3,15 +3,22 @@ package config
 import "time"
 
 type MainAgentConfig struct {
+	// TokenFile is the file the access token was read from. When set, the
+	// client refreshes the token using the refresh token stored next to it.
+	TokenFile    string
 	RemoteServer RemoteServer
 }
 
 type RemoteServer struct {
-	Address   string
-	CACert    string
-	Port      int
-	Timeout   time.Duration
-	EnableTLS bool
+	Address string
+	CACert  string
+	// ClientCert and ClientKey are presented to a server running with
+	// --client-ca-file, for LoginWithCertificate.
+	ClientCert string
+	ClientKey  string
+	Port       int
+	Timeout    time.Duration
+	EnableTLS  bool
 }
 
 func NewAgentConfig() *MainAgentConfig {
@@ -21,7 +28,7 @@ func NewAgentConfig() *MainAgentConfig {
 		defaultTimeout    = 5 * time.Second
 	)
 	return &MainAgentConfig{
-		RemoteServer{
+		RemoteServer: RemoteServer{
 			Address: remoteGrpcAddress,
 			Port:    remoteGrpcPORT,
 			Timeout: defaultTimeout,
@@ -0,0 +1,11 @@
+package dto
+
+type AuditRecord struct {
+	Method     string
+	Path       string
+	Result     string
+	ClientAddr string
+	RequestID  string
+	UserID     int64
+	Version    int64
+}
@@ -8,3 +8,19 @@ type Claims struct {
 	jwt.RegisteredClaims
 	UserID int64 `json:"user_id"`
 }
+
+// JWKS is the JSON Web Key Set served at /.well-known/jwks.json.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is a public signing key, OKP for Ed25519 or EC for P-256.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y,omitempty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// DatabaseCredentials is a generated database user. Password is only ever
+// returned here and is not stored by the server.
+type DatabaseCredentials struct {
+	ExpiresAt time.Time
+	LeaseID   string
+	Role      string
+	Username  string
+	Password  string
+}
+
+type DatabaseLease struct {
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	LeaseID   string
+	Role      string
+	Username  string
+}
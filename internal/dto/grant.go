@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+type AgentGrant struct {
+	CreatedAt time.Time
+	Owner     string
+	Grantee   string
+	Path      string
+	Access    string
+}
+
+type AgentGrantList struct {
+	Granted  []AgentGrant
+	Received []AgentGrant
+}
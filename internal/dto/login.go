@@ -1,6 +1,16 @@
 package dto
 
 type LoginUser struct {
-	Login    string
-	Password string
+	Login      string
+	Password   string
+	DeviceName string
+	ClientIP   string
+}
+
+// CertificateLogin is a login with a client certificate already verified by
+// the TLS handshake. Subject is the certificate's subject in RFC 2253 form.
+type CertificateLogin struct {
+	Subject    string
+	DeviceName string
+	ClientIP   string
 }
@@ -0,0 +1,10 @@
+package dto
+
+// ChangePassword is a password change by the owner of the session holding Token.
+type ChangePassword struct {
+	Token       string
+	OldPassword string
+	NewPassword string
+	ClientIP    string
+	UserID      int64
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+type IssueCertificate struct {
+	Role       string
+	CommonName string
+	AltNames   []string
+	IPSANs     []string
+	// KeyType of "" uses the first key type the role allows.
+	KeyType string
+	// TTL of zero uses the role default.
+	TTL time.Duration
+}
+
+// IssuedCertificate is a new leaf certificate. PrivateKey is only ever
+// returned here and is not stored by the server.
+type IssuedCertificate struct {
+	ExpiresAt   time.Time
+	Serial      string
+	Certificate string
+	PrivateKey  string
+	IssuingCA   string
+	// Chain is the issuing CA followed by its own issuers, PEM encoded.
+	Chain string
+}
@@ -1,6 +1,8 @@
 package dto
 
 type RegisterUser struct {
-	Login    string
-	Password string
+	Login      string
+	Password   string
+	DeviceName string
+	ClientIP   string
 }
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type CreateServiceAccount struct {
+	Name        string
+	Description string
+	// Team makes the account team-owned; empty means owned by the caller.
+	Team string
+	// TeamRole is the role the account gets in Team, viewer by default.
+	TeamRole string
+}
+
+type CreateAPIKey struct {
+	Account      string
+	Name         string
+	PathPrefixes []string
+	Capabilities []string
+	AllowedCIDRs []string
+	// TTL of zero creates a key that never expires.
+	TTL time.Duration
+}
+
+type AgentServiceAccount struct {
+	CreatedAt   time.Time
+	Name        string
+	Login       string
+	Description string
+	Owner       string
+}
+
+type AgentAPIKey struct {
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+	LastUsedAt   time.Time
+	RevokedAt    time.Time
+	Prefix       string
+	Name         string
+	Account      string
+	LastIP       string
+	PathPrefixes []string
+	Capabilities []string
+	AllowedCIDRs []string
+	ID           int64
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type AgentSession struct {
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	DeviceName string
+	LastIP     string
+	ID         int64
+	Current    bool
+}
+
+// AgentTokens is what the agent keeps after login: a short-lived access token
+// and the refresh token used to obtain the next one. When the account has 2FA
+// enabled, Login returns only TwoFactorChallenge instead.
+type AgentTokens struct {
+	Token              string
+	RefreshToken       string
+	TwoFactorChallenge string
+}
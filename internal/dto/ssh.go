@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type SignSSHKey struct {
+	Role string
+	// PublicKey is in authorized_keys format, as in ~/.ssh/id_ed25519.pub.
+	PublicKey string
+	// Principals of nil uses the role defaults.
+	Principals []string
+	// TTL of zero uses the role default.
+	TTL time.Duration
+}
+
+type SignedSSHKey struct {
+	ExpiresAt time.Time
+	// Certificate is in authorized_keys format, ready for a -cert.pub file.
+	Certificate string
+	KeyID       string
+	Principals  []string
+	Serial      uint64
+}
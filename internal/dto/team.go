@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type AgentTeam struct {
+	CreatedAt time.Time
+	Name      string
+	Role      string
+}
+
+type AgentTeamMember struct {
+	CreatedAt time.Time
+	Login     string
+	Role      string
+}
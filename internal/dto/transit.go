@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// TransitKeyRef names a transit key: a key of the caller when Team is empty,
+// otherwise a key of the team.
+type TransitKeyRef struct {
+	Name string
+	Team string
+}
+
+type TransitKey struct {
+	UpdatedAt time.Time
+	Name      string
+	Type      string
+	// Owner is the owning login, or team/<name> for team keys.
+	Owner         string
+	LatestVersion int
+}
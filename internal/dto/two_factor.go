@@ -0,0 +1,7 @@
+package dto
+
+// TOTPEnrollment is shown to the user once, to be added to an authenticator app.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string
+}
@@ -1,14 +1,21 @@
 package dto
 
-import "time"
+import (
+	"keeper/internal/entity"
+	"time"
+)
 
 type AgentCreateSecret struct {
 	ExpiredAt   time.Time
 	Token       string
+	Owner       string
 	Path        string
 	Description string
 	FilePath    *string
-	Payload     []byte
+	// CAS, when set, makes the write fail unless the latest version of Path
+	// equals it.
+	CAS     *int64
+	Payload []byte
 }
 
 type AgentGetSecret struct {
@@ -22,13 +29,27 @@ type AgentGetSecret struct {
 	Version     int64
 }
 
+type AgentSecretVersion struct {
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	Version   int64
+	Destroyed bool
+}
+
 type ServerCreateSecret struct {
 	ExpiredAt   time.Time
+	Owner       string
 	Path        string
 	Description string
 	FilePath    *string
-	Payload     []byte
-	UserID      int64
+	// CAS, when set, requires the latest version of Path to equal it; 0
+	// means Path must have no versions yet.
+	CAS     *int64
+	Payload []byte
+	UserID  int64
+	// KeepMetadata saves a new version without touching the description and
+	// expiry of an existing path; ExpiredAt and Description are ignored.
+	KeepMetadata bool
 }
 
 type DecryptedSecretResponse struct {
@@ -41,3 +62,63 @@ type DecryptedSecretResponse struct {
 	Data        []byte
 	Version     int64
 }
+
+type AgentSharedSecret struct {
+	Owner  string
+	Path   string
+	Access string
+}
+
+type AgentSecretList struct {
+	Paths  []string
+	Shared []AgentSharedSecret
+}
+
+// VaultTransferStats counts what an export or import did. Versions counts
+// every version written; Skipped counts paths left out.
+type VaultTransferStats struct {
+	Secrets  int
+	Versions int
+	Skipped  int
+}
+
+// ChangesPage addresses one page of the change feed: Cursor is where the
+// sync starts, Horizon and AfterID continue a sync that has more pages.
+type ChangesPage struct {
+	Cursor  int64
+	Horizon int64
+	AfterID int64
+	Limit   int
+}
+
+// SecretChanges is one page of the change feed. Values of key/value secrets
+// are decrypted; file secrets carry only their file path.
+type SecretChanges struct {
+	Changes []entity.SecretChange
+	Cursor  int64
+	Horizon int64
+	AfterID int64
+	HasMore bool
+}
+
+// AgentSecretChange is a change as the agent receives it; Payload is encoded
+// like AgentGetSecret.Payload.
+type AgentSecretChange struct {
+	ExpiredAt     time.Time
+	CreatedAt     time.Time
+	Path          string
+	Description   string
+	FilePath      string
+	Payload       []byte
+	Version       int64
+	LatestVersion int64
+	Deleted       bool
+}
+
+type AgentSecretChanges struct {
+	Changes []AgentSecretChange
+	Cursor  int64
+	Horizon int64
+	AfterID int64
+	HasMore bool
+}
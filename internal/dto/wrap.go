@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type WrapSecret struct {
+	// Owner and Path select a stored secret; Value is wrapped instead when
+	// Path is empty.
+	Owner  string
+	Path   string
+	Value  []byte
+	UserID int64
+	// TTL of zero uses the default lifetime.
+	TTL time.Duration
+}
+
+type WrappedToken struct {
+	ExpiresAt time.Time
+	Token     string
+	URL       string
+}
+
+type UnwrappedSecret struct {
+	CreatedAt   time.Time
+	Path        string
+	Description string
+	FileName    string
+	Value       []byte
+}
@@ -2,10 +2,18 @@ package entity
 
 import "time"
 
+// AccessToken is one login session. LastUsedAt and RevokedAt are nil until the
+// token is used or revoked. RefreshToken is only set in the plain form right
+// after it was issued, the database keeps its hash.
 type AccessToken struct {
-	ExpiresAt time.Time
-	CreatedAt time.Time
-	Token     string
-	ID        int64
-	UserID    int64
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+	RevokedAt    *time.Time
+	Token        string
+	RefreshToken string
+	DeviceName   string
+	LastIP       string
+	ID           int64
+	UserID       int64
 }
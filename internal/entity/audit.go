@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// AuditEvent is one record of the hash-chained audit log. Path and client
+// address are stored as HMACs, Hash covers every other field and PrevHash.
+type AuditEvent struct {
+	CreatedAt      time.Time `json:"created_at"`
+	Method         string    `json:"method"`
+	PathHMAC       string    `json:"path_hmac"`
+	Result         string    `json:"result"`
+	ClientAddrHMAC string    `json:"client_addr_hmac"`
+	RequestID      string    `json:"request_id"`
+	PrevHash       string    `json:"prev_hash"`
+	Hash           string    `json:"-"`
+	ID             int64     `json:"-"`
+	UserID         int64     `json:"user_id"`
+	Version        int64     `json:"version"`
+}
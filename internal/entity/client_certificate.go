@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// ClientCertificate binds a client certificate subject to a user for
+// certificate login.
+type ClientCertificate struct {
+	CreatedAt time.Time
+	Subject   string
+	Login     string
+	ID        int64
+	UserID    int64
+}
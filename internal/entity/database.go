@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// DBConnection is a target PostgreSQL server. DSN is encrypted with the data
+// key.
+type DBConnection struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	DSN       []byte
+	ID        int64
+}
+
+// DBRole describes how dynamic users are created on a connection. The
+// statements may use the {{name}}, {{password}} and {{expiration}} templates.
+type DBRole struct {
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Name                 string
+	Connection           string
+	CreationStatements   string
+	RevocationStatements string
+	DefaultTTL           time.Duration
+	MaxTTL               time.Duration
+	ID                   int64
+	ConnectionID         int64
+}
+
+// DBLease tracks a generated database user until it is dropped.
+type DBLease struct {
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	LeaseID   string
+	Role      string
+	Username  string
+	ID        int64
+	RoleID    int64
+	UserID    int64
+}
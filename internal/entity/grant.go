@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+const (
+	AccessRead  = "read"
+	AccessWrite = "write"
+	// AccessManage covers destroying secrets and deleting metadata. It can't be
+	// granted and is reserved to the owner of the vault.
+	AccessManage = "manage"
+)
+
+type SecretGrant struct {
+	CreatedAt    time.Time
+	OwnerLogin   string
+	GranteeLogin string
+	Path         string
+	Access       string
+	ID           int64
+	OwnerID      int64
+	GranteeID    int64
+}
+
+type SharedSecret struct {
+	OwnerLogin string
+	Path       string
+	Access     string
+}
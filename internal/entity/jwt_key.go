@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+const (
+	JWTAlgorithmEdDSA = "EdDSA"
+	JWTAlgorithmES256 = "ES256"
+)
+
+// JWTKey is one key of the access token keyring. PrivateKey is PKCS#8
+// encrypted with the data key, PublicKey is PKIX DER. A key with RetiredAt
+// set no longer signs tokens but still verifies them.
+type JWTKey struct {
+	CreatedAt  time.Time
+	RetiredAt  *time.Time
+	KID        string
+	Algorithm  string
+	PrivateKey []byte
+	PublicKey  []byte
+	ID         int64
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+const (
+	PKIKeyEC      = "ec"
+	PKIKeyRSA     = "rsa"
+	PKIKeyEd25519 = "ed25519"
+)
+
+// PKICA is the issuing CA. Certificate and Chain are PEM; PrivateKey is
+// PKCS#8 DER encrypted with the data key.
+type PKICA struct {
+	CreatedAt   time.Time
+	Certificate string
+	Chain       string
+	PrivateKey  []byte
+}
+
+// PKIRole limits what can be issued through it. A DNS name is allowed when
+// it equals one of AllowedDomains (AllowBareDomains) or is below one of them
+// (AllowSubdomains).
+type PKIRole struct {
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Name             string
+	AllowedDomains   []string
+	KeyTypes         []string
+	DefaultTTL       time.Duration
+	MaxTTL           time.Duration
+	ID               int64
+	AllowBareDomains bool
+	AllowSubdomains  bool
+	AllowIPSANs      bool
+	ServerAuth       bool
+	ClientAuth       bool
+}
+
+// PKICertificate is an issued certificate. Serial is lower-case hex.
+type PKICertificate struct {
+	CreatedAt   time.Time
+	NotAfter    time.Time
+	RevokedAt   *time.Time
+	IssuedBy    *int64
+	Serial      string
+	Role        string
+	CommonName  string
+	Certificate string
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+const (
+	CapabilityRead    = "read"
+	CapabilityCreate  = "create"
+	CapabilityUpdate  = "update"
+	CapabilityDelete  = "delete"
+	CapabilityDestroy = "destroy"
+	CapabilityList    = "list"
+	// CapabilityDeny overrides every other capability matched on the same path.
+	CapabilityDeny = "deny"
+)
+
+const (
+	SubjectUser  = "user"
+	SubjectTeam  = "team"
+	SubjectToken = "token"
+)
+
+type Policy struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Name        string
+	Description string
+	Rules       []PolicyRule
+	ID          int64
+}
+
+// PolicyRule grants capabilities on the paths matched by a glob: "*" matches
+// within one path segment and "**" matches across segments.
+type PolicyRule struct {
+	Path         string   `json:"path"`
+	Capabilities []string `json:"capabilities"`
+}
+
+type PolicyAttachment struct {
+	CreatedAt   time.Time
+	SubjectType string
+	SubjectName string
+	PolicyID    int64
+	SubjectID   int64
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// RefreshToken is stored as a SHA-256 hash. UserID and SessionRevoked are
+// read from the session it belongs to.
+type RefreshToken struct {
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UsedAt         *time.Time
+	TokenHash      string
+	ID             int64
+	SessionID      int64
+	UserID         int64
+	SessionRevoked bool
+}
@@ -10,13 +10,27 @@ type SecretMetadata struct {
 	Path        string
 	Description string
 	UserID      int64
+	TeamID      int64
+	// KeepCurrent makes a save leave the description and expiry of an
+	// existing path as they are; it is not stored.
+	KeepCurrent bool
+}
+
+// SecretOwner identifies the vault a secret lives in: either a user's personal
+// vault or a team namespace. Exactly one of the IDs is set.
+type SecretOwner struct {
+	UserID int64
+	TeamID int64
 }
 
 type SecretVersion struct {
-	ExpiredAt  time.Time
-	CreatedAt  time.Time
-	DeletedAt  *time.Time
-	FilePath   *string
+	ExpiredAt time.Time
+	CreatedAt time.Time
+	DeletedAt *time.Time
+	FilePath  *string
+	// CAS makes a save succeed only if the latest version equals it; it is
+	// not stored.
+	CAS        *int64
 	Value      []byte
 	MetadataID int64
 	Version    int64
@@ -35,3 +49,19 @@ type OneSecretVersionWithMetadata struct {
 	Version     int64
 	Destroyed   bool
 }
+
+// SecretChange is the state of a secret in the caller's vault after its
+// latest change, as returned by the change feed. Version is the latest live
+// version, 0 when every version is deleted or the metadata is; Latest counts
+// deleted versions too, so it is what a check-and-set write compares with.
+type SecretChange struct {
+	ExpiredAt   time.Time
+	CreatedAt   time.Time
+	FilePath    *string
+	Path        string
+	Description string
+	Value       []byte
+	ID          int64
+	Version     int64
+	Latest      int64
+}
@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+const (
+	UserKindUser    = "user"
+	UserKindService = "service"
+
+	// ServiceAccountLoginPrefix is reserved: registered users can't take it.
+	ServiceAccountLoginPrefix = "svc:"
+)
+
+// ServiceAccount is a non-human principal owned by either a user or a team.
+// UserID is its row in users; Login is "svc:<name>".
+type ServiceAccount struct {
+	CreatedAt   time.Time
+	OwnerUserID *int64
+	OwnerTeamID *int64
+	Name        string
+	Login       string
+	Description string
+	OwnerLogin  string
+	OwnerTeam   string
+	ID          int64
+	UserID      int64
+}
+
+// APIKey is a long-lived credential of a service account. The plain key is
+// only returned once on creation; Hash is its SHA-256. A key can only be used
+// for secret operations on paths starting with one of PathPrefixes and with
+// one of Capabilities. An empty AllowedCIDRs allows any address.
+type APIKey struct {
+	CreatedAt        time.Time
+	ExpiresAt        *time.Time
+	LastUsedAt       *time.Time
+	RevokedAt        *time.Time
+	Hash             string
+	Prefix           string
+	Name             string
+	LastIP           string
+	Account          string
+	PathPrefixes     []string
+	Capabilities     []string
+	AllowedCIDRs     []string
+	ID               int64
+	ServiceAccountID int64
+	UserID           int64
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// SSHPrincipalLogin in a role's principals stands for the login of the
+// requesting user.
+const SSHPrincipalLogin = "{{login}}"
+
+// SSHCA is the key that signs user certificates. PrivateKey is PKCS#8 DER
+// encrypted with the data key; PublicKey is in authorized_keys format.
+type SSHCA struct {
+	CreatedAt  time.Time
+	PublicKey  string
+	PrivateKey []byte
+}
+
+// SSHRole limits the certificates signed through it. "*" in
+// AllowedPrincipals allows any principal.
+type SSHRole struct {
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Name              string
+	AllowedPrincipals []string
+	DefaultPrincipals []string
+	Extensions        []string
+	DefaultTTL        time.Duration
+	MaxTTL            time.Duration
+	ID                int64
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+const (
+	TeamRoleOwner  = "owner"
+	TeamRoleEditor = "editor"
+	TeamRoleViewer = "viewer"
+)
+
+type Team struct {
+	CreatedAt time.Time
+	Name      string
+	ID        int64
+}
+
+type TeamMember struct {
+	CreatedAt time.Time
+	Login     string
+	Role      string
+	TeamID    int64
+	UserID    int64
+}
+
+type TeamMembership struct {
+	CreatedAt time.Time
+	TeamName  string
+	Role      string
+	TeamID    int64
+}
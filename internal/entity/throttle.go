@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// AuthThrottle counts recent failures for one key, see LockoutConfig.
+type AuthThrottle struct {
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+	Key           string
+	Failures      int
+}
+
+// LockoutEvent is kept for admins each time a key gets locked.
+type LockoutEvent struct {
+	LockedUntil time.Time
+	CreatedAt   time.Time
+	Key         string
+	ClientIP    string
+	ID          int64
+	Failures    int
+}
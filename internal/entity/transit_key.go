@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+const (
+	TransitKeyAES256GCM = "aes256-gcm"
+	TransitKeyEd25519   = "ed25519"
+)
+
+// TransitKey is a named key of the transit engine owned by either a user or
+// a team. LatestVersion is used for new encryptions and signatures.
+type TransitKey struct {
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	OwnerUserID   *int64
+	OwnerTeamID   *int64
+	Name          string
+	Type          string
+	OwnerLogin    string
+	OwnerTeam     string
+	ID            int64
+	LatestVersion int
+}
+
+// TransitKeyVersion holds the material of one version. Material and HMACKey
+// are encrypted with the data key; PublicKey is only set for ed25519 keys.
+type TransitKeyVersion struct {
+	CreatedAt time.Time
+	Material  []byte
+	HMACKey   []byte
+	PublicKey []byte
+	KeyID     int64
+	Version   int
+}
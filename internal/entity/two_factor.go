@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// TOTPCredential is a user's authenticator secret. It only protects logins
+// once ConfirmedAt is set.
+type TOTPCredential struct {
+	ConfirmedAt *time.Time
+	Secret      []byte
+	UserID      int64
+	LastStep    int64
+}
+
+// LoginChallenge is a login that passed the password check and waits for a
+// TOTP or recovery code. Only the hash of the challenge is stored.
+type LoginChallenge struct {
+	ExpiresAt     time.Time
+	ChallengeHash string
+	DeviceName    string
+	ID            int64
+	UserID        int64
+	Attempts      int
+}
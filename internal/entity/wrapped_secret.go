@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// WrappedSecret is a single-use copy of a secret or an ad-hoc value. Path is
+// empty for ad-hoc values and FileName is set when the value is a file.
+// Payload is encrypted with the data key.
+type WrappedSecret struct {
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	TokenHash   string
+	Path        string
+	Description string
+	FileName    string
+	Payload     []byte
+	ID          int64
+	CreatedBy   int64
+}
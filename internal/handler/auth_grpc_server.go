@@ -2,24 +2,40 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"keeper/internal/dto"
+	"keeper/internal/entity"
 	"keeper/internal/logger"
 	pb "keeper/internal/proto/v1"
 	pbModel "keeper/internal/proto/v1/model"
 	"keeper/internal/service"
+	utils "keeper/internal/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AuthServerHandler struct {
 	pb.UnimplementedAuthServiceServer
-	authService service.AuthService
-	logger      *logger.ZapLogger
+	authService      service.AuthService
+	sessionService   service.SessionService
+	twoFactorService service.TwoFactorService
+	logger           *logger.ZapLogger
 }
 
-func NewAuthHandler(l *logger.ZapLogger, svc service.AuthService) *AuthServerHandler {
+func NewAuthHandler(
+	l *logger.ZapLogger,
+	svc service.AuthService,
+	sessionSvc service.SessionService,
+	twoFactorSvc service.TwoFactorService,
+) *AuthServerHandler {
 	return &AuthServerHandler{
-		authService: svc,
-		logger:      l,
+		authService:      svc,
+		sessionService:   sessionSvc,
+		twoFactorService: twoFactorSvc,
+		logger:           l,
 	}
 }
 
@@ -28,15 +44,20 @@ func (s *AuthServerHandler) Register(
 	req *pbModel.RegisterRequest,
 ) (*pbModel.RegisterResponse, error) {
 	registerDto := &dto.RegisterUser{
-		Login:    req.GetLogin(),
-		Password: req.GetPassword(),
+		Login:      req.GetLogin(),
+		Password:   req.GetPassword(),
+		DeviceName: req.GetDeviceName(),
+		ClientIP:   utils.ClientIP(ctx),
 	}
 	token, err := s.authService.Register(ctx, registerDto)
 	if err != nil {
+		if st := authErrorStatus(err); st != nil {
+			return nil, st
+		}
 		return nil, fmt.Errorf("failed to register user: %w", err)
 	}
 
-	return fillAuthResponse(&pbModel.RegisterResponse{}, "Register successful.", token.Token), nil
+	return fillAuthResponse(&pbModel.RegisterResponse{}, "Register successful.", token), nil
 }
 
 func (s *AuthServerHandler) Login(
@@ -44,24 +65,265 @@ func (s *AuthServerHandler) Login(
 	req *pbModel.LoginRequest,
 ) (*pbModel.LoginResponse, error) {
 	loginDto := &dto.LoginUser{
-		Login:    req.GetLogin(),
-		Password: req.GetPassword(),
+		Login:      req.GetLogin(),
+		Password:   req.GetPassword(),
+		DeviceName: req.GetDeviceName(),
+		ClientIP:   utils.ClientIP(ctx),
 	}
 	token, err := s.authService.Login(ctx, loginDto)
+	var required *service.TwoFactorRequiredError
+	if errors.As(err, &required) {
+		resp := &pbModel.LoginResponse{}
+		resp.SetMessage("Two-factor code required.")
+		resp.SetTwoFactorRequired(true)
+		resp.SetChallenge(required.Challenge)
+		return resp, nil
+	}
 	if err != nil {
+		if st := authErrorStatus(err); st != nil {
+			return nil, st
+		}
 		return nil, fmt.Errorf("failed to login: %w", err)
 	}
 
-	return fillAuthResponse(&pbModel.LoginResponse{}, "Login successful.", token.Token), nil
+	return fillAuthResponse(&pbModel.LoginResponse{}, "Login successful.", token), nil
+}
+
+// LoginWithCertificate needs the server to run with a client CA, otherwise
+// no certificate is ever verified and every call is rejected.
+func (s *AuthServerHandler) LoginWithCertificate(
+	ctx context.Context,
+	req *pbModel.CertificateLoginRequest,
+) (*pbModel.LoginResponse, error) {
+	subject := utils.ClientCertSubject(ctx)
+	if subject == "" {
+		return nil, status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	token, err := s.authService.LoginWithCertificate(ctx, dto.CertificateLogin{
+		Subject:    subject,
+		DeviceName: req.GetDeviceName(),
+		ClientIP:   utils.ClientIP(ctx),
+	})
+	if err != nil {
+		if st := authErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, fmt.Errorf("failed to login with certificate: %w", err)
+	}
+
+	return fillAuthResponse(&pbModel.LoginResponse{}, "Login successful.", token), nil
+}
+
+func (s *AuthServerHandler) VerifyTwoFactor(
+	ctx context.Context,
+	req *pbModel.VerifyTwoFactorRequest,
+) (*pbModel.LoginResponse, error) {
+	token, err := s.authService.VerifyTwoFactor(ctx, req.GetChallenge(), req.GetCode(), utils.ClientIP(ctx))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTwoFactorCode) || errors.Is(err, service.ErrInvalidLoginChallenge) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if st := authErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+
+	return fillAuthResponse(&pbModel.LoginResponse{}, "Login successful.", token), nil
+}
+
+func (s *AuthServerHandler) ChangePassword(
+	ctx context.Context,
+	req *pbModel.ChangePasswordRequest,
+) (*pbModel.ChangePasswordResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	revoked, err := s.authService.ChangePassword(ctx, dto.ChangePassword{
+		UserID:      userID,
+		Token:       utils.GetToken(ctx),
+		OldPassword: req.GetOldPassword(),
+		NewPassword: req.GetNewPassword(),
+		ClientIP:    utils.ClientIP(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrEmptyPassword) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if st := authErrorStatus(err); st != nil {
+			return nil, st
+		}
+		return nil, fmt.Errorf("failed to change password: %w", err)
+	}
+
+	resp := &pbModel.ChangePasswordResponse{}
+	resp.SetMessage("Password changed.")
+	resp.SetRevokedSessions(revoked)
+	return resp, nil
+}
+
+func (s *AuthServerHandler) EnrollTOTP(
+	ctx context.Context,
+	req *pbModel.EnrollTOTPRequest,
+) (*pbModel.EnrollTOTPResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	enrollment, err := s.twoFactorService.Enroll(ctx, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrTwoFactorAlreadyEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("failed to enroll TOTP: %w", err)
+	}
+
+	resp := &pbModel.EnrollTOTPResponse{}
+	resp.SetSecret(enrollment.Secret)
+	resp.SetUri(enrollment.URI)
+	return resp, nil
+}
+
+func (s *AuthServerHandler) ConfirmTOTP(
+	ctx context.Context,
+	req *pbModel.ConfirmTOTPRequest,
+) (*pbModel.ConfirmTOTPResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	recoveryCodes, err := s.twoFactorService.Confirm(ctx, userID, req.GetCode())
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidTwoFactorCode):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrTwoFactorAlreadyEnabled), errors.Is(err, service.ErrTwoFactorNotEnrolled):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("failed to confirm TOTP: %w", err)
+	}
+
+	resp := &pbModel.ConfirmTOTPResponse{}
+	resp.SetRecoveryCodes(recoveryCodes)
+	return resp, nil
+}
+
+func (s *AuthServerHandler) RefreshToken(
+	ctx context.Context,
+	req *pbModel.RefreshTokenRequest,
+) (*pbModel.RefreshTokenResponse, error) {
+	token, err := s.authService.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	resp := &pbModel.RefreshTokenResponse{}
+	resp.SetToken(token.Token)
+	resp.SetRefreshToken(token.RefreshToken)
+	return resp, nil
+}
+
+func (s *AuthServerHandler) Logout(
+	ctx context.Context,
+	req *pbModel.LogoutRequest,
+) (*pbModel.SessionResponse, error) {
+	if err := s.sessionService.Logout(ctx, utils.GetToken(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to logout: %w", err)
+	}
+
+	return sessionResponse("Logout successful."), nil
+}
+
+func (s *AuthServerHandler) ListSessions(
+	ctx context.Context,
+	req *pbModel.ListSessionsRequest,
+) (*pbModel.ListSessionsResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	sessions, err := s.sessionService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	result := make([]*pbModel.Session, 0, len(sessions))
+	for i := range sessions {
+		item := &pbModel.Session{}
+		item.SetId(sessions[i].ID)
+		item.SetDeviceName(sessions[i].DeviceName)
+		item.SetLastIp(sessions[i].LastIP)
+		if sessions[i].LastUsedAt != nil {
+			item.SetLastUsedAt(timestamppb.New(*sessions[i].LastUsedAt))
+		}
+		item.SetCreatedAt(timestamppb.New(sessions[i].CreatedAt))
+		item.SetExpiresAt(timestamppb.New(sessions[i].ExpiresAt))
+		item.SetCurrent(sessions[i].Token == utils.GetToken(ctx))
+		result = append(result, item)
+	}
+
+	resp := &pbModel.ListSessionsResponse{}
+	resp.SetSessions(result)
+
+	return resp, nil
+}
+
+func (s *AuthServerHandler) RevokeSession(
+	ctx context.Context,
+	req *pbModel.RevokeSessionRequest,
+) (*pbModel.SessionResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.sessionService.RevokeSession(ctx, userID, req.GetSessionId()); err != nil {
+		return nil, fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return sessionResponse("Session revoked."), nil
+}
+
+func sessionResponse(message string) *pbModel.SessionResponse {
+	resp := &pbModel.SessionResponse{}
+	resp.SetMessage(message)
+	return resp
+}
+
+// authErrorStatus maps login and registration failures to fixed messages,
+// so the response never says whether the login exists. It returns nil for
+// any other error.
+func authErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidCredentials):
+		return status.Error(codes.Unauthenticated, service.ErrInvalidCredentials.Error())
+	case errors.Is(err, service.ErrTooManyAttempts):
+		return status.Error(codes.ResourceExhausted, service.ErrTooManyAttempts.Error())
+	case errors.Is(err, service.ErrRegistrationFailed):
+		return status.Error(codes.InvalidArgument, service.ErrRegistrationFailed.Error())
+	case errors.Is(err, service.ErrCertificateNotBound):
+		return status.Error(codes.Unauthenticated, service.ErrCertificateNotBound.Error())
+	}
+	return nil
 }
 
 func fillAuthResponse[T interface {
 	SetSuccess(bool)
 	SetMessage(string)
 	SetToken(string)
-}](resp T, message, token string) T {
+	SetRefreshToken(string)
+}](resp T, message string, token entity.AccessToken) T {
 	resp.SetSuccess(true)
 	resp.SetMessage(message)
-	resp.SetToken(token)
+	resp.SetToken(token.Token)
+	resp.SetRefreshToken(token.RefreshToken)
 	return resp
 }
@@ -1,22 +1,33 @@
 package handler
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
+	"fmt"
 	"keeper/internal/dto"
 	"keeper/internal/entity"
 	"keeper/internal/logger"
 	"keeper/internal/proto/v1/model"
+	"keeper/internal/service"
 	mocks "keeper/internal/service/mocks"
+	utils "keeper/internal/util"
+	"net"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 func mockAuthHandler(mockAuthService *mocks.MockAuthService) *AuthServerHandler {
 	zapLog, _ := logger.NewZapLogger(zap.InfoLevel)
-	return NewAuthHandler(zapLog, mockAuthService)
+	return NewAuthHandler(zapLog, mockAuthService, nil, nil)
 }
 
 func TestRegister_Success(t *testing.T) {
@@ -80,6 +91,143 @@ func TestLogin_Success(t *testing.T) {
 	require.Equal(t, "login-token", resp.GetToken())
 }
 
+func TestLogin_InvalidCredentialsIsUniform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+	ctx := t.Context()
+	mockAuthService.
+		EXPECT().
+		Login(ctx, &dto.LoginUser{Login: "user", Password: "pass"}).
+		Return(entity.AccessToken{}, service.ErrInvalidCredentials)
+	resp, err := h.Login(ctx, getLoginDto())
+
+	require.Nil(t, resp)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, "invalid login or password", status.Convert(err).Message())
+}
+
+func TestRegister_FailureHidesCause(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+	ctx := t.Context()
+	mockAuthService.
+		EXPECT().
+		Register(ctx, getRegisterUserDto()).
+		Return(entity.AccessToken{}, fmt.Errorf("%w: duplicate key", service.ErrRegistrationFailed))
+	resp, err := h.Register(ctx, getRegisterDto())
+
+	require.Nil(t, resp)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "registration failed", status.Convert(err).Message())
+}
+
+func TestLogin_TwoFactorRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+	ctx := t.Context()
+	mockAuthService.
+		EXPECT().
+		Login(ctx, &dto.LoginUser{Login: "user", Password: "pass"}).
+		Return(entity.AccessToken{}, &service.TwoFactorRequiredError{Challenge: "challenge-1"})
+	resp, err := h.Login(ctx, getLoginDto())
+
+	require.NoError(t, err)
+	require.False(t, resp.GetSuccess())
+	require.True(t, resp.GetTwoFactorRequired())
+	require.Equal(t, "challenge-1", resp.GetChallenge())
+	require.Empty(t, resp.GetToken())
+}
+
+func TestVerifyTwoFactor_InvalidCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+	ctx := t.Context()
+	mockAuthService.
+		EXPECT().
+		VerifyTwoFactor(ctx, "challenge-1", "000000", "").
+		Return(entity.AccessToken{}, service.ErrInvalidTwoFactorCode)
+	req := &model.VerifyTwoFactorRequest{}
+	req.SetChallenge("challenge-1")
+	req.SetCode("000000")
+	resp, err := h.VerifyTwoFactor(ctx, req)
+
+	require.Nil(t, resp)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestChangePassword_WrongOldPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+	ctx := utils.SetToken(utils.SetUserID(t.Context(), 7), "current-token")
+	mockAuthService.
+		EXPECT().
+		ChangePassword(ctx, dto.ChangePassword{
+			UserID:      7,
+			Token:       "current-token",
+			OldPassword: "old",
+			NewPassword: "new",
+		}).
+		Return(int64(0), service.ErrInvalidCredentials)
+	req := &model.ChangePasswordRequest{}
+	req.SetOldPassword("old")
+	req.SetNewPassword("new")
+	resp, err := h.ChangePassword(ctx, req)
+
+	require.Nil(t, resp)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLoginWithCertificate_NoCertificate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+
+	resp, err := h.LoginWithCertificate(t.Context(), &model.CertificateLoginRequest{})
+
+	require.Nil(t, resp)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestLoginWithCertificate_VerifiedSubject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	h := mockAuthHandler(mockAuthService)
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "ci-runner", Organization: []string{"Keeper"}}}
+	ctx := peer.NewContext(t.Context(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 5), Port: 40000},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{cert}},
+		}},
+	})
+	mockAuthService.
+		EXPECT().
+		LoginWithCertificate(ctx, dto.CertificateLogin{
+			Subject:    "CN=ci-runner,O=Keeper",
+			DeviceName: "runner-1",
+			ClientIP:   "10.0.0.5",
+		}).
+		Return(entity.AccessToken{Token: "cert-token"}, nil)
+	req := &model.CertificateLoginRequest{}
+	req.SetDeviceName("runner-1")
+
+	resp, err := h.LoginWithCertificate(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, "cert-token", resp.GetToken())
+}
+
 func getRegisterDto() *model.RegisterRequest {
 	reqRegister := &model.RegisterRequest{}
 	reqRegister.SetLogin("user")
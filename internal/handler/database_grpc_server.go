@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DatabaseServerHandler struct {
+	pb.UnimplementedDatabaseServiceServer
+	credsService service.DatabaseCredentialsService
+	logger       *logger.ZapLogger
+}
+
+func NewDatabaseHandler(l *logger.ZapLogger, svc service.DatabaseCredentialsService) *DatabaseServerHandler {
+	return &DatabaseServerHandler{
+		credsService: svc,
+		logger:       l,
+	}
+}
+
+func databaseError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrPolicyDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrUnknownDBRole), errors.Is(err, service.ErrLeaseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+func (s *DatabaseServerHandler) GenerateCredentials(
+	ctx context.Context,
+	req *pbModel.GenerateCredentialsRequest,
+) (*pbModel.DatabaseCredentials, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	creds, err := s.credsService.GenerateCredentials(ctx, userID, utils.GetToken(ctx),
+		req.GetRole(), time.Duration(req.GetTtlSeconds())*time.Second)
+	if err != nil {
+		return nil, databaseError("failed to generate credentials", err)
+	}
+
+	resp := &pbModel.DatabaseCredentials{}
+	resp.SetLeaseId(creds.LeaseID)
+	resp.SetRole(creds.Role)
+	resp.SetUsername(creds.Username)
+	resp.SetPassword(creds.Password)
+	resp.SetExpiresAt(timestamppb.New(creds.ExpiresAt))
+	return resp, nil
+}
+
+func (s *DatabaseServerHandler) ListLeases(
+	ctx context.Context,
+	_ *pbModel.ListLeasesRequest,
+) (*pbModel.ListLeasesResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	leases, err := s.credsService.ListLeases(ctx, userID)
+	if err != nil {
+		return nil, databaseError("failed to list leases", err)
+	}
+
+	items := make([]*pbModel.Lease, 0, len(leases))
+	for _, l := range leases {
+		item := &pbModel.Lease{}
+		item.SetLeaseId(l.LeaseID)
+		item.SetRole(l.Role)
+		item.SetUsername(l.Username)
+		item.SetExpiresAt(timestamppb.New(l.ExpiresAt))
+		item.SetCreatedAt(timestamppb.New(l.CreatedAt))
+		items = append(items, item)
+	}
+	resp := &pbModel.ListLeasesResponse{}
+	resp.SetLeases(items)
+	return resp, nil
+}
+
+func (s *DatabaseServerHandler) RevokeLease(
+	ctx context.Context,
+	req *pbModel.RevokeLeaseRequest,
+) (*pbModel.RevokeLeaseResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.credsService.RevokeLease(ctx, userID, req.GetLeaseId()); err != nil {
+		return nil, databaseError("failed to revoke lease", err)
+	}
+
+	resp := &pbModel.RevokeLeaseResponse{}
+	resp.SetMessage("lease revoked")
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PKIServerHandler struct {
+	pb.UnimplementedPKIServiceServer
+	pkiService service.PKIService
+	logger     *logger.ZapLogger
+}
+
+func NewPKIHandler(l *logger.ZapLogger, svc service.PKIService) *PKIServerHandler {
+	return &PKIServerHandler{
+		pkiService: svc,
+		logger:     l,
+	}
+}
+
+func pkiError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrPolicyDenied), errors.Is(err, service.ErrPKINameNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrUnknownPKIRole), errors.Is(err, service.ErrCertificateNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPKINoCA):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+func (s *PKIServerHandler) IssueCertificate(
+	ctx context.Context,
+	req *pbModel.IssueCertificateRequest,
+) (*pbModel.IssueCertificateResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	issued, err := s.pkiService.IssueCertificate(ctx, userID, utils.GetToken(ctx), dto.IssueCertificate{
+		Role:       req.GetRole(),
+		CommonName: req.GetCommonName(),
+		AltNames:   req.GetAltNames(),
+		IPSANs:     req.GetIpSans(),
+		KeyType:    req.GetKeyType(),
+		TTL:        time.Duration(req.GetTtlSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, pkiError("failed to issue certificate", err)
+	}
+
+	resp := &pbModel.IssueCertificateResponse{}
+	resp.SetSerial(issued.Serial)
+	resp.SetCertificate(issued.Certificate)
+	resp.SetPrivateKey(issued.PrivateKey)
+	resp.SetIssuingCa(issued.IssuingCA)
+	resp.SetChain(issued.Chain)
+	resp.SetExpiresAt(timestamppb.New(issued.ExpiresAt))
+	return resp, nil
+}
+
+func (s *PKIServerHandler) RevokeCertificate(
+	ctx context.Context,
+	req *pbModel.RevokeCertificateRequest,
+) (*pbModel.RevokeCertificateResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.pkiService.RevokeCertificate(ctx, userID, req.GetSerial()); err != nil {
+		return nil, pkiError("failed to revoke certificate", err)
+	}
+
+	resp := &pbModel.RevokeCertificateResponse{}
+	resp.SetMessage("certificate revoked")
+	return resp, nil
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ServiceAccountServerHandler struct {
+	pb.UnimplementedServiceAccountServiceServer
+	serviceAccountService service.ServiceAccountService
+	logger                *logger.ZapLogger
+}
+
+func NewServiceAccountHandler(l *logger.ZapLogger, svc service.ServiceAccountService) *ServiceAccountServerHandler {
+	return &ServiceAccountServerHandler{
+		serviceAccountService: svc,
+		logger:                l,
+	}
+}
+
+func (s *ServiceAccountServerHandler) CreateServiceAccount(
+	ctx context.Context,
+	req *pbModel.CreateServiceAccountRequest,
+) (*pbModel.ServiceAccount, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	account, err := s.serviceAccountService.CreateAccount(ctx, userID, dto.CreateServiceAccount{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Team:        req.GetTeam(),
+		TeamRole:    req.GetTeamRole(),
+	})
+	if err != nil {
+		return nil, serviceAccountError("failed to create service account", err)
+	}
+
+	return serviceAccountToProto(account), nil
+}
+
+func (s *ServiceAccountServerHandler) ListServiceAccounts(
+	ctx context.Context,
+	req *pbModel.ListServiceAccountsRequest,
+) (*pbModel.ListServiceAccountsResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	accounts, err := s.serviceAccountService.ListAccounts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	result := make([]*pbModel.ServiceAccount, 0, len(accounts))
+	for i := range accounts {
+		result = append(result, serviceAccountToProto(accounts[i]))
+	}
+	resp := &pbModel.ListServiceAccountsResponse{}
+	resp.SetAccounts(result)
+	return resp, nil
+}
+
+func (s *ServiceAccountServerHandler) DeleteServiceAccount(
+	ctx context.Context,
+	req *pbModel.DeleteServiceAccountRequest,
+) (*pbModel.ServiceAccountResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.serviceAccountService.DeleteAccount(ctx, userID, req.GetName()); err != nil {
+		return nil, serviceAccountError("failed to delete service account", err)
+	}
+
+	return serviceAccountResponse("Delete service account: success"), nil
+}
+
+func (s *ServiceAccountServerHandler) CreateAPIKey(
+	ctx context.Context,
+	req *pbModel.CreateAPIKeyRequest,
+) (*pbModel.CreateAPIKeyResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	plain, key, err := s.serviceAccountService.CreateKey(ctx, userID, dto.CreateAPIKey{
+		Account:      req.GetAccount(),
+		Name:         req.GetName(),
+		PathPrefixes: req.GetPathPrefixes(),
+		Capabilities: req.GetCapabilities(),
+		AllowedCIDRs: req.GetAllowedCidrs(),
+		TTL:          time.Duration(req.GetTtlSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, serviceAccountError("failed to create api key", err)
+	}
+
+	resp := &pbModel.CreateAPIKeyResponse{}
+	resp.SetKey(plain)
+	resp.SetInfo(apiKeyToProto(key))
+	return resp, nil
+}
+
+func (s *ServiceAccountServerHandler) ListAPIKeys(
+	ctx context.Context,
+	req *pbModel.ListAPIKeysRequest,
+) (*pbModel.ListAPIKeysResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	keys, err := s.serviceAccountService.ListKeys(ctx, userID, req.GetAccount())
+	if err != nil {
+		return nil, serviceAccountError("failed to list api keys", err)
+	}
+
+	result := make([]*pbModel.APIKey, 0, len(keys))
+	for i := range keys {
+		result = append(result, apiKeyToProto(keys[i]))
+	}
+	resp := &pbModel.ListAPIKeysResponse{}
+	resp.SetKeys(result)
+	return resp, nil
+}
+
+func (s *ServiceAccountServerHandler) RevokeAPIKey(
+	ctx context.Context,
+	req *pbModel.RevokeAPIKeyRequest,
+) (*pbModel.ServiceAccountResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.serviceAccountService.RevokeKey(ctx, userID, req.GetId()); err != nil {
+		return nil, serviceAccountError("failed to revoke api key", err)
+	}
+
+	return serviceAccountResponse("Revoke api key: success"), nil
+}
+
+func serviceAccountError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrServiceAccountExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+func serviceAccountResponse(message string) *pbModel.ServiceAccountResponse {
+	resp := &pbModel.ServiceAccountResponse{}
+	resp.SetMessage(message)
+	return resp
+}
+
+func serviceAccountToProto(account entity.ServiceAccount) *pbModel.ServiceAccount {
+	item := &pbModel.ServiceAccount{}
+	item.SetName(account.Name)
+	item.SetLogin(account.Login)
+	item.SetDescription(account.Description)
+	if account.OwnerTeam != "" {
+		item.SetOwner(service.TeamPathPrefix + account.OwnerTeam)
+	} else {
+		item.SetOwner(account.OwnerLogin)
+	}
+	item.SetCreatedAt(timestamppb.New(account.CreatedAt))
+	return item
+}
+
+func apiKeyToProto(key entity.APIKey) *pbModel.APIKey {
+	item := &pbModel.APIKey{}
+	item.SetId(key.ID)
+	item.SetAccount(key.Account)
+	item.SetName(key.Name)
+	item.SetPrefix(key.Prefix)
+	item.SetPathPrefixes(key.PathPrefixes)
+	item.SetCapabilities(key.Capabilities)
+	item.SetAllowedCidrs(key.AllowedCIDRs)
+	item.SetLastIp(key.LastIP)
+	item.SetCreatedAt(timestamppb.New(key.CreatedAt))
+	if key.ExpiresAt != nil {
+		item.SetExpiresAt(timestamppb.New(*key.ExpiresAt))
+	}
+	if key.LastUsedAt != nil {
+		item.SetLastUsedAt(timestamppb.New(*key.LastUsedAt))
+	}
+	if key.RevokedAt != nil {
+		item.SetRevokedAt(timestamppb.New(*key.RevokedAt))
+	}
+	return item
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SSHServerHandler struct {
+	pb.UnimplementedSSHServiceServer
+	sshService service.SSHService
+	logger     *logger.ZapLogger
+}
+
+func NewSSHHandler(l *logger.ZapLogger, svc service.SSHService) *SSHServerHandler {
+	return &SSHServerHandler{
+		sshService: svc,
+		logger:     l,
+	}
+}
+
+func sshError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrPolicyDenied), errors.Is(err, service.ErrSSHPrincipalNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrUnknownSSHRole):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrSSHNoCA):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+func (s *SSHServerHandler) SignKey(
+	ctx context.Context,
+	req *pbModel.SignSSHKeyRequest,
+) (*pbModel.SignSSHKeyResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	signed, err := s.sshService.SignKey(ctx, userID, utils.GetToken(ctx), dto.SignSSHKey{
+		Role:       req.GetRole(),
+		PublicKey:  req.GetPublicKey(),
+		Principals: req.GetPrincipals(),
+		TTL:        time.Duration(req.GetTtlSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, sshError("failed to sign key", err)
+	}
+
+	resp := &pbModel.SignSSHKeyResponse{}
+	resp.SetCertificate(signed.Certificate)
+	resp.SetSerial(signed.Serial)
+	resp.SetKeyId(signed.KeyID)
+	resp.SetPrincipals(signed.Principals)
+	resp.SetExpiresAt(timestamppb.New(signed.ExpiresAt))
+	return resp, nil
+}
+
+func (s *SSHServerHandler) GetCAPublicKey(
+	ctx context.Context,
+	_ *pbModel.GetSSHCARequest,
+) (*pbModel.GetSSHCAResponse, error) {
+	if _, err := utils.GetUserID(ctx); err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	key, err := s.sshService.CAPublicKey(ctx)
+	if err != nil {
+		return nil, sshError("failed to get ca key", err)
+	}
+
+	resp := &pbModel.GetSSHCAResponse{}
+	resp.SetPublicKey(key)
+	return resp, nil
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TeamServerHandler struct {
+	pb.UnimplementedTeamServiceServer
+	teamService service.TeamService
+	logger      *logger.ZapLogger
+}
+
+func NewTeamHandler(l *logger.ZapLogger, svc service.TeamService) *TeamServerHandler {
+	return &TeamServerHandler{
+		teamService: svc,
+		logger:      l,
+	}
+}
+
+func (s *TeamServerHandler) CreateTeam(
+	ctx context.Context,
+	req *pbModel.TeamRequest,
+) (*pbModel.TeamResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if _, err := s.teamService.CreateTeam(ctx, userID, req.GetTeam()); err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	return teamResponse("Create team: success"), nil
+}
+
+func (s *TeamServerHandler) DeleteTeam(
+	ctx context.Context,
+	req *pbModel.TeamRequest,
+) (*pbModel.TeamResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.teamService.DeleteTeam(ctx, userID, req.GetTeam()); err != nil {
+		return nil, fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	return teamResponse("Delete team: success"), nil
+}
+
+func (s *TeamServerHandler) AddMember(
+	ctx context.Context,
+	req *pbModel.TeamMemberRequest,
+) (*pbModel.TeamResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	err = s.teamService.AddMember(ctx, userID, req.GetTeam(), req.GetLogin(), req.GetRole())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add member: %w", err)
+	}
+
+	return teamResponse("Add member: success"), nil
+}
+
+func (s *TeamServerHandler) RemoveMember(
+	ctx context.Context,
+	req *pbModel.TeamMemberRequest,
+) (*pbModel.TeamResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	if err := s.teamService.RemoveMember(ctx, userID, req.GetTeam(), req.GetLogin()); err != nil {
+		return nil, fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	return teamResponse("Remove member: success"), nil
+}
+
+func (s *TeamServerHandler) ListTeams(
+	ctx context.Context,
+	req *pbModel.ListTeamsRequest,
+) (*pbModel.ListTeamsResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	teams, err := s.teamService.ListTeams(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	result := make([]*pbModel.Team, 0, len(teams))
+	for i := range teams {
+		t := &pbModel.Team{}
+		t.SetName(teams[i].TeamName)
+		t.SetRole(teams[i].Role)
+		t.SetCreatedAt(timestamppb.New(teams[i].CreatedAt))
+		result = append(result, t)
+	}
+
+	resp := &pbModel.ListTeamsResponse{}
+	resp.SetTeams(result)
+
+	return resp, nil
+}
+
+func (s *TeamServerHandler) ListMembers(
+	ctx context.Context,
+	req *pbModel.TeamRequest,
+) (*pbModel.ListTeamMembersResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	members, err := s.teamService.ListMembers(ctx, userID, req.GetTeam())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	result := make([]*pbModel.TeamMember, 0, len(members))
+	for i := range members {
+		m := &pbModel.TeamMember{}
+		m.SetLogin(members[i].Login)
+		m.SetRole(members[i].Role)
+		m.SetCreatedAt(timestamppb.New(members[i].CreatedAt))
+		result = append(result, m)
+	}
+
+	resp := &pbModel.ListTeamMembersResponse{}
+	resp.SetMembers(result)
+
+	return resp, nil
+}
+
+func teamResponse(message string) *pbModel.TeamResponse {
+	resp := &pbModel.TeamResponse{}
+	resp.SetMessage(message)
+	return resp
+}
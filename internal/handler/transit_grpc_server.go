@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TransitServerHandler struct {
+	pb.UnimplementedTransitServiceServer
+	transitService service.TransitService
+	logger         *logger.ZapLogger
+}
+
+func NewTransitHandler(l *logger.ZapLogger, svc service.TransitService) *TransitServerHandler {
+	return &TransitServerHandler{
+		transitService: svc,
+		logger:         l,
+	}
+}
+
+// transitRequest is implemented by every request that names a key.
+type transitRequest interface {
+	GetName() string
+	GetTeam() string
+}
+
+func transitKeyRef(req transitRequest) dto.TransitKeyRef {
+	return dto.TransitKeyRef{Name: req.GetName(), Team: req.GetTeam()}
+}
+
+func transitError(msg string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrTransitKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrTransitKeyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrTransitKeyType), errors.Is(err, service.ErrInvalidTransitValue):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+func transitKeyToProto(key entity.TransitKey) *pbModel.TransitKey {
+	item := &pbModel.TransitKey{}
+	item.SetName(key.Name)
+	item.SetType(key.Type)
+	if key.OwnerTeam != "" {
+		item.SetOwner(service.TeamPathPrefix + key.OwnerTeam)
+	} else {
+		item.SetOwner(key.OwnerLogin)
+	}
+	item.SetLatestVersion(int32(key.LatestVersion))
+	item.SetUpdatedAt(timestamppb.New(key.UpdatedAt))
+	return item
+}
+
+func (s *TransitServerHandler) CreateKey(
+	ctx context.Context,
+	req *pbModel.CreateTransitKeyRequest,
+) (*pbModel.TransitKey, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	key, err := s.transitService.CreateKey(ctx, userID, transitKeyRef(req), req.GetType())
+	if err != nil {
+		return nil, transitError("failed to create transit key", err)
+	}
+	return transitKeyToProto(key), nil
+}
+
+func (s *TransitServerHandler) RotateKey(
+	ctx context.Context,
+	req *pbModel.RotateTransitKeyRequest,
+) (*pbModel.TransitKey, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	key, err := s.transitService.RotateKey(ctx, userID, transitKeyRef(req))
+	if err != nil {
+		return nil, transitError("failed to rotate transit key", err)
+	}
+	return transitKeyToProto(key), nil
+}
+
+func (s *TransitServerHandler) ListKeys(
+	ctx context.Context,
+	_ *pbModel.ListTransitKeysRequest,
+) (*pbModel.ListTransitKeysResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	keys, err := s.transitService.ListKeys(ctx, userID)
+	if err != nil {
+		return nil, transitError("failed to list transit keys", err)
+	}
+	items := make([]*pbModel.TransitKey, 0, len(keys))
+	for i := range keys {
+		items = append(items, transitKeyToProto(keys[i]))
+	}
+	resp := &pbModel.ListTransitKeysResponse{}
+	resp.SetKeys(items)
+	return resp, nil
+}
+
+func (s *TransitServerHandler) Encrypt(
+	ctx context.Context,
+	req *pbModel.EncryptRequest,
+) (*pbModel.EncryptResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	ciphertext, err := s.transitService.Encrypt(ctx, userID, transitKeyRef(req), req.GetPlaintext())
+	if err != nil {
+		return nil, transitError("failed to encrypt", err)
+	}
+	resp := &pbModel.EncryptResponse{}
+	resp.SetCiphertext(ciphertext)
+	return resp, nil
+}
+
+func (s *TransitServerHandler) Decrypt(
+	ctx context.Context,
+	req *pbModel.DecryptRequest,
+) (*pbModel.DecryptResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	plaintext, err := s.transitService.Decrypt(ctx, userID, transitKeyRef(req), req.GetCiphertext())
+	if err != nil {
+		return nil, transitError("failed to decrypt", err)
+	}
+	resp := &pbModel.DecryptResponse{}
+	resp.SetPlaintext(plaintext)
+	return resp, nil
+}
+
+func (s *TransitServerHandler) Rewrap(
+	ctx context.Context,
+	req *pbModel.RewrapRequest,
+) (*pbModel.EncryptResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	ciphertext, err := s.transitService.Rewrap(ctx, userID, transitKeyRef(req), req.GetCiphertext())
+	if err != nil {
+		return nil, transitError("failed to rewrap", err)
+	}
+	resp := &pbModel.EncryptResponse{}
+	resp.SetCiphertext(ciphertext)
+	return resp, nil
+}
+
+func (s *TransitServerHandler) Sign(
+	ctx context.Context,
+	req *pbModel.SignRequest,
+) (*pbModel.SignResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	signature, err := s.transitService.Sign(ctx, userID, transitKeyRef(req), req.GetInput())
+	if err != nil {
+		return nil, transitError("failed to sign", err)
+	}
+	resp := &pbModel.SignResponse{}
+	resp.SetSignature(signature)
+	return resp, nil
+}
+
+func (s *TransitServerHandler) Verify(
+	ctx context.Context,
+	req *pbModel.VerifyRequest,
+) (*pbModel.VerifyResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	valid, err := s.transitService.Verify(ctx, userID, transitKeyRef(req), req.GetInput(), req.GetSignature())
+	if err != nil {
+		return nil, transitError("failed to verify", err)
+	}
+	resp := &pbModel.VerifyResponse{}
+	resp.SetValid(valid)
+	return resp, nil
+}
+
+func (s *TransitServerHandler) HMAC(
+	ctx context.Context,
+	req *pbModel.HMACRequest,
+) (*pbModel.HMACResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+	mac, err := s.transitService.HMAC(ctx, userID, transitKeyRef(req), req.GetInput())
+	if err != nil {
+		return nil, transitError("failed to compute hmac", err)
+	}
+	resp := &pbModel.HMACResponse{}
+	resp.SetHmac(mac)
+	return resp, nil
+}
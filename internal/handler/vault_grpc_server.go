@@ -3,14 +3,18 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"keeper/internal/dto"
+	"keeper/internal/entity"
 	"keeper/internal/logger"
 	pb "keeper/internal/proto/v1"
 	pbModel "keeper/internal/proto/v1/model"
 	"keeper/internal/service"
 	utils "keeper/internal/util"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -19,12 +23,18 @@ const errorInvalidToken = "invalid token: %w"
 type VaultServerHandler struct {
 	pb.UnimplementedDataServiceServer
 	vaultService service.VaultService
+	grantService service.GrantService
 	logger       *logger.ZapLogger
 }
 
-func NewVaultHandler(l *logger.ZapLogger, svc service.VaultService) *VaultServerHandler {
+func NewVaultHandler(
+	l *logger.ZapLogger,
+	svc service.VaultService,
+	grantSvc service.GrantService,
+) *VaultServerHandler {
 	return &VaultServerHandler{
 		vaultService: svc,
+		grantService: grantSvc,
 		logger:       l,
 	}
 }
@@ -38,7 +48,7 @@ func (s *VaultServerHandler) GetSecret(
 		return nil, fmt.Errorf(errorInvalidToken, err)
 	}
 
-	secret, err := s.vaultService.GetSecret(ctx, userID, req.GetPath())
+	secret, err := s.vaultService.GetSecretVersion(ctx, userID, req.GetOwner(), req.GetPath(), req.GetVersion())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret: %w", err)
 	}
@@ -68,11 +78,96 @@ func (s *VaultServerHandler) GetSecret(
 	resp.SetExpiredAt(timestamppb.New(secret.ExpiredAt))
 	resp.SetVersion(secret.Version)
 	resp.SetDeletedAt(deletedAt)
+	resp.SetCreatedAt(timestamppb.New(secret.CreatedAt))
 	resp.SetFilePath(filePath)
 
 	return resp, nil
 }
 
+func (s *VaultServerHandler) ListSecretVersions(
+	ctx context.Context,
+	req *pbModel.ListSecretVersionsRequest,
+) (*pbModel.ListSecretVersionsResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	versions, err := s.vaultService.ListSecretVersions(ctx, userID, req.GetOwner(), req.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secret versions: %w", err)
+	}
+
+	items := make([]*pbModel.SecretVersionInfo, 0, len(versions))
+	for i := range versions {
+		v := &pbModel.SecretVersionInfo{}
+		v.SetVersion(versions[i].Version)
+		v.SetCreatedAt(timestamppb.New(versions[i].CreatedAt))
+		if versions[i].DeletedAt != nil {
+			v.SetDeletedAt(timestamppb.New(*versions[i].DeletedAt))
+		}
+		v.SetDestroyed(versions[i].Destroyed)
+		items = append(items, v)
+	}
+
+	resp := &pbModel.ListSecretVersionsResponse{}
+	resp.SetVersions(items)
+	return resp, nil
+}
+
+// GetChangesSince feeds keeper-agent sync. Values are encoded like GetSecret
+// encodes them, so the agent can cache a change as if it had read it.
+func (s *VaultServerHandler) GetChangesSince(
+	ctx context.Context,
+	req *pbModel.GetChangesSinceRequest,
+) (*pbModel.GetChangesSinceResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	page, err := s.vaultService.GetChangesSince(ctx, userID, dto.ChangesPage{
+		Cursor:  req.GetCursor(),
+		Horizon: req.GetHorizon(),
+		AfterID: req.GetAfterId(),
+		Limit:   int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+
+	changes := make([]*pbModel.SecretChange, 0, len(page.Changes))
+	for i := range page.Changes {
+		c := &page.Changes[i]
+		change := &pbModel.SecretChange{}
+		change.SetPath(c.Path)
+		change.SetVersion(c.Version)
+		change.SetLatestVersion(c.Latest)
+		change.SetDeleted(c.Version == 0)
+		change.SetDescription(c.Description)
+		change.SetExpiredAt(timestamppb.New(c.ExpiredAt))
+		change.SetCreatedAt(timestamppb.New(c.CreatedAt))
+		if c.FilePath != nil {
+			change.SetFilePath(*c.FilePath)
+		} else if c.Version != 0 {
+			value, err := json.Marshal(c.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal secret data: %w", err)
+			}
+			change.SetValue(value)
+		}
+		changes = append(changes, change)
+	}
+
+	resp := &pbModel.GetChangesSinceResponse{}
+	resp.SetChanges(changes)
+	resp.SetCursor(page.Cursor)
+	resp.SetHasMore(page.HasMore)
+	resp.SetHorizon(page.Horizon)
+	resp.SetAfterId(page.AfterID)
+	return resp, nil
+}
+
 func (s *VaultServerHandler) ListSecrets(
 	ctx context.Context,
 	req *pbModel.ListSecretPathsRequest,
@@ -87,8 +182,23 @@ func (s *VaultServerHandler) ListSecrets(
 		return nil, fmt.Errorf("failed to list secrets: %w", err)
 	}
 
+	shared, err := s.vaultService.ListSharedSecrets(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared secrets: %w", err)
+	}
+
+	sharedPaths := make([]*pbModel.SharedSecretPath, 0, len(shared))
+	for i := range shared {
+		p := &pbModel.SharedSecretPath{}
+		p.SetOwner(shared[i].OwnerLogin)
+		p.SetPath(shared[i].Path)
+		p.SetAccess(shared[i].Access)
+		sharedPaths = append(sharedPaths, p)
+	}
+
 	resp := &pbModel.ListSecretPathsResponse{}
 	resp.SetPaths(paths)
+	resp.SetShared(sharedPaths)
 
 	return resp, nil
 }
@@ -109,20 +219,33 @@ func (s *VaultServerHandler) SaveSecret(
 
 	serverCreateSecretDTO := &dto.ServerCreateSecret{
 		UserID:      userID,
+		Owner:       req.GetOwner(),
 		Path:        req.GetPath(),
 		Description: req.GetDescription(),
 		Payload:     req.GetValue(),
 		ExpiredAt:   req.GetExpiredAt().AsTime(),
 		FilePath:    filePath,
+		// Clients that don't manage metadata, like the Vault KV API,
+		// leave both fields unset and keep what the path has.
+		KeepMetadata: !req.HasExpiredAt() && !req.HasDescription(),
+	}
+	if req.HasCas() {
+		cas := req.GetCas()
+		serverCreateSecretDTO.CAS = &cas
 	}
 
-	err = s.vaultService.SaveSecret(ctx, serverCreateSecretDTO)
+	version, err := s.vaultService.SaveSecret(ctx, serverCreateSecretDTO)
+	if errors.Is(err, service.ErrCheckAndSet) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to save secret: %w", err)
 	}
 	resp := &pbModel.SaveSecretResponse{}
 	resp.SetSuccess(true)
 	resp.SetMessage("Save: success")
+	resp.SetVersion(version.Version)
+	resp.SetCreatedAt(timestamppb.New(version.CreatedAt))
 
 	return resp, nil
 }
@@ -136,7 +259,11 @@ func (s *VaultServerHandler) DeleteSecret(
 		return nil, fmt.Errorf(errorInvalidToken, err)
 	}
 
-	err = s.vaultService.DeleteSecret(ctx, userID, req.GetPath())
+	if versions := req.GetVersions(); len(versions) > 0 {
+		err = s.vaultService.DeleteVersions(ctx, userID, req.GetOwner(), req.GetPath(), versions)
+	} else {
+		err = s.vaultService.DeleteSecret(ctx, userID, req.GetOwner(), req.GetPath())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete secret: %w", err)
 	}
@@ -156,7 +283,11 @@ func (s *VaultServerHandler) DestroySecret(
 		return nil, fmt.Errorf(errorInvalidToken, err)
 	}
 
-	err = s.vaultService.DestroySecret(ctx, userID, req.GetPath())
+	if versions := req.GetVersions(); len(versions) > 0 {
+		err = s.vaultService.DestroyVersions(ctx, userID, req.GetOwner(), req.GetPath(), versions)
+	} else {
+		err = s.vaultService.DestroySecret(ctx, userID, req.GetOwner(), req.GetPath())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to destroy secret: %w", err)
 	}
@@ -176,7 +307,7 @@ func (s *VaultServerHandler) DeleteMetadata(
 		return nil, fmt.Errorf(errorInvalidToken, err)
 	}
 
-	err = s.vaultService.DeleteMetadata(ctx, userID, req.GetPath())
+	err = s.vaultService.DeleteMetadata(ctx, userID, req.GetOwner(), req.GetPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete metadata secret: %w", err)
 	}
@@ -196,7 +327,7 @@ func (s *VaultServerHandler) UndeleteSecret(
 		return nil, fmt.Errorf(errorInvalidToken, err)
 	}
 
-	err = s.vaultService.UndeleteSecret(ctx, userID, req.GetPath(), req.GetVersion())
+	err = s.vaultService.UndeleteSecret(ctx, userID, req.GetOwner(), req.GetPath(), req.GetVersion())
 	if err != nil {
 		return nil, fmt.Errorf("failed to undelete secret: %w", err)
 	}
@@ -206,3 +337,78 @@ func (s *VaultServerHandler) UndeleteSecret(
 
 	return resp, nil
 }
+
+func (s *VaultServerHandler) GrantAccess(
+	ctx context.Context,
+	req *pbModel.GrantAccessRequest,
+) (*pbModel.GrantResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	_, err = s.grantService.Grant(ctx, userID, req.GetGrantee(), req.GetPath(), req.GetAccess())
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant access: %w", err)
+	}
+
+	resp := &pbModel.GrantResponse{}
+	resp.SetMessage("Grant: success")
+
+	return resp, nil
+}
+
+func (s *VaultServerHandler) RevokeAccess(
+	ctx context.Context,
+	req *pbModel.RevokeAccessRequest,
+) (*pbModel.GrantResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	err = s.grantService.Revoke(ctx, userID, req.GetGrantee(), req.GetPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke access: %w", err)
+	}
+
+	resp := &pbModel.GrantResponse{}
+	resp.SetMessage("Revoke: success")
+
+	return resp, nil
+}
+
+func (s *VaultServerHandler) ListGrants(
+	ctx context.Context,
+	req *pbModel.ListGrantsRequest,
+) (*pbModel.ListGrantsResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	granted, received, err := s.grantService.ListGrants(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grants: %w", err)
+	}
+
+	resp := &pbModel.ListGrantsResponse{}
+	resp.SetGranted(toPbGrants(granted))
+	resp.SetReceived(toPbGrants(received))
+
+	return resp, nil
+}
+
+func toPbGrants(grants []entity.SecretGrant) []*pbModel.Grant {
+	result := make([]*pbModel.Grant, 0, len(grants))
+	for i := range grants {
+		g := &pbModel.Grant{}
+		g.SetOwner(grants[i].OwnerLogin)
+		g.SetGrantee(grants[i].GranteeLogin)
+		g.SetPath(grants[i].Path)
+		g.SetAccess(grants[i].Access)
+		g.SetCreatedAt(timestamppb.New(grants[i].CreatedAt))
+		result = append(result, g)
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+package web
+
+import (
+	"encoding/json"
+	"keeper/internal/logger"
+	"keeper/internal/service"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// jwksMaxAge lets verifiers cache the key set for a minute. They are expected
+// to fetch it again when a token names an unknown kid.
+const jwksMaxAge = "max-age=60"
+
+type JWKSHandler struct {
+	log *logger.ZapLogger
+	jwt service.JwtService
+}
+
+func NewJWKSHandler(log *logger.ZapLogger, jwt service.JwtService) *JWKSHandler {
+	return &JWKSHandler{log: log, jwt: jwt}
+}
+
+// KeySetHandler serves the public keys that verify access tokens at
+// /.well-known/jwks.json.
+func (h *JWKSHandler) KeySetHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		set, err := h.jwt.JWKS(r.Context())
+		if err != nil {
+			h.log.InfoCtx(r.Context(), "failed to load jwt keys", zap.Error(err))
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", jwksMaxAge)
+		if err := json.NewEncoder(w).Encode(set); err != nil {
+			h.log.InfoCtx(r.Context(), "failed to write jwks", zap.Error(err))
+		}
+	}
+}
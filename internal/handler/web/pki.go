@@ -0,0 +1,66 @@
+package web
+
+import (
+	"errors"
+	"keeper/internal/logger"
+	"keeper/internal/service"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// pkiMaxAge lets relying parties cache the CRL and CA chain briefly; the CRL
+// itself is rebuilt on every request.
+const pkiMaxAge = "max-age=60"
+
+type PKIHandler struct {
+	log *logger.ZapLogger
+	pki service.PKIService
+}
+
+func NewPKIHandler(log *logger.ZapLogger, pki service.PKIService) *PKIHandler {
+	return &PKIHandler{log: log, pki: pki}
+}
+
+func (h *PKIHandler) fail(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	if errors.Is(err, service.ErrPKINoCA) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	h.log.InfoCtx(r.Context(), msg, zap.Error(err))
+	http.Error(w, "internal server error", http.StatusInternalServerError)
+}
+
+// CRLHandler serves the DER encoded revocation list of the PKI CA.
+func (h *PKIHandler) CRLHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		crl, err := h.pki.CRL(r.Context())
+		if err != nil {
+			h.fail(w, r, "failed to build crl", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/pkix-crl")
+		w.Header().Set("Cache-Control", pkiMaxAge)
+		if _, err := w.Write(crl); err != nil {
+			h.log.InfoCtx(r.Context(), "failed to write crl", zap.Error(err))
+		}
+	}
+}
+
+// CAHandler serves the PKI CA certificate and its issuers as PEM.
+func (h *PKIHandler) CAHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		chain, err := h.pki.CAChain(r.Context())
+		if err != nil {
+			h.fail(w, r, "failed to load ca", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		w.Header().Set("Cache-Control", pkiMaxAge)
+		if _, err := w.Write([]byte(chain)); err != nil {
+			h.log.InfoCtx(r.Context(), "failed to write ca", zap.Error(err))
+		}
+	}
+}
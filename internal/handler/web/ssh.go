@@ -0,0 +1,42 @@
+package web
+
+import (
+	"errors"
+	"keeper/internal/logger"
+	"keeper/internal/service"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type SSHHandler struct {
+	log *logger.ZapLogger
+	ssh service.SSHService
+}
+
+func NewSSHHandler(log *logger.ZapLogger, ssh service.SSHService) *SSHHandler {
+	return &SSHHandler{log: log, ssh: ssh}
+}
+
+// CAHandler serves the SSH CA public key, a single line ready for the file
+// sshd's TrustedUserCAKeys points at.
+func (h *SSHHandler) CAHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key, err := h.ssh.CAPublicKey(r.Context())
+		if errors.Is(err, service.ErrSSHNoCA) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			h.log.InfoCtx(r.Context(), "failed to load ssh ca", zap.Error(err))
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Cache-Control", pkiMaxAge)
+		if _, err := w.Write([]byte(key + "\n")); err != nil {
+			h.log.InfoCtx(r.Context(), "failed to write ssh ca", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package web
+
+import (
+	"errors"
+	"html/template"
+	"keeper/internal/logger"
+	"keeper/internal/service"
+	"mime"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// The GET page only asks for confirmation: link previews in chats fetch
+// URLs on their own and must not burn the secret. The value is revealed on
+// POST.
+var (
+	unwrapConfirmTemplate = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html>
+	<head>
+		<meta charset="UTF-8">
+		<title>Shared secret</title>
+	</head>
+	<body>
+		<h1>Someone shared a secret with you</h1>
+		<p>It can be viewed only once. After that the link stops working.</p>
+		<form method="post">
+			<button type="submit">Reveal secret</button>
+		</form>
+	</body>
+</html>
+`))
+
+	unwrapRevealTemplate = template.Must(template.New("reveal").Parse(`<!DOCTYPE html>
+<html>
+	<head>
+		<meta charset="UTF-8">
+		<title>Shared secret</title>
+	</head>
+	<body>
+		<h1>Shared secret</h1>
+		{{if .Path}}<p>Path: {{.Path}}</p>{{end}}
+		{{if .Description}}<p>{{.Description}}</p>{{end}}
+		<pre>{{printf "%s" .Value}}</pre>
+		<p>This secret has been destroyed on the server. Copy it now.</p>
+	</body>
+</html>
+`))
+
+	unwrapGoneTemplate = template.Must(template.New("gone").Parse(`<!DOCTYPE html>
+<html>
+	<head>
+		<meta charset="UTF-8">
+		<title>Shared secret</title>
+	</head>
+	<body>
+		<h1>Link is invalid, expired or already used</h1>
+	</body>
+</html>
+`))
+)
+
+type UnwrapPage interface {
+	ConfirmHandler() http.HandlerFunc
+	RevealHandler() http.HandlerFunc
+}
+
+type UnwrapHandler struct {
+	log  *logger.ZapLogger
+	wrap service.WrapService
+}
+
+func NewUnwrapHandler(log *logger.ZapLogger, wrap service.WrapService) *UnwrapHandler {
+	return &UnwrapHandler{log: log, wrap: wrap}
+}
+
+func (h *UnwrapHandler) ConfirmHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setUnwrapHeaders(w)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := unwrapConfirmTemplate.Execute(w, nil); err != nil {
+			h.log.InfoCtx(r.Context(), "template execution failed", zap.Error(err))
+		}
+	}
+}
+
+// RevealHandler unwraps the token from the URL. Files are sent as a download,
+// other values are shown on the page.
+func (h *UnwrapHandler) RevealHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		setUnwrapHeaders(w)
+		secret, err := h.wrap.Unwrap(r.Context(), chi.URLParam(r, "token"))
+		if errors.Is(err, service.ErrInvalidWrapToken) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(http.StatusNotFound)
+			_ = unwrapGoneTemplate.Execute(w, nil)
+			return
+		}
+		if err != nil {
+			h.log.InfoCtx(r.Context(), "failed to unwrap", zap.Error(err))
+			http.Error(w, "internal server error", http.StatusInternalServerError)
+			return
+		}
+
+		if secret.FileName != "" {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition",
+				mime.FormatMediaType("attachment", map[string]string{"filename": secret.FileName}))
+			_, _ = w.Write(secret.Value)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := unwrapRevealTemplate.Execute(w, secret); err != nil {
+			h.log.InfoCtx(r.Context(), "template execution failed", zap.Error(err))
+		}
+	}
+}
+
+// setUnwrapHeaders keeps the page and its URL, which holds the token, out of
+// caches and Referer headers.
+func setUnwrapHeaders(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+}
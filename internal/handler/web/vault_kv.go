@@ -0,0 +1,660 @@
+package web
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	chi "github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// VaultAPIPrefix is where the Vault compatible API is mounted.
+	VaultAPIPrefix = "/v1"
+
+	// vaultMount is the only KV v2 mount the API exposes. It holds the
+	// caller's own secrets and the team/<name>/ paths of their teams.
+	vaultMount = "secret"
+
+	// methodList is the LIST verb the vault CLI and client libraries use.
+	methodList = "LIST"
+
+	vaultTokenHeader    = "X-Vault-Token"
+	vaultRequestIDBytes = 16
+	vaultMaxBodySize    = 32 * 1024 * 1024
+
+	errCheckAndSet = "check-and-set parameter did not match the current version"
+)
+
+// chi only routes methods it knows about.
+func init() {
+	chi.RegisterMethod(methodList)
+}
+
+// VaultKVHandler serves a subset of the HashiCorp Vault KV v2 API, so the
+// vault CLI and Vault client libraries can be pointed at Keeper unchanged.
+// Every request goes once through the same interceptors as the gRPC server,
+// as a call of the DataService method it corresponds to, and is then served
+// by direct DataService calls: the X-Vault-Token header carries a Keeper
+// token or API key, and audit records and policies apply as usual. Deleting
+// the latest version and undeleting first list the versions as a separate
+// ListSecretVersions call, so they need read access too.
+type VaultKVHandler struct {
+	log         *logger.ZapLogger
+	data        pb.DataServiceServer
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func NewVaultKVHandler(
+	log *logger.ZapLogger,
+	data pb.DataServiceServer,
+	interceptor grpc.UnaryServerInterceptor,
+) *VaultKVHandler {
+	return &VaultKVHandler{log: log, data: data, interceptor: interceptor}
+}
+
+// Routes returns the router to mount at VaultAPIPrefix.
+func (h *VaultKVHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/sys/internal/ui/mounts/*", h.MountHandler())
+	r.Handle("/"+vaultMount+"/data/*", h.DataHandler())
+	r.Handle("/"+vaultMount+"/metadata/*", h.MetadataHandler())
+	r.Handle("/"+vaultMount+"/delete/*", h.VersionsHandler(pb.DataService_DeleteSecret_FullMethodName))
+	r.Handle("/"+vaultMount+"/undelete/*", h.VersionsHandler(pb.DataService_UndeleteSecret_FullMethodName))
+	r.Handle("/"+vaultMount+"/destroy/*", h.VersionsHandler(pb.DataService_DestroySecret_FullMethodName))
+	r.NotFound(func(w http.ResponseWriter, _ *http.Request) {
+		writeVaultErrors(w, http.StatusNotFound)
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, _ *http.Request) {
+		writeVaultErrors(w, http.StatusMethodNotAllowed)
+	})
+	return r
+}
+
+// vaultResponse is the envelope of every successful Vault response.
+type vaultResponse struct {
+	Data          any      `json:"data"`
+	WrapInfo      any      `json:"wrap_info"`
+	Auth          any      `json:"auth"`
+	Warnings      []string `json:"warnings"`
+	RequestID     string   `json:"request_id"`
+	LeaseID       string   `json:"lease_id"`
+	LeaseDuration int      `json:"lease_duration"`
+	Renewable     bool     `json:"renewable"`
+}
+
+type versionMetadata struct {
+	CustomMetadata map[string]string `json:"custom_metadata"`
+	CreatedTime    string            `json:"created_time"`
+	DeletionTime   string            `json:"deletion_time"`
+	Version        int64             `json:"version"`
+	Destroyed      bool              `json:"destroyed"`
+}
+
+type versionSummary struct {
+	CreatedTime  string `json:"created_time"`
+	DeletionTime string `json:"deletion_time"`
+	Destroyed    bool   `json:"destroyed"`
+}
+
+type secretMetadata struct {
+	Versions           map[string]versionSummary `json:"versions"`
+	CustomMetadata     map[string]string         `json:"custom_metadata"`
+	CreatedTime        string                    `json:"created_time"`
+	UpdatedTime        string                    `json:"updated_time"`
+	DeleteVersionAfter string                    `json:"delete_version_after"`
+	CurrentVersion     int64                     `json:"current_version"`
+	OldestVersion      int64                     `json:"oldest_version"`
+	MaxVersions        int                       `json:"max_versions"`
+	CASRequired        bool                      `json:"cas_required"`
+}
+
+// kvCall is one HTTP request on its way to DataService. md and peer are
+// what the interceptors would see on a gRPC call.
+type kvCall struct {
+	w         http.ResponseWriter
+	r         *http.Request
+	md        metadata.MD
+	peer      *peer.Peer
+	requestID string
+	path      string
+}
+
+func (h *VaultKVHandler) newCall(w http.ResponseWriter, r *http.Request) *kvCall {
+	requestID := r.Header.Get("X-Request-Id")
+	if requestID == "" {
+		buf := make([]byte, vaultRequestIDBytes)
+		_, _ = rand.Read(buf)
+		requestID = hex.EncodeToString(buf)
+	}
+
+	token := r.Header.Get(vaultTokenHeader)
+	if token == "" {
+		if scheme, value, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "bearer") {
+			token = value
+		}
+	}
+	md := metadata.Pairs("x-request-id", requestID)
+	if token != "" {
+		md.Set("authorization", "Bearer "+token)
+	}
+	c := &kvCall{
+		w:         w,
+		r:         r,
+		md:        md,
+		requestID: requestID,
+		path:      strings.Trim(chi.URLParam(r, "*"), "/"),
+	}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		c.peer = &peer.Peer{Addr: addr}
+	}
+	return c
+}
+
+// invoke runs one HTTP request through the interceptors, once, as a call of
+// method with req: that is what the audit records and what policies are
+// evaluated on. do then makes the DataService calls the request needs with
+// the authenticated context, without going through the interceptors again.
+func invoke[Resp any](
+	h *VaultKVHandler,
+	c *kvCall,
+	method string,
+	req any,
+	do func(ctx context.Context) (Resp, error),
+) (Resp, error) {
+	var zero Resp
+	ctx := metadata.NewIncomingContext(c.r.Context(), c.md)
+	if c.peer != nil {
+		ctx = peer.NewContext(ctx, c.peer)
+	}
+	info := &grpc.UnaryServerInfo{Server: h.data, FullMethod: method}
+	resp, err := h.interceptor(ctx, req, info, func(ctx context.Context, _ any) (any, error) {
+		return do(ctx)
+	})
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := resp.(Resp)
+	if !ok {
+		return zero, status.Errorf(codes.Internal, "unexpected response type %T", resp)
+	}
+	return typed, nil
+}
+
+func (h *VaultKVHandler) fail(c *kvCall, msg string, err error) {
+	code := status.Code(err)
+	switch {
+	case code == codes.Unauthenticated || code == codes.PermissionDenied || errors.Is(err, service.ErrAccessDenied):
+		writeVaultErrors(c.w, http.StatusForbidden, "permission denied")
+	case code == codes.FailedPrecondition && status.Convert(err).Message() == service.ErrCheckAndSet.Error():
+		writeVaultErrors(c.w, http.StatusBadRequest, errCheckAndSet)
+	default:
+		h.log.InfoCtx(c.r.Context(), msg, zap.Error(err), zap.String("request_id", c.requestID))
+		writeVaultErrors(c.w, http.StatusInternalServerError, "internal error")
+	}
+}
+
+func (h *VaultKVHandler) respond(c *kvCall, code int, data any) {
+	writeVaultJSON(c.w, code, vaultResponse{RequestID: c.requestID, Data: data})
+}
+
+func writeVaultErrors(w http.ResponseWriter, code int, errs ...string) {
+	if errs == nil {
+		errs = []string{}
+	}
+	writeVaultJSON(w, code, map[string][]string{"errors": errs})
+}
+
+func writeVaultJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func decodeVaultBody(c *kvCall, body any) bool {
+	err := json.NewDecoder(http.MaxBytesReader(c.w, c.r.Body, vaultMaxBodySize)).Decode(body)
+	if err != nil {
+		writeVaultErrors(c.w, http.StatusBadRequest, "failed to parse JSON input: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// MountHandler answers the preflight request the vault CLI makes to learn
+// the KV version of a mount.
+func (h *VaultKVHandler) MountHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := h.newCall(w, r)
+		if c.path != vaultMount && !strings.HasPrefix(c.path, vaultMount+"/") {
+			writeVaultErrors(w, http.StatusBadRequest, "no mount for path "+c.path+", only "+vaultMount+"/ is served")
+			return
+		}
+		h.respond(c, http.StatusOK, map[string]any{
+			"path":        vaultMount + "/",
+			"type":        "kv",
+			"description": "Keeper secrets",
+			"options":     map[string]string{"version": "2"},
+		})
+	}
+}
+
+// DataHandler reads, writes and soft-deletes the latest version of a secret.
+func (h *VaultKVHandler) DataHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := h.newCall(w, r)
+		if c.path == "" {
+			writeVaultErrors(w, http.StatusBadRequest, "missing secret path")
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			h.readData(c)
+		case http.MethodPost, http.MethodPut:
+			h.writeData(c)
+		case http.MethodDelete:
+			h.deleteLatest(c)
+		default:
+			writeVaultErrors(w, http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// MetadataHandler reads and lists metadata and deletes a secret with all of
+// its versions. Custom metadata and version limits are not supported, so
+// writing metadata is rejected.
+func (h *VaultKVHandler) MetadataHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := h.newCall(w, r)
+		list, _ := strconv.ParseBool(r.URL.Query().Get("list"))
+		switch {
+		case r.Method == methodList || (r.Method == http.MethodGet && list):
+			h.list(c)
+		case c.path == "":
+			writeVaultErrors(w, http.StatusBadRequest, "missing secret path")
+		case r.Method == http.MethodGet:
+			h.readMetadata(c)
+		case r.Method == http.MethodDelete:
+			h.deleteMetadata(c)
+		default:
+			writeVaultErrors(w, http.StatusMethodNotAllowed, "writing metadata is not supported")
+		}
+	}
+}
+
+// VersionsHandler deletes, undeletes or destroys the versions listed in
+// the request body.
+func (h *VaultKVHandler) VersionsHandler(method string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := h.newCall(w, r)
+		if r.Method != http.MethodPost && r.Method != http.MethodPut {
+			writeVaultErrors(w, http.StatusMethodNotAllowed)
+			return
+		}
+		if c.path == "" {
+			writeVaultErrors(w, http.StatusBadRequest, "missing secret path")
+			return
+		}
+		var body struct {
+			Versions []int64 `json:"versions"`
+		}
+		if !decodeVaultBody(c, &body) {
+			return
+		}
+		if len(body.Versions) == 0 {
+			writeVaultErrors(w, http.StatusBadRequest, "no version number provided")
+			return
+		}
+
+		var err error
+		if method == pb.DataService_UndeleteSecret_FullMethodName {
+			err = h.undelete(c, body.Versions)
+		} else {
+			err = h.deleteVersions(c, method, body.Versions)
+		}
+		if err != nil {
+			h.fail(c, "failed to change secret versions", err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// listVersions lists the versions of the path as a ListSecretVersions call of
+// its own.
+func (h *VaultKVHandler) listVersions(c *kvCall) ([]*pbModel.SecretVersionInfo, error) {
+	req := &pbModel.ListSecretVersionsRequest{}
+	req.SetPath(c.path)
+	resp, err := invoke(h, c, pb.DataService_ListSecretVersions_FullMethodName, req,
+		func(ctx context.Context) (*pbModel.ListSecretVersionsResponse, error) {
+			return h.data.ListSecretVersions(ctx, req)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetVersions(), nil
+}
+
+func (h *VaultKVHandler) versions(ctx context.Context, path string) ([]*pbModel.SecretVersionInfo, error) {
+	req := &pbModel.ListSecretVersionsRequest{}
+	req.SetPath(path)
+	resp, err := h.data.ListSecretVersions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetVersions(), nil
+}
+
+// kvRead is what a read found: no version at all, the metadata of a deleted
+// or destroyed version, or a live version with its value.
+type kvRead struct {
+	secret *pbModel.SecretResponse
+	meta   versionMetadata
+	found  bool
+}
+
+func (h *VaultKVHandler) readData(c *kvCall) {
+	var want int64
+	if v := c.r.URL.Query().Get("version"); v != "" && v != "0" {
+		var err error
+		if want, err = strconv.ParseInt(v, 10, 64); err != nil {
+			writeVaultErrors(c.w, http.StatusBadRequest, "invalid version "+v)
+			return
+		}
+	}
+
+	req := &pbModel.GetSecretRequest{}
+	req.SetPath(c.path)
+	req.SetVersion(want)
+	read, err := invoke(h, c, pb.DataService_GetSecret_FullMethodName, req, func(ctx context.Context) (kvRead, error) {
+		versions, err := h.versions(ctx, c.path)
+		if err != nil || len(versions) == 0 {
+			return kvRead{}, err
+		}
+		version := want
+		if version == 0 {
+			version = currentVersion(versions)
+		}
+		i := slices.IndexFunc(versions, func(v *pbModel.SecretVersionInfo) bool { return v.GetVersion() == version })
+		if i < 0 {
+			return kvRead{}, nil
+		}
+		read := kvRead{meta: toVersionMetadata(versions[i]), found: true}
+		if versions[i].HasDeletedAt() || versions[i].GetDestroyed() {
+			return read, nil
+		}
+		req.SetVersion(version)
+		read.secret, err = h.data.GetSecret(ctx, req)
+		return read, err
+	})
+	if err != nil {
+		h.fail(c, "failed to get secret", err)
+		return
+	}
+	if !read.found {
+		writeVaultErrors(c.w, http.StatusNotFound)
+		return
+	}
+	if read.secret == nil {
+		// Vault answers 404 for deleted versions but still returns their
+		// metadata, which is how clients tell them from missing ones.
+		h.respond(c, http.StatusNotFound, map[string]any{"data": nil, "metadata": read.meta})
+		return
+	}
+	data, err := kvData(read.secret)
+	if err != nil {
+		h.fail(c, "failed to decode secret", err)
+		return
+	}
+	h.respond(c, http.StatusOK, map[string]any{"data": data, "metadata": read.meta})
+}
+
+// kvData turns a secret into the map Vault clients expect. JSON objects are
+// returned as they are, other values under "value" and files base64 encoded
+// under "data_base64".
+func kvData(secret *pbModel.SecretResponse) (map[string]any, error) {
+	if secret.GetFilePath() != "" {
+		return map[string]any{"data_base64": base64.StdEncoding.EncodeToString(secret.GetValue())}, nil
+	}
+	// GetSecret returns key-value secrets as a JSON string of their bytes.
+	var raw []byte
+	if err := json.Unmarshal(secret.GetValue(), &raw); err != nil {
+		return nil, err
+	}
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err == nil && data != nil {
+		return data, nil
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		value = string(raw)
+	}
+	return map[string]any{"value": value}, nil
+}
+
+func (h *VaultKVHandler) writeData(c *kvCall) {
+	var body struct {
+		Data    json.RawMessage `json:"data"`
+		Options struct {
+			CAS *int64 `json:"cas"`
+		} `json:"options"`
+	}
+	if !decodeVaultBody(c, &body) {
+		return
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body.Data, &object); err != nil || object == nil {
+		writeVaultErrors(c.w, http.StatusBadRequest, "no data provided")
+		return
+	}
+
+	// Vault has no description or expiry: leaving both unset keeps the ones
+	// the path already has.
+	req := &pbModel.WriteSecret{}
+	req.SetPath(c.path)
+	req.SetValue(body.Data)
+	if body.Options.CAS != nil {
+		req.SetCas(*body.Options.CAS)
+	}
+	resp, err := invoke(h, c, pb.DataService_SaveSecret_FullMethodName, req,
+		func(ctx context.Context) (*pbModel.SaveSecretResponse, error) { return h.data.SaveSecret(ctx, req) })
+	if err != nil {
+		h.fail(c, "failed to save secret", err)
+		return
+	}
+	h.respond(c, http.StatusOK, versionMetadata{
+		CreatedTime: formatVaultTime(resp.GetCreatedAt()),
+		Version:     resp.GetVersion(),
+	})
+}
+
+func (h *VaultKVHandler) deleteLatest(c *kvCall) {
+	versions, err := h.listVersions(c)
+	if err != nil {
+		h.fail(c, "failed to list secret versions", err)
+		return
+	}
+	if len(versions) > 0 {
+		err = h.deleteVersions(c, pb.DataService_DeleteSecret_FullMethodName, []int64{currentVersion(versions)})
+		if err != nil {
+			h.fail(c, "failed to delete secret", err)
+			return
+		}
+	}
+	c.w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *VaultKVHandler) deleteVersions(c *kvCall, method string, versions []int64) error {
+	req := &pbModel.DeleteSecretRequest{}
+	req.SetPath(c.path)
+	req.SetVersions(versions)
+	call := h.data.DeleteSecret
+	if method == pb.DataService_DestroySecret_FullMethodName {
+		call = h.data.DestroySecret
+	}
+	_, err := invoke(h, c, method, req, func(ctx context.Context) (*pbModel.DeleteSecretResponse, error) {
+		return call(ctx, req)
+	})
+	return err
+}
+
+// undelete restores the listed versions that are deleted but not destroyed;
+// like Vault, it ignores the others.
+func (h *VaultKVHandler) undelete(c *kvCall, versions []int64) error {
+	existing, err := h.listVersions(c)
+	if err != nil {
+		return err
+	}
+	req := &pbModel.UndeleteSecretRequest{}
+	req.SetPath(c.path)
+	_, err = invoke(h, c, pb.DataService_UndeleteSecret_FullMethodName, req,
+		func(ctx context.Context) (*pbModel.DeleteSecretResponse, error) {
+			var (
+				resp *pbModel.DeleteSecretResponse
+				err  error
+			)
+			for _, v := range existing {
+				if !slices.Contains(versions, v.GetVersion()) || !v.HasDeletedAt() || v.GetDestroyed() {
+					continue
+				}
+				req.SetVersion(v.GetVersion())
+				if resp, err = h.data.UndeleteSecret(ctx, req); err != nil {
+					return nil, err
+				}
+			}
+			return resp, nil
+		})
+	return err
+}
+
+func (h *VaultKVHandler) readMetadata(c *kvCall) {
+	versions, err := h.listVersions(c)
+	if err != nil {
+		h.fail(c, "failed to list secret versions", err)
+		return
+	}
+	if len(versions) == 0 {
+		writeVaultErrors(c.w, http.StatusNotFound)
+		return
+	}
+
+	meta := secretMetadata{
+		Versions:           make(map[string]versionSummary, len(versions)),
+		DeleteVersionAfter: "0s",
+		OldestVersion:      versions[0].GetVersion(),
+	}
+	for _, v := range versions {
+		meta.Versions[strconv.FormatInt(v.GetVersion(), 10)] = versionSummary{
+			CreatedTime:  formatVaultTime(v.GetCreatedAt()),
+			DeletionTime: deletionTime(v),
+			Destroyed:    v.GetDestroyed(),
+		}
+		if v.GetVersion() <= meta.OldestVersion {
+			meta.OldestVersion = v.GetVersion()
+			meta.CreatedTime = formatVaultTime(v.GetCreatedAt())
+		}
+		if v.GetVersion() >= meta.CurrentVersion {
+			meta.CurrentVersion = v.GetVersion()
+			meta.UpdatedTime = formatVaultTime(v.GetCreatedAt())
+		}
+	}
+	h.respond(c, http.StatusOK, meta)
+}
+
+// list returns the keys directly under a folder; subfolders end in "/".
+func (h *VaultKVHandler) list(c *kvCall) {
+	req := &pbModel.ListSecretPathsRequest{}
+	resp, err := invoke(h, c, pb.DataService_ListSecrets_FullMethodName, req,
+		func(ctx context.Context) (*pbModel.ListSecretPathsResponse, error) {
+			return h.data.ListSecrets(ctx, req)
+		})
+	if err != nil {
+		h.fail(c, "failed to list secrets", err)
+		return
+	}
+
+	prefix := c.path
+	if prefix != "" {
+		prefix += "/"
+	}
+	var keys []string
+	for _, p := range resp.GetPaths() {
+		rest, ok := strings.CutPrefix(p, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		if i := strings.Index(rest, "/"); i >= 0 {
+			rest = rest[:i+1]
+		}
+		if !slices.Contains(keys, rest) {
+			keys = append(keys, rest)
+		}
+	}
+	if len(keys) == 0 {
+		writeVaultErrors(c.w, http.StatusNotFound)
+		return
+	}
+	slices.Sort(keys)
+	h.respond(c, http.StatusOK, map[string][]string{"keys": keys})
+}
+
+func (h *VaultKVHandler) deleteMetadata(c *kvCall) {
+	req := &pbModel.DeleteSecretRequest{}
+	req.SetPath(c.path)
+	_, err := invoke(h, c, pb.DataService_DeleteMetadata_FullMethodName, req,
+		func(ctx context.Context) (*pbModel.DeleteSecretResponse, error) {
+			return h.data.DeleteMetadata(ctx, req)
+		})
+	if err != nil {
+		h.fail(c, "failed to delete metadata", err)
+		return
+	}
+	c.w.WriteHeader(http.StatusNoContent)
+}
+
+func currentVersion(versions []*pbModel.SecretVersionInfo) int64 {
+	var current int64
+	for _, v := range versions {
+		current = max(current, v.GetVersion())
+	}
+	return current
+}
+
+func toVersionMetadata(v *pbModel.SecretVersionInfo) versionMetadata {
+	return versionMetadata{
+		CreatedTime:  formatVaultTime(v.GetCreatedAt()),
+		DeletionTime: deletionTime(v),
+		Version:      v.GetVersion(),
+		Destroyed:    v.GetDestroyed(),
+	}
+}
+
+func deletionTime(v *pbModel.SecretVersionInfo) string {
+	if !v.HasDeletedAt() {
+		return ""
+	}
+	return formatVaultTime(v.GetDeletedAt())
+}
+
+func formatVaultTime(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
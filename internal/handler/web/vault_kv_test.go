@@ -0,0 +1,389 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/handler"
+	"keeper/internal/logger"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	chi "github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const kvToken = "good-token"
+
+// memoryVault keeps versions in memory with the semantics of the vault
+// repository.
+type memoryVault struct {
+	service.VaultService
+	secrets map[string][]entity.SecretVersion
+	meta    map[string]entity.SecretMetadata
+}
+
+func (m *memoryVault) SaveSecret(_ context.Context, req *dto.ServerCreateSecret) (entity.SecretVersion, error) {
+	versions := m.secrets[req.Path]
+	if req.CAS != nil && *req.CAS != int64(len(versions)) {
+		return entity.SecretVersion{}, service.ErrCheckAndSet
+	}
+	if _, ok := m.meta[req.Path]; !ok || !req.KeepMetadata {
+		m.meta[req.Path] = entity.SecretMetadata{Path: req.Path, Description: req.Description, ExpiredAt: req.ExpiredAt}
+	}
+	v := entity.SecretVersion{Value: req.Payload, Version: int64(len(versions)) + 1, CreatedAt: time.Now()}
+	m.secrets[req.Path] = append(versions, v)
+	return v, nil
+}
+
+func (m *memoryVault) GetSecretVersion(
+	_ context.Context,
+	_ int64,
+	_, path string,
+	version int64,
+) (dto.DecryptedSecretResponse, error) {
+	v := m.secrets[path][version-1]
+	meta := m.meta[path]
+	return dto.DecryptedSecretResponse{
+		Path:        path,
+		Description: meta.Description,
+		ExpiredAt:   meta.ExpiredAt,
+		Data:        v.Value,
+		Version:     v.Version,
+		CreatedAt:   v.CreatedAt,
+	}, nil
+}
+
+func (m *memoryVault) ListSecretVersions(_ context.Context, _ int64, _, path string) ([]entity.SecretVersion, error) {
+	return m.secrets[path], nil
+}
+
+func (m *memoryVault) ListSecretsPaths(context.Context, int64) ([]string, error) {
+	var paths []string
+	for p := range m.secrets {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+func (m *memoryVault) ListSharedSecrets(context.Context, int64) ([]entity.SharedSecret, error) {
+	return nil, nil
+}
+
+func (m *memoryVault) update(path string, versions []int64, f func(v *entity.SecretVersion)) {
+	for i := range m.secrets[path] {
+		if slices.Contains(versions, m.secrets[path][i].Version) {
+			f(&m.secrets[path][i])
+		}
+	}
+}
+
+func (m *memoryVault) DeleteVersions(_ context.Context, _ int64, _, path string, versions []int64) error {
+	now := time.Now()
+	m.update(path, versions, func(v *entity.SecretVersion) { v.DeletedAt = &now })
+	return nil
+}
+
+func (m *memoryVault) DestroyVersions(_ context.Context, _ int64, _, path string, versions []int64) error {
+	now := time.Now()
+	m.update(path, versions, func(v *entity.SecretVersion) { v.Destroyed, v.DeletedAt, v.Value = true, &now, nil })
+	return nil
+}
+
+func (m *memoryVault) UndeleteSecret(_ context.Context, _ int64, _, path string, version int64) error {
+	m.update(path, []int64{version}, func(v *entity.SecretVersion) { v.DeletedAt = nil })
+	return nil
+}
+
+func (m *memoryVault) DeleteMetadata(_ context.Context, _ int64, _, path string) error {
+	delete(m.secrets, path)
+	return nil
+}
+
+// authByToken stands in for the interceptor chain: it accepts kvToken,
+// records the methods called and refuses the denied ones.
+func authByToken(s *kvServer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+		method := strings.TrimPrefix(info.FullMethod, "/keeper.go.grpc.v1.DataService/")
+		s.methods = append(s.methods, method)
+		md, _ := metadata.FromIncomingContext(ctx)
+		if auth := md.Get("authorization"); len(auth) == 0 || auth[0] != "Bearer "+kvToken {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		if slices.Contains(s.denied, method) {
+			return nil, status.Error(codes.PermissionDenied, "denied by policy")
+		}
+		return h(utils.SetUserID(ctx, 1), req)
+	}
+}
+
+type kvServer struct {
+	t       *testing.T
+	router  http.Handler
+	vault   *memoryVault
+	data    *handler.VaultServerHandler
+	methods []string
+	denied  []string
+}
+
+func newKVServer(t *testing.T) *kvServer {
+	t.Helper()
+	zl, err := logger.NewZapLogger(zapcore.InfoLevel)
+	require.NoError(t, err)
+	s := &kvServer{t: t, vault: &memoryVault{
+		secrets: map[string][]entity.SecretVersion{},
+		meta:    map[string]entity.SecretMetadata{},
+	}}
+	s.data = handler.NewVaultHandler(zl, s.vault, nil)
+	h := NewVaultKVHandler(zl, s.data, authByToken(s))
+	r := chi.NewRouter()
+	r.Mount(VaultAPIPrefix, h.Routes())
+	s.router = r
+	return s
+}
+
+// do sends a request as the vault CLI would and decodes the JSON response.
+func (s *kvServer) do(method, target, body string) (int, map[string]any) {
+	s.t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(vaultTokenHeader, kvToken)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+
+	var resp map[string]any
+	if rec.Body.Len() > 0 {
+		require.NoError(s.t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	}
+	return rec.Code, resp
+}
+
+func data(resp map[string]any) map[string]any {
+	d, _ := resp["data"].(map[string]any)
+	return d
+}
+
+func TestVaultKV_Mount(t *testing.T) {
+	s := newKVServer(t)
+
+	code, resp := s.do(http.MethodGet, "/v1/sys/internal/ui/mounts/secret/app/db", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "secret/", data(resp)["path"])
+	assert.Equal(t, map[string]any{"version": "2"}, data(resp)["options"])
+
+	code, _ = s.do(http.MethodGet, "/v1/sys/internal/ui/mounts/kv/app", "")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestVaultKV_Token(t *testing.T) {
+	s := newKVServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/secret/data/app", http.NoBody)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.JSONEq(t, `{"errors":["permission denied"]}`, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/secret/data/app", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+kvToken)
+	rec = httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.JSONEq(t, `{"errors":[]}`, rec.Body.String())
+}
+
+func TestVaultKV_Versions(t *testing.T) {
+	s := newKVServer(t)
+
+	code, resp := s.do(http.MethodPost, "/v1/secret/data/app/db", `{"data":{"password":"one"},"options":{"cas":0}}`)
+	require.Equal(t, http.StatusOK, code)
+	assert.InDelta(t, 1, data(resp)["version"], 0)
+	assert.Empty(t, data(resp)["deletion_time"])
+
+	code, resp = s.do(http.MethodPut, "/v1/secret/data/app/db", `{"data":{"password":"two"},"options":{"cas":0}}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, []any{errCheckAndSet}, resp["errors"])
+
+	code, _ = s.do(http.MethodPut, "/v1/secret/data/app/db", `{"data":{"password":"two"},"options":{"cas":1}}`)
+	require.Equal(t, http.StatusOK, code)
+
+	code, _ = s.do(http.MethodPut, "/v1/secret/data/app/db", `{"data":"not an object"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, resp = s.do(http.MethodGet, "/v1/secret/data/app/db", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]any{"password": "two"}, data(resp)["data"])
+	assert.InDelta(t, 2, data(resp)["metadata"].(map[string]any)["version"], 0)
+	assert.NotEmpty(t, resp["request_id"])
+
+	code, resp = s.do(http.MethodGet, "/v1/secret/data/app/db?version=1", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]any{"password": "one"}, data(resp)["data"])
+
+	// Deleting the secret only deletes its latest version.
+	code, _ = s.do(http.MethodDelete, "/v1/secret/data/app/db", "")
+	require.Equal(t, http.StatusNoContent, code)
+	code, resp = s.do(http.MethodGet, "/v1/secret/data/app/db", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Nil(t, data(resp)["data"])
+	assert.NotEmpty(t, data(resp)["metadata"].(map[string]any)["deletion_time"])
+	code, _ = s.do(http.MethodGet, "/v1/secret/data/app/db?version=1", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = s.do(http.MethodPost, "/v1/secret/undelete/app/db", `{"versions":[2]}`)
+	require.Equal(t, http.StatusNoContent, code)
+	code, _ = s.do(http.MethodGet, "/v1/secret/data/app/db", "")
+	assert.Equal(t, http.StatusOK, code)
+
+	code, _ = s.do(http.MethodPut, "/v1/secret/destroy/app/db", `{"versions":[1]}`)
+	require.Equal(t, http.StatusNoContent, code)
+	code, resp = s.do(http.MethodGet, "/v1/secret/data/app/db?version=1", "")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, true, data(resp)["metadata"].(map[string]any)["destroyed"])
+
+	// Destroyed versions can't be undeleted.
+	code, _ = s.do(http.MethodPost, "/v1/secret/undelete/app/db", `{"versions":[1]}`)
+	require.Equal(t, http.StatusNoContent, code)
+	code, _ = s.do(http.MethodGet, "/v1/secret/data/app/db?version=1", "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, resp = s.do(http.MethodPost, "/v1/secret/delete/app/db", `{}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, []any{"no version number provided"}, resp["errors"])
+
+	code, resp = s.do(http.MethodGet, "/v1/secret/metadata/app/db", "")
+	require.Equal(t, http.StatusOK, code)
+	meta := data(resp)
+	assert.InDelta(t, 2, meta["current_version"], 0)
+	assert.InDelta(t, 1, meta["oldest_version"], 0)
+	assert.Equal(t, "0s", meta["delete_version_after"])
+	versions := meta["versions"].(map[string]any)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, true, versions["1"].(map[string]any)["destroyed"])
+	assert.Empty(t, versions["2"].(map[string]any)["deletion_time"])
+
+	code, _ = s.do(http.MethodDelete, "/v1/secret/metadata/app/db", "")
+	require.Equal(t, http.StatusNoContent, code)
+	code, _ = s.do(http.MethodGet, "/v1/secret/metadata/app/db", "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	assert.Contains(t, s.methods, "DestroySecret")
+	assert.Contains(t, s.methods, "DeleteMetadata")
+}
+
+func TestVaultKV_KeepsMetadata(t *testing.T) {
+	s := newKVServer(t)
+	ctx := utils.SetUserID(t.Context(), 1)
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	req := &pbModel.WriteSecret{}
+	req.SetPath("app/db")
+	req.SetValue([]byte(`{"password":"one"}`))
+	req.SetDescription("primary database")
+	req.SetExpiredAt(timestamppb.New(expiry))
+	_, err := s.data.SaveSecret(ctx, req)
+	require.NoError(t, err)
+
+	code, _ := s.do(http.MethodPut, "/v1/secret/data/app/db", `{"data":{"password":"two"}}`)
+	require.Equal(t, http.StatusOK, code)
+
+	get := &pbModel.GetSecretRequest{}
+	get.SetPath("app/db")
+	get.SetVersion(2)
+	secret, err := s.data.GetSecret(ctx, get)
+	require.NoError(t, err)
+	assert.Equal(t, "primary database", secret.GetDescription())
+	assert.Equal(t, expiry, secret.GetExpiredAt().AsTime())
+}
+
+func TestVaultKV_OneCallPerRequest(t *testing.T) {
+	s := newKVServer(t)
+	s.vault.secrets["app/db"] = []entity.SecretVersion{{Version: 1, Value: []byte(`{}`)}}
+	s.vault.secrets["app/cache"] = []entity.SecretVersion{{Version: 1, Value: []byte(`{}`)}}
+
+	for _, tc := range []struct{ method, target, body, want string }{
+		{http.MethodGet, "/v1/secret/data/app/db", "", "GetSecret"},
+		{http.MethodDelete, "/v1/secret/data/app/db", "", "ListSecretVersions DeleteSecret"},
+		{http.MethodPost, "/v1/secret/undelete/app/db", `{"versions":[1]}`, "ListSecretVersions UndeleteSecret"},
+		{http.MethodGet, "/v1/secret/metadata/app/db", "", "ListSecretVersions"},
+		{methodList, "/v1/secret/metadata/app/", "", "ListSecrets"},
+	} {
+		s.methods = nil
+		code, _ := s.do(tc.method, tc.target, tc.body)
+		assert.Less(t, code, http.StatusMultipleChoices, tc.target)
+		assert.Equal(t, strings.Fields(tc.want), s.methods, tc.method+" "+tc.target)
+	}
+}
+
+func TestVaultKV_DeleteNeedsRead(t *testing.T) {
+	s := newKVServer(t)
+	s.vault.secrets["app/db"] = []entity.SecretVersion{{Version: 1, Value: []byte(`{}`)}}
+	s.denied = []string{"ListSecretVersions"}
+
+	code, _ := s.do(http.MethodDelete, "/v1/secret/data/app/db", "")
+	assert.Equal(t, http.StatusForbidden, code)
+	code, _ = s.do(http.MethodPost, "/v1/secret/undelete/app/db", `{"versions":[1]}`)
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, []string{"ListSecretVersions", "ListSecretVersions"}, s.methods)
+	assert.Nil(t, s.vault.secrets["app/db"][0].DeletedAt)
+}
+
+func TestVaultKV_List(t *testing.T) {
+	s := newKVServer(t)
+	for _, p := range []string{"app/db", "app/cache/redis", "web", "team/ops/ssh"} {
+		s.vault.secrets[p] = []entity.SecretVersion{{Version: 1, Value: []byte(`{}`)}}
+	}
+
+	code, resp := s.do(methodList, "/v1/secret/metadata/", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []any{"app/", "team/", "web"}, data(resp)["keys"])
+
+	code, resp = s.do(http.MethodGet, "/v1/secret/metadata/app/?list=true", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []any{"cache/", "db"}, data(resp)["keys"])
+
+	code, _ = s.do(methodList, "/v1/secret/metadata/missing", "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestVaultKV_NonObjectValues(t *testing.T) {
+	s := newKVServer(t)
+	s.vault.secrets["token"] = []entity.SecretVersion{{Version: 1, Value: []byte("plain text")}}
+	s.vault.secrets["count"] = []entity.SecretVersion{{Version: 1, Value: []byte("42")}}
+
+	code, resp := s.do(http.MethodGet, "/v1/secret/data/token", "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]any{"value": "plain text"}, data(resp)["data"])
+
+	_, resp = s.do(http.MethodGet, "/v1/secret/data/count", "")
+	assert.Equal(t, map[string]any{"value": 42.0}, data(resp)["data"])
+
+	code, _ = s.do(http.MethodPatch, "/v1/secret/data/token", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
+
+func TestVaultKV_Body(t *testing.T) {
+	s := newKVServer(t)
+	req := httptest.NewRequest(http.MethodPost, "/v1/secret/data/app", bytes.NewReader([]byte("{")))
+	req.Header.Set(vaultTokenHeader, kvToken)
+	rec := httptest.NewRecorder()
+	s.router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, s.methods)
+}
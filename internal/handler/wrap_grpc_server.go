@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/logger"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UnwrapPagePath is where the HTTP server serves the unwrap page; the
+// wrapping token follows it.
+const UnwrapPagePath = "/unwrap/"
+
+type WrapServerHandler struct {
+	pb.UnimplementedWrapServiceServer
+	wrapService service.WrapService
+	logger      *logger.ZapLogger
+	publicURL   string
+}
+
+// NewWrapHandler takes the public base URL of the HTTP server, used to build
+// share links. Without it Wrap only returns the token.
+func NewWrapHandler(l *logger.ZapLogger, svc service.WrapService, publicURL string) *WrapServerHandler {
+	return &WrapServerHandler{
+		wrapService: svc,
+		logger:      l,
+		publicURL:   strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+func (s *WrapServerHandler) Wrap(
+	ctx context.Context,
+	req *pbModel.WrapRequest,
+) (*pbModel.WrapResponse, error) {
+	userID, err := utils.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidToken, err)
+	}
+
+	wrapped, err := s.wrapService.Wrap(ctx, dto.WrapSecret{
+		Owner:  req.GetOwner(),
+		Path:   req.GetPath(),
+		Value:  req.GetValue(),
+		UserID: userID,
+		TTL:    time.Duration(req.GetTtlSeconds()) * time.Second,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrAccessDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, fmt.Errorf("failed to wrap secret: %w", err)
+	}
+
+	resp := &pbModel.WrapResponse{}
+	resp.SetWrapToken(wrapped.Token)
+	resp.SetExpiresAt(timestamppb.New(wrapped.ExpiresAt))
+	if s.publicURL != "" {
+		resp.SetUrl(s.publicURL + UnwrapPagePath + wrapped.Token)
+	}
+	return resp, nil
+}
+
+func (s *WrapServerHandler) Unwrap(
+	ctx context.Context,
+	req *pbModel.UnwrapRequest,
+) (*pbModel.UnwrapResponse, error) {
+	secret, err := s.wrapService.Unwrap(ctx, req.GetWrapToken())
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidWrapToken) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, fmt.Errorf("failed to unwrap: %w", err)
+	}
+
+	resp := &pbModel.UnwrapResponse{}
+	resp.SetPath(secret.Path)
+	resp.SetDescription(secret.Description)
+	resp.SetFileName(secret.FileName)
+	resp.SetValue(secret.Value)
+	resp.SetCreatedAt(timestamppb.New(secret.CreatedAt))
+	return resp, nil
+}
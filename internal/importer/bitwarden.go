@@ -0,0 +1,144 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Bitwarden item types and custom field types.
+const (
+	bitwardenLogin    = 1
+	bitwardenNote     = 2
+	bitwardenCard     = 3
+	bitwardenIdentity = 4
+	bitwardenSSHKey   = 5
+
+	bitwardenFieldLinked = 3
+)
+
+// Bitwarden unencrypted JSON export, personal or organization.
+type bitwardenExport struct {
+	Encrypted   bool              `json:"encrypted"`
+	Folders     []bitwardenFolder `json:"folders"`
+	Collections []bitwardenFolder `json:"collections"`
+	Items       []bitwardenItem   `json:"items"`
+}
+
+type bitwardenFolder struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type bitwardenItem struct {
+	FolderID      string   `json:"folderId"`
+	CollectionIDs []string `json:"collectionIds"`
+	Type          int      `json:"type"`
+	Name          string   `json:"name"`
+	Notes         string   `json:"notes"`
+	Fields        []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+		Type  int    `json:"type"`
+	} `json:"fields"`
+	Login *struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		TOTP     string `json:"totp"`
+		URIs     []struct {
+			URI string `json:"uri"`
+		} `json:"uris"`
+		Passkeys []json.RawMessage `json:"fido2Credentials"`
+	} `json:"login"`
+	Card     map[string]any `json:"card"`
+	Identity map[string]any `json:"identity"`
+	SSHKey   map[string]any `json:"sshKey"`
+}
+
+func parseBitwarden(r io.Reader) (*Result, error) {
+	var export bitwardenExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to parse Bitwarden JSON: %w", err)
+	}
+	if export.Encrypted {
+		return nil, errors.New("encrypted Bitwarden exports are not supported, export as unencrypted JSON")
+	}
+
+	folders := make(map[string]string, len(export.Folders)+len(export.Collections))
+	for _, f := range slices.Concat(export.Folders, export.Collections) {
+		folders[f.ID] = f.Name
+	}
+
+	res := &Result{}
+	for _, item := range export.Items {
+		folder := folders[item.FolderID]
+		if folder == "" && len(item.CollectionIDs) > 0 {
+			folder = folders[item.CollectionIDs[0]]
+		}
+		// Bitwarden nests folders by naming them "parent/child".
+		e := Entry{Title: item.Name}
+		if folder != "" {
+			e.Folder = strings.Split(folder, "/")
+		}
+
+		switch item.Type {
+		case bitwardenLogin:
+			if item.Login != nil {
+				e.Set(FieldUsername, item.Login.Username)
+				e.Set(FieldPassword, item.Login.Password)
+				e.Set(FieldTOTP, item.Login.TOTP)
+				for _, uri := range item.Login.URIs {
+					e.Set(FieldURL, uri.URI)
+				}
+				if len(item.Login.Passkeys) > 0 {
+					res.Unconverted = append(res.Unconverted, Unconverted{
+						Item:   e.Name(),
+						Reason: "passkeys can't be exported from Bitwarden",
+					})
+				}
+			}
+		case bitwardenCard:
+			setBitwardenObject(&e, item.Card)
+		case bitwardenIdentity:
+			setBitwardenObject(&e, item.Identity)
+		case bitwardenSSHKey:
+			setBitwardenObject(&e, item.SSHKey)
+		case bitwardenNote:
+		default:
+			res.Unconverted = append(res.Unconverted, Unconverted{
+				Item:   e.Name(),
+				Reason: "unknown item type " + strconv.Itoa(item.Type),
+			})
+			continue
+		}
+		e.Set(FieldNotes, item.Notes)
+
+		for _, f := range item.Fields {
+			if f.Type == bitwardenFieldLinked {
+				res.Unconverted = append(res.Unconverted, Unconverted{
+					Item:   e.Name() + " / " + f.Name,
+					Reason: "linked fields only point at another field",
+				})
+				continue
+			}
+			e.Set(f.Name, f.Value)
+		}
+		res.Entries = append(res.Entries, e)
+	}
+	return res, nil
+}
+
+// setBitwardenObject copies the string members of a card, identity or SSH
+// key, in a stable order.
+func setBitwardenObject(e *Entry, object map[string]any) {
+	for _, k := range slices.Sorted(maps.Keys(object)) {
+		if s, ok := object[k].(string); ok {
+			e.Set(k, s)
+		}
+	}
+}
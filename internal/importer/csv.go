@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strconv"
+	"strings"
+)
+
+// Column roles of a CSV export.
+const (
+	columnField = iota
+	columnTitle
+	columnFolder
+	columnPath
+	columnTags
+	columnArchived
+	columnIgnored
+)
+
+// column is what a CSV column holds. Columns without a role are fields named
+// after their header.
+type column struct {
+	role  int
+	field string
+}
+
+// csvColumns maps the headers of generic CSV files, including those written
+// by browsers and LastPass, onto roles or common field names.
+var csvColumns = map[string]column{
+	"title":             {role: columnTitle},
+	"name":              {role: columnTitle},
+	"folder":            {role: columnFolder},
+	"group":             {role: columnFolder},
+	"grouping":          {role: columnFolder},
+	"path":              {role: columnPath},
+	"username":          {field: FieldUsername},
+	"user name":         {field: FieldUsername},
+	"login":             {field: FieldUsername},
+	"login_username":    {field: FieldUsername},
+	"password":          {field: FieldPassword},
+	"login_password":    {field: FieldPassword},
+	"url":               {field: FieldURL},
+	"website":           {field: FieldURL},
+	"login_uri":         {field: FieldURL},
+	"totp":              {field: FieldTOTP},
+	"otp":               {field: FieldTOTP},
+	"otpauth":           {field: FieldTOTP},
+	"login_totp":        {field: FieldTOTP},
+	"one-time password": {field: FieldTOTP},
+	"notes":             {field: FieldNotes},
+	"note":              {field: FieldNotes},
+	"extra":             {field: FieldNotes},
+}
+
+// onePasswordColumns adds the 1Password columns that aren't fields. Tags are
+// the closest thing a 1Password CSV has to folders.
+var onePasswordColumns = map[string]column{
+	"tags":     {role: columnTags},
+	"archived": {role: columnArchived},
+	"favorite": {role: columnIgnored},
+	"type":     {role: columnIgnored},
+}
+
+func parseCSV(r io.Reader) (*Result, error) {
+	return parseColumns(r, csvColumns)
+}
+
+func parseOnePassword(r io.Reader) (*Result, error) {
+	columns := maps.Clone(csvColumns)
+	maps.Copy(columns, onePasswordColumns)
+	return parseColumns(r, columns)
+}
+
+func parseColumns(r io.Reader, columns map[string]column) (*Result, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	roles := make([]column, len(header))
+	named := false
+	for i, h := range header {
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff")
+		}
+		h = strings.TrimSpace(h)
+		role, ok := columns[strings.ToLower(h)]
+		if !ok {
+			role = column{role: columnField, field: h}
+		}
+		roles[i] = role
+		named = named || role.role == columnTitle || role.role == columnPath
+	}
+	if !named {
+		return nil, errors.New("CSV needs a title, name or path column")
+	}
+
+	res := &Result{}
+	for row := 1; ; row++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		var e Entry
+		archived := false
+		for i, value := range record {
+			if i >= len(roles) {
+				res.Unconverted = append(res.Unconverted, Unconverted{
+					Item:   "row " + strconv.Itoa(row),
+					Reason: "more values than columns",
+				})
+				break
+			}
+			value = strings.TrimSpace(value)
+			switch roles[i].role {
+			case columnField:
+				e.Set(roles[i].field, value)
+			case columnTitle:
+				e.Title = value
+			case columnFolder:
+				e.Folder = splitFolder(value)
+			case columnPath:
+				if parts := splitFolder(value); len(parts) > 0 {
+					e.Folder, e.Title = parts[:len(parts)-1], parts[len(parts)-1]
+				}
+			case columnTags:
+				if tag, _, _ := strings.Cut(value, ","); e.Folder == nil {
+					e.Folder = splitFolder(tag)
+				}
+			case columnArchived:
+				archived, _ = strconv.ParseBool(value)
+			}
+		}
+		if archived {
+			res.Unconverted = append(res.Unconverted, Unconverted{Item: e.Name(), Reason: "item is archived"})
+			continue
+		}
+		res.Entries = append(res.Entries, e)
+	}
+}
+
+func splitFolder(s string) []string {
+	var parts []string
+	for _, p := range strings.Split(s, "/") {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,260 @@
+// Package importer reads the exports of other password managers and turns
+// their entries into Keeper secrets.
+//
+// Every format is first parsed into Entry values. Convert then maps them onto
+// Keeper: the folders and the title become the path, the fields (username,
+// password, url, totp, notes and custom fields) become the JSON value, and
+// each attachment becomes a file secret under the entry's path. Notes are
+// kept in the value rather than the description because descriptions are
+// stored unencrypted; the description records where the entry came from.
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"keeper/internal/kpx"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	FormatKeePass     = "keepass"
+	FormatBitwarden   = "bitwarden"
+	FormatOnePassword = "1password"
+	FormatCSV         = "csv"
+
+	// maxAttachmentSize matches the largest file the agent writes.
+	maxAttachmentSize = 10 * 1024 * 1024
+	untitled          = "untitled"
+)
+
+// Field names shared by every format.
+const (
+	FieldUsername = "username"
+	FieldPassword = "password"
+	FieldURL      = "url"
+	FieldTOTP     = "totp"
+	FieldNotes    = "notes"
+)
+
+var ErrFormat = errors.New("format must be keepass, bitwarden, 1password or csv")
+
+// Entry is one item of a foreign export.
+type Entry struct {
+	Folder      []string
+	Title       string
+	Fields      []Field
+	Attachments []Attachment
+	ExpiresAt   time.Time
+}
+
+type Field struct {
+	Name  string
+	Value string
+}
+
+type Attachment struct {
+	Name string
+	Data []byte
+}
+
+// Unconverted records an item, or part of one, that was left out.
+type Unconverted struct {
+	Item   string
+	Reason string
+}
+
+// Result is what a parser produced: the entries and what it had to leave out.
+type Result struct {
+	Entries     []Entry
+	Unconverted []Unconverted
+}
+
+// Parse reads an export of the given format.
+func Parse(format string, r io.Reader) (*Result, error) {
+	switch format {
+	case FormatKeePass:
+		return parseKeePass(r)
+	case FormatBitwarden:
+		return parseBitwarden(r)
+	case FormatOnePassword:
+		return parseOnePassword(r)
+	case FormatCSV:
+		return parseCSV(r)
+	default:
+		return nil, ErrFormat
+	}
+}
+
+// Name is how an entry is referred to in Unconverted.
+func (e *Entry) Name() string {
+	return strings.Join(append(append([]string{}, e.Folder...), e.Title), " / ")
+}
+
+// Set adds a field, skipping empty values. A name that is already taken gets
+// a numeric suffix, so nothing is overwritten.
+func (e *Entry) Set(name, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "field"
+	}
+	unique := name
+	for i := 2; e.has(unique); i++ {
+		unique = name + "-" + strconv.Itoa(i)
+	}
+	e.Fields = append(e.Fields, Field{Name: unique, Value: value})
+}
+
+func (e *Entry) has(name string) bool {
+	for _, f := range e.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Rule rewrites the start of a path: a path equal to From or below it has
+// From replaced with To. An empty From matches every path.
+type Rule struct {
+	From string
+	To   string
+}
+
+// ParseRule reads a rule written as "from=to".
+func ParseRule(s string) (Rule, error) {
+	from, to, ok := strings.Cut(s, "=")
+	if !ok {
+		return Rule{}, fmt.Errorf("mapping rule %q must look like from=to", s)
+	}
+	return Rule{From: strings.Trim(from, "/"), To: strings.Trim(to, "/")}, nil
+}
+
+func (r Rule) apply(path string) (string, bool) {
+	var rest string
+	switch {
+	case r.From == "":
+		rest = "/" + path
+	case path == r.From:
+	case strings.HasPrefix(path, r.From+"/"):
+		rest = path[len(r.From):]
+	default:
+		return path, false
+	}
+	return strings.TrimPrefix(r.To+rest, "/"), true
+}
+
+// Convert maps entries onto Keeper secrets. Paths are built from the
+// sanitized folders and title, rewritten by the first matching rule; a path
+// that is already taken gets a numeric suffix. source names the format in
+// the descriptions.
+func Convert(res *Result, source string, rules []Rule) ([]*kpx.Secret, []Unconverted) {
+	unconverted := append([]Unconverted{}, res.Unconverted...)
+	secrets := make([]*kpx.Secret, 0, len(res.Entries))
+	taken := make(map[string]bool, len(res.Entries))
+	claim := func(path string) string {
+		unique := path
+		for i := 2; taken[unique]; i++ {
+			unique = path + "-" + strconv.Itoa(i)
+		}
+		taken[unique] = true
+		return unique
+	}
+
+	for i := range res.Entries {
+		e := &res.Entries[i]
+		segments := make([]string, 0, len(e.Folder)+1)
+		for _, folder := range e.Folder {
+			if s := sanitize(folder); s != "" {
+				segments = append(segments, s)
+			}
+		}
+		title := sanitize(e.Title)
+		if title == "" {
+			title = untitled
+		}
+		path := strings.Join(append(segments, title), "/")
+		for _, rule := range rules {
+			if mapped, ok := rule.apply(path); ok {
+				path = mapped
+				break
+			}
+		}
+		if path == "" {
+			unconverted = append(unconverted, Unconverted{Item: e.Name(), Reason: "mapping rule left an empty path"})
+			continue
+		}
+		if len(e.Fields) == 0 && len(e.Attachments) == 0 {
+			unconverted = append(unconverted, Unconverted{Item: e.Name(), Reason: "entry holds no data"})
+			continue
+		}
+
+		path = claim(path)
+		description := fmt.Sprintf("Imported from %s: %s", source, e.Name())
+		if len(e.Fields) > 0 {
+			value := make(map[string]string, len(e.Fields))
+			for _, f := range e.Fields {
+				value[f.Name] = f.Value
+			}
+			data, err := json.Marshal(value)
+			if err != nil {
+				unconverted = append(unconverted, Unconverted{Item: e.Name(), Reason: err.Error()})
+				continue
+			}
+			secrets = append(secrets, &kpx.Secret{
+				Path:        path,
+				Description: description,
+				ExpiresAt:   e.ExpiresAt,
+				Versions:    []kpx.Version{{Version: 1, Data: data}},
+			})
+		}
+
+		for _, a := range e.Attachments {
+			if len(a.Data) > maxAttachmentSize {
+				unconverted = append(unconverted, Unconverted{
+					Item:   e.Name() + " / " + a.Name,
+					Reason: fmt.Sprintf("attachment is larger than %d bytes", maxAttachmentSize),
+				})
+				continue
+			}
+			name := sanitize(a.Name)
+			if name == "" {
+				name = "attachment"
+			}
+			secrets = append(secrets, &kpx.Secret{
+				Path:        claim(path + "/" + name),
+				Description: description,
+				ExpiresAt:   e.ExpiresAt,
+				Versions:    []kpx.Version{{Version: 1, FileName: a.Name, Data: a.Data}},
+			})
+		}
+	}
+	return secrets, unconverted
+}
+
+// sanitize turns a folder or title into a path segment: letters, digits and
+// "-_.@+" are kept, everything else, including "/" and glob characters,
+// becomes a single "-".
+func sanitize(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.TrimSpace(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_.@+", r) {
+			b.WriteRune(r)
+			dash = r == '-'
+			continue
+		}
+		if !dash {
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+	return strings.Trim(b.String(), "-.")
+}
@@ -0,0 +1,239 @@
+package importer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipBase64(t *testing.T, data []byte) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func keepassXML(t *testing.T) string {
+	t.Helper()
+	return `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<KeePassFile>
+	<Meta>
+		<RecycleBinUUID>bin</RecycleBinUUID>
+		<Binaries><Binary ID="0" Compressed="True">` + gzipBase64(t, []byte("-----BEGIN KEY-----")) + `</Binary></Binaries>
+	</Meta>
+	<Root>
+		<Group>
+			<UUID>root</UUID>
+			<Name>Database</Name>
+			<Entry>
+				<String><Key>Title</Key><Value>Top</Value></String>
+				<String><Key>Password</Key><Value ProtectInMemory="True">p0</Value></String>
+			</Entry>
+			<Group>
+				<UUID>work</UUID>
+				<Name>Work Stuff</Name>
+				<Entry>
+					<String><Key>Title</Key><Value>GitHub</Value></String>
+					<String><Key>UserName</Key><Value>alice</Value></String>
+					<String><Key>Password</Key><Value ProtectInMemory="True">s3cret</Value></String>
+					<String><Key>URL</Key><Value>https://github.com</Value></String>
+					<String><Key>Notes</Key><Value>recovery codes in the safe</Value></String>
+					<String><Key>otp</Key><Value>otpauth://totp/GitHub?secret=JBSWY3DPEHPK3PXP</Value></String>
+					<String><Key>PIN</Key><Value Protected="True">c2VjcmV0</Value></String>
+					<Binary><Key>deploy.pem</Key><Value Ref="0"/></Binary>
+					<Times><Expires>True</Expires><ExpiryTime>2030-01-02T03:04:05Z</ExpiryTime></Times>
+					<History><Entry><String><Key>Title</Key><Value>Old</Value></String></Entry></History>
+				</Entry>
+			</Group>
+			<Group>
+				<UUID>bin</UUID>
+				<Name>Recycle Bin</Name>
+				<Entry><String><Key>Title</Key><Value>Deleted</Value></String></Entry>
+			</Group>
+		</Group>
+	</Root>
+</KeePassFile>`
+}
+
+func TestParseKeePass(t *testing.T) {
+	res, err := Parse(FormatKeePass, strings.NewReader(keepassXML(t)))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+
+	top := res.Entries[0]
+	assert.Empty(t, top.Folder)
+	assert.Equal(t, []Field{{Name: FieldPassword, Value: "p0"}}, top.Fields)
+
+	gh := res.Entries[1]
+	assert.Equal(t, []string{"Work Stuff"}, gh.Folder)
+	assert.Equal(t, "GitHub", gh.Title)
+	assert.Equal(t, []Field{
+		{Name: FieldUsername, Value: "alice"},
+		{Name: FieldPassword, Value: "s3cret"},
+		{Name: FieldURL, Value: "https://github.com"},
+		{Name: FieldNotes, Value: "recovery codes in the safe"},
+		{Name: FieldTOTP, Value: "otpauth://totp/GitHub?secret=JBSWY3DPEHPK3PXP"},
+	}, gh.Fields)
+	assert.Equal(t, []Attachment{{Name: "deploy.pem", Data: []byte("-----BEGIN KEY-----")}}, gh.Attachments)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), gh.ExpiresAt)
+
+	assert.Equal(t, []Unconverted{
+		{Item: "Work Stuff / GitHub / PIN", Reason: "value is encrypted; use the XML export of KeePass or KeePassXC"},
+		{Item: "Recycle Bin / Deleted", Reason: "entry is in the recycle bin"},
+	}, res.Unconverted)
+
+	_, err = Parse(FormatKeePass, strings.NewReader("<KeePassFile><Root/></KeePassFile>"))
+	assert.Error(t, err)
+}
+
+func TestParseBitwarden(t *testing.T) {
+	export := `{
+		"encrypted": false,
+		"folders": [{"id": "f1", "name": "Work/Infra"}],
+		"items": [
+			{"type": 1, "folderId": "f1", "name": "AWS", "notes": null,
+			 "login": {"username": "root", "password": "pw", "totp": "JBSWY3DP",
+			           "uris": [{"uri": "https://aws.amazon.com"}, {"uri": "https://console.aws.amazon.com"}],
+			           "fido2Credentials": [{"credentialId": "x"}]},
+			 "fields": [{"name": "account", "value": "1234", "type": 0},
+			            {"name": "user", "value": null, "type": 3}]},
+			{"type": 2, "folderId": null, "name": "Wifi", "notes": "hunter2", "secureNote": {"type": 0}},
+			{"type": 3, "name": "Visa", "card": {"cardholderName": "Alice", "number": "4111", "code": "123"}},
+			{"type": 9, "name": "Future"}
+		]
+	}`
+	res, err := Parse(FormatBitwarden, strings.NewReader(export))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+
+	aws := res.Entries[0]
+	assert.Equal(t, []string{"Work", "Infra"}, aws.Folder)
+	assert.Equal(t, []Field{
+		{Name: FieldUsername, Value: "root"},
+		{Name: FieldPassword, Value: "pw"},
+		{Name: FieldTOTP, Value: "JBSWY3DP"},
+		{Name: FieldURL, Value: "https://aws.amazon.com"},
+		{Name: "url-2", Value: "https://console.aws.amazon.com"},
+		{Name: "account", Value: "1234"},
+	}, aws.Fields)
+	assert.Equal(t, []Field{{Name: FieldNotes, Value: "hunter2"}}, res.Entries[1].Fields)
+	assert.Equal(t, []Field{
+		{Name: "cardholderName", Value: "Alice"},
+		{Name: "code", Value: "123"},
+		{Name: "number", Value: "4111"},
+	}, res.Entries[2].Fields)
+
+	assert.Len(t, res.Unconverted, 3)
+	assert.Equal(t, "Work / Infra / AWS", res.Unconverted[0].Item)
+	assert.Equal(t, "Work / Infra / AWS / user", res.Unconverted[1].Item)
+	assert.Equal(t, "Future", res.Unconverted[2].Item)
+
+	_, err = Parse(FormatBitwarden, strings.NewReader(`{"encrypted": true, "data": "..."}`))
+	assert.ErrorContains(t, err, "encrypted")
+}
+
+func TestParseCSV(t *testing.T) {
+	onePassword := "\ufeffTitle,Url,Username,Password,OTPAuth,Favorite,Archived,Tags,Notes\n" +
+		"Gmail,https://mail.google.com,alice,pw,otpauth://totp/x?secret=AB,true,false,\"Personal/Mail,other\",\"line 1\nline 2\"\n" +
+		"Old,,bob,pw2,,false,true,,\n"
+	res, err := Parse(FormatOnePassword, strings.NewReader(onePassword))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	assert.Equal(t, Entry{
+		Folder: []string{"Personal", "Mail"},
+		Title:  "Gmail",
+		Fields: []Field{
+			{Name: FieldURL, Value: "https://mail.google.com"},
+			{Name: FieldUsername, Value: "alice"},
+			{Name: FieldPassword, Value: "pw"},
+			{Name: FieldTOTP, Value: "otpauth://totp/x?secret=AB"},
+			{Name: FieldNotes, Value: "line 1\nline 2"},
+		},
+	}, res.Entries[0])
+	assert.Equal(t, []Unconverted{{Item: "Old", Reason: "item is archived"}}, res.Unconverted)
+
+	generic := "path,password,Environment\nteam/ops/db,pw,prod\n"
+	res, err = Parse(FormatCSV, strings.NewReader(generic))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	assert.Equal(t, []string{"team", "ops"}, res.Entries[0].Folder)
+	assert.Equal(t, "db", res.Entries[0].Title)
+	assert.Equal(t, []Field{{Name: FieldPassword, Value: "pw"}, {Name: "Environment", Value: "prod"}},
+		res.Entries[0].Fields)
+
+	_, err = Parse(FormatCSV, strings.NewReader("username,password\nalice,pw\n"))
+	assert.Error(t, err)
+	_, err = Parse("lastpass", strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrFormat)
+}
+
+func TestConvert(t *testing.T) {
+	res := &Result{
+		Entries: []Entry{
+			{Folder: []string{"Work Stuff"}, Title: "GitHub [main]", Fields: []Field{{Name: "password", Value: "a"}},
+				Attachments: []Attachment{{Name: "deploy key.pem", Data: []byte("k")}}},
+			{Folder: []string{"Work Stuff"}, Title: "GitHub (main)", Fields: []Field{{Name: "password", Value: "b"}}},
+			{Folder: []string{"Personal"}, Title: "Bank", Fields: []Field{{Name: "pin", Value: "1"}}},
+			{Title: "scan", Attachments: []Attachment{
+				{Name: "passport.pdf", Data: []byte("pdf")},
+				{Name: "huge.bin", Data: make([]byte, maxAttachmentSize+1)},
+			}},
+			{Title: "empty"},
+		},
+		Unconverted: []Unconverted{{Item: "x", Reason: "parser"}},
+	}
+	rules := []Rule{{From: "Work-Stuff", To: "team/dev"}, {From: "", To: "personal"}}
+
+	secrets, unconverted := Convert(res, FormatKeePass, rules)
+	paths := make([]string, 0, len(secrets))
+	for _, s := range secrets {
+		paths = append(paths, s.Path)
+	}
+	assert.Equal(t, []string{
+		"team/dev/GitHub-main",
+		"team/dev/GitHub-main/deploy-key.pem",
+		"team/dev/GitHub-main-2",
+		"personal/Personal/Bank",
+		"personal/scan/passport.pdf",
+	}, paths)
+
+	var value map[string]string
+	require.NoError(t, json.Unmarshal(secrets[2].Versions[0].Data, &value))
+	assert.Equal(t, map[string]string{"password": "b"}, value)
+	assert.Equal(t, "Imported from keepass: Work Stuff / GitHub (main)", secrets[2].Description)
+	assert.Equal(t, "deploy key.pem", secrets[1].Versions[0].FileName)
+
+	require.Len(t, unconverted, 3)
+	assert.Equal(t, "parser", unconverted[0].Reason)
+	assert.Equal(t, "scan / huge.bin", unconverted[1].Item)
+	assert.Equal(t, "entry holds no data", unconverted[2].Reason)
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := ParseRule("/Work/=team/ops/")
+	require.NoError(t, err)
+	assert.Equal(t, Rule{From: "Work", To: "team/ops"}, rule)
+
+	mapped, ok := rule.apply("Work")
+	assert.True(t, ok)
+	assert.Equal(t, "team/ops", mapped)
+	_, ok = rule.apply("Workshop/x")
+	assert.False(t, ok)
+
+	strip := Rule{From: "Imported"}
+	mapped, _ = strip.apply("Imported/db")
+	assert.Equal(t, "db", mapped)
+
+	_, err = ParseRule("no-equals")
+	assert.Error(t, err)
+}
@@ -0,0 +1,186 @@
+package importer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// KeePass 2 XML export, as written by KeePass and KeePassXC.
+type keepassFile struct {
+	Meta struct {
+		RecycleBinUUID string          `xml:"RecycleBinUUID"`
+		Binaries       []keepassBinary `xml:"Binaries>Binary"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []keepassGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+type keepassBinary struct {
+	ID         string `xml:"ID,attr"`
+	Compressed bool   `xml:"Compressed,attr"`
+	Data       string `xml:",chardata"`
+}
+
+type keepassGroup struct {
+	UUID    string         `xml:"UUID"`
+	Name    string         `xml:"Name"`
+	Groups  []keepassGroup `xml:"Group"`
+	Entries []keepassEntry `xml:"Entry"`
+}
+
+type keepassEntry struct {
+	Strings []struct {
+		Key   string `xml:"Key"`
+		Value struct {
+			Text      string `xml:",chardata"`
+			Protected bool   `xml:"Protected,attr"`
+		} `xml:"Value"`
+	} `xml:"String"`
+	Binaries []struct {
+		Key   string `xml:"Key"`
+		Value struct {
+			Ref  string `xml:"Ref,attr"`
+			Text string `xml:",chardata"`
+		} `xml:"Value"`
+	} `xml:"Binary"`
+	Times struct {
+		Expires    bool   `xml:"Expires"`
+		ExpiryTime string `xml:"ExpiryTime"`
+	} `xml:"Times"`
+}
+
+// keepassFields maps KeePass's standard strings and the keys TOTP plugins use
+// onto the common field names.
+var keepassFields = map[string]string{
+	"UserName":              FieldUsername,
+	"Password":              FieldPassword,
+	"URL":                   FieldURL,
+	"Notes":                 FieldNotes,
+	"otp":                   FieldTOTP,
+	"TimeOtp-Secret-Base32": FieldTOTP,
+	"TOTP Seed":             FieldTOTP,
+}
+
+func parseKeePass(r io.Reader) (*Result, error) {
+	var file keepassFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse KeePass XML: %w", err)
+	}
+	if len(file.Root.Groups) == 0 {
+		return nil, fmt.Errorf("failed to parse KeePass XML: no root group")
+	}
+
+	binaries := make(map[string][]byte, len(file.Meta.Binaries))
+	res := &Result{}
+	for _, b := range file.Meta.Binaries {
+		data, err := keepassBinaryData(b.Data, b.Compressed)
+		if err != nil {
+			res.Unconverted = append(res.Unconverted, Unconverted{Item: "binary " + b.ID, Reason: err.Error()})
+			continue
+		}
+		binaries[b.ID] = data
+	}
+
+	// The root group is the database itself and is not part of any path.
+	for _, root := range file.Root.Groups {
+		res.addKeePassGroup(root, nil, file.Meta.RecycleBinUUID, binaries)
+	}
+	return res, nil
+}
+
+func (res *Result) addKeePassGroup(g keepassGroup, folder []string, recycleBin string, binaries map[string][]byte) {
+	if recycleBin != "" && g.UUID == recycleBin {
+		for _, e := range g.Entries {
+			res.Unconverted = append(res.Unconverted, Unconverted{
+				Item:   keepassTitle(e, folder),
+				Reason: "entry is in the recycle bin",
+			})
+		}
+		return
+	}
+
+	for _, ke := range g.Entries {
+		e := Entry{Folder: folder}
+		for _, s := range ke.Strings {
+			if s.Key == "Title" {
+				e.Title = s.Value.Text
+				continue
+			}
+			if s.Value.Protected {
+				// Protected values in the XML inside a database are encrypted
+				// with a stream key that an XML export doesn't carry.
+				res.Unconverted = append(res.Unconverted, Unconverted{
+					Item:   keepassTitle(ke, folder) + " / " + s.Key,
+					Reason: "value is encrypted; use the XML export of KeePass or KeePassXC",
+				})
+				continue
+			}
+			name, ok := keepassFields[s.Key]
+			if !ok {
+				name = s.Key
+			}
+			e.Set(name, s.Value.Text)
+		}
+		for _, b := range ke.Binaries {
+			data, ok := binaries[b.Value.Ref]
+			if b.Value.Ref == "" {
+				var err error
+				data, err = keepassBinaryData(b.Value.Text, false)
+				ok = err == nil
+			}
+			if !ok {
+				res.Unconverted = append(res.Unconverted, Unconverted{
+					Item:   keepassTitle(ke, folder) + " / " + b.Key,
+					Reason: "attachment data is missing",
+				})
+				continue
+			}
+			e.Attachments = append(e.Attachments, Attachment{Name: b.Key, Data: data})
+		}
+		if ke.Times.Expires {
+			if t, err := time.Parse(time.RFC3339, ke.Times.ExpiryTime); err == nil {
+				e.ExpiresAt = t
+			}
+		}
+		res.Entries = append(res.Entries, e)
+	}
+
+	for _, sub := range g.Groups {
+		res.addKeePassGroup(sub, append(append([]string{}, folder...), sub.Name), recycleBin, binaries)
+	}
+}
+
+func keepassTitle(e keepassEntry, folder []string) string {
+	for _, s := range e.Strings {
+		if s.Key == "Title" {
+			return strings.Join(append(append([]string{}, folder...), s.Value.Text), " / ")
+		}
+	}
+	return strings.Join(append(append([]string{}, folder...), untitled), " / ")
+}
+
+func keepassBinaryData(text string, compressed bool) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(text))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	if !compressed {
+		return data, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid gzip: %w", err)
+	}
+	data, err = io.ReadAll(io.LimitReader(zr, maxAttachmentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("invalid gzip: %w", err)
+	}
+	return data, nil
+}
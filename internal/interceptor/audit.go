@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"keeper/internal/dto"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	requestIDHeader = "x-request-id"
+	requestIDBytes  = 16
+)
+
+// auditStarted is the result of the record written before a request runs.
+const auditStarted = "Started"
+
+// AuditInterceptor writes two audit records per request, linked by the request
+// id. It runs first in the chain, so failed and unauthenticated attempts are
+// recorded too. The first record is written before anything else runs, and
+// the request fails without running if it can't be stored. The second one
+// records the caller, the result and the version once the request is done.
+func AuditInterceptor(auditService service.AuditService) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		requestID := incomingRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+		record := dto.AuditRecord{
+			Method:    info.FullMethod,
+			Result:    auditStarted,
+			RequestID: requestID,
+		}
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			record.ClientAddr = p.Addr.String()
+		}
+		if msg, ok := req.(interface{ GetPath() string }); ok {
+			record.Path = msg.GetPath()
+		}
+		if msg, ok := req.(interface{ GetVersion() int64 }); ok {
+			record.Version = msg.GetVersion()
+		}
+		if err := auditService.Record(context.WithoutCancel(ctx), record); err != nil {
+			return nil, status.Error(codes.Internal, "failed to write audit record")
+		}
+
+		ctx = utils.TrackCaller(ctx)
+		resp, err := handler(ctx, req)
+
+		record.Result = status.Code(err).String()
+		record.UserID = utils.TrackedCaller(ctx)
+		if msg, ok := resp.(interface{ GetVersion() int64 }); ok && err == nil {
+			record.Version = msg.GetVersion()
+		}
+		// The request has run, so failing it now would only hide its result.
+		// The first record stands for it, and the audit service logs the loss.
+		_ = auditService.Record(context.WithoutCancel(ctx), record)
+		return resp, err
+	}
+}
+
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDHeader); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	buf := make([]byte, requestIDBytes)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
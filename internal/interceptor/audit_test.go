@@ -0,0 +1,81 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	utils "keeper/internal/util"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingAudit struct {
+	records []dto.AuditRecord
+	err     error
+}
+
+func (a *recordingAudit) Record(_ context.Context, record dto.AuditRecord) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.records = append(a.records, record)
+	return nil
+}
+
+func (a *recordingAudit) Verify(context.Context) (int64, error) { return 0, nil }
+
+func (a *recordingAudit) Recent(context.Context, int) ([]entity.AuditEvent, error) { return nil, nil }
+
+func (a *recordingAudit) After(context.Context, int64, int) ([]entity.AuditEvent, error) {
+	return nil, nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/m"}
+
+	t.Run("records the attempt before and the result after", func(t *testing.T) {
+		audit := &recordingAudit{}
+		auth := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+			return h(utils.SetUserID(ctx, 7), req)
+		}
+		chain := Chain(AuditInterceptor(audit), auth)
+		_, err := chain(t.Context(), "req", info, func(context.Context, any) (any, error) {
+			require.Len(t, audit.records, 1, "the attempt is on record before the handler runs")
+			return nil, status.Error(codes.PermissionDenied, "denied")
+		})
+		require.Error(t, err)
+
+		require.Len(t, audit.records, 2)
+		assert.Equal(t, auditStarted, audit.records[0].Result)
+		assert.Zero(t, audit.records[0].UserID)
+		assert.Equal(t, codes.PermissionDenied.String(), audit.records[1].Result)
+		assert.Equal(t, int64(7), audit.records[1].UserID)
+		assert.Equal(t, audit.records[0].RequestID, audit.records[1].RequestID)
+	})
+
+	t.Run("unauthenticated attempts are recorded", func(t *testing.T) {
+		audit := &recordingAudit{}
+		auth := func(context.Context, any, *grpc.UnaryServerInfo, grpc.UnaryHandler) (any, error) {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		_, err := Chain(AuditInterceptor(audit), auth)(t.Context(), "req", info, nil)
+		require.Error(t, err)
+		require.Len(t, audit.records, 2)
+		assert.Equal(t, codes.Unauthenticated.String(), audit.records[1].Result)
+	})
+
+	t.Run("an audit failure blocks the request", func(t *testing.T) {
+		audit := &recordingAudit{err: errors.New("no sink")}
+		_, err := AuditInterceptor(audit)(t.Context(), "req", info, func(context.Context, any) (any, error) {
+			t.Fatal("the handler must not run")
+			return nil, nil
+		})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
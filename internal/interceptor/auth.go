@@ -2,20 +2,41 @@ package interceptor
 
 import (
 	"context"
+	"errors"
 	"keeper/internal/service"
 	utils "keeper/internal/util"
+	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-func AuthInterceptor(jwtService service.JwtService) grpc.UnaryServerInterceptor {
-	skipAuth := map[string]bool{
-		"/keeper.go.grpc.v1.AuthService/Login":    true,
-		"/keeper.go.grpc.v1.AuthService/Register": true,
-	}
+const (
+	authorizationHeader = "authorization"
+	bearerScheme        = "bearer "
+)
+
+var skipAuth = map[string]bool{
+	"/keeper.go.grpc.v1.AuthService/Login":                true,
+	"/keeper.go.grpc.v1.AuthService/Register":             true,
+	"/keeper.go.grpc.v1.AuthService/RefreshToken":         true,
+	"/keeper.go.grpc.v1.AuthService/VerifyTwoFactor":      true,
+	"/keeper.go.grpc.v1.AuthService/LoginWithCertificate": true,
+	"/keeper.go.grpc.v1.WrapService/Unwrap":               true,
+}
 
+// AuthInterceptor validates the JWT and checks that its session in
+// access_tokens has not been revoked. The token is taken from the
+// "authorization: Bearer" metadata; the deprecated token request field is
+// still accepted when the header is missing. Service account API keys are
+// accepted in place of a JWT; their scope is enforced by PolicyInterceptor.
+func AuthInterceptor(
+	jwtService service.JwtService,
+	sessionService service.SessionService,
+	apiKeyService service.ServiceAccountService,
+) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -25,20 +46,135 @@ func AuthInterceptor(jwtService service.JwtService) grpc.UnaryServerInterceptor
 		if skipAuth[info.FullMethod] {
 			return handler(ctx, req)
 		}
-		msg, ok := req.(interface {
-			GetToken() string
-		})
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "request does not contain a token")
+
+		token, err := bearerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if token == "" {
+			if msg, ok := req.(interface{ GetToken() string }); ok {
+				token = msg.GetToken()
+			}
 		}
 
-		userID, err := jwtService.GetUserID(msg.GetToken())
+		if strings.HasPrefix(token, service.APIKeyPrefix) {
+			ctx, err = authenticateAPIKey(ctx, apiKeyService, token)
+		} else {
+			ctx, err = authenticate(ctx, jwtService, sessionService, token)
+		}
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		ctx = utils.SetUserID(ctx, userID)
+// AuthStreamInterceptor is AuthInterceptor for streaming calls. Streams have
+// no request field to fall back to, so the metadata header is required. API
+// keys are not accepted: their scope is only checked for unary DataService
+// calls.
+func AuthStreamInterceptor(jwtService service.JwtService, sessionService service.SessionService) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if skipAuth[info.FullMethod] {
+			return handler(srv, ss)
+		}
 
-		return handler(ctx, req)
+		token, err := bearerToken(ss.Context())
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(token, service.APIKeyPrefix) {
+			return status.Error(codes.PermissionDenied, "api keys can't be used for streaming calls")
+		}
+		ctx, err := authenticate(ss.Context(), jwtService, sessionService, token)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
 	}
 }
+
+// authServerStream carries the authenticated context to the stream handler.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// bearerToken returns an empty string when the authorization header is absent
+// and an error when it is present but not a bearer token.
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", nil
+	}
+	if len(values) > 1 {
+		return "", status.Error(codes.Unauthenticated, "multiple authorization headers")
+	}
+	value := values[0]
+	if len(value) < len(bearerScheme) || !strings.EqualFold(value[:len(bearerScheme)], bearerScheme) {
+		return "", status.Error(codes.Unauthenticated, "authorization header must use the Bearer scheme")
+	}
+	return strings.TrimSpace(value[len(bearerScheme):]), nil
+}
+
+func authenticate(
+	ctx context.Context,
+	jwtService service.JwtService,
+	sessionService service.SessionService,
+	token string,
+) (context.Context, error) {
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "request does not contain a token")
+	}
+
+	userID, err := jwtService.GetUserID(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	session, err := sessionService.Authenticate(ctx, token, utils.ClientIP(ctx))
+	if err != nil {
+		if errors.Is(err, service.ErrSessionInactive) {
+			return nil, status.Error(codes.Unauthenticated, service.ErrSessionInactive.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to check session: %v", err)
+	}
+	if session.UserID != userID {
+		return nil, status.Error(codes.Unauthenticated, "token does not match its session")
+	}
+
+	ctx = utils.SetUserID(ctx, userID)
+	ctx = utils.SetToken(ctx, token)
+	return ctx, nil
+}
+
+func authenticateAPIKey(
+	ctx context.Context,
+	apiKeyService service.ServiceAccountService,
+	token string,
+) (context.Context, error) {
+	key, err := apiKeyService.Authenticate(ctx, token, utils.ClientIP(ctx))
+	if errors.Is(err, service.ErrInvalidAPIKey) {
+		return nil, status.Error(codes.Unauthenticated, service.ErrInvalidAPIKey.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check api key: %v", err)
+	}
+
+	ctx = utils.SetUserID(ctx, key.UserID)
+	ctx = utils.SetAPIKey(ctx, key)
+	return ctx, nil
+}
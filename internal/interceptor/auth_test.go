@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      metadata.MD
+		want    string
+		wantErr bool
+	}{
+		{name: "no metadata", md: nil, want: ""},
+		{name: "no header", md: metadata.Pairs("x-other", "1"), want: ""},
+		{name: "bearer", md: metadata.Pairs("authorization", "Bearer abc.def"), want: "abc.def"},
+		{name: "lower case scheme", md: metadata.Pairs("authorization", "bearer abc"), want: "abc"},
+		{name: "basic scheme", md: metadata.Pairs("authorization", "Basic dXNlcjpwYXNz"), wantErr: true},
+		{name: "bare token", md: metadata.Pairs("authorization", "abc"), wantErr: true},
+		{
+			name:    "two headers",
+			md:      metadata.Pairs("authorization", "Bearer a", "authorization", "Bearer b"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			got, err := bearerToken(ctx)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
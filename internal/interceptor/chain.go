@@ -0,0 +1,29 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Chain combines interceptors into one that runs them in order, as
+// grpc.ChainUnaryInterceptor does. The HTTP API calls gRPC handlers through
+// the same chain, so it audits, authenticates and enforces policies exactly
+// like the gRPC server.
+func Chain(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			current, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return current(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
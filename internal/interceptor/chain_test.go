@@ -0,0 +1,30 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+			calls = append(calls, name+" "+info.FullMethod)
+			return h(ctx, req)
+		}
+	}
+
+	chain := Chain(record("audit"), record("auth"), record("policy"))
+	resp, err := chain(t.Context(), "req", &grpc.UnaryServerInfo{FullMethod: "/m"},
+		func(_ context.Context, req any) (any, error) {
+			calls = append(calls, "handler")
+			return req, nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, "req", resp)
+	assert.Equal(t, []string{"audit /m", "auth /m", "policy /m", "handler"}, calls)
+}
@@ -0,0 +1,220 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	utils "keeper/internal/util"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const dataServicePrefix = "/keeper.go.grpc.v1.DataService/"
+
+// methodCapabilities maps DataService methods and Wrap to the policy
+// capability they require. SaveSecret is resolved to create or update at
+// request time.
+var methodCapabilities = map[string]string{
+	dataServicePrefix + "GetSecret":          entity.CapabilityRead,
+	dataServicePrefix + "ListSecrets":        entity.CapabilityList,
+	dataServicePrefix + "DeleteSecret":       entity.CapabilityDelete,
+	dataServicePrefix + "UndeleteSecret":     entity.CapabilityUpdate,
+	dataServicePrefix + "DestroySecret":      entity.CapabilityDestroy,
+	dataServicePrefix + "DeleteMetadata":     entity.CapabilityDestroy,
+	dataServicePrefix + "ListSecretVersions": entity.CapabilityRead,
+	dataServicePrefix + "GetChangesSince":    entity.CapabilityRead,
+	wrapMethod:                               entity.CapabilityRead,
+}
+
+const saveSecretMethod = dataServicePrefix + "SaveSecret"
+
+// listMethod returns many paths, so policies are evaluated on each returned
+// path and the ones the caller may not list are dropped from the response. An
+// API key is checked for the list capability up front.
+const listMethod = dataServicePrefix + "ListSecrets"
+
+// changesMethod returns the whole vault, so it is evaluated on the empty
+// path, which only policies covering every path allow, and path-scoped API
+// keys can't call it at all.
+const changesMethod = dataServicePrefix + "GetChangesSince"
+
+// wrapMethod copies a secret into a share link, which needs read on its path.
+const wrapMethod = "/keeper.go.grpc.v1.WrapService/Wrap"
+
+const transitServicePrefix = "/keeper.go.grpc.v1.TransitService/"
+
+// transitCapabilities is what an API key needs on the transit path of a key
+// for key management; every other transit operation needs read.
+var transitCapabilities = map[string]string{
+	transitServicePrefix + "CreateKey": entity.CapabilityCreate,
+	transitServicePrefix + "RotateKey": entity.CapabilityUpdate,
+	transitServicePrefix + "ListKeys":  entity.CapabilityList,
+}
+
+// PolicyInterceptor enforces ACL policies on DataService calls. It must run
+// after AuthInterceptor, which puts the user id and token into the context.
+// Requests made with an API key are limited to these DataService methods and
+// transit operations, and to the key's own scope on top of the policies.
+func PolicyInterceptor(policyService service.PolicyService, vaultService service.VaultService) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		apiKey, withAPIKey := utils.GetAPIKey(ctx)
+		if strings.HasPrefix(info.FullMethod, transitServicePrefix) {
+			// Transit keys are not vault paths: ownership and team roles are
+			// checked by the service, policies don't apply.
+			if withAPIKey {
+				if err := checkTransitScope(apiKey, info.FullMethod, req); err != nil {
+					return nil, status.Error(codes.PermissionDenied, err.Error())
+				}
+			}
+			return handler(ctx, req)
+		}
+
+		capability, ok := methodCapabilities[info.FullMethod]
+		if !ok && info.FullMethod != saveSecretMethod {
+			if withAPIKey {
+				return nil, status.Error(codes.PermissionDenied, "api keys can only be used for secret operations")
+			}
+			return handler(ctx, req)
+		}
+
+		userID, err := utils.GetUserID(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+
+		var path, owner string
+		if msg, ok := req.(interface{ GetPath() string }); ok {
+			path = msg.GetPath()
+		}
+		if msg, ok := req.(interface{ GetOwner() string }); ok {
+			owner = msg.GetOwner()
+		}
+
+		if info.FullMethod == changesMethod && withAPIKey {
+			return nil, status.Error(codes.PermissionDenied, "api keys can't sync the whole vault")
+		}
+
+		if info.FullMethod == wrapMethod && path == "" && !withAPIKey {
+			// An ad-hoc value is not vault data, there is no path to check.
+			return handler(ctx, req)
+		}
+
+		if info.FullMethod == saveSecretMethod {
+			capability = entity.CapabilityCreate
+			// Authorization errors are reported by the handler itself.
+			if exists, err := vaultService.SecretExists(ctx, userID, owner, path); err == nil && exists {
+				capability = entity.CapabilityUpdate
+			}
+		}
+
+		if withAPIKey {
+			scopeErr := service.CheckAPIKeyScope(apiKey, path, capability)
+			if info.FullMethod == listMethod || (info.FullMethod == wrapMethod && path == "") {
+				// Neither names a vault path.
+				scopeErr = service.CheckAPIKeyCapability(apiKey, capability)
+			}
+			if scopeErr != nil {
+				return nil, status.Error(codes.PermissionDenied, scopeErr.Error())
+			}
+		}
+
+		if info.FullMethod == listMethod {
+			return listWithPolicies(ctx, req, handler, policyService, userID, apiKey, withAPIKey)
+		}
+
+		err = policyService.Evaluate(ctx, userID, utils.GetToken(ctx), path, capability)
+		if errors.Is(err, service.ErrPolicyDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to evaluate policies: %v", err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// listWithPolicies runs ListSecrets and keeps the paths the caller may list:
+// policies are evaluated on every returned path rather than on the listing
+// as a whole, and an API key also drops the paths outside its prefixes.
+func listWithPolicies(
+	ctx context.Context,
+	req interface{},
+	handler grpc.UnaryHandler,
+	policyService service.PolicyService,
+	userID int64,
+	apiKey entity.APIKey,
+	withAPIKey bool,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := resp.(*pbModel.ListSecretPathsResponse)
+	if !ok {
+		return resp, nil
+	}
+	paths := slices.Clone(list.GetPaths())
+	for _, secret := range list.GetShared() {
+		paths = append(paths, secret.GetPath())
+	}
+	allowed, err := policyService.Filter(ctx, userID, utils.GetToken(ctx), paths, entity.CapabilityList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to evaluate policies: %v", err)
+	}
+	listable := make(map[string]bool, len(allowed))
+	for _, path := range allowed {
+		listable[path] = !withAPIKey || service.APIKeyCovers(apiKey, path)
+	}
+	return filterListing(list, func(path string) bool { return listable[path] }), nil
+}
+
+// filterListing drops the paths allowed rejects from a ListSecrets response.
+func filterListing(resp interface{}, allowed func(path string) bool) interface{} {
+	list, ok := resp.(*pbModel.ListSecretPathsResponse)
+	if !ok {
+		return resp
+	}
+	paths := make([]string, 0, len(list.GetPaths()))
+	for _, path := range list.GetPaths() {
+		if allowed(path) {
+			paths = append(paths, path)
+		}
+	}
+	shared := make([]*pbModel.SharedSecretPath, 0, len(list.GetShared()))
+	for _, secret := range list.GetShared() {
+		if allowed(secret.GetPath()) {
+			shared = append(shared, secret)
+		}
+	}
+	list.SetPaths(paths)
+	list.SetShared(shared)
+	return list
+}
+
+func checkTransitScope(apiKey entity.APIKey, method string, req interface{}) error {
+	capability, ok := transitCapabilities[method]
+	if !ok {
+		capability = entity.CapabilityRead
+	}
+	path := service.TransitPathPrefix
+	if msg, ok := req.(interface {
+		GetName() string
+		GetTeam() string
+	}); ok {
+		path = service.TransitKeyPath(dto.TransitKeyRef{Name: msg.GetName(), Team: msg.GetTeam()})
+	}
+	return service.CheckAPIKeyScope(apiKey, path, capability)
+}
@@ -0,0 +1,45 @@
+package interceptor
+
+import (
+	"keeper/internal/entity"
+	pbModel "keeper/internal/proto/v1/model"
+	"keeper/internal/service"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTransitScope(t *testing.T) {
+	key := entity.APIKey{
+		PathPrefixes: []string{"transit/team/payments/"},
+		Capabilities: []string{entity.CapabilityRead},
+	}
+	encrypt := &pbModel.EncryptRequest{}
+	encrypt.SetName("cards")
+	encrypt.SetTeam("payments")
+	require.NoError(t, checkTransitScope(key, transitServicePrefix+"Encrypt", encrypt))
+
+	encrypt.SetTeam("")
+	require.ErrorIs(t, checkTransitScope(key, transitServicePrefix+"Encrypt", encrypt), service.ErrAPIKeyScope)
+
+	rotate := &pbModel.RotateTransitKeyRequest{}
+	rotate.SetName("cards")
+	rotate.SetTeam("payments")
+	require.ErrorIs(t, checkTransitScope(key, transitServicePrefix+"RotateKey", rotate), service.ErrAPIKeyScope)
+}
+
+func TestFilterListing(t *testing.T) {
+	key := entity.APIKey{PathPrefixes: []string{"ci/"}, Capabilities: []string{entity.CapabilityList}}
+	shared := &pbModel.SharedSecretPath{}
+	shared.SetOwner("alice")
+	shared.SetPath("ci/shared")
+	resp := &pbModel.ListSecretPathsResponse{}
+	resp.SetPaths([]string{"ci/deploy-token", "ci2/token", "prod/db"})
+	resp.SetShared([]*pbModel.SharedSecretPath{shared})
+
+	covered := func(path string) bool { return service.APIKeyCovers(key, path) }
+	got, ok := filterListing(resp, covered).(*pbModel.ListSecretPathsResponse)
+	require.True(t, ok)
+	require.Equal(t, []string{"ci/deploy-token"}, got.GetPaths())
+	require.Len(t, got.GetShared(), 1)
+}
@@ -0,0 +1,192 @@
+// Package kpx reads and writes .kpx files, the portable export of a user's
+// vault.
+//
+// A .kpx file is:
+//
+//	magic        8 bytes   "KEEPERPX"
+//	version      1 byte    1
+//	memory       4 bytes   Argon2id memory in KiB, big endian
+//	iterations   4 bytes   Argon2id passes, big endian
+//	parallelism  1 byte    Argon2id lanes
+//	salt        16 bytes
+//	body                   security.NewStreamWriter stream
+//
+// The body key is Argon2id(passphrase, salt) with the parameters above; the
+// stream seals 64 KiB chunks with AES-256-GCM. Decrypted, the body is JSON
+// lines: a Header object followed by one Secret object per line. Binary
+// values are base64 strings, times are RFC 3339.
+package kpx
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"keeper/internal/security"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	FormatVersion = 1
+
+	magic      = "KEEPERPX"
+	headerSize = len(magic) + 1 + 4 + 4 + 1 + saltSize
+	saltSize   = 16
+	keySize    = 32
+
+	defaultMemory      = 64 * 1024
+	defaultIterations  = 3
+	defaultParallelism = 4
+	// Limits on parameters read from a file, so a crafted one can't make
+	// the reader allocate without bound.
+	maxMemory     = 1024 * 1024
+	maxIterations = 64
+)
+
+var ErrPassphrase = errors.New("wrong passphrase or damaged file")
+
+type Header struct {
+	Format     int       `json:"format"`
+	ExportedAt time.Time `json:"exported_at"`
+	Source     string    `json:"source,omitempty"`
+}
+
+// Secret is one path with the versions that were exported, oldest first.
+type Secret struct {
+	Path        string    `json:"path"`
+	Description string    `json:"description,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Versions    []Version `json:"versions"`
+}
+
+// Version holds the value as the user wrote it: a JSON document, or the
+// contents of a file when FileName is set.
+type Version struct {
+	Version   int64     `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	FileName  string    `json:"file_name,omitempty"`
+	Data      []byte    `json:"data"`
+}
+
+type Writer struct {
+	body io.WriteCloser
+	enc  *json.Encoder
+}
+
+// NewWriter writes the file header and h; every secret is then added with
+// Write. Close must be called to seal the file.
+func NewWriter(w io.Writer, passphrase string, h Header) (*Writer, error) {
+	if passphrase == "" {
+		return nil, errors.New("passphrase is required")
+	}
+	header := make([]byte, 0, headerSize)
+	header = append(header, magic...)
+	header = append(header, FormatVersion)
+	header = binary.BigEndian.AppendUint32(header, defaultMemory)
+	header = binary.BigEndian.AppendUint32(header, defaultIterations)
+	header = append(header, defaultParallelism)
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	header = append(header, salt...)
+	if _, err := w.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(passphrase), salt, defaultIterations, defaultMemory, defaultParallelism, keySize)
+	body, err := security.NewStreamWriter(w, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start encryption: %w", err)
+	}
+	kw := &Writer{body: body, enc: json.NewEncoder(body)}
+	h.Format = FormatVersion
+	if err := kw.enc.Encode(h); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	return kw, nil
+}
+
+func (w *Writer) Write(s *Secret) error {
+	if err := w.enc.Encode(s); err != nil {
+		return fmt.Errorf("failed to write %s: %w", s.Path, err)
+	}
+	return nil
+}
+
+func (w *Writer) Close() error {
+	if err := w.body.Close(); err != nil {
+		return fmt.Errorf("failed to seal file: %w", err)
+	}
+	return nil
+}
+
+type Reader struct {
+	dec    *json.Decoder
+	header Header
+}
+
+// NewReader derives the key from passphrase and reads the header; a wrong
+// passphrase fails here rather than on the first secret.
+func NewReader(r io.Reader, passphrase string) (*Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	if !bytes.Equal(header[:len(magic)], []byte(magic)) {
+		return nil, errors.New("not a .kpx file")
+	}
+	rest := header[len(magic):]
+	if rest[0] != FormatVersion {
+		return nil, fmt.Errorf("unsupported .kpx version %d", rest[0])
+	}
+	memory := binary.BigEndian.Uint32(rest[1:5])
+	iterations := binary.BigEndian.Uint32(rest[5:9])
+	parallelism := rest[9]
+	salt := rest[10:]
+	if memory == 0 || memory > maxMemory || iterations == 0 || iterations > maxIterations || parallelism == 0 {
+		return nil, fmt.Errorf("unsupported key derivation parameters m=%d,t=%d,p=%d", memory, iterations, parallelism)
+	}
+
+	key := argon2.IDKey([]byte(passphrase), salt, iterations, memory, parallelism, keySize)
+	body, err := security.NewStreamReader(r, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	kr := &Reader{dec: json.NewDecoder(body)}
+	if err := kr.dec.Decode(&kr.header); err != nil {
+		if errors.Is(err, security.ErrStreamCorrupt) {
+			return nil, ErrPassphrase
+		}
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	if kr.header.Format != FormatVersion {
+		return nil, fmt.Errorf("unsupported .kpx format %d", kr.header.Format)
+	}
+	return kr, nil
+}
+
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next secret, or io.EOF after the last one once the whole
+// file has been authenticated.
+func (r *Reader) Next() (*Secret, error) {
+	var s Secret
+	if err := r.dec.Decode(&s); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		if errors.Is(err, security.ErrStreamCorrupt) {
+			return nil, ErrPassphrase
+		}
+		return nil, fmt.Errorf("failed to read secret: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,89 @@
+package kpx
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSecrets() []*Secret {
+	created := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	return []*Secret{
+		{
+			Path:        "db/password",
+			Description: "prod database",
+			ExpiresAt:   created.Add(24 * time.Hour),
+			Versions: []Version{
+				{Version: 1, CreatedAt: created, Data: []byte(`{"password":"old"}`)},
+				{Version: 2, CreatedAt: created.Add(time.Hour), Data: []byte(`{"password":"new"}`)},
+			},
+		},
+		{
+			Path:     "keys/id_ed25519",
+			Versions: []Version{{Version: 4, CreatedAt: created, FileName: "id_ed25519", Data: []byte{0, 1, 2, 255}}},
+		},
+	}
+}
+
+func writeFile(t *testing.T, passphrase string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, passphrase, Header{ExportedAt: time.Now().UTC(), Source: "keeper.example.com"})
+	require.NoError(t, err)
+	for _, s := range testSecrets() {
+		require.NoError(t, w.Write(s))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := writeFile(t, "correct horse")
+
+	r, err := NewReader(bytes.NewReader(data), "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, FormatVersion, r.Header().Format)
+	assert.Equal(t, "keeper.example.com", r.Header().Source)
+
+	var got []*Secret
+	for {
+		s, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, s)
+	}
+	assert.Equal(t, testSecrets(), got)
+}
+
+func TestWrongPassphrase(t *testing.T) {
+	data := writeFile(t, "correct horse")
+	_, err := NewReader(bytes.NewReader(data), "battery staple")
+	assert.ErrorIs(t, err, ErrPassphrase)
+}
+
+func TestTruncated(t *testing.T) {
+	data := writeFile(t, "correct horse")
+	// The whole body fits in one sealed chunk, so the header can't be read.
+	_, err := NewReader(bytes.NewReader(data[:len(data)-3]), "correct horse")
+	assert.ErrorIs(t, err, ErrPassphrase)
+}
+
+func TestRejectsOtherFiles(t *testing.T) {
+	_, err := NewReader(bytes.NewReader([]byte("not a kpx file at all, just some text")), "x")
+	require.Error(t, err)
+
+	data := writeFile(t, "correct horse")
+	data[len(magic)+1] = 0xff // memory far above maxMemory
+	_, err = NewReader(bytes.NewReader(data), "correct horse")
+	assert.ErrorContains(t, err, "key derivation parameters")
+
+	_, err = NewWriter(io.Discard, "", Header{})
+	assert.Error(t, err)
+}
@@ -36,6 +36,86 @@ func (m *MockAuthServiceClient) EXPECT() *MockAuthServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServiceClient) ChangePassword(arg0 context.Context, arg1 *model.ChangePasswordRequest, arg2 ...grpc.CallOption) (*model.ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*model.ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceClientMockRecorder) ChangePassword(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ChangePassword), varargs...)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockAuthServiceClient) ConfirmTOTP(arg0 context.Context, arg1 *model.ConfirmTOTPRequest, arg2 ...grpc.CallOption) (*model.ConfirmTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmTOTP", varargs...)
+	ret0, _ := ret[0].(*model.ConfirmTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockAuthServiceClientMockRecorder) ConfirmTOTP(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockAuthServiceClient)(nil).ConfirmTOTP), varargs...)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockAuthServiceClient) EnrollTOTP(arg0 context.Context, arg1 *model.EnrollTOTPRequest, arg2 ...grpc.CallOption) (*model.EnrollTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnrollTOTP", varargs...)
+	ret0, _ := ret[0].(*model.EnrollTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockAuthServiceClientMockRecorder) EnrollTOTP(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockAuthServiceClient)(nil).EnrollTOTP), varargs...)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthServiceClient) ListSessions(arg0 context.Context, arg1 *model.ListSessionsRequest, arg2 ...grpc.CallOption) (*model.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*model.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServiceClientMockRecorder) ListSessions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthServiceClient)(nil).ListSessions), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockAuthServiceClient) Login(arg0 context.Context, arg1 *model.LoginRequest, arg2 ...grpc.CallOption) (*model.LoginResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +136,66 @@ func (mr *MockAuthServiceClientMockRecorder) Login(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthServiceClient)(nil).Login), varargs...)
 }
 
+// LoginWithCertificate mocks base method.
+func (m *MockAuthServiceClient) LoginWithCertificate(arg0 context.Context, arg1 *model.CertificateLoginRequest, arg2 ...grpc.CallOption) (*model.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LoginWithCertificate", varargs...)
+	ret0, _ := ret[0].(*model.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginWithCertificate indicates an expected call of LoginWithCertificate.
+func (mr *MockAuthServiceClientMockRecorder) LoginWithCertificate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithCertificate", reflect.TypeOf((*MockAuthServiceClient)(nil).LoginWithCertificate), varargs...)
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceClient) Logout(arg0 context.Context, arg1 *model.LogoutRequest, arg2 ...grpc.CallOption) (*model.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Logout", varargs...)
+	ret0, _ := ret[0].(*model.SessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceClientMockRecorder) Logout(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthServiceClient) RefreshToken(arg0 context.Context, arg1 *model.RefreshTokenRequest, arg2 ...grpc.CallOption) (*model.RefreshTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshToken", varargs...)
+	ret0, _ := ret[0].(*model.RefreshTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthServiceClientMockRecorder) RefreshToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RefreshToken), varargs...)
+}
+
 // Register mocks base method.
 func (m *MockAuthServiceClient) Register(arg0 context.Context, arg1 *model.RegisterRequest, arg2 ...grpc.CallOption) (*model.RegisterResponse, error) {
 	m.ctrl.T.Helper()
@@ -75,3 +215,43 @@ func (mr *MockAuthServiceClientMockRecorder) Register(arg0, arg1 interface{}, ar
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthServiceClient)(nil).Register), varargs...)
 }
+
+// RevokeSession mocks base method.
+func (m *MockAuthServiceClient) RevokeSession(arg0 context.Context, arg1 *model.RevokeSessionRequest, arg2 ...grpc.CallOption) (*model.SessionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*model.SessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServiceClientMockRecorder) RevokeSession(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeSession), varargs...)
+}
+
+// VerifyTwoFactor mocks base method.
+func (m *MockAuthServiceClient) VerifyTwoFactor(arg0 context.Context, arg1 *model.VerifyTwoFactorRequest, arg2 ...grpc.CallOption) (*model.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyTwoFactor", varargs...)
+	ret0, _ := ret[0].(*model.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactor indicates an expected call of VerifyTwoFactor.
+func (mr *MockAuthServiceClientMockRecorder) VerifyTwoFactor(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactor", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifyTwoFactor), varargs...)
+}
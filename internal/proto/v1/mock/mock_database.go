@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: DatabaseServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockDatabaseServiceClient is a mock of DatabaseServiceClient interface.
+type MockDatabaseServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseServiceClientMockRecorder
+}
+
+// MockDatabaseServiceClientMockRecorder is the mock recorder for MockDatabaseServiceClient.
+type MockDatabaseServiceClientMockRecorder struct {
+	mock *MockDatabaseServiceClient
+}
+
+// NewMockDatabaseServiceClient creates a new mock instance.
+func NewMockDatabaseServiceClient(ctrl *gomock.Controller) *MockDatabaseServiceClient {
+	mock := &MockDatabaseServiceClient{ctrl: ctrl}
+	mock.recorder = &MockDatabaseServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseServiceClient) EXPECT() *MockDatabaseServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GenerateCredentials mocks base method.
+func (m *MockDatabaseServiceClient) GenerateCredentials(arg0 context.Context, arg1 *model.GenerateCredentialsRequest, arg2 ...grpc.CallOption) (*model.DatabaseCredentials, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateCredentials", varargs...)
+	ret0, _ := ret[0].(*model.DatabaseCredentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCredentials indicates an expected call of GenerateCredentials.
+func (mr *MockDatabaseServiceClientMockRecorder) GenerateCredentials(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCredentials", reflect.TypeOf((*MockDatabaseServiceClient)(nil).GenerateCredentials), varargs...)
+}
+
+// ListLeases mocks base method.
+func (m *MockDatabaseServiceClient) ListLeases(arg0 context.Context, arg1 *model.ListLeasesRequest, arg2 ...grpc.CallOption) (*model.ListLeasesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLeases", varargs...)
+	ret0, _ := ret[0].(*model.ListLeasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeases indicates an expected call of ListLeases.
+func (mr *MockDatabaseServiceClientMockRecorder) ListLeases(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeases", reflect.TypeOf((*MockDatabaseServiceClient)(nil).ListLeases), varargs...)
+}
+
+// RevokeLease mocks base method.
+func (m *MockDatabaseServiceClient) RevokeLease(arg0 context.Context, arg1 *model.RevokeLeaseRequest, arg2 ...grpc.CallOption) (*model.RevokeLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeLease", varargs...)
+	ret0, _ := ret[0].(*model.RevokeLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeLease indicates an expected call of RevokeLease.
+func (mr *MockDatabaseServiceClientMockRecorder) RevokeLease(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeLease", reflect.TypeOf((*MockDatabaseServiceClient)(nil).RevokeLease), varargs...)
+}
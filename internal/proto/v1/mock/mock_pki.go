@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: PKIServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockPKIServiceClient is a mock of PKIServiceClient interface.
+type MockPKIServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPKIServiceClientMockRecorder
+}
+
+// MockPKIServiceClientMockRecorder is the mock recorder for MockPKIServiceClient.
+type MockPKIServiceClientMockRecorder struct {
+	mock *MockPKIServiceClient
+}
+
+// NewMockPKIServiceClient creates a new mock instance.
+func NewMockPKIServiceClient(ctrl *gomock.Controller) *MockPKIServiceClient {
+	mock := &MockPKIServiceClient{ctrl: ctrl}
+	mock.recorder = &MockPKIServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPKIServiceClient) EXPECT() *MockPKIServiceClientMockRecorder {
+	return m.recorder
+}
+
+// IssueCertificate mocks base method.
+func (m *MockPKIServiceClient) IssueCertificate(arg0 context.Context, arg1 *model.IssueCertificateRequest, arg2 ...grpc.CallOption) (*model.IssueCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IssueCertificate", varargs...)
+	ret0, _ := ret[0].(*model.IssueCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueCertificate indicates an expected call of IssueCertificate.
+func (mr *MockPKIServiceClientMockRecorder) IssueCertificate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueCertificate", reflect.TypeOf((*MockPKIServiceClient)(nil).IssueCertificate), varargs...)
+}
+
+// RevokeCertificate mocks base method.
+func (m *MockPKIServiceClient) RevokeCertificate(arg0 context.Context, arg1 *model.RevokeCertificateRequest, arg2 ...grpc.CallOption) (*model.RevokeCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeCertificate", varargs...)
+	ret0, _ := ret[0].(*model.RevokeCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificate indicates an expected call of RevokeCertificate.
+func (mr *MockPKIServiceClientMockRecorder) RevokeCertificate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificate", reflect.TypeOf((*MockPKIServiceClient)(nil).RevokeCertificate), varargs...)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: ServiceAccountServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockServiceAccountServiceClient is a mock of ServiceAccountServiceClient interface.
+type MockServiceAccountServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountServiceClientMockRecorder
+}
+
+// MockServiceAccountServiceClientMockRecorder is the mock recorder for MockServiceAccountServiceClient.
+type MockServiceAccountServiceClientMockRecorder struct {
+	mock *MockServiceAccountServiceClient
+}
+
+// NewMockServiceAccountServiceClient creates a new mock instance.
+func NewMockServiceAccountServiceClient(ctrl *gomock.Controller) *MockServiceAccountServiceClient {
+	mock := &MockServiceAccountServiceClient{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountServiceClient) EXPECT() *MockServiceAccountServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockServiceAccountServiceClient) CreateAPIKey(arg0 context.Context, arg1 *model.CreateAPIKeyRequest, arg2 ...grpc.CallOption) (*model.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKey", varargs...)
+	ret0, _ := ret[0].(*model.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockServiceAccountServiceClientMockRecorder) CreateAPIKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockServiceAccountServiceClient)(nil).CreateAPIKey), varargs...)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockServiceAccountServiceClient) CreateServiceAccount(arg0 context.Context, arg1 *model.CreateServiceAccountRequest, arg2 ...grpc.CallOption) (*model.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceAccount", varargs...)
+	ret0, _ := ret[0].(*model.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockServiceAccountServiceClientMockRecorder) CreateServiceAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockServiceAccountServiceClient)(nil).CreateServiceAccount), varargs...)
+}
+
+// DeleteServiceAccount mocks base method.
+func (m *MockServiceAccountServiceClient) DeleteServiceAccount(arg0 context.Context, arg1 *model.DeleteServiceAccountRequest, arg2 ...grpc.CallOption) (*model.ServiceAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceAccount", varargs...)
+	ret0, _ := ret[0].(*model.ServiceAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceAccount indicates an expected call of DeleteServiceAccount.
+func (mr *MockServiceAccountServiceClientMockRecorder) DeleteServiceAccount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceAccount", reflect.TypeOf((*MockServiceAccountServiceClient)(nil).DeleteServiceAccount), varargs...)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockServiceAccountServiceClient) ListAPIKeys(arg0 context.Context, arg1 *model.ListAPIKeysRequest, arg2 ...grpc.CallOption) (*model.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPIKeys", varargs...)
+	ret0, _ := ret[0].(*model.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockServiceAccountServiceClientMockRecorder) ListAPIKeys(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockServiceAccountServiceClient)(nil).ListAPIKeys), varargs...)
+}
+
+// ListServiceAccounts mocks base method.
+func (m *MockServiceAccountServiceClient) ListServiceAccounts(arg0 context.Context, arg1 *model.ListServiceAccountsRequest, arg2 ...grpc.CallOption) (*model.ListServiceAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceAccounts", varargs...)
+	ret0, _ := ret[0].(*model.ListServiceAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts.
+func (mr *MockServiceAccountServiceClientMockRecorder) ListServiceAccounts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockServiceAccountServiceClient)(nil).ListServiceAccounts), varargs...)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockServiceAccountServiceClient) RevokeAPIKey(arg0 context.Context, arg1 *model.RevokeAPIKeyRequest, arg2 ...grpc.CallOption) (*model.ServiceAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKey", varargs...)
+	ret0, _ := ret[0].(*model.ServiceAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockServiceAccountServiceClientMockRecorder) RevokeAPIKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockServiceAccountServiceClient)(nil).RevokeAPIKey), varargs...)
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: SSHServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockSSHServiceClient is a mock of SSHServiceClient interface.
+type MockSSHServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHServiceClientMockRecorder
+}
+
+// MockSSHServiceClientMockRecorder is the mock recorder for MockSSHServiceClient.
+type MockSSHServiceClientMockRecorder struct {
+	mock *MockSSHServiceClient
+}
+
+// NewMockSSHServiceClient creates a new mock instance.
+func NewMockSSHServiceClient(ctrl *gomock.Controller) *MockSSHServiceClient {
+	mock := &MockSSHServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSSHServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHServiceClient) EXPECT() *MockSSHServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetCAPublicKey mocks base method.
+func (m *MockSSHServiceClient) GetCAPublicKey(arg0 context.Context, arg1 *model.GetSSHCARequest, arg2 ...grpc.CallOption) (*model.GetSSHCAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCAPublicKey", varargs...)
+	ret0, _ := ret[0].(*model.GetSSHCAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCAPublicKey indicates an expected call of GetCAPublicKey.
+func (mr *MockSSHServiceClientMockRecorder) GetCAPublicKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCAPublicKey", reflect.TypeOf((*MockSSHServiceClient)(nil).GetCAPublicKey), varargs...)
+}
+
+// SignKey mocks base method.
+func (m *MockSSHServiceClient) SignKey(arg0 context.Context, arg1 *model.SignSSHKeyRequest, arg2 ...grpc.CallOption) (*model.SignSSHKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignKey", varargs...)
+	ret0, _ := ret[0].(*model.SignSSHKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignKey indicates an expected call of SignKey.
+func (mr *MockSSHServiceClientMockRecorder) SignKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockSSHServiceClient)(nil).SignKey), varargs...)
+}
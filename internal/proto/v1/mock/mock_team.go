@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: TeamServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockTeamServiceClient is a mock of TeamServiceClient interface.
+type MockTeamServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamServiceClientMockRecorder
+}
+
+// MockTeamServiceClientMockRecorder is the mock recorder for MockTeamServiceClient.
+type MockTeamServiceClientMockRecorder struct {
+	mock *MockTeamServiceClient
+}
+
+// NewMockTeamServiceClient creates a new mock instance.
+func NewMockTeamServiceClient(ctrl *gomock.Controller) *MockTeamServiceClient {
+	mock := &MockTeamServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTeamServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamServiceClient) EXPECT() *MockTeamServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockTeamServiceClient) AddMember(arg0 context.Context, arg1 *model.TeamMemberRequest, arg2 ...grpc.CallOption) (*model.TeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMember", varargs...)
+	ret0, _ := ret[0].(*model.TeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockTeamServiceClientMockRecorder) AddMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockTeamServiceClient)(nil).AddMember), varargs...)
+}
+
+// CreateTeam mocks base method.
+func (m *MockTeamServiceClient) CreateTeam(arg0 context.Context, arg1 *model.TeamRequest, arg2 ...grpc.CallOption) (*model.TeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTeam", varargs...)
+	ret0, _ := ret[0].(*model.TeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockTeamServiceClientMockRecorder) CreateTeam(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockTeamServiceClient)(nil).CreateTeam), varargs...)
+}
+
+// DeleteTeam mocks base method.
+func (m *MockTeamServiceClient) DeleteTeam(arg0 context.Context, arg1 *model.TeamRequest, arg2 ...grpc.CallOption) (*model.TeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTeam", varargs...)
+	ret0, _ := ret[0].(*model.TeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockTeamServiceClientMockRecorder) DeleteTeam(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockTeamServiceClient)(nil).DeleteTeam), varargs...)
+}
+
+// ListMembers mocks base method.
+func (m *MockTeamServiceClient) ListMembers(arg0 context.Context, arg1 *model.TeamRequest, arg2 ...grpc.CallOption) (*model.ListTeamMembersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMembers", varargs...)
+	ret0, _ := ret[0].(*model.ListTeamMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockTeamServiceClientMockRecorder) ListMembers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockTeamServiceClient)(nil).ListMembers), varargs...)
+}
+
+// ListTeams mocks base method.
+func (m *MockTeamServiceClient) ListTeams(arg0 context.Context, arg1 *model.ListTeamsRequest, arg2 ...grpc.CallOption) (*model.ListTeamsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTeams", varargs...)
+	ret0, _ := ret[0].(*model.ListTeamsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockTeamServiceClientMockRecorder) ListTeams(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockTeamServiceClient)(nil).ListTeams), varargs...)
+}
+
+// RemoveMember mocks base method.
+func (m *MockTeamServiceClient) RemoveMember(arg0 context.Context, arg1 *model.TeamMemberRequest, arg2 ...grpc.CallOption) (*model.TeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveMember", varargs...)
+	ret0, _ := ret[0].(*model.TeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockTeamServiceClientMockRecorder) RemoveMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockTeamServiceClient)(nil).RemoveMember), varargs...)
+}
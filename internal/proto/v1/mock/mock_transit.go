@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: TransitServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockTransitServiceClient is a mock of TransitServiceClient interface.
+type MockTransitServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransitServiceClientMockRecorder
+}
+
+// MockTransitServiceClientMockRecorder is the mock recorder for MockTransitServiceClient.
+type MockTransitServiceClientMockRecorder struct {
+	mock *MockTransitServiceClient
+}
+
+// NewMockTransitServiceClient creates a new mock instance.
+func NewMockTransitServiceClient(ctrl *gomock.Controller) *MockTransitServiceClient {
+	mock := &MockTransitServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTransitServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransitServiceClient) EXPECT() *MockTransitServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateKey mocks base method.
+func (m *MockTransitServiceClient) CreateKey(arg0 context.Context, arg1 *model.CreateTransitKeyRequest, arg2 ...grpc.CallOption) (*model.TransitKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateKey", varargs...)
+	ret0, _ := ret[0].(*model.TransitKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockTransitServiceClientMockRecorder) CreateKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockTransitServiceClient)(nil).CreateKey), varargs...)
+}
+
+// Decrypt mocks base method.
+func (m *MockTransitServiceClient) Decrypt(arg0 context.Context, arg1 *model.DecryptRequest, arg2 ...grpc.CallOption) (*model.DecryptResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Decrypt", varargs...)
+	ret0, _ := ret[0].(*model.DecryptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockTransitServiceClientMockRecorder) Decrypt(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockTransitServiceClient)(nil).Decrypt), varargs...)
+}
+
+// Encrypt mocks base method.
+func (m *MockTransitServiceClient) Encrypt(arg0 context.Context, arg1 *model.EncryptRequest, arg2 ...grpc.CallOption) (*model.EncryptResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Encrypt", varargs...)
+	ret0, _ := ret[0].(*model.EncryptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockTransitServiceClientMockRecorder) Encrypt(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockTransitServiceClient)(nil).Encrypt), varargs...)
+}
+
+// HMAC mocks base method.
+func (m *MockTransitServiceClient) HMAC(arg0 context.Context, arg1 *model.HMACRequest, arg2 ...grpc.CallOption) (*model.HMACResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HMAC", varargs...)
+	ret0, _ := ret[0].(*model.HMACResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HMAC indicates an expected call of HMAC.
+func (mr *MockTransitServiceClientMockRecorder) HMAC(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HMAC", reflect.TypeOf((*MockTransitServiceClient)(nil).HMAC), varargs...)
+}
+
+// ListKeys mocks base method.
+func (m *MockTransitServiceClient) ListKeys(arg0 context.Context, arg1 *model.ListTransitKeysRequest, arg2 ...grpc.CallOption) (*model.ListTransitKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKeys", varargs...)
+	ret0, _ := ret[0].(*model.ListTransitKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeys indicates an expected call of ListKeys.
+func (mr *MockTransitServiceClientMockRecorder) ListKeys(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockTransitServiceClient)(nil).ListKeys), varargs...)
+}
+
+// Rewrap mocks base method.
+func (m *MockTransitServiceClient) Rewrap(arg0 context.Context, arg1 *model.RewrapRequest, arg2 ...grpc.CallOption) (*model.EncryptResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Rewrap", varargs...)
+	ret0, _ := ret[0].(*model.EncryptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rewrap indicates an expected call of Rewrap.
+func (mr *MockTransitServiceClientMockRecorder) Rewrap(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rewrap", reflect.TypeOf((*MockTransitServiceClient)(nil).Rewrap), varargs...)
+}
+
+// RotateKey mocks base method.
+func (m *MockTransitServiceClient) RotateKey(arg0 context.Context, arg1 *model.RotateTransitKeyRequest, arg2 ...grpc.CallOption) (*model.TransitKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateKey", varargs...)
+	ret0, _ := ret[0].(*model.TransitKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey.
+func (mr *MockTransitServiceClientMockRecorder) RotateKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockTransitServiceClient)(nil).RotateKey), varargs...)
+}
+
+// Sign mocks base method.
+func (m *MockTransitServiceClient) Sign(arg0 context.Context, arg1 *model.SignRequest, arg2 ...grpc.CallOption) (*model.SignResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Sign", varargs...)
+	ret0, _ := ret[0].(*model.SignResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockTransitServiceClientMockRecorder) Sign(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockTransitServiceClient)(nil).Sign), varargs...)
+}
+
+// Verify mocks base method.
+func (m *MockTransitServiceClient) Verify(arg0 context.Context, arg1 *model.VerifyRequest, arg2 ...grpc.CallOption) (*model.VerifyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Verify", varargs...)
+	ret0, _ := ret[0].(*model.VerifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTransitServiceClientMockRecorder) Verify(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockTransitServiceClient)(nil).Verify), varargs...)
+}
@@ -96,6 +96,26 @@ func (mr *MockDataServiceClientMockRecorder) DestroySecret(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockDataServiceClient)(nil).DestroySecret), varargs...)
 }
 
+// GetChangesSince mocks base method.
+func (m *MockDataServiceClient) GetChangesSince(arg0 context.Context, arg1 *model.GetChangesSinceRequest, arg2 ...grpc.CallOption) (*model.GetChangesSinceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChangesSince", varargs...)
+	ret0, _ := ret[0].(*model.GetChangesSinceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangesSince indicates an expected call of GetChangesSince.
+func (mr *MockDataServiceClientMockRecorder) GetChangesSince(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangesSince", reflect.TypeOf((*MockDataServiceClient)(nil).GetChangesSince), varargs...)
+}
+
 // GetSecret mocks base method.
 func (m *MockDataServiceClient) GetSecret(arg0 context.Context, arg1 *model.GetSecretRequest, arg2 ...grpc.CallOption) (*model.SecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +136,66 @@ func (mr *MockDataServiceClientMockRecorder) GetSecret(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockDataServiceClient)(nil).GetSecret), varargs...)
 }
 
+// GrantAccess mocks base method.
+func (m *MockDataServiceClient) GrantAccess(arg0 context.Context, arg1 *model.GrantAccessRequest, arg2 ...grpc.CallOption) (*model.GrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GrantAccess", varargs...)
+	ret0, _ := ret[0].(*model.GrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockDataServiceClientMockRecorder) GrantAccess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockDataServiceClient)(nil).GrantAccess), varargs...)
+}
+
+// ListGrants mocks base method.
+func (m *MockDataServiceClient) ListGrants(arg0 context.Context, arg1 *model.ListGrantsRequest, arg2 ...grpc.CallOption) (*model.ListGrantsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGrants", varargs...)
+	ret0, _ := ret[0].(*model.ListGrantsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrants indicates an expected call of ListGrants.
+func (mr *MockDataServiceClientMockRecorder) ListGrants(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrants", reflect.TypeOf((*MockDataServiceClient)(nil).ListGrants), varargs...)
+}
+
+// ListSecretVersions mocks base method.
+func (m *MockDataServiceClient) ListSecretVersions(arg0 context.Context, arg1 *model.ListSecretVersionsRequest, arg2 ...grpc.CallOption) (*model.ListSecretVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecretVersions", varargs...)
+	ret0, _ := ret[0].(*model.ListSecretVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretVersions indicates an expected call of ListSecretVersions.
+func (mr *MockDataServiceClientMockRecorder) ListSecretVersions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretVersions", reflect.TypeOf((*MockDataServiceClient)(nil).ListSecretVersions), varargs...)
+}
+
 // ListSecrets mocks base method.
 func (m *MockDataServiceClient) ListSecrets(arg0 context.Context, arg1 *model.ListSecretPathsRequest, arg2 ...grpc.CallOption) (*model.ListSecretPathsResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +216,26 @@ func (mr *MockDataServiceClientMockRecorder) ListSecrets(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockDataServiceClient)(nil).ListSecrets), varargs...)
 }
 
+// RevokeAccess mocks base method.
+func (m *MockDataServiceClient) RevokeAccess(arg0 context.Context, arg1 *model.RevokeAccessRequest, arg2 ...grpc.CallOption) (*model.GrantResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAccess", varargs...)
+	ret0, _ := ret[0].(*model.GrantResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockDataServiceClientMockRecorder) RevokeAccess(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockDataServiceClient)(nil).RevokeAccess), varargs...)
+}
+
 // SaveSecret mocks base method.
 func (m *MockDataServiceClient) SaveSecret(arg0 context.Context, arg1 *model.WriteSecret, arg2 ...grpc.CallOption) (*model.SaveSecretResponse, error) {
 	m.ctrl.T.Helper()
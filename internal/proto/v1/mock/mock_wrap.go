@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keeper/internal/proto/v1 (interfaces: WrapServiceClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	model "keeper/internal/proto/v1/model"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockWrapServiceClient is a mock of WrapServiceClient interface.
+type MockWrapServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWrapServiceClientMockRecorder
+}
+
+// MockWrapServiceClientMockRecorder is the mock recorder for MockWrapServiceClient.
+type MockWrapServiceClientMockRecorder struct {
+	mock *MockWrapServiceClient
+}
+
+// NewMockWrapServiceClient creates a new mock instance.
+func NewMockWrapServiceClient(ctrl *gomock.Controller) *MockWrapServiceClient {
+	mock := &MockWrapServiceClient{ctrl: ctrl}
+	mock.recorder = &MockWrapServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWrapServiceClient) EXPECT() *MockWrapServiceClientMockRecorder {
+	return m.recorder
+}
+
+// Unwrap mocks base method.
+func (m *MockWrapServiceClient) Unwrap(arg0 context.Context, arg1 *model.UnwrapRequest, arg2 ...grpc.CallOption) (*model.UnwrapResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unwrap", varargs...)
+	ret0, _ := ret[0].(*model.UnwrapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unwrap indicates an expected call of Unwrap.
+func (mr *MockWrapServiceClientMockRecorder) Unwrap(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unwrap", reflect.TypeOf((*MockWrapServiceClient)(nil).Unwrap), varargs...)
+}
+
+// Wrap mocks base method.
+func (m *MockWrapServiceClient) Wrap(arg0 context.Context, arg1 *model.WrapRequest, arg2 ...grpc.CallOption) (*model.WrapResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Wrap", varargs...)
+	ret0, _ := ret[0].(*model.WrapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Wrap indicates an expected call of Wrap.
+func (mr *MockWrapServiceClientMockRecorder) Wrap(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wrap", reflect.TypeOf((*MockWrapServiceClient)(nil).Wrap), varargs...)
+}
@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: model/changes.proto
+
+package model
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetChangesSinceRequest asks for the secrets of the caller's own vault that
+// changed after cursor. A sync may take several pages: the first request
+// sends only the cursor, and each following one repeats it together with the
+// horizon and after_id of the previous response.
+type GetChangesSinceRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Cursor      int64                  `protobuf:"varint,1,opt,name=cursor"`
+	xxx_hidden_Horizon     int64                  `protobuf:"varint,2,opt,name=horizon"`
+	xxx_hidden_AfterId     int64                  `protobuf:"varint,3,opt,name=after_id,json=afterId"`
+	xxx_hidden_Limit       int32                  `protobuf:"varint,4,opt,name=limit"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetChangesSinceRequest) Reset() {
+	*x = GetChangesSinceRequest{}
+	mi := &file_model_changes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChangesSinceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesSinceRequest) ProtoMessage() {}
+
+func (x *GetChangesSinceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_changes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetChangesSinceRequest) GetCursor() int64 {
+	if x != nil {
+		return x.xxx_hidden_Cursor
+	}
+	return 0
+}
+
+func (x *GetChangesSinceRequest) GetHorizon() int64 {
+	if x != nil {
+		return x.xxx_hidden_Horizon
+	}
+	return 0
+}
+
+func (x *GetChangesSinceRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AfterId
+	}
+	return 0
+}
+
+func (x *GetChangesSinceRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
+}
+
+func (x *GetChangesSinceRequest) SetCursor(v int64) {
+	x.xxx_hidden_Cursor = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *GetChangesSinceRequest) SetHorizon(v int64) {
+	x.xxx_hidden_Horizon = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *GetChangesSinceRequest) SetAfterId(v int64) {
+	x.xxx_hidden_AfterId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *GetChangesSinceRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *GetChangesSinceRequest) HasCursor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetChangesSinceRequest) HasHorizon() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetChangesSinceRequest) HasAfterId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetChangesSinceRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetChangesSinceRequest) ClearCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Cursor = 0
+}
+
+func (x *GetChangesSinceRequest) ClearHorizon() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Horizon = 0
+}
+
+func (x *GetChangesSinceRequest) ClearAfterId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_AfterId = 0
+}
+
+func (x *GetChangesSinceRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Limit = 0
+}
+
+type GetChangesSinceRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Cursor returned by the last completed sync; 0 returns the whole vault.
+	Cursor  *int64
+	Horizon *int64
+	AfterId *int64
+	// Page size; the server caps it.
+	Limit *int32
+}
+
+func (b0 GetChangesSinceRequest_builder) Build() *GetChangesSinceRequest {
+	m0 := &GetChangesSinceRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Cursor = *b.Cursor
+	}
+	if b.Horizon != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Horizon = *b.Horizon
+	}
+	if b.AfterId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_AfterId = *b.AfterId
+	}
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Limit = *b.Limit
+	}
+	return m0
+}
+
+type SecretChange struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Path          *string                `protobuf:"bytes,1,opt,name=path"`
+	xxx_hidden_Version       int64                  `protobuf:"varint,2,opt,name=version"`
+	xxx_hidden_LatestVersion int64                  `protobuf:"varint,3,opt,name=latest_version,json=latestVersion"`
+	xxx_hidden_Deleted       bool                   `protobuf:"varint,4,opt,name=deleted"`
+	xxx_hidden_Description   *string                `protobuf:"bytes,5,opt,name=description"`
+	xxx_hidden_ExpiredAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expired_at,json=expiredAt"`
+	xxx_hidden_CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	xxx_hidden_Value         []byte                 `protobuf:"bytes,8,opt,name=value"`
+	xxx_hidden_FilePath      *string                `protobuf:"bytes,9,opt,name=file_path,json=filePath"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SecretChange) Reset() {
+	*x = SecretChange{}
+	mi := &file_model_changes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretChange) ProtoMessage() {}
+
+func (x *SecretChange) ProtoReflect() protoreflect.Message {
+	mi := &file_model_changes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecretChange) GetPath() string {
+	if x != nil {
+		if x.xxx_hidden_Path != nil {
+			return *x.xxx_hidden_Path
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SecretChange) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *SecretChange) GetLatestVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_LatestVersion
+	}
+	return 0
+}
+
+func (x *SecretChange) GetDeleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Deleted
+	}
+	return false
+}
+
+func (x *SecretChange) GetDescription() string {
+	if x != nil {
+		if x.xxx_hidden_Description != nil {
+			return *x.xxx_hidden_Description
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SecretChange) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiredAt
+	}
+	return nil
+}
+
+func (x *SecretChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *SecretChange) GetValue() []byte {
+	if x != nil {
+		return x.xxx_hidden_Value
+	}
+	return nil
+}
+
+func (x *SecretChange) GetFilePath() string {
+	if x != nil {
+		if x.xxx_hidden_FilePath != nil {
+			return *x.xxx_hidden_FilePath
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SecretChange) SetPath(v string) {
+	x.xxx_hidden_Path = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
+}
+
+func (x *SecretChange) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
+
+func (x *SecretChange) SetLatestVersion(v int64) {
+	x.xxx_hidden_LatestVersion = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
+}
+
+func (x *SecretChange) SetDeleted(v bool) {
+	x.xxx_hidden_Deleted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
+}
+
+func (x *SecretChange) SetDescription(v string) {
+	x.xxx_hidden_Description = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
+}
+
+func (x *SecretChange) SetExpiredAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiredAt = v
+}
+
+func (x *SecretChange) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *SecretChange) SetValue(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Value = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *SecretChange) SetFilePath(v string) {
+	x.xxx_hidden_FilePath = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
+}
+
+func (x *SecretChange) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SecretChange) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SecretChange) HasLatestVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SecretChange) HasDeleted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SecretChange) HasDescription() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *SecretChange) HasExpiredAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiredAt != nil
+}
+
+func (x *SecretChange) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *SecretChange) HasValue() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *SecretChange) HasFilePath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *SecretChange) ClearPath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Path = nil
+}
+
+func (x *SecretChange) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+func (x *SecretChange) ClearLatestVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_LatestVersion = 0
+}
+
+func (x *SecretChange) ClearDeleted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Deleted = false
+}
+
+func (x *SecretChange) ClearDescription() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Description = nil
+}
+
+func (x *SecretChange) ClearExpiredAt() {
+	x.xxx_hidden_ExpiredAt = nil
+}
+
+func (x *SecretChange) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *SecretChange) ClearValue() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Value = nil
+}
+
+func (x *SecretChange) ClearFilePath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_FilePath = nil
+}
+
+type SecretChange_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path *string
+	// Latest live version, 0 when the secret is deleted.
+	Version *int64
+	// Latest version including deleted ones, what check-and-set compares with.
+	LatestVersion *int64
+	Deleted       *bool
+	Description   *string
+	ExpiredAt     *timestamppb.Timestamp
+	CreatedAt     *timestamppb.Timestamp
+	// Encoded like SecretResponse.value. File secrets only carry file_path and
+	// are read with GetSecret.
+	Value    []byte
+	FilePath *string
+}
+
+func (b0 SecretChange_builder) Build() *SecretChange {
+	m0 := &SecretChange{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Path != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_Path = b.Path
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_Version = *b.Version
+	}
+	if b.LatestVersion != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_LatestVersion = *b.LatestVersion
+	}
+	if b.Deleted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_Deleted = *b.Deleted
+	}
+	if b.Description != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_Description = b.Description
+	}
+	x.xxx_hidden_ExpiredAt = b.ExpiredAt
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	if b.Value != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_Value = b.Value
+	}
+	if b.FilePath != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_FilePath = b.FilePath
+	}
+	return m0
+}
+
+type GetChangesSinceResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Changes     *[]*SecretChange       `protobuf:"bytes,1,rep,name=changes"`
+	xxx_hidden_Cursor      int64                  `protobuf:"varint,2,opt,name=cursor"`
+	xxx_hidden_HasMore     bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore"`
+	xxx_hidden_Horizon     int64                  `protobuf:"varint,4,opt,name=horizon"`
+	xxx_hidden_AfterId     int64                  `protobuf:"varint,5,opt,name=after_id,json=afterId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetChangesSinceResponse) Reset() {
+	*x = GetChangesSinceResponse{}
+	mi := &file_model_changes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChangesSinceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesSinceResponse) ProtoMessage() {}
+
+func (x *GetChangesSinceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_changes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetChangesSinceResponse) GetChanges() []*SecretChange {
+	if x != nil {
+		if x.xxx_hidden_Changes != nil {
+			return *x.xxx_hidden_Changes
+		}
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse) GetCursor() int64 {
+	if x != nil {
+		return x.xxx_hidden_Cursor
+	}
+	return 0
+}
+
+func (x *GetChangesSinceResponse) GetHasMore() bool {
+	if x != nil {
+		return x.xxx_hidden_HasMore
+	}
+	return false
+}
+
+func (x *GetChangesSinceResponse) GetHorizon() int64 {
+	if x != nil {
+		return x.xxx_hidden_Horizon
+	}
+	return 0
+}
+
+func (x *GetChangesSinceResponse) GetAfterId() int64 {
+	if x != nil {
+		return x.xxx_hidden_AfterId
+	}
+	return 0
+}
+
+func (x *GetChangesSinceResponse) SetChanges(v []*SecretChange) {
+	x.xxx_hidden_Changes = &v
+}
+
+func (x *GetChangesSinceResponse) SetCursor(v int64) {
+	x.xxx_hidden_Cursor = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *GetChangesSinceResponse) SetHasMore(v bool) {
+	x.xxx_hidden_HasMore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *GetChangesSinceResponse) SetHorizon(v int64) {
+	x.xxx_hidden_Horizon = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *GetChangesSinceResponse) SetAfterId(v int64) {
+	x.xxx_hidden_AfterId = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *GetChangesSinceResponse) HasCursor() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetChangesSinceResponse) HasHasMore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetChangesSinceResponse) HasHorizon() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *GetChangesSinceResponse) HasAfterId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *GetChangesSinceResponse) ClearCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Cursor = 0
+}
+
+func (x *GetChangesSinceResponse) ClearHasMore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_HasMore = false
+}
+
+func (x *GetChangesSinceResponse) ClearHorizon() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Horizon = 0
+}
+
+func (x *GetChangesSinceResponse) ClearAfterId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_AfterId = 0
+}
+
+type GetChangesSinceResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Changes []*SecretChange
+	// Once has_more is false, the cursor to send on the next sync.
+	Cursor  *int64
+	HasMore *bool
+	Horizon *int64
+	AfterId *int64
+}
+
+func (b0 GetChangesSinceResponse_builder) Build() *GetChangesSinceResponse {
+	m0 := &GetChangesSinceResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Changes = &b.Changes
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Cursor = *b.Cursor
+	}
+	if b.HasMore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_HasMore = *b.HasMore
+	}
+	if b.Horizon != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Horizon = *b.Horizon
+	}
+	if b.AfterId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_AfterId = *b.AfterId
+	}
+	return m0
+}
+
+var File_model_changes_proto protoreflect.FileDescriptor
+
+const file_model_changes_proto_rawDesc = "" +
+	"\n" +
+	"\x13model/changes.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"{\n" +
+	"\x16GetChangesSinceRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x03R\x06cursor\x12\x18\n" +
+	"\ahorizon\x18\x02 \x01(\x03R\ahorizon\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xc8\x02\n" +
+	"\fSecretChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12%\n" +
+	"\x0elatest_version\x18\x03 \x01(\x03R\rlatestVersion\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"expired_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05value\x18\b \x01(\fR\x05value\x12\x1b\n" +
+	"\tfile_path\x18\t \x01(\tR\bfilePath\"\xc2\x01\n" +
+	"\x17GetChangesSinceResponse\x12?\n" +
+	"\achanges\x18\x01 \x03(\v2%.keeper.go.grpc.v1.model.SecretChangeR\achanges\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\x12\x18\n" +
+	"\ahorizon\x18\x04 \x01(\x03R\ahorizon\x12\x19\n" +
+	"\bafter_id\x18\x05 \x01(\x03R\aafterIdB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_model_changes_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_model_changes_proto_goTypes = []any{
+	(*GetChangesSinceRequest)(nil),  // 0: keeper.go.grpc.v1.model.GetChangesSinceRequest
+	(*SecretChange)(nil),            // 1: keeper.go.grpc.v1.model.SecretChange
+	(*GetChangesSinceResponse)(nil), // 2: keeper.go.grpc.v1.model.GetChangesSinceResponse
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+}
+var file_model_changes_proto_depIdxs = []int32{
+	3, // 0: keeper.go.grpc.v1.model.SecretChange.expired_at:type_name -> google.protobuf.Timestamp
+	3, // 1: keeper.go.grpc.v1.model.SecretChange.created_at:type_name -> google.protobuf.Timestamp
+	1, // 2: keeper.go.grpc.v1.model.GetChangesSinceResponse.changes:type_name -> keeper.go.grpc.v1.model.SecretChange
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_model_changes_proto_init() }
+func file_model_changes_proto_init() {
+	if File_model_changes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_changes_proto_rawDesc), len(file_model_changes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_model_changes_proto_goTypes,
+		DependencyIndexes: file_model_changes_proto_depIdxs,
+		MessageInfos:      file_model_changes_proto_msgTypes,
+	}.Build()
+	File_model_changes_proto = out.File
+	file_model_changes_proto_goTypes = nil
+	file_model_changes_proto_depIdxs = nil
+}
@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: model/database.proto
+
+package model
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GenerateCredentialsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role        *string                `protobuf:"bytes,1,opt,name=role"`
+	xxx_hidden_TtlSeconds  int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GenerateCredentialsRequest) Reset() {
+	*x = GenerateCredentialsRequest{}
+	mi := &file_model_database_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateCredentialsRequest) ProtoMessage() {}
+
+func (x *GenerateCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GenerateCredentialsRequest) GetRole() string {
+	if x != nil {
+		if x.xxx_hidden_Role != nil {
+			return *x.xxx_hidden_Role
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GenerateCredentialsRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.xxx_hidden_TtlSeconds
+	}
+	return 0
+}
+
+func (x *GenerateCredentialsRequest) SetRole(v string) {
+	x.xxx_hidden_Role = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *GenerateCredentialsRequest) SetTtlSeconds(v int64) {
+	x.xxx_hidden_TtlSeconds = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GenerateCredentialsRequest) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GenerateCredentialsRequest) HasTtlSeconds() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GenerateCredentialsRequest) ClearRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Role = nil
+}
+
+func (x *GenerateCredentialsRequest) ClearTtlSeconds() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_TtlSeconds = 0
+}
+
+type GenerateCredentialsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role *string
+	// Zero uses the default ttl of the role; longer than its max is capped.
+	TtlSeconds *int64
+}
+
+func (b0 GenerateCredentialsRequest_builder) Build() *GenerateCredentialsRequest {
+	m0 := &GenerateCredentialsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Role != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Role = b.Role
+	}
+	if b.TtlSeconds != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_TtlSeconds = *b.TtlSeconds
+	}
+	return m0
+}
+
+type DatabaseCredentials struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_LeaseId     *string                `protobuf:"bytes,1,opt,name=lease_id,json=leaseId"`
+	xxx_hidden_Role        *string                `protobuf:"bytes,2,opt,name=role"`
+	xxx_hidden_Username    *string                `protobuf:"bytes,3,opt,name=username"`
+	xxx_hidden_Password    *string                `protobuf:"bytes,4,opt,name=password"`
+	xxx_hidden_ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DatabaseCredentials) Reset() {
+	*x = DatabaseCredentials{}
+	mi := &file_model_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseCredentials) ProtoMessage() {}
+
+func (x *DatabaseCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DatabaseCredentials) GetLeaseId() string {
+	if x != nil {
+		if x.xxx_hidden_LeaseId != nil {
+			return *x.xxx_hidden_LeaseId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetRole() string {
+	if x != nil {
+		if x.xxx_hidden_Role != nil {
+			return *x.xxx_hidden_Role
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetUsername() string {
+	if x != nil {
+		if x.xxx_hidden_Username != nil {
+			return *x.xxx_hidden_Username
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetPassword() string {
+	if x != nil {
+		if x.xxx_hidden_Password != nil {
+			return *x.xxx_hidden_Password
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DatabaseCredentials) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *DatabaseCredentials) SetLeaseId(v string) {
+	x.xxx_hidden_LeaseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *DatabaseCredentials) SetRole(v string) {
+	x.xxx_hidden_Role = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *DatabaseCredentials) SetUsername(v string) {
+	x.xxx_hidden_Username = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *DatabaseCredentials) SetPassword(v string) {
+	x.xxx_hidden_Password = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *DatabaseCredentials) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *DatabaseCredentials) HasLeaseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DatabaseCredentials) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DatabaseCredentials) HasUsername() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DatabaseCredentials) HasPassword() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DatabaseCredentials) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *DatabaseCredentials) ClearLeaseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_LeaseId = nil
+}
+
+func (x *DatabaseCredentials) ClearRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Role = nil
+}
+
+func (x *DatabaseCredentials) ClearUsername() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Username = nil
+}
+
+func (x *DatabaseCredentials) ClearPassword() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Password = nil
+}
+
+func (x *DatabaseCredentials) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type DatabaseCredentials_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	LeaseId   *string
+	Role      *string
+	Username  *string
+	Password  *string
+	ExpiresAt *timestamppb.Timestamp
+}
+
+func (b0 DatabaseCredentials_builder) Build() *DatabaseCredentials {
+	m0 := &DatabaseCredentials{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.LeaseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_LeaseId = b.LeaseId
+	}
+	if b.Role != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Role = b.Role
+	}
+	if b.Username != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Username = b.Username
+	}
+	if b.Password != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Password = b.Password
+	}
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type ListLeasesRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeasesRequest) Reset() {
+	*x = ListLeasesRequest{}
+	mi := &file_model_database_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeasesRequest) ProtoMessage() {}
+
+func (x *ListLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type ListLeasesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 ListLeasesRequest_builder) Build() *ListLeasesRequest {
+	m0 := &ListLeasesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type Lease struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_LeaseId     *string                `protobuf:"bytes,1,opt,name=lease_id,json=leaseId"`
+	xxx_hidden_Role        *string                `protobuf:"bytes,2,opt,name=role"`
+	xxx_hidden_Username    *string                `protobuf:"bytes,3,opt,name=username"`
+	xxx_hidden_ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	mi := &file_model_database_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Lease) GetLeaseId() string {
+	if x != nil {
+		if x.xxx_hidden_LeaseId != nil {
+			return *x.xxx_hidden_LeaseId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Lease) GetRole() string {
+	if x != nil {
+		if x.xxx_hidden_Role != nil {
+			return *x.xxx_hidden_Role
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Lease) GetUsername() string {
+	if x != nil {
+		if x.xxx_hidden_Username != nil {
+			return *x.xxx_hidden_Username
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Lease) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *Lease) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Lease) SetLeaseId(v string) {
+	x.xxx_hidden_LeaseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *Lease) SetRole(v string) {
+	x.xxx_hidden_Role = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *Lease) SetUsername(v string) {
+	x.xxx_hidden_Username = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *Lease) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *Lease) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Lease) HasLeaseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Lease) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Lease) HasUsername() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Lease) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *Lease) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Lease) ClearLeaseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_LeaseId = nil
+}
+
+func (x *Lease) ClearRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Role = nil
+}
+
+func (x *Lease) ClearUsername() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Username = nil
+}
+
+func (x *Lease) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+func (x *Lease) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type Lease_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	LeaseId   *string
+	Role      *string
+	Username  *string
+	ExpiresAt *timestamppb.Timestamp
+	CreatedAt *timestamppb.Timestamp
+}
+
+func (b0 Lease_builder) Build() *Lease {
+	m0 := &Lease{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.LeaseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_LeaseId = b.LeaseId
+	}
+	if b.Role != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Role = b.Role
+	}
+	if b.Username != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Username = b.Username
+	}
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	return m0
+}
+
+type ListLeasesResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Leases *[]*Lease              `protobuf:"bytes,1,rep,name=leases"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListLeasesResponse) Reset() {
+	*x = ListLeasesResponse{}
+	mi := &file_model_database_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeasesResponse) ProtoMessage() {}
+
+func (x *ListLeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListLeasesResponse) GetLeases() []*Lease {
+	if x != nil {
+		if x.xxx_hidden_Leases != nil {
+			return *x.xxx_hidden_Leases
+		}
+	}
+	return nil
+}
+
+func (x *ListLeasesResponse) SetLeases(v []*Lease) {
+	x.xxx_hidden_Leases = &v
+}
+
+type ListLeasesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Leases []*Lease
+}
+
+func (b0 ListLeasesResponse_builder) Build() *ListLeasesResponse {
+	m0 := &ListLeasesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Leases = &b.Leases
+	return m0
+}
+
+type RevokeLeaseRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_LeaseId     *string                `protobuf:"bytes,1,opt,name=lease_id,json=leaseId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RevokeLeaseRequest) Reset() {
+	*x = RevokeLeaseRequest{}
+	mi := &file_model_database_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeaseRequest) ProtoMessage() {}
+
+func (x *RevokeLeaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		if x.xxx_hidden_LeaseId != nil {
+			return *x.xxx_hidden_LeaseId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RevokeLeaseRequest) SetLeaseId(v string) {
+	x.xxx_hidden_LeaseId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *RevokeLeaseRequest) HasLeaseId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RevokeLeaseRequest) ClearLeaseId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_LeaseId = nil
+}
+
+type RevokeLeaseRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	LeaseId *string
+}
+
+func (b0 RevokeLeaseRequest_builder) Build() *RevokeLeaseRequest {
+	m0 := &RevokeLeaseRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.LeaseId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_LeaseId = b.LeaseId
+	}
+	return m0
+}
+
+type RevokeLeaseResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,1,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RevokeLeaseResponse) Reset() {
+	*x = RevokeLeaseResponse{}
+	mi := &file_model_database_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeLeaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLeaseResponse) ProtoMessage() {}
+
+func (x *RevokeLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_database_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeLeaseResponse) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RevokeLeaseResponse) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *RevokeLeaseResponse) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RevokeLeaseResponse) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Message = nil
+}
+
+type RevokeLeaseResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Message *string
+}
+
+func (b0 RevokeLeaseResponse_builder) Build() *RevokeLeaseResponse {
+	m0 := &RevokeLeaseResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Message = b.Message
+	}
+	return m0
+}
+
+var File_model_database_proto protoreflect.FileDescriptor
+
+const file_model_database_proto_rawDesc = "" +
+	"\n" +
+	"\x14model/database.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"Q\n" +
+	"\x1aGenerateCredentialsRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\"\xb7\x01\n" +
+	"\x13DatabaseCredentials\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x13\n" +
+	"\x11ListLeasesRequest\"\xc8\x01\n" +
+	"\x05Lease\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"L\n" +
+	"\x12ListLeasesResponse\x126\n" +
+	"\x06leases\x18\x01 \x03(\v2\x1e.keeper.go.grpc.v1.model.LeaseR\x06leases\"/\n" +
+	"\x12RevokeLeaseRequest\x12\x19\n" +
+	"\blease_id\x18\x01 \x01(\tR\aleaseId\"/\n" +
+	"\x13RevokeLeaseResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_model_database_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_model_database_proto_goTypes = []any{
+	(*GenerateCredentialsRequest)(nil), // 0: keeper.go.grpc.v1.model.GenerateCredentialsRequest
+	(*DatabaseCredentials)(nil),        // 1: keeper.go.grpc.v1.model.DatabaseCredentials
+	(*ListLeasesRequest)(nil),          // 2: keeper.go.grpc.v1.model.ListLeasesRequest
+	(*Lease)(nil),                      // 3: keeper.go.grpc.v1.model.Lease
+	(*ListLeasesResponse)(nil),         // 4: keeper.go.grpc.v1.model.ListLeasesResponse
+	(*RevokeLeaseRequest)(nil),         // 5: keeper.go.grpc.v1.model.RevokeLeaseRequest
+	(*RevokeLeaseResponse)(nil),        // 6: keeper.go.grpc.v1.model.RevokeLeaseResponse
+	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
+}
+var file_model_database_proto_depIdxs = []int32{
+	7, // 0: keeper.go.grpc.v1.model.DatabaseCredentials.expires_at:type_name -> google.protobuf.Timestamp
+	7, // 1: keeper.go.grpc.v1.model.Lease.expires_at:type_name -> google.protobuf.Timestamp
+	7, // 2: keeper.go.grpc.v1.model.Lease.created_at:type_name -> google.protobuf.Timestamp
+	3, // 3: keeper.go.grpc.v1.model.ListLeasesResponse.leases:type_name -> keeper.go.grpc.v1.model.Lease
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_model_database_proto_init() }
+func file_model_database_proto_init() {
+	if File_model_database_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_database_proto_rawDesc), len(file_model_database_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_model_database_proto_goTypes,
+		DependencyIndexes: file_model_database_proto_depIdxs,
+		MessageInfos:      file_model_database_proto_msgTypes,
+	}.Build()
+	File_model_database_proto = out.File
+	file_model_database_proto_goTypes = nil
+	file_model_database_proto_depIdxs = nil
+}
@@ -26,6 +26,8 @@ type DeleteSecretRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Token       *string                `protobuf:"bytes,1,opt,name=token"`
 	xxx_hidden_Path        *string                `protobuf:"bytes,2,opt,name=path"`
+	xxx_hidden_Owner       *string                `protobuf:"bytes,3,opt,name=owner"`
+	xxx_hidden_Versions    []int64                `protobuf:"varint,4,rep,packed,name=versions"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -57,6 +59,7 @@ func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *DeleteSecretRequest) GetToken() string {
 	if x != nil {
 		if x.xxx_hidden_Token != nil {
@@ -77,16 +80,44 @@ func (x *DeleteSecretRequest) GetPath() string {
 	return ""
 }
 
+func (x *DeleteSecretRequest) GetOwner() string {
+	if x != nil {
+		if x.xxx_hidden_Owner != nil {
+			return *x.xxx_hidden_Owner
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetVersions() []int64 {
+	if x != nil {
+		return x.xxx_hidden_Versions
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *DeleteSecretRequest) SetToken(v string) {
 	x.xxx_hidden_Token = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
 func (x *DeleteSecretRequest) SetPath(v string) {
 	x.xxx_hidden_Path = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
+func (x *DeleteSecretRequest) SetOwner(v string) {
+	x.xxx_hidden_Owner = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *DeleteSecretRequest) SetVersions(v []int64) {
+	x.xxx_hidden_Versions = v
+}
+
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *DeleteSecretRequest) HasToken() bool {
 	if x == nil {
 		return false
@@ -101,6 +132,14 @@ func (x *DeleteSecretRequest) HasPath() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *DeleteSecretRequest) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *DeleteSecretRequest) ClearToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Token = nil
@@ -111,11 +150,23 @@ func (x *DeleteSecretRequest) ClearPath() {
 	x.xxx_hidden_Path = nil
 }
 
+func (x *DeleteSecretRequest) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Owner = nil
+}
+
 type DeleteSecretRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Deprecated: send the token in the authorization metadata instead.
+	//
+	// Deprecated: Marked as deprecated in model/delete_secret.proto.
 	Token *string
 	Path  *string
+	Owner *string
+	// DeleteSecret and DestroySecret act on these versions only when set,
+	// instead of every version; versions already in that state are skipped.
+	Versions []int64
 }
 
 func (b0 DeleteSecretRequest_builder) Build() *DeleteSecretRequest {
@@ -123,13 +174,18 @@ func (b0 DeleteSecretRequest_builder) Build() *DeleteSecretRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Token != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_Token = b.Token
 	}
 	if b.Path != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_Path = b.Path
 	}
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Owner = b.Owner
+	}
+	x.xxx_hidden_Versions = b.Versions
 	return m0
 }
 
@@ -138,6 +194,7 @@ type UndeleteSecretRequest struct {
 	xxx_hidden_Token       *string                `protobuf:"bytes,1,opt,name=token"`
 	xxx_hidden_Path        *string                `protobuf:"bytes,2,opt,name=path"`
 	xxx_hidden_Version     int64                  `protobuf:"varint,3,opt,name=version"`
+	xxx_hidden_Owner       *string                `protobuf:"bytes,4,opt,name=owner"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -169,6 +226,7 @@ func (x *UndeleteSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *UndeleteSecretRequest) GetToken() string {
 	if x != nil {
 		if x.xxx_hidden_Token != nil {
@@ -196,21 +254,38 @@ func (x *UndeleteSecretRequest) GetVersion() int64 {
 	return 0
 }
 
+func (x *UndeleteSecretRequest) GetOwner() string {
+	if x != nil {
+		if x.xxx_hidden_Owner != nil {
+			return *x.xxx_hidden_Owner
+		}
+		return ""
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *UndeleteSecretRequest) SetToken(v string) {
 	x.xxx_hidden_Token = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
 func (x *UndeleteSecretRequest) SetPath(v string) {
 	x.xxx_hidden_Path = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
 func (x *UndeleteSecretRequest) SetVersion(v int64) {
 	x.xxx_hidden_Version = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
+func (x *UndeleteSecretRequest) SetOwner(v string) {
+	x.xxx_hidden_Owner = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *UndeleteSecretRequest) HasToken() bool {
 	if x == nil {
 		return false
@@ -232,6 +307,14 @@ func (x *UndeleteSecretRequest) HasVersion() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
+func (x *UndeleteSecretRequest) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+// Deprecated: Marked as deprecated in model/delete_secret.proto.
 func (x *UndeleteSecretRequest) ClearToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Token = nil
@@ -247,12 +330,21 @@ func (x *UndeleteSecretRequest) ClearVersion() {
 	x.xxx_hidden_Version = 0
 }
 
+func (x *UndeleteSecretRequest) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Owner = nil
+}
+
 type UndeleteSecretRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Deprecated: send the token in the authorization metadata instead.
+	//
+	// Deprecated: Marked as deprecated in model/delete_secret.proto.
 	Token   *string
 	Path    *string
 	Version *int64
+	Owner   *string
 }
 
 func (b0 UndeleteSecretRequest_builder) Build() *UndeleteSecretRequest {
@@ -260,17 +352,21 @@ func (b0 UndeleteSecretRequest_builder) Build() *UndeleteSecretRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Token != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_Token = b.Token
 	}
 	if b.Path != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_Path = b.Path
 	}
 	if b.Version != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
 		x.xxx_hidden_Version = *b.Version
 	}
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Owner = b.Owner
+	}
 	return m0
 }
 
@@ -356,14 +452,17 @@ var File_model_delete_secret_proto protoreflect.FileDescriptor
 
 const file_model_delete_secret_proto_rawDesc = "" +
 	"\n" +
-	"\x19model/delete_secret.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"?\n" +
-	"\x13DeleteSecretRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04path\"[\n" +
-	"\x15UndeleteSecretRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x19model/delete_secret.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"u\n" +
+	"\x13DeleteSecretRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x1a\n" +
+	"\bversions\x18\x04 \x03(\x03R\bversions\"u\n" +
+	"\x15UndeleteSecretRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x03R\aversion\"0\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\"0\n" +
 	"\x14DeleteSecretResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessageB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
 
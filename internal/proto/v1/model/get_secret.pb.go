@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -26,6 +27,8 @@ type GetSecretRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Token       *string                `protobuf:"bytes,1,opt,name=token"`
 	xxx_hidden_Path        *string                `protobuf:"bytes,2,opt,name=path"`
+	xxx_hidden_Owner       *string                `protobuf:"bytes,3,opt,name=owner"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,4,opt,name=version"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -57,6 +60,7 @@ func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Marked as deprecated in model/get_secret.proto.
 func (x *GetSecretRequest) GetToken() string {
 	if x != nil {
 		if x.xxx_hidden_Token != nil {
@@ -77,16 +81,45 @@ func (x *GetSecretRequest) GetPath() string {
 	return ""
 }
 
+func (x *GetSecretRequest) GetOwner() string {
+	if x != nil {
+		if x.xxx_hidden_Owner != nil {
+			return *x.xxx_hidden_Owner
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in model/get_secret.proto.
 func (x *GetSecretRequest) SetToken(v string) {
 	x.xxx_hidden_Token = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
 func (x *GetSecretRequest) SetPath(v string) {
 	x.xxx_hidden_Path = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *GetSecretRequest) SetOwner(v string) {
+	x.xxx_hidden_Owner = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
+func (x *GetSecretRequest) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+// Deprecated: Marked as deprecated in model/get_secret.proto.
 func (x *GetSecretRequest) HasToken() bool {
 	if x == nil {
 		return false
@@ -101,6 +134,21 @@ func (x *GetSecretRequest) HasPath() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *GetSecretRequest) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *GetSecretRequest) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+// Deprecated: Marked as deprecated in model/get_secret.proto.
 func (x *GetSecretRequest) ClearToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Token = nil
@@ -111,11 +159,27 @@ func (x *GetSecretRequest) ClearPath() {
 	x.xxx_hidden_Path = nil
 }
 
+func (x *GetSecretRequest) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Owner = nil
+}
+
+func (x *GetSecretRequest) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Version = 0
+}
+
 type GetSecretRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Deprecated: send the token in the authorization metadata instead.
+	//
+	// Deprecated: Marked as deprecated in model/get_secret.proto.
 	Token *string
 	Path  *string
+	Owner *string
+	// Version to read; 0 reads the latest live version.
+	Version *int64
 }
 
 func (b0 GetSecretRequest_builder) Build() *GetSecretRequest {
@@ -123,13 +187,346 @@ func (b0 GetSecretRequest_builder) Build() *GetSecretRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Token != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_Token = b.Token
 	}
 	if b.Path != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_Path = b.Path
 	}
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Owner = b.Owner
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+type ListSecretVersionsRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Path        *string                `protobuf:"bytes,1,opt,name=path"`
+	xxx_hidden_Owner       *string                `protobuf:"bytes,2,opt,name=owner"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListSecretVersionsRequest) Reset() {
+	*x = ListSecretVersionsRequest{}
+	mi := &file_model_get_secret_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretVersionsRequest) ProtoMessage() {}
+
+func (x *ListSecretVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_get_secret_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSecretVersionsRequest) GetPath() string {
+	if x != nil {
+		if x.xxx_hidden_Path != nil {
+			return *x.xxx_hidden_Path
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListSecretVersionsRequest) GetOwner() string {
+	if x != nil {
+		if x.xxx_hidden_Owner != nil {
+			return *x.xxx_hidden_Owner
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListSecretVersionsRequest) SetPath(v string) {
+	x.xxx_hidden_Path = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListSecretVersionsRequest) SetOwner(v string) {
+	x.xxx_hidden_Owner = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListSecretVersionsRequest) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ListSecretVersionsRequest) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListSecretVersionsRequest) ClearPath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Path = nil
+}
+
+func (x *ListSecretVersionsRequest) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Owner = nil
+}
+
+type ListSecretVersionsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Path  *string
+	Owner *string
+}
+
+func (b0 ListSecretVersionsRequest_builder) Build() *ListSecretVersionsRequest {
+	m0 := &ListSecretVersionsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Path != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Path = b.Path
+	}
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Owner = b.Owner
+	}
+	return m0
+}
+
+type SecretVersionInfo struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,1,opt,name=version"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt"`
+	xxx_hidden_DeletedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt"`
+	xxx_hidden_Destroyed   bool                   `protobuf:"varint,4,opt,name=destroyed"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SecretVersionInfo) Reset() {
+	*x = SecretVersionInfo{}
+	mi := &file_model_get_secret_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretVersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretVersionInfo) ProtoMessage() {}
+
+func (x *SecretVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_model_get_secret_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SecretVersionInfo) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *SecretVersionInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *SecretVersionInfo) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_DeletedAt
+	}
+	return nil
+}
+
+func (x *SecretVersionInfo) GetDestroyed() bool {
+	if x != nil {
+		return x.xxx_hidden_Destroyed
+	}
+	return false
+}
+
+func (x *SecretVersionInfo) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SecretVersionInfo) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *SecretVersionInfo) SetDeletedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_DeletedAt = v
+}
+
+func (x *SecretVersionInfo) SetDestroyed(v bool) {
+	x.xxx_hidden_Destroyed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SecretVersionInfo) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SecretVersionInfo) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *SecretVersionInfo) HasDeletedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_DeletedAt != nil
+}
+
+func (x *SecretVersionInfo) HasDestroyed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SecretVersionInfo) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Version = 0
+}
+
+func (x *SecretVersionInfo) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *SecretVersionInfo) ClearDeletedAt() {
+	x.xxx_hidden_DeletedAt = nil
+}
+
+func (x *SecretVersionInfo) ClearDestroyed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Destroyed = false
+}
+
+type SecretVersionInfo_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Version   *int64
+	CreatedAt *timestamppb.Timestamp
+	DeletedAt *timestamppb.Timestamp
+	Destroyed *bool
+}
+
+func (b0 SecretVersionInfo_builder) Build() *SecretVersionInfo {
+	m0 := &SecretVersionInfo{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Version = *b.Version
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_DeletedAt = b.DeletedAt
+	if b.Destroyed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Destroyed = *b.Destroyed
+	}
+	return m0
+}
+
+type ListSecretVersionsResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Versions *[]*SecretVersionInfo  `protobuf:"bytes,1,rep,name=versions"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListSecretVersionsResponse) Reset() {
+	*x = ListSecretVersionsResponse{}
+	mi := &file_model_get_secret_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretVersionsResponse) ProtoMessage() {}
+
+func (x *ListSecretVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_get_secret_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListSecretVersionsResponse) GetVersions() []*SecretVersionInfo {
+	if x != nil {
+		if x.xxx_hidden_Versions != nil {
+			return *x.xxx_hidden_Versions
+		}
+	}
+	return nil
+}
+
+func (x *ListSecretVersionsResponse) SetVersions(v []*SecretVersionInfo) {
+	x.xxx_hidden_Versions = &v
+}
+
+type ListSecretVersionsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Versions []*SecretVersionInfo
+}
+
+func (b0 ListSecretVersionsResponse_builder) Build() *ListSecretVersionsResponse {
+	m0 := &ListSecretVersionsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Versions = &b.Versions
 	return m0
 }
 
@@ -137,21 +534,42 @@ var File_model_get_secret_proto protoreflect.FileDescriptor
 
 const file_model_get_secret_proto_rawDesc = "" +
 	"\n" +
-	"\x16model/get_secret.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"<\n" +
-	"\x10GetSecretRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04path\x18\x02 \x01(\tR\x04pathB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+	"\x16model/get_secret.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"p\n" +
+	"\x10GetSecretRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"E\n" +
+	"\x19ListSecretVersionsRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\"\xc1\x01\n" +
+	"\x11SecretVersionInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1c\n" +
+	"\tdestroyed\x18\x04 \x01(\bR\tdestroyed\"d\n" +
+	"\x1aListSecretVersionsResponse\x12F\n" +
+	"\bversions\x18\x01 \x03(\v2*.keeper.go.grpc.v1.model.SecretVersionInfoR\bversionsB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
 
-var file_model_get_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_model_get_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_model_get_secret_proto_goTypes = []any{
-	(*GetSecretRequest)(nil), // 0: keeper.go.grpc.v1.model.GetSecretRequest
+	(*GetSecretRequest)(nil),           // 0: keeper.go.grpc.v1.model.GetSecretRequest
+	(*ListSecretVersionsRequest)(nil),  // 1: keeper.go.grpc.v1.model.ListSecretVersionsRequest
+	(*SecretVersionInfo)(nil),          // 2: keeper.go.grpc.v1.model.SecretVersionInfo
+	(*ListSecretVersionsResponse)(nil), // 3: keeper.go.grpc.v1.model.ListSecretVersionsResponse
+	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
 }
 var file_model_get_secret_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: keeper.go.grpc.v1.model.SecretVersionInfo.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: keeper.go.grpc.v1.model.SecretVersionInfo.deleted_at:type_name -> google.protobuf.Timestamp
+	2, // 2: keeper.go.grpc.v1.model.ListSecretVersionsResponse.versions:type_name -> keeper.go.grpc.v1.model.SecretVersionInfo
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_model_get_secret_proto_init() }
@@ -165,7 +583,7 @@ func file_model_get_secret_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_get_secret_proto_rawDesc), len(file_model_get_secret_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
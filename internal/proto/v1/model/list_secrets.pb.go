@@ -56,6 +56,7 @@ func (x *ListSecretPathsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Marked as deprecated in model/list_secrets.proto.
 func (x *ListSecretPathsRequest) GetToken() string {
 	if x != nil {
 		if x.xxx_hidden_Token != nil {
@@ -66,11 +67,13 @@ func (x *ListSecretPathsRequest) GetToken() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in model/list_secrets.proto.
 func (x *ListSecretPathsRequest) SetToken(v string) {
 	x.xxx_hidden_Token = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
+// Deprecated: Marked as deprecated in model/list_secrets.proto.
 func (x *ListSecretPathsRequest) HasToken() bool {
 	if x == nil {
 		return false
@@ -78,6 +81,7 @@ func (x *ListSecretPathsRequest) HasToken() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
+// Deprecated: Marked as deprecated in model/list_secrets.proto.
 func (x *ListSecretPathsRequest) ClearToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Token = nil
@@ -86,6 +90,9 @@ func (x *ListSecretPathsRequest) ClearToken() {
 type ListSecretPathsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Deprecated: send the token in the authorization metadata instead.
+	//
+	// Deprecated: Marked as deprecated in model/list_secrets.proto.
 	Token *string
 }
 
@@ -100,16 +107,161 @@ func (b0 ListSecretPathsRequest_builder) Build() *ListSecretPathsRequest {
 	return m0
 }
 
+type SharedSecretPath struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Owner       *string                `protobuf:"bytes,1,opt,name=owner"`
+	xxx_hidden_Path        *string                `protobuf:"bytes,2,opt,name=path"`
+	xxx_hidden_Access      *string                `protobuf:"bytes,3,opt,name=access"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SharedSecretPath) Reset() {
+	*x = SharedSecretPath{}
+	mi := &file_model_list_secrets_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedSecretPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedSecretPath) ProtoMessage() {}
+
+func (x *SharedSecretPath) ProtoReflect() protoreflect.Message {
+	mi := &file_model_list_secrets_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SharedSecretPath) GetOwner() string {
+	if x != nil {
+		if x.xxx_hidden_Owner != nil {
+			return *x.xxx_hidden_Owner
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SharedSecretPath) GetPath() string {
+	if x != nil {
+		if x.xxx_hidden_Path != nil {
+			return *x.xxx_hidden_Path
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SharedSecretPath) GetAccess() string {
+	if x != nil {
+		if x.xxx_hidden_Access != nil {
+			return *x.xxx_hidden_Access
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SharedSecretPath) SetOwner(v string) {
+	x.xxx_hidden_Owner = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *SharedSecretPath) SetPath(v string) {
+	x.xxx_hidden_Path = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *SharedSecretPath) SetAccess(v string) {
+	x.xxx_hidden_Access = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *SharedSecretPath) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SharedSecretPath) HasPath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SharedSecretPath) HasAccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SharedSecretPath) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Owner = nil
+}
+
+func (x *SharedSecretPath) ClearPath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Path = nil
+}
+
+func (x *SharedSecretPath) ClearAccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Access = nil
+}
+
+type SharedSecretPath_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Owner  *string
+	Path   *string
+	Access *string
+}
+
+func (b0 SharedSecretPath_builder) Build() *SharedSecretPath {
+	m0 := &SharedSecretPath{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Owner = b.Owner
+	}
+	if b.Path != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Path = b.Path
+	}
+	if b.Access != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Access = b.Access
+	}
+	return m0
+}
+
 type ListSecretPathsResponse struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Paths []string               `protobuf:"bytes,1,rep,name=paths"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Paths  []string               `protobuf:"bytes,1,rep,name=paths"`
+	xxx_hidden_Shared *[]*SharedSecretPath   `protobuf:"bytes,2,rep,name=shared"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListSecretPathsResponse) Reset() {
 	*x = ListSecretPathsResponse{}
-	mi := &file_model_list_secrets_proto_msgTypes[1]
+	mi := &file_model_list_secrets_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -121,7 +273,7 @@ func (x *ListSecretPathsResponse) String() string {
 func (*ListSecretPathsResponse) ProtoMessage() {}
 
 func (x *ListSecretPathsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_model_list_secrets_proto_msgTypes[1]
+	mi := &file_model_list_secrets_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -139,14 +291,28 @@ func (x *ListSecretPathsResponse) GetPaths() []string {
 	return nil
 }
 
+func (x *ListSecretPathsResponse) GetShared() []*SharedSecretPath {
+	if x != nil {
+		if x.xxx_hidden_Shared != nil {
+			return *x.xxx_hidden_Shared
+		}
+	}
+	return nil
+}
+
 func (x *ListSecretPathsResponse) SetPaths(v []string) {
 	x.xxx_hidden_Paths = v
 }
 
+func (x *ListSecretPathsResponse) SetShared(v []*SharedSecretPath) {
+	x.xxx_hidden_Shared = &v
+}
+
 type ListSecretPathsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Paths []string
+	Paths  []string
+	Shared []*SharedSecretPath
 }
 
 func (b0 ListSecretPathsResponse_builder) Build() *ListSecretPathsResponse {
@@ -154,6 +320,7 @@ func (b0 ListSecretPathsResponse_builder) Build() *ListSecretPathsResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.xxx_hidden_Paths = b.Paths
+	x.xxx_hidden_Shared = &b.Shared
 	return m0
 }
 
@@ -161,23 +328,30 @@ var File_model_list_secrets_proto protoreflect.FileDescriptor
 
 const file_model_list_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\x18model/list_secrets.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\".\n" +
-	"\x16ListSecretPathsRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"/\n" +
+	"\x18model/list_secrets.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"2\n" +
+	"\x16ListSecretPathsRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\"T\n" +
+	"\x10SharedSecretPath\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x16\n" +
+	"\x06access\x18\x03 \x01(\tR\x06access\"r\n" +
 	"\x17ListSecretPathsResponse\x12\x14\n" +
-	"\x05paths\x18\x01 \x03(\tR\x05pathsB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+	"\x05paths\x18\x01 \x03(\tR\x05paths\x12A\n" +
+	"\x06shared\x18\x02 \x03(\v2).keeper.go.grpc.v1.model.SharedSecretPathR\x06sharedB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
 
-var file_model_list_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_model_list_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_model_list_secrets_proto_goTypes = []any{
 	(*ListSecretPathsRequest)(nil),  // 0: keeper.go.grpc.v1.model.ListSecretPathsRequest
-	(*ListSecretPathsResponse)(nil), // 1: keeper.go.grpc.v1.model.ListSecretPathsResponse
+	(*SharedSecretPath)(nil),        // 1: keeper.go.grpc.v1.model.SharedSecretPath
+	(*ListSecretPathsResponse)(nil), // 2: keeper.go.grpc.v1.model.ListSecretPathsResponse
 }
 var file_model_list_secrets_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: keeper.go.grpc.v1.model.ListSecretPathsResponse.shared:type_name -> keeper.go.grpc.v1.model.SharedSecretPath
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_model_list_secrets_proto_init() }
@@ -191,7 +365,7 @@ func file_model_list_secrets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_list_secrets_proto_rawDesc), len(file_model_list_secrets_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
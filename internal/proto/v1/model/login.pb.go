@@ -26,6 +26,7 @@ type LoginRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Login       *string                `protobuf:"bytes,1,opt,name=login"`
 	xxx_hidden_Password    *string                `protobuf:"bytes,2,opt,name=password"`
+	xxx_hidden_DeviceName  *string                `protobuf:"bytes,3,opt,name=device_name,json=deviceName"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -77,14 +78,29 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDeviceName() string {
+	if x != nil {
+		if x.xxx_hidden_DeviceName != nil {
+			return *x.xxx_hidden_DeviceName
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *LoginRequest) SetLogin(v string) {
 	x.xxx_hidden_Login = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
 func (x *LoginRequest) SetPassword(v string) {
 	x.xxx_hidden_Password = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
+
+func (x *LoginRequest) SetDeviceName(v string) {
+	x.xxx_hidden_DeviceName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
 func (x *LoginRequest) HasLogin() bool {
@@ -101,6 +117,13 @@ func (x *LoginRequest) HasPassword() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *LoginRequest) HasDeviceName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
 func (x *LoginRequest) ClearLogin() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Login = nil
@@ -111,11 +134,17 @@ func (x *LoginRequest) ClearPassword() {
 	x.xxx_hidden_Password = nil
 }
 
+func (x *LoginRequest) ClearDeviceName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_DeviceName = nil
+}
+
 type LoginRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Login    *string
-	Password *string
+	Login      *string
+	Password   *string
+	DeviceName *string
 }
 
 func (b0 LoginRequest_builder) Build() *LoginRequest {
@@ -123,25 +152,32 @@ func (b0 LoginRequest_builder) Build() *LoginRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Login != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
 		x.xxx_hidden_Login = b.Login
 	}
 	if b.Password != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
 		x.xxx_hidden_Password = b.Password
 	}
+	if b.DeviceName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_DeviceName = b.DeviceName
+	}
 	return m0
 }
 
 type LoginResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
-	xxx_hidden_Message     *string                `protobuf:"bytes,2,opt,name=message"`
-	xxx_hidden_Token       *string                `protobuf:"bytes,3,opt,name=token"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	state                        protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Success           bool                   `protobuf:"varint,1,opt,name=success"`
+	xxx_hidden_Message           *string                `protobuf:"bytes,2,opt,name=message"`
+	xxx_hidden_Token             *string                `protobuf:"bytes,3,opt,name=token"`
+	xxx_hidden_RefreshToken      *string                `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken"`
+	xxx_hidden_TwoFactorRequired bool                   `protobuf:"varint,5,opt,name=two_factor_required,json=twoFactorRequired"`
+	xxx_hidden_Challenge         *string                `protobuf:"bytes,6,opt,name=challenge"`
+	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
+	XXX_presence                 [1]uint32
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -196,19 +232,61 @@ func (x *LoginResponse) GetToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		if x.xxx_hidden_RefreshToken != nil {
+			return *x.xxx_hidden_RefreshToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.xxx_hidden_TwoFactorRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetChallenge() string {
+	if x != nil {
+		if x.xxx_hidden_Challenge != nil {
+			return *x.xxx_hidden_Challenge
+		}
+		return ""
+	}
+	return ""
+}
+
 func (x *LoginResponse) SetSuccess(v bool) {
 	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
 }
 
 func (x *LoginResponse) SetMessage(v string) {
 	x.xxx_hidden_Message = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
 }
 
 func (x *LoginResponse) SetToken(v string) {
 	x.xxx_hidden_Token = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *LoginResponse) SetRefreshToken(v string) {
+	x.xxx_hidden_RefreshToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *LoginResponse) SetTwoFactorRequired(v bool) {
+	x.xxx_hidden_TwoFactorRequired = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *LoginResponse) SetChallenge(v string) {
+	x.xxx_hidden_Challenge = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
 }
 
 func (x *LoginResponse) HasSuccess() bool {
@@ -232,6 +310,27 @@ func (x *LoginResponse) HasToken() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
+func (x *LoginResponse) HasRefreshToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *LoginResponse) HasTwoFactorRequired() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *LoginResponse) HasChallenge() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
 func (x *LoginResponse) ClearSuccess() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Success = false
@@ -247,12 +346,32 @@ func (x *LoginResponse) ClearToken() {
 	x.xxx_hidden_Token = nil
 }
 
+func (x *LoginResponse) ClearRefreshToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RefreshToken = nil
+}
+
+func (x *LoginResponse) ClearTwoFactorRequired() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_TwoFactorRequired = false
+}
+
+func (x *LoginResponse) ClearChallenge() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Challenge = nil
+}
+
 type LoginResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success *bool
-	Message *string
-	Token   *string
+	Success      *bool
+	Message      *string
+	Token        *string
+	RefreshToken *string
+	// two_factor_required is set instead of the tokens when the account has
+	// TOTP enabled; challenge is then passed to VerifyTwoFactor with the code.
+	TwoFactorRequired *bool
+	Challenge         *string
 }
 
 func (b0 LoginResponse_builder) Build() *LoginResponse {
@@ -260,17 +379,109 @@ func (b0 LoginResponse_builder) Build() *LoginResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
 		x.xxx_hidden_Success = *b.Success
 	}
 	if b.Message != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
 		x.xxx_hidden_Message = b.Message
 	}
 	if b.Token != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
 		x.xxx_hidden_Token = b.Token
 	}
+	if b.RefreshToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_RefreshToken = b.RefreshToken
+	}
+	if b.TwoFactorRequired != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_TwoFactorRequired = *b.TwoFactorRequired
+	}
+	if b.Challenge != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_Challenge = b.Challenge
+	}
+	return m0
+}
+
+// CertificateLoginRequest logs in as the user bound to the client certificate
+// presented in the TLS handshake.
+type CertificateLoginRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_DeviceName  *string                `protobuf:"bytes,1,opt,name=device_name,json=deviceName"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CertificateLoginRequest) Reset() {
+	*x = CertificateLoginRequest{}
+	mi := &file_model_login_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateLoginRequest) ProtoMessage() {}
+
+func (x *CertificateLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_login_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CertificateLoginRequest) GetDeviceName() string {
+	if x != nil {
+		if x.xxx_hidden_DeviceName != nil {
+			return *x.xxx_hidden_DeviceName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CertificateLoginRequest) SetDeviceName(v string) {
+	x.xxx_hidden_DeviceName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *CertificateLoginRequest) HasDeviceName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CertificateLoginRequest) ClearDeviceName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_DeviceName = nil
+}
+
+type CertificateLoginRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	DeviceName *string
+}
+
+func (b0 CertificateLoginRequest_builder) Build() *CertificateLoginRequest {
+	m0 := &CertificateLoginRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.DeviceName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_DeviceName = b.DeviceName
+	}
 	return m0
 }
 
@@ -278,19 +489,28 @@ var File_model_login_proto protoreflect.FileDescriptor
 
 const file_model_login_proto_rawDesc = "" +
 	"\n" +
-	"\x11model/login.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"@\n" +
+	"\x11model/login.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"a\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"Y\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\"\xcc\x01\n" +
 	"\rLoginResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05token\x18\x03 \x01(\tR\x05tokenB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
-
-var file_model_login_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+	"\x05token\x18\x03 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12.\n" +
+	"\x13two_factor_required\x18\x05 \x01(\bR\x11twoFactorRequired\x12\x1c\n" +
+	"\tchallenge\x18\x06 \x01(\tR\tchallenge\":\n" +
+	"\x17CertificateLoginRequest\x12\x1f\n" +
+	"\vdevice_name\x18\x01 \x01(\tR\n" +
+	"deviceNameB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_model_login_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_model_login_proto_goTypes = []any{
-	(*LoginRequest)(nil),  // 0: keeper.go.grpc.v1.model.LoginRequest
-	(*LoginResponse)(nil), // 1: keeper.go.grpc.v1.model.LoginResponse
+	(*LoginRequest)(nil),            // 0: keeper.go.grpc.v1.model.LoginRequest
+	(*LoginResponse)(nil),           // 1: keeper.go.grpc.v1.model.LoginResponse
+	(*CertificateLoginRequest)(nil), // 2: keeper.go.grpc.v1.model.CertificateLoginRequest
 }
 var file_model_login_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -311,7 +531,7 @@ func file_model_login_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_login_proto_rawDesc), len(file_model_login_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: model/pki.proto
+
+package model
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IssueCertificateRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role        *string                `protobuf:"bytes,1,opt,name=role"`
+	xxx_hidden_CommonName  *string                `protobuf:"bytes,2,opt,name=common_name,json=commonName"`
+	xxx_hidden_AltNames    []string               `protobuf:"bytes,3,rep,name=alt_names,json=altNames"`
+	xxx_hidden_IpSans      []string               `protobuf:"bytes,4,rep,name=ip_sans,json=ipSans"`
+	xxx_hidden_KeyType     *string                `protobuf:"bytes,5,opt,name=key_type,json=keyType"`
+	xxx_hidden_TtlSeconds  int64                  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *IssueCertificateRequest) Reset() {
+	*x = IssueCertificateRequest{}
+	mi := &file_model_pki_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateRequest) ProtoMessage() {}
+
+func (x *IssueCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_pki_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *IssueCertificateRequest) GetRole() string {
+	if x != nil {
+		if x.xxx_hidden_Role != nil {
+			return *x.xxx_hidden_Role
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetCommonName() string {
+	if x != nil {
+		if x.xxx_hidden_CommonName != nil {
+			return *x.xxx_hidden_CommonName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetAltNames() []string {
+	if x != nil {
+		return x.xxx_hidden_AltNames
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetIpSans() []string {
+	if x != nil {
+		return x.xxx_hidden_IpSans
+	}
+	return nil
+}
+
+func (x *IssueCertificateRequest) GetKeyType() string {
+	if x != nil {
+		if x.xxx_hidden_KeyType != nil {
+			return *x.xxx_hidden_KeyType
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.xxx_hidden_TtlSeconds
+	}
+	return 0
+}
+
+func (x *IssueCertificateRequest) SetRole(v string) {
+	x.xxx_hidden_Role = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *IssueCertificateRequest) SetCommonName(v string) {
+	x.xxx_hidden_CommonName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *IssueCertificateRequest) SetAltNames(v []string) {
+	x.xxx_hidden_AltNames = v
+}
+
+func (x *IssueCertificateRequest) SetIpSans(v []string) {
+	x.xxx_hidden_IpSans = v
+}
+
+func (x *IssueCertificateRequest) SetKeyType(v string) {
+	x.xxx_hidden_KeyType = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *IssueCertificateRequest) SetTtlSeconds(v int64) {
+	x.xxx_hidden_TtlSeconds = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+}
+
+func (x *IssueCertificateRequest) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *IssueCertificateRequest) HasCommonName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *IssueCertificateRequest) HasKeyType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *IssueCertificateRequest) HasTtlSeconds() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *IssueCertificateRequest) ClearRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Role = nil
+}
+
+func (x *IssueCertificateRequest) ClearCommonName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_CommonName = nil
+}
+
+func (x *IssueCertificateRequest) ClearKeyType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_KeyType = nil
+}
+
+func (x *IssueCertificateRequest) ClearTtlSeconds() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_TtlSeconds = 0
+}
+
+type IssueCertificateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role       *string
+	CommonName *string
+	AltNames   []string
+	IpSans     []string
+	// Empty uses the first key type the role allows: ec, rsa or ed25519.
+	KeyType *string
+	// Zero uses the default ttl of the role; longer than its max is capped.
+	TtlSeconds *int64
+}
+
+func (b0 IssueCertificateRequest_builder) Build() *IssueCertificateRequest {
+	m0 := &IssueCertificateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Role != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Role = b.Role
+	}
+	if b.CommonName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_CommonName = b.CommonName
+	}
+	x.xxx_hidden_AltNames = b.AltNames
+	x.xxx_hidden_IpSans = b.IpSans
+	if b.KeyType != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_KeyType = b.KeyType
+	}
+	if b.TtlSeconds != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		x.xxx_hidden_TtlSeconds = *b.TtlSeconds
+	}
+	return m0
+}
+
+type IssueCertificateResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Serial      *string                `protobuf:"bytes,1,opt,name=serial"`
+	xxx_hidden_Certificate *string                `protobuf:"bytes,2,opt,name=certificate"`
+	xxx_hidden_PrivateKey  *string                `protobuf:"bytes,3,opt,name=private_key,json=privateKey"`
+	xxx_hidden_IssuingCa   *string                `protobuf:"bytes,4,opt,name=issuing_ca,json=issuingCa"`
+	xxx_hidden_Chain       *string                `protobuf:"bytes,5,opt,name=chain"`
+	xxx_hidden_ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *IssueCertificateResponse) Reset() {
+	*x = IssueCertificateResponse{}
+	mi := &file_model_pki_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCertificateResponse) ProtoMessage() {}
+
+func (x *IssueCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_pki_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *IssueCertificateResponse) GetSerial() string {
+	if x != nil {
+		if x.xxx_hidden_Serial != nil {
+			return *x.xxx_hidden_Serial
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetCertificate() string {
+	if x != nil {
+		if x.xxx_hidden_Certificate != nil {
+			return *x.xxx_hidden_Certificate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetPrivateKey() string {
+	if x != nil {
+		if x.xxx_hidden_PrivateKey != nil {
+			return *x.xxx_hidden_PrivateKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetIssuingCa() string {
+	if x != nil {
+		if x.xxx_hidden_IssuingCa != nil {
+			return *x.xxx_hidden_IssuingCa
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetChain() string {
+	if x != nil {
+		if x.xxx_hidden_Chain != nil {
+			return *x.xxx_hidden_Chain
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *IssueCertificateResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *IssueCertificateResponse) SetSerial(v string) {
+	x.xxx_hidden_Serial = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+}
+
+func (x *IssueCertificateResponse) SetCertificate(v string) {
+	x.xxx_hidden_Certificate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+}
+
+func (x *IssueCertificateResponse) SetPrivateKey(v string) {
+	x.xxx_hidden_PrivateKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+}
+
+func (x *IssueCertificateResponse) SetIssuingCa(v string) {
+	x.xxx_hidden_IssuingCa = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
+
+func (x *IssueCertificateResponse) SetChain(v string) {
+	x.xxx_hidden_Chain = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+}
+
+func (x *IssueCertificateResponse) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *IssueCertificateResponse) HasSerial() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *IssueCertificateResponse) HasCertificate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *IssueCertificateResponse) HasPrivateKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *IssueCertificateResponse) HasIssuingCa() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *IssueCertificateResponse) HasChain() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *IssueCertificateResponse) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *IssueCertificateResponse) ClearSerial() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Serial = nil
+}
+
+func (x *IssueCertificateResponse) ClearCertificate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Certificate = nil
+}
+
+func (x *IssueCertificateResponse) ClearPrivateKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_PrivateKey = nil
+}
+
+func (x *IssueCertificateResponse) ClearIssuingCa() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_IssuingCa = nil
+}
+
+func (x *IssueCertificateResponse) ClearChain() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Chain = nil
+}
+
+func (x *IssueCertificateResponse) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type IssueCertificateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Serial      *string
+	Certificate *string
+	PrivateKey  *string
+	IssuingCa   *string
+	Chain       *string
+	ExpiresAt   *timestamppb.Timestamp
+}
+
+func (b0 IssueCertificateResponse_builder) Build() *IssueCertificateResponse {
+	m0 := &IssueCertificateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Serial != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Serial = b.Serial
+	}
+	if b.Certificate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_Certificate = b.Certificate
+	}
+	if b.PrivateKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_PrivateKey = b.PrivateKey
+	}
+	if b.IssuingCa != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_IssuingCa = b.IssuingCa
+	}
+	if b.Chain != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		x.xxx_hidden_Chain = b.Chain
+	}
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type RevokeCertificateRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Serial      *string                `protobuf:"bytes,1,opt,name=serial"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateRequest) Reset() {
+	*x = RevokeCertificateRequest{}
+	mi := &file_model_pki_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateRequest) ProtoMessage() {}
+
+func (x *RevokeCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_pki_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeCertificateRequest) GetSerial() string {
+	if x != nil {
+		if x.xxx_hidden_Serial != nil {
+			return *x.xxx_hidden_Serial
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RevokeCertificateRequest) SetSerial(v string) {
+	x.xxx_hidden_Serial = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *RevokeCertificateRequest) HasSerial() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RevokeCertificateRequest) ClearSerial() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Serial = nil
+}
+
+type RevokeCertificateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Serial *string
+}
+
+func (b0 RevokeCertificateRequest_builder) Build() *RevokeCertificateRequest {
+	m0 := &RevokeCertificateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Serial != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Serial = b.Serial
+	}
+	return m0
+}
+
+type RevokeCertificateResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Message     *string                `protobuf:"bytes,1,opt,name=message"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RevokeCertificateResponse) Reset() {
+	*x = RevokeCertificateResponse{}
+	mi := &file_model_pki_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertificateResponse) ProtoMessage() {}
+
+func (x *RevokeCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_pki_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *RevokeCertificateResponse) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *RevokeCertificateResponse) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *RevokeCertificateResponse) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *RevokeCertificateResponse) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Message = nil
+}
+
+type RevokeCertificateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Message *string
+}
+
+func (b0 RevokeCertificateResponse_builder) Build() *RevokeCertificateResponse {
+	m0 := &RevokeCertificateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Message = b.Message
+	}
+	return m0
+}
+
+var File_model_pki_proto protoreflect.FileDescriptor
+
+const file_model_pki_proto_rawDesc = "" +
+	"\n" +
+	"\x0fmodel/pki.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"\xc0\x01\n" +
+	"\x17IssueCertificateRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1f\n" +
+	"\vcommon_name\x18\x02 \x01(\tR\n" +
+	"commonName\x12\x1b\n" +
+	"\talt_names\x18\x03 \x03(\tR\baltNames\x12\x17\n" +
+	"\aip_sans\x18\x04 \x03(\tR\x06ipSans\x12\x19\n" +
+	"\bkey_type\x18\x05 \x01(\tR\akeyType\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x03R\n" +
+	"ttlSeconds\"\xe5\x01\n" +
+	"\x18IssueCertificateResponse\x12\x16\n" +
+	"\x06serial\x18\x01 \x01(\tR\x06serial\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\tR\vcertificate\x12\x1f\n" +
+	"\vprivate_key\x18\x03 \x01(\tR\n" +
+	"privateKey\x12\x1d\n" +
+	"\n" +
+	"issuing_ca\x18\x04 \x01(\tR\tissuingCa\x12\x14\n" +
+	"\x05chain\x18\x05 \x01(\tR\x05chain\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"2\n" +
+	"\x18RevokeCertificateRequest\x12\x16\n" +
+	"\x06serial\x18\x01 \x01(\tR\x06serial\"5\n" +
+	"\x19RevokeCertificateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessageB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_model_pki_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_model_pki_proto_goTypes = []any{
+	(*IssueCertificateRequest)(nil),   // 0: keeper.go.grpc.v1.model.IssueCertificateRequest
+	(*IssueCertificateResponse)(nil),  // 1: keeper.go.grpc.v1.model.IssueCertificateResponse
+	(*RevokeCertificateRequest)(nil),  // 2: keeper.go.grpc.v1.model.RevokeCertificateRequest
+	(*RevokeCertificateResponse)(nil), // 3: keeper.go.grpc.v1.model.RevokeCertificateResponse
+	(*timestamppb.Timestamp)(nil),     // 4: google.protobuf.Timestamp
+}
+var file_model_pki_proto_depIdxs = []int32{
+	4, // 0: keeper.go.grpc.v1.model.IssueCertificateResponse.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_model_pki_proto_init() }
+func file_model_pki_proto_init() {
+	if File_model_pki_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_pki_proto_rawDesc), len(file_model_pki_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_model_pki_proto_goTypes,
+		DependencyIndexes: file_model_pki_proto_depIdxs,
+		MessageInfos:      file_model_pki_proto_msgTypes,
+	}.Build()
+	File_model_pki_proto = out.File
+	file_model_pki_proto_goTypes = nil
+	file_model_pki_proto_depIdxs = nil
+}
@@ -31,6 +31,8 @@ type WriteSecret struct {
 	xxx_hidden_Description *string                `protobuf:"bytes,4,opt,name=description"`
 	xxx_hidden_Value       []byte                 `protobuf:"bytes,5,opt,name=value"`
 	xxx_hidden_FilePath    *string                `protobuf:"bytes,6,opt,name=file_path,json=filePath"`
+	xxx_hidden_Owner       *string                `protobuf:"bytes,7,opt,name=owner"`
+	xxx_hidden_Cas         int64                  `protobuf:"varint,8,opt,name=cas"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -62,6 +64,7 @@ func (x *WriteSecret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Marked as deprecated in model/secret.proto.
 func (x *WriteSecret) GetToken() string {
 	if x != nil {
 		if x.xxx_hidden_Token != nil {
@@ -116,14 +119,32 @@ func (x *WriteSecret) GetFilePath() string {
 	return ""
 }
 
+func (x *WriteSecret) GetOwner() string {
+	if x != nil {
+		if x.xxx_hidden_Owner != nil {
+			return *x.xxx_hidden_Owner
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *WriteSecret) GetCas() int64 {
+	if x != nil {
+		return x.xxx_hidden_Cas
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in model/secret.proto.
 func (x *WriteSecret) SetToken(v string) {
 	x.xxx_hidden_Token = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
 }
 
 func (x *WriteSecret) SetPath(v string) {
 	x.xxx_hidden_Path = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
 }
 
 func (x *WriteSecret) SetExpiredAt(v *timestamppb.Timestamp) {
@@ -132,7 +153,7 @@ func (x *WriteSecret) SetExpiredAt(v *timestamppb.Timestamp) {
 
 func (x *WriteSecret) SetDescription(v string) {
 	x.xxx_hidden_Description = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
 }
 
 func (x *WriteSecret) SetValue(v []byte) {
@@ -140,14 +161,25 @@ func (x *WriteSecret) SetValue(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
 }
 
 func (x *WriteSecret) SetFilePath(v string) {
 	x.xxx_hidden_FilePath = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *WriteSecret) SetOwner(v string) {
+	x.xxx_hidden_Owner = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
 }
 
+func (x *WriteSecret) SetCas(v int64) {
+	x.xxx_hidden_Cas = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+}
+
+// Deprecated: Marked as deprecated in model/secret.proto.
 func (x *WriteSecret) HasToken() bool {
 	if x == nil {
 		return false
@@ -190,6 +222,21 @@ func (x *WriteSecret) HasFilePath() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
 }
 
+func (x *WriteSecret) HasOwner() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *WriteSecret) HasCas() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+// Deprecated: Marked as deprecated in model/secret.proto.
 func (x *WriteSecret) ClearToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Token = nil
@@ -219,15 +266,34 @@ func (x *WriteSecret) ClearFilePath() {
 	x.xxx_hidden_FilePath = nil
 }
 
+func (x *WriteSecret) ClearOwner() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Owner = nil
+}
+
+func (x *WriteSecret) ClearCas() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Cas = 0
+}
+
 type WriteSecret_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Token       *string
-	Path        *string
+	// Deprecated: send the token in the authorization metadata instead.
+	//
+	// Deprecated: Marked as deprecated in model/secret.proto.
+	Token *string
+	Path  *string
+	// A write that sets neither expired_at nor description keeps the current
+	// ones of the path.
 	ExpiredAt   *timestamppb.Timestamp
 	Description *string
 	Value       []byte
 	FilePath    *string
+	Owner       *string
+	// Check-and-set: when present the write fails unless the latest version of
+	// the path is cas; 0 requires that the path holds no versions yet.
+	Cas *int64
 }
 
 func (b0 WriteSecret_builder) Build() *WriteSecret {
@@ -235,26 +301,34 @@ func (b0 WriteSecret_builder) Build() *WriteSecret {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Token != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
 		x.xxx_hidden_Token = b.Token
 	}
 	if b.Path != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
 		x.xxx_hidden_Path = b.Path
 	}
 	x.xxx_hidden_ExpiredAt = b.ExpiredAt
 	if b.Description != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
 		x.xxx_hidden_Description = b.Description
 	}
 	if b.Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
 		x.xxx_hidden_Value = b.Value
 	}
 	if b.FilePath != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
 		x.xxx_hidden_FilePath = b.FilePath
 	}
+	if b.Owner != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_Owner = b.Owner
+	}
+	if b.Cas != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Cas = *b.Cas
+	}
 	return m0
 }
 
@@ -262,6 +336,8 @@ type SaveSecretResponse struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
 	xxx_hidden_Message     *string                `protobuf:"bytes,2,opt,name=message"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,3,opt,name=version"`
+	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
@@ -310,14 +386,37 @@ func (x *SaveSecretResponse) GetMessage() string {
 	return ""
 }
 
+func (x *SaveSecretResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *SaveSecretResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
 func (x *SaveSecretResponse) SetSuccess(v bool) {
 	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
 func (x *SaveSecretResponse) SetMessage(v string) {
 	x.xxx_hidden_Message = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *SaveSecretResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *SaveSecretResponse) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
 }
 
 func (x *SaveSecretResponse) HasSuccess() bool {
@@ -334,6 +433,20 @@ func (x *SaveSecretResponse) HasMessage() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *SaveSecretResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SaveSecretResponse) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
 func (x *SaveSecretResponse) ClearSuccess() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Success = false
@@ -344,11 +457,22 @@ func (x *SaveSecretResponse) ClearMessage() {
 	x.xxx_hidden_Message = nil
 }
 
+func (x *SaveSecretResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Version = 0
+}
+
+func (x *SaveSecretResponse) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
 type SaveSecretResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success *bool
-	Message *string
+	Success   *bool
+	Message   *string
+	Version   *int64
+	CreatedAt *timestamppb.Timestamp
 }
 
 func (b0 SaveSecretResponse_builder) Build() *SaveSecretResponse {
@@ -356,13 +480,18 @@ func (b0 SaveSecretResponse_builder) Build() *SaveSecretResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_Success = *b.Success
 	}
 	if b.Message != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_Message = b.Message
 	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Version = *b.Version
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
 	return m0
 }
 
@@ -652,18 +781,23 @@ var File_model_secret_proto protoreflect.FileDescriptor
 
 const file_model_secret_proto_rawDesc = "" +
 	"\n" +
-	"\x12model/secret.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"\xc7\x01\n" +
-	"\vWriteSecret\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x12model/secret.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"\xf3\x01\n" +
+	"\vWriteSecret\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x129\n" +
 	"\n" +
 	"expired_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05value\x18\x05 \x01(\fR\x05value\x12\x1b\n" +
-	"\tfile_path\x18\x06 \x01(\tR\bfilePath\"H\n" +
+	"\tfile_path\x18\x06 \x01(\tR\bfilePath\x12\x14\n" +
+	"\x05owner\x18\a \x01(\tR\x05owner\x12\x10\n" +
+	"\x03cas\x18\b \x01(\x03R\x03cas\"\x9d\x01\n" +
 	"\x12SaveSecretResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xc4\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc4\x02\n" +
 	"\x0eSecretResponse\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x129\n" +
 	"\n" +
@@ -686,14 +820,15 @@ var file_model_secret_proto_goTypes = []any{
 }
 var file_model_secret_proto_depIdxs = []int32{
 	3, // 0: keeper.go.grpc.v1.model.WriteSecret.expired_at:type_name -> google.protobuf.Timestamp
-	3, // 1: keeper.go.grpc.v1.model.SecretResponse.expired_at:type_name -> google.protobuf.Timestamp
-	3, // 2: keeper.go.grpc.v1.model.SecretResponse.deleted_at:type_name -> google.protobuf.Timestamp
-	3, // 3: keeper.go.grpc.v1.model.SecretResponse.created_at:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 1: keeper.go.grpc.v1.model.SaveSecretResponse.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: keeper.go.grpc.v1.model.SecretResponse.expired_at:type_name -> google.protobuf.Timestamp
+	3, // 3: keeper.go.grpc.v1.model.SecretResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	3, // 4: keeper.go.grpc.v1.model.SecretResponse.created_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_model_secret_proto_init() }
@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: model/ssh.proto
+
+package model
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/gofeaturespb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SignSSHKeyRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Role        *string                `protobuf:"bytes,1,opt,name=role"`
+	xxx_hidden_PublicKey   *string                `protobuf:"bytes,2,opt,name=public_key,json=publicKey"`
+	xxx_hidden_Principals  []string               `protobuf:"bytes,3,rep,name=principals"`
+	xxx_hidden_TtlSeconds  int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SignSSHKeyRequest) Reset() {
+	*x = SignSSHKeyRequest{}
+	mi := &file_model_ssh_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSSHKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSSHKeyRequest) ProtoMessage() {}
+
+func (x *SignSSHKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_ssh_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignSSHKeyRequest) GetRole() string {
+	if x != nil {
+		if x.xxx_hidden_Role != nil {
+			return *x.xxx_hidden_Role
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SignSSHKeyRequest) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SignSSHKeyRequest) GetPrincipals() []string {
+	if x != nil {
+		return x.xxx_hidden_Principals
+	}
+	return nil
+}
+
+func (x *SignSSHKeyRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.xxx_hidden_TtlSeconds
+	}
+	return 0
+}
+
+func (x *SignSSHKeyRequest) SetRole(v string) {
+	x.xxx_hidden_Role = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *SignSSHKeyRequest) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *SignSSHKeyRequest) SetPrincipals(v []string) {
+	x.xxx_hidden_Principals = v
+}
+
+func (x *SignSSHKeyRequest) SetTtlSeconds(v int64) {
+	x.xxx_hidden_TtlSeconds = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *SignSSHKeyRequest) HasRole() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SignSSHKeyRequest) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SignSSHKeyRequest) HasTtlSeconds() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *SignSSHKeyRequest) ClearRole() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Role = nil
+}
+
+func (x *SignSSHKeyRequest) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_PublicKey = nil
+}
+
+func (x *SignSSHKeyRequest) ClearTtlSeconds() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_TtlSeconds = 0
+}
+
+type SignSSHKeyRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Role *string
+	// In authorized_keys format, as in ~/.ssh/id_ed25519.pub.
+	PublicKey *string
+	// Empty uses the default principals of the role.
+	Principals []string
+	// Zero uses the default ttl of the role; longer than its max is capped.
+	TtlSeconds *int64
+}
+
+func (b0 SignSSHKeyRequest_builder) Build() *SignSSHKeyRequest {
+	m0 := &SignSSHKeyRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Role != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Role = b.Role
+	}
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	x.xxx_hidden_Principals = b.Principals
+	if b.TtlSeconds != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_TtlSeconds = *b.TtlSeconds
+	}
+	return m0
+}
+
+type SignSSHKeyResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Certificate *string                `protobuf:"bytes,1,opt,name=certificate"`
+	xxx_hidden_Serial      uint64                 `protobuf:"varint,2,opt,name=serial"`
+	xxx_hidden_KeyId       *string                `protobuf:"bytes,3,opt,name=key_id,json=keyId"`
+	xxx_hidden_Principals  []string               `protobuf:"bytes,4,rep,name=principals"`
+	xxx_hidden_ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SignSSHKeyResponse) Reset() {
+	*x = SignSSHKeyResponse{}
+	mi := &file_model_ssh_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSSHKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSSHKeyResponse) ProtoMessage() {}
+
+func (x *SignSSHKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_ssh_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *SignSSHKeyResponse) GetCertificate() string {
+	if x != nil {
+		if x.xxx_hidden_Certificate != nil {
+			return *x.xxx_hidden_Certificate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SignSSHKeyResponse) GetSerial() uint64 {
+	if x != nil {
+		return x.xxx_hidden_Serial
+	}
+	return 0
+}
+
+func (x *SignSSHKeyResponse) GetKeyId() string {
+	if x != nil {
+		if x.xxx_hidden_KeyId != nil {
+			return *x.xxx_hidden_KeyId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *SignSSHKeyResponse) GetPrincipals() []string {
+	if x != nil {
+		return x.xxx_hidden_Principals
+	}
+	return nil
+}
+
+func (x *SignSSHKeyResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ExpiresAt
+	}
+	return nil
+}
+
+func (x *SignSSHKeyResponse) SetCertificate(v string) {
+	x.xxx_hidden_Certificate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
+}
+
+func (x *SignSSHKeyResponse) SetSerial(v uint64) {
+	x.xxx_hidden_Serial = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
+}
+
+func (x *SignSSHKeyResponse) SetKeyId(v string) {
+	x.xxx_hidden_KeyId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
+}
+
+func (x *SignSSHKeyResponse) SetPrincipals(v []string) {
+	x.xxx_hidden_Principals = v
+}
+
+func (x *SignSSHKeyResponse) SetExpiresAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ExpiresAt = v
+}
+
+func (x *SignSSHKeyResponse) HasCertificate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SignSSHKeyResponse) HasSerial() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SignSSHKeyResponse) HasKeyId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SignSSHKeyResponse) HasExpiresAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ExpiresAt != nil
+}
+
+func (x *SignSSHKeyResponse) ClearCertificate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Certificate = nil
+}
+
+func (x *SignSSHKeyResponse) ClearSerial() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Serial = 0
+}
+
+func (x *SignSSHKeyResponse) ClearKeyId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_KeyId = nil
+}
+
+func (x *SignSSHKeyResponse) ClearExpiresAt() {
+	x.xxx_hidden_ExpiresAt = nil
+}
+
+type SignSSHKeyResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Certificate *string
+	Serial      *uint64
+	KeyId       *string
+	Principals  []string
+	ExpiresAt   *timestamppb.Timestamp
+}
+
+func (b0 SignSSHKeyResponse_builder) Build() *SignSSHKeyResponse {
+	m0 := &SignSSHKeyResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Certificate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Certificate = b.Certificate
+	}
+	if b.Serial != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Serial = *b.Serial
+	}
+	if b.KeyId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_KeyId = b.KeyId
+	}
+	x.xxx_hidden_Principals = b.Principals
+	x.xxx_hidden_ExpiresAt = b.ExpiresAt
+	return m0
+}
+
+type GetSSHCARequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSSHCARequest) Reset() {
+	*x = GetSSHCARequest{}
+	mi := &file_model_ssh_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSHCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSHCARequest) ProtoMessage() {}
+
+func (x *GetSSHCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_model_ssh_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type GetSSHCARequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 GetSSHCARequest_builder) Build() *GetSSHCARequest {
+	m0 := &GetSSHCARequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type GetSSHCAResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PublicKey   *string                `protobuf:"bytes,1,opt,name=public_key,json=publicKey"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetSSHCAResponse) Reset() {
+	*x = GetSSHCAResponse{}
+	mi := &file_model_ssh_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSSHCAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSHCAResponse) ProtoMessage() {}
+
+func (x *GetSSHCAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_model_ssh_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetSSHCAResponse) GetPublicKey() string {
+	if x != nil {
+		if x.xxx_hidden_PublicKey != nil {
+			return *x.xxx_hidden_PublicKey
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetSSHCAResponse) SetPublicKey(v string) {
+	x.xxx_hidden_PublicKey = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetSSHCAResponse) HasPublicKey() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetSSHCAResponse) ClearPublicKey() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_PublicKey = nil
+}
+
+type GetSSHCAResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	PublicKey *string
+}
+
+func (b0 GetSSHCAResponse_builder) Build() *GetSSHCAResponse {
+	m0 := &GetSSHCAResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.PublicKey != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_PublicKey = b.PublicKey
+	}
+	return m0
+}
+
+var File_model_ssh_proto protoreflect.FileDescriptor
+
+const file_model_ssh_proto_rawDesc = "" +
+	"\n" +
+	"\x0fmodel/ssh.proto\x12\x17keeper.go.grpc.v1.model\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"\x87\x01\n" +
+	"\x11SignSSHKeyRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1e\n" +
+	"\n" +
+	"principals\x18\x03 \x03(\tR\n" +
+	"principals\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"\xc0\x01\n" +
+	"\x12SignSSHKeyResponse\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\tR\vcertificate\x12\x16\n" +
+	"\x06serial\x18\x02 \x01(\x04R\x06serial\x12\x15\n" +
+	"\x06key_id\x18\x03 \x01(\tR\x05keyId\x12\x1e\n" +
+	"\n" +
+	"principals\x18\x04 \x03(\tR\n" +
+	"principals\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x11\n" +
+	"\x0fGetSSHCARequest\"1\n" +
+	"\x10GetSSHCAResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKeyB(Z\x1ekeeper/internal/proto/v1/model\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_model_ssh_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_model_ssh_proto_goTypes = []any{
+	(*SignSSHKeyRequest)(nil),     // 0: keeper.go.grpc.v1.model.SignSSHKeyRequest
+	(*SignSSHKeyResponse)(nil),    // 1: keeper.go.grpc.v1.model.SignSSHKeyResponse
+	(*GetSSHCARequest)(nil),       // 2: keeper.go.grpc.v1.model.GetSSHCARequest
+	(*GetSSHCAResponse)(nil),      // 3: keeper.go.grpc.v1.model.GetSSHCAResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_model_ssh_proto_depIdxs = []int32{
+	4, // 0: keeper.go.grpc.v1.model.SignSSHKeyResponse.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_model_ssh_proto_init() }
+func file_model_ssh_proto_init() {
+	if File_model_ssh_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_model_ssh_proto_rawDesc), len(file_model_ssh_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_model_ssh_proto_goTypes,
+		DependencyIndexes: file_model_ssh_proto_depIdxs,
+		MessageInfos:      file_model_ssh_proto_msgTypes,
+	}.Build()
+	File_model_ssh_proto = out.File
+	file_model_ssh_proto_goTypes = nil
+	file_model_ssh_proto_depIdxs = nil
+}
@@ -60,6 +60,7 @@ func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Marked as deprecated in model/upload.proto.
 func (x *UploadFileRequest) GetToken() string {
 	if x != nil {
 		if x.xxx_hidden_Token != nil {
@@ -107,6 +108,7 @@ func (x *UploadFileRequest) GetData() []byte {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in model/upload.proto.
 func (x *UploadFileRequest) SetToken(v string) {
 	x.xxx_hidden_Token = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
@@ -135,6 +137,7 @@ func (x *UploadFileRequest) SetData(v []byte) {
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
 }
 
+// Deprecated: Marked as deprecated in model/upload.proto.
 func (x *UploadFileRequest) HasToken() bool {
 	if x == nil {
 		return false
@@ -170,6 +173,7 @@ func (x *UploadFileRequest) HasData() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
+// Deprecated: Marked as deprecated in model/upload.proto.
 func (x *UploadFileRequest) ClearToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Token = nil
@@ -198,6 +202,9 @@ func (x *UploadFileRequest) ClearData() {
 type UploadFileRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Deprecated: send the token in the authorization metadata instead.
+	//
+	// Deprecated: Marked as deprecated in model/upload.proto.
 	Token    *string
 	Path     *string
 	Name     *string
@@ -314,9 +321,9 @@ var File_model_upload_proto protoreflect.FileDescriptor
 
 const file_model_upload_proto_rawDesc = "" +
 	"\n" +
-	"\x12model/upload.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"\x82\x01\n" +
-	"\x11UploadFileRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x12model/upload.proto\x12\x17keeper.go.grpc.v1.model\x1a!google/protobuf/go_features.proto\"\x86\x01\n" +
+	"\x11UploadFileRequest\x12\x18\n" +
+	"\x05token\x18\x01 \x01(\tB\x02\x18\x01R\x05token\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
 	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12\x12\n" +
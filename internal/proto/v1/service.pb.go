@@ -27,10 +27,21 @@ var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x12\x11keeper.go.grpc.v1\x1a\x14model/register.proto\x1a\x11model/login.proto\x1a!google/protobuf/go_features.proto\x1a\x12model/secret.proto\x1a\x16model/get_secret.proto\x1a\x19model/delete_secret.proto\x1a\x18model/list_secrets.proto\x1a\x12model/upload.proto2\xc6\x01\n" +
+	"\rservice.proto\x12\x11keeper.go.grpc.v1\x1a\x14model/register.proto\x1a\x11model/login.proto\x1a\x13model/session.proto\x1a\x16model/two_factor.proto\x1a\x14model/password.proto\x1a!google/protobuf/go_features.proto\x1a\x12model/secret.proto\x1a\x16model/get_secret.proto\x1a\x19model/delete_secret.proto\x1a\x18model/list_secrets.proto\x1a\x11model/grant.proto\x1a\x13model/changes.proto\x1a\x12model/upload.proto\x1a\x10model/team.proto\x1a\x1bmodel/service_account.proto\x1a\x10model/wrap.proto\x1a\x14model/database.proto\x1a\x13model/transit.proto\x1a\x0fmodel/pki.proto\x1a\x0fmodel/ssh.proto2\x88\t\n" +
 	"\vAuthService\x12_\n" +
 	"\bRegister\x12(.keeper.go.grpc.v1.model.RegisterRequest\x1a).keeper.go.grpc.v1.model.RegisterResponse\x12V\n" +
-	"\x05Login\x12%.keeper.go.grpc.v1.model.LoginRequest\x1a&.keeper.go.grpc.v1.model.LoginResponse2\xfc\x05\n" +
+	"\x05Login\x12%.keeper.go.grpc.v1.model.LoginRequest\x1a&.keeper.go.grpc.v1.model.LoginResponse\x12k\n" +
+	"\fRefreshToken\x12,.keeper.go.grpc.v1.model.RefreshTokenRequest\x1a-.keeper.go.grpc.v1.model.RefreshTokenResponse\x12Z\n" +
+	"\x06Logout\x12&.keeper.go.grpc.v1.model.LogoutRequest\x1a(.keeper.go.grpc.v1.model.SessionResponse\x12k\n" +
+	"\fListSessions\x12,.keeper.go.grpc.v1.model.ListSessionsRequest\x1a-.keeper.go.grpc.v1.model.ListSessionsResponse\x12h\n" +
+	"\rRevokeSession\x12-.keeper.go.grpc.v1.model.RevokeSessionRequest\x1a(.keeper.go.grpc.v1.model.SessionResponse\x12e\n" +
+	"\n" +
+	"EnrollTOTP\x12*.keeper.go.grpc.v1.model.EnrollTOTPRequest\x1a+.keeper.go.grpc.v1.model.EnrollTOTPResponse\x12h\n" +
+	"\vConfirmTOTP\x12+.keeper.go.grpc.v1.model.ConfirmTOTPRequest\x1a,.keeper.go.grpc.v1.model.ConfirmTOTPResponse\x12j\n" +
+	"\x0fVerifyTwoFactor\x12/.keeper.go.grpc.v1.model.VerifyTwoFactorRequest\x1a&.keeper.go.grpc.v1.model.LoginResponse\x12q\n" +
+	"\x0eChangePassword\x12..keeper.go.grpc.v1.model.ChangePasswordRequest\x1a/.keeper.go.grpc.v1.model.ChangePasswordResponse\x12p\n" +
+	"\x14LoginWithCertificate\x120.keeper.go.grpc.v1.model.CertificateLoginRequest\x1a&.keeper.go.grpc.v1.model.LoginResponse2\xa2\n" +
+	"\n" +
 	"\vDataService\x12_\n" +
 	"\tGetSecret\x12).keeper.go.grpc.v1.model.GetSecretRequest\x1a'.keeper.go.grpc.v1.model.SecretResponse\x12p\n" +
 	"\vListSecrets\x12/.keeper.go.grpc.v1.model.ListSecretPathsRequest\x1a0.keeper.go.grpc.v1.model.ListSecretPathsResponse\x12_\n" +
@@ -39,51 +50,262 @@ const file_service_proto_rawDesc = "" +
 	"\fDeleteSecret\x12,.keeper.go.grpc.v1.model.DeleteSecretRequest\x1a-.keeper.go.grpc.v1.model.DeleteSecretResponse\x12l\n" +
 	"\rDestroySecret\x12,.keeper.go.grpc.v1.model.DeleteSecretRequest\x1a-.keeper.go.grpc.v1.model.DeleteSecretResponse\x12m\n" +
 	"\x0eDeleteMetadata\x12,.keeper.go.grpc.v1.model.DeleteSecretRequest\x1a-.keeper.go.grpc.v1.model.DeleteSecretResponse\x12o\n" +
-	"\x0eUndeleteSecret\x12..keeper.go.grpc.v1.model.UndeleteSecretRequest\x1a-.keeper.go.grpc.v1.model.DeleteSecretResponse2t\n" +
+	"\x0eUndeleteSecret\x12..keeper.go.grpc.v1.model.UndeleteSecretRequest\x1a-.keeper.go.grpc.v1.model.DeleteSecretResponse\x12b\n" +
+	"\vGrantAccess\x12+.keeper.go.grpc.v1.model.GrantAccessRequest\x1a&.keeper.go.grpc.v1.model.GrantResponse\x12d\n" +
+	"\fRevokeAccess\x12,.keeper.go.grpc.v1.model.RevokeAccessRequest\x1a&.keeper.go.grpc.v1.model.GrantResponse\x12e\n" +
+	"\n" +
+	"ListGrants\x12*.keeper.go.grpc.v1.model.ListGrantsRequest\x1a+.keeper.go.grpc.v1.model.ListGrantsResponse\x12}\n" +
+	"\x12ListSecretVersions\x122.keeper.go.grpc.v1.model.ListSecretVersionsRequest\x1a3.keeper.go.grpc.v1.model.ListSecretVersionsResponse\x12t\n" +
+	"\x0fGetChangesSince\x12/.keeper.go.grpc.v1.model.GetChangesSinceRequest\x1a0.keeper.go.grpc.v1.model.GetChangesSinceResponse2t\n" +
 	"\vFileService\x12e\n" +
 	"\n" +
-	"UploadFile\x12*.keeper.go.grpc.v1.model.UploadFileRequest\x1a+.keeper.go.grpc.v1.model.UploadFileResponseB\"Z\x18keeper/internal/proto/v1\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+	"UploadFile\x12*.keeper.go.grpc.v1.model.UploadFileRequest\x1a+.keeper.go.grpc.v1.model.UploadFileResponse2\xd1\x04\n" +
+	"\vTeamService\x12Y\n" +
+	"\n" +
+	"CreateTeam\x12$.keeper.go.grpc.v1.model.TeamRequest\x1a%.keeper.go.grpc.v1.model.TeamResponse\x12Y\n" +
+	"\n" +
+	"DeleteTeam\x12$.keeper.go.grpc.v1.model.TeamRequest\x1a%.keeper.go.grpc.v1.model.TeamResponse\x12^\n" +
+	"\tAddMember\x12*.keeper.go.grpc.v1.model.TeamMemberRequest\x1a%.keeper.go.grpc.v1.model.TeamResponse\x12a\n" +
+	"\fRemoveMember\x12*.keeper.go.grpc.v1.model.TeamMemberRequest\x1a%.keeper.go.grpc.v1.model.TeamResponse\x12b\n" +
+	"\tListTeams\x12).keeper.go.grpc.v1.model.ListTeamsRequest\x1a*.keeper.go.grpc.v1.model.ListTeamsResponse\x12e\n" +
+	"\vListMembers\x12$.keeper.go.grpc.v1.model.TeamRequest\x1a0.keeper.go.grpc.v1.model.ListTeamMembersResponse2\xd6\x05\n" +
+	"\x15ServiceAccountService\x12u\n" +
+	"\x14CreateServiceAccount\x124.keeper.go.grpc.v1.model.CreateServiceAccountRequest\x1a'.keeper.go.grpc.v1.model.ServiceAccount\x12\x80\x01\n" +
+	"\x13ListServiceAccounts\x123.keeper.go.grpc.v1.model.ListServiceAccountsRequest\x1a4.keeper.go.grpc.v1.model.ListServiceAccountsResponse\x12}\n" +
+	"\x14DeleteServiceAccount\x124.keeper.go.grpc.v1.model.DeleteServiceAccountRequest\x1a/.keeper.go.grpc.v1.model.ServiceAccountResponse\x12k\n" +
+	"\fCreateAPIKey\x12,.keeper.go.grpc.v1.model.CreateAPIKeyRequest\x1a-.keeper.go.grpc.v1.model.CreateAPIKeyResponse\x12h\n" +
+	"\vListAPIKeys\x12+.keeper.go.grpc.v1.model.ListAPIKeysRequest\x1a,.keeper.go.grpc.v1.model.ListAPIKeysResponse\x12m\n" +
+	"\fRevokeAPIKey\x12,.keeper.go.grpc.v1.model.RevokeAPIKeyRequest\x1a/.keeper.go.grpc.v1.model.ServiceAccountResponse2\xbd\x01\n" +
+	"\vWrapService\x12S\n" +
+	"\x04Wrap\x12$.keeper.go.grpc.v1.model.WrapRequest\x1a%.keeper.go.grpc.v1.model.WrapResponse\x12Y\n" +
+	"\x06Unwrap\x12&.keeper.go.grpc.v1.model.UnwrapRequest\x1a'.keeper.go.grpc.v1.model.UnwrapResponse2\xdc\x02\n" +
+	"\x0fDatabaseService\x12x\n" +
+	"\x13GenerateCredentials\x123.keeper.go.grpc.v1.model.GenerateCredentialsRequest\x1a,.keeper.go.grpc.v1.model.DatabaseCredentials\x12e\n" +
+	"\n" +
+	"ListLeases\x12*.keeper.go.grpc.v1.model.ListLeasesRequest\x1a+.keeper.go.grpc.v1.model.ListLeasesResponse\x12h\n" +
+	"\vRevokeLease\x12+.keeper.go.grpc.v1.model.RevokeLeaseRequest\x1a,.keeper.go.grpc.v1.model.RevokeLeaseResponse2\xe4\x06\n" +
+	"\x0eTransitService\x12b\n" +
+	"\tCreateKey\x120.keeper.go.grpc.v1.model.CreateTransitKeyRequest\x1a#.keeper.go.grpc.v1.model.TransitKey\x12b\n" +
+	"\tRotateKey\x120.keeper.go.grpc.v1.model.RotateTransitKeyRequest\x1a#.keeper.go.grpc.v1.model.TransitKey\x12m\n" +
+	"\bListKeys\x12/.keeper.go.grpc.v1.model.ListTransitKeysRequest\x1a0.keeper.go.grpc.v1.model.ListTransitKeysResponse\x12\\\n" +
+	"\aEncrypt\x12'.keeper.go.grpc.v1.model.EncryptRequest\x1a(.keeper.go.grpc.v1.model.EncryptResponse\x12\\\n" +
+	"\aDecrypt\x12'.keeper.go.grpc.v1.model.DecryptRequest\x1a(.keeper.go.grpc.v1.model.DecryptResponse\x12Z\n" +
+	"\x06Rewrap\x12&.keeper.go.grpc.v1.model.RewrapRequest\x1a(.keeper.go.grpc.v1.model.EncryptResponse\x12S\n" +
+	"\x04Sign\x12$.keeper.go.grpc.v1.model.SignRequest\x1a%.keeper.go.grpc.v1.model.SignResponse\x12Y\n" +
+	"\x06Verify\x12&.keeper.go.grpc.v1.model.VerifyRequest\x1a'.keeper.go.grpc.v1.model.VerifyResponse\x12S\n" +
+	"\x04HMAC\x12$.keeper.go.grpc.v1.model.HMACRequest\x1a%.keeper.go.grpc.v1.model.HMACResponse2\x81\x02\n" +
+	"\n" +
+	"PKIService\x12w\n" +
+	"\x10IssueCertificate\x120.keeper.go.grpc.v1.model.IssueCertificateRequest\x1a1.keeper.go.grpc.v1.model.IssueCertificateResponse\x12z\n" +
+	"\x11RevokeCertificate\x121.keeper.go.grpc.v1.model.RevokeCertificateRequest\x1a2.keeper.go.grpc.v1.model.RevokeCertificateResponse2\xd7\x01\n" +
+	"\n" +
+	"SSHService\x12b\n" +
+	"\aSignKey\x12*.keeper.go.grpc.v1.model.SignSSHKeyRequest\x1a+.keeper.go.grpc.v1.model.SignSSHKeyResponse\x12e\n" +
+	"\x0eGetCAPublicKey\x12(.keeper.go.grpc.v1.model.GetSSHCARequest\x1a).keeper.go.grpc.v1.model.GetSSHCAResponseB\"Z\x18keeper/internal/proto/v1\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
 
 var file_service_proto_goTypes = []any{
-	(*model.RegisterRequest)(nil),         // 0: keeper.go.grpc.v1.model.RegisterRequest
-	(*model.LoginRequest)(nil),            // 1: keeper.go.grpc.v1.model.LoginRequest
-	(*model.GetSecretRequest)(nil),        // 2: keeper.go.grpc.v1.model.GetSecretRequest
-	(*model.ListSecretPathsRequest)(nil),  // 3: keeper.go.grpc.v1.model.ListSecretPathsRequest
-	(*model.WriteSecret)(nil),             // 4: keeper.go.grpc.v1.model.WriteSecret
-	(*model.DeleteSecretRequest)(nil),     // 5: keeper.go.grpc.v1.model.DeleteSecretRequest
-	(*model.UndeleteSecretRequest)(nil),   // 6: keeper.go.grpc.v1.model.UndeleteSecretRequest
-	(*model.UploadFileRequest)(nil),       // 7: keeper.go.grpc.v1.model.UploadFileRequest
-	(*model.RegisterResponse)(nil),        // 8: keeper.go.grpc.v1.model.RegisterResponse
-	(*model.LoginResponse)(nil),           // 9: keeper.go.grpc.v1.model.LoginResponse
-	(*model.SecretResponse)(nil),          // 10: keeper.go.grpc.v1.model.SecretResponse
-	(*model.ListSecretPathsResponse)(nil), // 11: keeper.go.grpc.v1.model.ListSecretPathsResponse
-	(*model.SaveSecretResponse)(nil),      // 12: keeper.go.grpc.v1.model.SaveSecretResponse
-	(*model.DeleteSecretResponse)(nil),    // 13: keeper.go.grpc.v1.model.DeleteSecretResponse
-	(*model.UploadFileResponse)(nil),      // 14: keeper.go.grpc.v1.model.UploadFileResponse
+	(*model.RegisterRequest)(nil),             // 0: keeper.go.grpc.v1.model.RegisterRequest
+	(*model.LoginRequest)(nil),                // 1: keeper.go.grpc.v1.model.LoginRequest
+	(*model.RefreshTokenRequest)(nil),         // 2: keeper.go.grpc.v1.model.RefreshTokenRequest
+	(*model.LogoutRequest)(nil),               // 3: keeper.go.grpc.v1.model.LogoutRequest
+	(*model.ListSessionsRequest)(nil),         // 4: keeper.go.grpc.v1.model.ListSessionsRequest
+	(*model.RevokeSessionRequest)(nil),        // 5: keeper.go.grpc.v1.model.RevokeSessionRequest
+	(*model.EnrollTOTPRequest)(nil),           // 6: keeper.go.grpc.v1.model.EnrollTOTPRequest
+	(*model.ConfirmTOTPRequest)(nil),          // 7: keeper.go.grpc.v1.model.ConfirmTOTPRequest
+	(*model.VerifyTwoFactorRequest)(nil),      // 8: keeper.go.grpc.v1.model.VerifyTwoFactorRequest
+	(*model.ChangePasswordRequest)(nil),       // 9: keeper.go.grpc.v1.model.ChangePasswordRequest
+	(*model.CertificateLoginRequest)(nil),     // 10: keeper.go.grpc.v1.model.CertificateLoginRequest
+	(*model.GetSecretRequest)(nil),            // 11: keeper.go.grpc.v1.model.GetSecretRequest
+	(*model.ListSecretPathsRequest)(nil),      // 12: keeper.go.grpc.v1.model.ListSecretPathsRequest
+	(*model.WriteSecret)(nil),                 // 13: keeper.go.grpc.v1.model.WriteSecret
+	(*model.DeleteSecretRequest)(nil),         // 14: keeper.go.grpc.v1.model.DeleteSecretRequest
+	(*model.UndeleteSecretRequest)(nil),       // 15: keeper.go.grpc.v1.model.UndeleteSecretRequest
+	(*model.GrantAccessRequest)(nil),          // 16: keeper.go.grpc.v1.model.GrantAccessRequest
+	(*model.RevokeAccessRequest)(nil),         // 17: keeper.go.grpc.v1.model.RevokeAccessRequest
+	(*model.ListGrantsRequest)(nil),           // 18: keeper.go.grpc.v1.model.ListGrantsRequest
+	(*model.ListSecretVersionsRequest)(nil),   // 19: keeper.go.grpc.v1.model.ListSecretVersionsRequest
+	(*model.GetChangesSinceRequest)(nil),      // 20: keeper.go.grpc.v1.model.GetChangesSinceRequest
+	(*model.UploadFileRequest)(nil),           // 21: keeper.go.grpc.v1.model.UploadFileRequest
+	(*model.TeamRequest)(nil),                 // 22: keeper.go.grpc.v1.model.TeamRequest
+	(*model.TeamMemberRequest)(nil),           // 23: keeper.go.grpc.v1.model.TeamMemberRequest
+	(*model.ListTeamsRequest)(nil),            // 24: keeper.go.grpc.v1.model.ListTeamsRequest
+	(*model.CreateServiceAccountRequest)(nil), // 25: keeper.go.grpc.v1.model.CreateServiceAccountRequest
+	(*model.ListServiceAccountsRequest)(nil),  // 26: keeper.go.grpc.v1.model.ListServiceAccountsRequest
+	(*model.DeleteServiceAccountRequest)(nil), // 27: keeper.go.grpc.v1.model.DeleteServiceAccountRequest
+	(*model.CreateAPIKeyRequest)(nil),         // 28: keeper.go.grpc.v1.model.CreateAPIKeyRequest
+	(*model.ListAPIKeysRequest)(nil),          // 29: keeper.go.grpc.v1.model.ListAPIKeysRequest
+	(*model.RevokeAPIKeyRequest)(nil),         // 30: keeper.go.grpc.v1.model.RevokeAPIKeyRequest
+	(*model.WrapRequest)(nil),                 // 31: keeper.go.grpc.v1.model.WrapRequest
+	(*model.UnwrapRequest)(nil),               // 32: keeper.go.grpc.v1.model.UnwrapRequest
+	(*model.GenerateCredentialsRequest)(nil),  // 33: keeper.go.grpc.v1.model.GenerateCredentialsRequest
+	(*model.ListLeasesRequest)(nil),           // 34: keeper.go.grpc.v1.model.ListLeasesRequest
+	(*model.RevokeLeaseRequest)(nil),          // 35: keeper.go.grpc.v1.model.RevokeLeaseRequest
+	(*model.CreateTransitKeyRequest)(nil),     // 36: keeper.go.grpc.v1.model.CreateTransitKeyRequest
+	(*model.RotateTransitKeyRequest)(nil),     // 37: keeper.go.grpc.v1.model.RotateTransitKeyRequest
+	(*model.ListTransitKeysRequest)(nil),      // 38: keeper.go.grpc.v1.model.ListTransitKeysRequest
+	(*model.EncryptRequest)(nil),              // 39: keeper.go.grpc.v1.model.EncryptRequest
+	(*model.DecryptRequest)(nil),              // 40: keeper.go.grpc.v1.model.DecryptRequest
+	(*model.RewrapRequest)(nil),               // 41: keeper.go.grpc.v1.model.RewrapRequest
+	(*model.SignRequest)(nil),                 // 42: keeper.go.grpc.v1.model.SignRequest
+	(*model.VerifyRequest)(nil),               // 43: keeper.go.grpc.v1.model.VerifyRequest
+	(*model.HMACRequest)(nil),                 // 44: keeper.go.grpc.v1.model.HMACRequest
+	(*model.IssueCertificateRequest)(nil),     // 45: keeper.go.grpc.v1.model.IssueCertificateRequest
+	(*model.RevokeCertificateRequest)(nil),    // 46: keeper.go.grpc.v1.model.RevokeCertificateRequest
+	(*model.SignSSHKeyRequest)(nil),           // 47: keeper.go.grpc.v1.model.SignSSHKeyRequest
+	(*model.GetSSHCARequest)(nil),             // 48: keeper.go.grpc.v1.model.GetSSHCARequest
+	(*model.RegisterResponse)(nil),            // 49: keeper.go.grpc.v1.model.RegisterResponse
+	(*model.LoginResponse)(nil),               // 50: keeper.go.grpc.v1.model.LoginResponse
+	(*model.RefreshTokenResponse)(nil),        // 51: keeper.go.grpc.v1.model.RefreshTokenResponse
+	(*model.SessionResponse)(nil),             // 52: keeper.go.grpc.v1.model.SessionResponse
+	(*model.ListSessionsResponse)(nil),        // 53: keeper.go.grpc.v1.model.ListSessionsResponse
+	(*model.EnrollTOTPResponse)(nil),          // 54: keeper.go.grpc.v1.model.EnrollTOTPResponse
+	(*model.ConfirmTOTPResponse)(nil),         // 55: keeper.go.grpc.v1.model.ConfirmTOTPResponse
+	(*model.ChangePasswordResponse)(nil),      // 56: keeper.go.grpc.v1.model.ChangePasswordResponse
+	(*model.SecretResponse)(nil),              // 57: keeper.go.grpc.v1.model.SecretResponse
+	(*model.ListSecretPathsResponse)(nil),     // 58: keeper.go.grpc.v1.model.ListSecretPathsResponse
+	(*model.SaveSecretResponse)(nil),          // 59: keeper.go.grpc.v1.model.SaveSecretResponse
+	(*model.DeleteSecretResponse)(nil),        // 60: keeper.go.grpc.v1.model.DeleteSecretResponse
+	(*model.GrantResponse)(nil),               // 61: keeper.go.grpc.v1.model.GrantResponse
+	(*model.ListGrantsResponse)(nil),          // 62: keeper.go.grpc.v1.model.ListGrantsResponse
+	(*model.ListSecretVersionsResponse)(nil),  // 63: keeper.go.grpc.v1.model.ListSecretVersionsResponse
+	(*model.GetChangesSinceResponse)(nil),     // 64: keeper.go.grpc.v1.model.GetChangesSinceResponse
+	(*model.UploadFileResponse)(nil),          // 65: keeper.go.grpc.v1.model.UploadFileResponse
+	(*model.TeamResponse)(nil),                // 66: keeper.go.grpc.v1.model.TeamResponse
+	(*model.ListTeamsResponse)(nil),           // 67: keeper.go.grpc.v1.model.ListTeamsResponse
+	(*model.ListTeamMembersResponse)(nil),     // 68: keeper.go.grpc.v1.model.ListTeamMembersResponse
+	(*model.ServiceAccount)(nil),              // 69: keeper.go.grpc.v1.model.ServiceAccount
+	(*model.ListServiceAccountsResponse)(nil), // 70: keeper.go.grpc.v1.model.ListServiceAccountsResponse
+	(*model.ServiceAccountResponse)(nil),      // 71: keeper.go.grpc.v1.model.ServiceAccountResponse
+	(*model.CreateAPIKeyResponse)(nil),        // 72: keeper.go.grpc.v1.model.CreateAPIKeyResponse
+	(*model.ListAPIKeysResponse)(nil),         // 73: keeper.go.grpc.v1.model.ListAPIKeysResponse
+	(*model.WrapResponse)(nil),                // 74: keeper.go.grpc.v1.model.WrapResponse
+	(*model.UnwrapResponse)(nil),              // 75: keeper.go.grpc.v1.model.UnwrapResponse
+	(*model.DatabaseCredentials)(nil),         // 76: keeper.go.grpc.v1.model.DatabaseCredentials
+	(*model.ListLeasesResponse)(nil),          // 77: keeper.go.grpc.v1.model.ListLeasesResponse
+	(*model.RevokeLeaseResponse)(nil),         // 78: keeper.go.grpc.v1.model.RevokeLeaseResponse
+	(*model.TransitKey)(nil),                  // 79: keeper.go.grpc.v1.model.TransitKey
+	(*model.ListTransitKeysResponse)(nil),     // 80: keeper.go.grpc.v1.model.ListTransitKeysResponse
+	(*model.EncryptResponse)(nil),             // 81: keeper.go.grpc.v1.model.EncryptResponse
+	(*model.DecryptResponse)(nil),             // 82: keeper.go.grpc.v1.model.DecryptResponse
+	(*model.SignResponse)(nil),                // 83: keeper.go.grpc.v1.model.SignResponse
+	(*model.VerifyResponse)(nil),              // 84: keeper.go.grpc.v1.model.VerifyResponse
+	(*model.HMACResponse)(nil),                // 85: keeper.go.grpc.v1.model.HMACResponse
+	(*model.IssueCertificateResponse)(nil),    // 86: keeper.go.grpc.v1.model.IssueCertificateResponse
+	(*model.RevokeCertificateResponse)(nil),   // 87: keeper.go.grpc.v1.model.RevokeCertificateResponse
+	(*model.SignSSHKeyResponse)(nil),          // 88: keeper.go.grpc.v1.model.SignSSHKeyResponse
+	(*model.GetSSHCAResponse)(nil),            // 89: keeper.go.grpc.v1.model.GetSSHCAResponse
 }
 var file_service_proto_depIdxs = []int32{
 	0,  // 0: keeper.go.grpc.v1.AuthService.Register:input_type -> keeper.go.grpc.v1.model.RegisterRequest
 	1,  // 1: keeper.go.grpc.v1.AuthService.Login:input_type -> keeper.go.grpc.v1.model.LoginRequest
-	2,  // 2: keeper.go.grpc.v1.DataService.GetSecret:input_type -> keeper.go.grpc.v1.model.GetSecretRequest
-	3,  // 3: keeper.go.grpc.v1.DataService.ListSecrets:input_type -> keeper.go.grpc.v1.model.ListSecretPathsRequest
-	4,  // 4: keeper.go.grpc.v1.DataService.SaveSecret:input_type -> keeper.go.grpc.v1.model.WriteSecret
-	5,  // 5: keeper.go.grpc.v1.DataService.DeleteSecret:input_type -> keeper.go.grpc.v1.model.DeleteSecretRequest
-	5,  // 6: keeper.go.grpc.v1.DataService.DestroySecret:input_type -> keeper.go.grpc.v1.model.DeleteSecretRequest
-	5,  // 7: keeper.go.grpc.v1.DataService.DeleteMetadata:input_type -> keeper.go.grpc.v1.model.DeleteSecretRequest
-	6,  // 8: keeper.go.grpc.v1.DataService.UndeleteSecret:input_type -> keeper.go.grpc.v1.model.UndeleteSecretRequest
-	7,  // 9: keeper.go.grpc.v1.FileService.UploadFile:input_type -> keeper.go.grpc.v1.model.UploadFileRequest
-	8,  // 10: keeper.go.grpc.v1.AuthService.Register:output_type -> keeper.go.grpc.v1.model.RegisterResponse
-	9,  // 11: keeper.go.grpc.v1.AuthService.Login:output_type -> keeper.go.grpc.v1.model.LoginResponse
-	10, // 12: keeper.go.grpc.v1.DataService.GetSecret:output_type -> keeper.go.grpc.v1.model.SecretResponse
-	11, // 13: keeper.go.grpc.v1.DataService.ListSecrets:output_type -> keeper.go.grpc.v1.model.ListSecretPathsResponse
-	12, // 14: keeper.go.grpc.v1.DataService.SaveSecret:output_type -> keeper.go.grpc.v1.model.SaveSecretResponse
-	13, // 15: keeper.go.grpc.v1.DataService.DeleteSecret:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
-	13, // 16: keeper.go.grpc.v1.DataService.DestroySecret:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
-	13, // 17: keeper.go.grpc.v1.DataService.DeleteMetadata:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
-	13, // 18: keeper.go.grpc.v1.DataService.UndeleteSecret:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
-	14, // 19: keeper.go.grpc.v1.FileService.UploadFile:output_type -> keeper.go.grpc.v1.model.UploadFileResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	2,  // 2: keeper.go.grpc.v1.AuthService.RefreshToken:input_type -> keeper.go.grpc.v1.model.RefreshTokenRequest
+	3,  // 3: keeper.go.grpc.v1.AuthService.Logout:input_type -> keeper.go.grpc.v1.model.LogoutRequest
+	4,  // 4: keeper.go.grpc.v1.AuthService.ListSessions:input_type -> keeper.go.grpc.v1.model.ListSessionsRequest
+	5,  // 5: keeper.go.grpc.v1.AuthService.RevokeSession:input_type -> keeper.go.grpc.v1.model.RevokeSessionRequest
+	6,  // 6: keeper.go.grpc.v1.AuthService.EnrollTOTP:input_type -> keeper.go.grpc.v1.model.EnrollTOTPRequest
+	7,  // 7: keeper.go.grpc.v1.AuthService.ConfirmTOTP:input_type -> keeper.go.grpc.v1.model.ConfirmTOTPRequest
+	8,  // 8: keeper.go.grpc.v1.AuthService.VerifyTwoFactor:input_type -> keeper.go.grpc.v1.model.VerifyTwoFactorRequest
+	9,  // 9: keeper.go.grpc.v1.AuthService.ChangePassword:input_type -> keeper.go.grpc.v1.model.ChangePasswordRequest
+	10, // 10: keeper.go.grpc.v1.AuthService.LoginWithCertificate:input_type -> keeper.go.grpc.v1.model.CertificateLoginRequest
+	11, // 11: keeper.go.grpc.v1.DataService.GetSecret:input_type -> keeper.go.grpc.v1.model.GetSecretRequest
+	12, // 12: keeper.go.grpc.v1.DataService.ListSecrets:input_type -> keeper.go.grpc.v1.model.ListSecretPathsRequest
+	13, // 13: keeper.go.grpc.v1.DataService.SaveSecret:input_type -> keeper.go.grpc.v1.model.WriteSecret
+	14, // 14: keeper.go.grpc.v1.DataService.DeleteSecret:input_type -> keeper.go.grpc.v1.model.DeleteSecretRequest
+	14, // 15: keeper.go.grpc.v1.DataService.DestroySecret:input_type -> keeper.go.grpc.v1.model.DeleteSecretRequest
+	14, // 16: keeper.go.grpc.v1.DataService.DeleteMetadata:input_type -> keeper.go.grpc.v1.model.DeleteSecretRequest
+	15, // 17: keeper.go.grpc.v1.DataService.UndeleteSecret:input_type -> keeper.go.grpc.v1.model.UndeleteSecretRequest
+	16, // 18: keeper.go.grpc.v1.DataService.GrantAccess:input_type -> keeper.go.grpc.v1.model.GrantAccessRequest
+	17, // 19: keeper.go.grpc.v1.DataService.RevokeAccess:input_type -> keeper.go.grpc.v1.model.RevokeAccessRequest
+	18, // 20: keeper.go.grpc.v1.DataService.ListGrants:input_type -> keeper.go.grpc.v1.model.ListGrantsRequest
+	19, // 21: keeper.go.grpc.v1.DataService.ListSecretVersions:input_type -> keeper.go.grpc.v1.model.ListSecretVersionsRequest
+	20, // 22: keeper.go.grpc.v1.DataService.GetChangesSince:input_type -> keeper.go.grpc.v1.model.GetChangesSinceRequest
+	21, // 23: keeper.go.grpc.v1.FileService.UploadFile:input_type -> keeper.go.grpc.v1.model.UploadFileRequest
+	22, // 24: keeper.go.grpc.v1.TeamService.CreateTeam:input_type -> keeper.go.grpc.v1.model.TeamRequest
+	22, // 25: keeper.go.grpc.v1.TeamService.DeleteTeam:input_type -> keeper.go.grpc.v1.model.TeamRequest
+	23, // 26: keeper.go.grpc.v1.TeamService.AddMember:input_type -> keeper.go.grpc.v1.model.TeamMemberRequest
+	23, // 27: keeper.go.grpc.v1.TeamService.RemoveMember:input_type -> keeper.go.grpc.v1.model.TeamMemberRequest
+	24, // 28: keeper.go.grpc.v1.TeamService.ListTeams:input_type -> keeper.go.grpc.v1.model.ListTeamsRequest
+	22, // 29: keeper.go.grpc.v1.TeamService.ListMembers:input_type -> keeper.go.grpc.v1.model.TeamRequest
+	25, // 30: keeper.go.grpc.v1.ServiceAccountService.CreateServiceAccount:input_type -> keeper.go.grpc.v1.model.CreateServiceAccountRequest
+	26, // 31: keeper.go.grpc.v1.ServiceAccountService.ListServiceAccounts:input_type -> keeper.go.grpc.v1.model.ListServiceAccountsRequest
+	27, // 32: keeper.go.grpc.v1.ServiceAccountService.DeleteServiceAccount:input_type -> keeper.go.grpc.v1.model.DeleteServiceAccountRequest
+	28, // 33: keeper.go.grpc.v1.ServiceAccountService.CreateAPIKey:input_type -> keeper.go.grpc.v1.model.CreateAPIKeyRequest
+	29, // 34: keeper.go.grpc.v1.ServiceAccountService.ListAPIKeys:input_type -> keeper.go.grpc.v1.model.ListAPIKeysRequest
+	30, // 35: keeper.go.grpc.v1.ServiceAccountService.RevokeAPIKey:input_type -> keeper.go.grpc.v1.model.RevokeAPIKeyRequest
+	31, // 36: keeper.go.grpc.v1.WrapService.Wrap:input_type -> keeper.go.grpc.v1.model.WrapRequest
+	32, // 37: keeper.go.grpc.v1.WrapService.Unwrap:input_type -> keeper.go.grpc.v1.model.UnwrapRequest
+	33, // 38: keeper.go.grpc.v1.DatabaseService.GenerateCredentials:input_type -> keeper.go.grpc.v1.model.GenerateCredentialsRequest
+	34, // 39: keeper.go.grpc.v1.DatabaseService.ListLeases:input_type -> keeper.go.grpc.v1.model.ListLeasesRequest
+	35, // 40: keeper.go.grpc.v1.DatabaseService.RevokeLease:input_type -> keeper.go.grpc.v1.model.RevokeLeaseRequest
+	36, // 41: keeper.go.grpc.v1.TransitService.CreateKey:input_type -> keeper.go.grpc.v1.model.CreateTransitKeyRequest
+	37, // 42: keeper.go.grpc.v1.TransitService.RotateKey:input_type -> keeper.go.grpc.v1.model.RotateTransitKeyRequest
+	38, // 43: keeper.go.grpc.v1.TransitService.ListKeys:input_type -> keeper.go.grpc.v1.model.ListTransitKeysRequest
+	39, // 44: keeper.go.grpc.v1.TransitService.Encrypt:input_type -> keeper.go.grpc.v1.model.EncryptRequest
+	40, // 45: keeper.go.grpc.v1.TransitService.Decrypt:input_type -> keeper.go.grpc.v1.model.DecryptRequest
+	41, // 46: keeper.go.grpc.v1.TransitService.Rewrap:input_type -> keeper.go.grpc.v1.model.RewrapRequest
+	42, // 47: keeper.go.grpc.v1.TransitService.Sign:input_type -> keeper.go.grpc.v1.model.SignRequest
+	43, // 48: keeper.go.grpc.v1.TransitService.Verify:input_type -> keeper.go.grpc.v1.model.VerifyRequest
+	44, // 49: keeper.go.grpc.v1.TransitService.HMAC:input_type -> keeper.go.grpc.v1.model.HMACRequest
+	45, // 50: keeper.go.grpc.v1.PKIService.IssueCertificate:input_type -> keeper.go.grpc.v1.model.IssueCertificateRequest
+	46, // 51: keeper.go.grpc.v1.PKIService.RevokeCertificate:input_type -> keeper.go.grpc.v1.model.RevokeCertificateRequest
+	47, // 52: keeper.go.grpc.v1.SSHService.SignKey:input_type -> keeper.go.grpc.v1.model.SignSSHKeyRequest
+	48, // 53: keeper.go.grpc.v1.SSHService.GetCAPublicKey:input_type -> keeper.go.grpc.v1.model.GetSSHCARequest
+	49, // 54: keeper.go.grpc.v1.AuthService.Register:output_type -> keeper.go.grpc.v1.model.RegisterResponse
+	50, // 55: keeper.go.grpc.v1.AuthService.Login:output_type -> keeper.go.grpc.v1.model.LoginResponse
+	51, // 56: keeper.go.grpc.v1.AuthService.RefreshToken:output_type -> keeper.go.grpc.v1.model.RefreshTokenResponse
+	52, // 57: keeper.go.grpc.v1.AuthService.Logout:output_type -> keeper.go.grpc.v1.model.SessionResponse
+	53, // 58: keeper.go.grpc.v1.AuthService.ListSessions:output_type -> keeper.go.grpc.v1.model.ListSessionsResponse
+	52, // 59: keeper.go.grpc.v1.AuthService.RevokeSession:output_type -> keeper.go.grpc.v1.model.SessionResponse
+	54, // 60: keeper.go.grpc.v1.AuthService.EnrollTOTP:output_type -> keeper.go.grpc.v1.model.EnrollTOTPResponse
+	55, // 61: keeper.go.grpc.v1.AuthService.ConfirmTOTP:output_type -> keeper.go.grpc.v1.model.ConfirmTOTPResponse
+	50, // 62: keeper.go.grpc.v1.AuthService.VerifyTwoFactor:output_type -> keeper.go.grpc.v1.model.LoginResponse
+	56, // 63: keeper.go.grpc.v1.AuthService.ChangePassword:output_type -> keeper.go.grpc.v1.model.ChangePasswordResponse
+	50, // 64: keeper.go.grpc.v1.AuthService.LoginWithCertificate:output_type -> keeper.go.grpc.v1.model.LoginResponse
+	57, // 65: keeper.go.grpc.v1.DataService.GetSecret:output_type -> keeper.go.grpc.v1.model.SecretResponse
+	58, // 66: keeper.go.grpc.v1.DataService.ListSecrets:output_type -> keeper.go.grpc.v1.model.ListSecretPathsResponse
+	59, // 67: keeper.go.grpc.v1.DataService.SaveSecret:output_type -> keeper.go.grpc.v1.model.SaveSecretResponse
+	60, // 68: keeper.go.grpc.v1.DataService.DeleteSecret:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
+	60, // 69: keeper.go.grpc.v1.DataService.DestroySecret:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
+	60, // 70: keeper.go.grpc.v1.DataService.DeleteMetadata:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
+	60, // 71: keeper.go.grpc.v1.DataService.UndeleteSecret:output_type -> keeper.go.grpc.v1.model.DeleteSecretResponse
+	61, // 72: keeper.go.grpc.v1.DataService.GrantAccess:output_type -> keeper.go.grpc.v1.model.GrantResponse
+	61, // 73: keeper.go.grpc.v1.DataService.RevokeAccess:output_type -> keeper.go.grpc.v1.model.GrantResponse
+	62, // 74: keeper.go.grpc.v1.DataService.ListGrants:output_type -> keeper.go.grpc.v1.model.ListGrantsResponse
+	63, // 75: keeper.go.grpc.v1.DataService.ListSecretVersions:output_type -> keeper.go.grpc.v1.model.ListSecretVersionsResponse
+	64, // 76: keeper.go.grpc.v1.DataService.GetChangesSince:output_type -> keeper.go.grpc.v1.model.GetChangesSinceResponse
+	65, // 77: keeper.go.grpc.v1.FileService.UploadFile:output_type -> keeper.go.grpc.v1.model.UploadFileResponse
+	66, // 78: keeper.go.grpc.v1.TeamService.CreateTeam:output_type -> keeper.go.grpc.v1.model.TeamResponse
+	66, // 79: keeper.go.grpc.v1.TeamService.DeleteTeam:output_type -> keeper.go.grpc.v1.model.TeamResponse
+	66, // 80: keeper.go.grpc.v1.TeamService.AddMember:output_type -> keeper.go.grpc.v1.model.TeamResponse
+	66, // 81: keeper.go.grpc.v1.TeamService.RemoveMember:output_type -> keeper.go.grpc.v1.model.TeamResponse
+	67, // 82: keeper.go.grpc.v1.TeamService.ListTeams:output_type -> keeper.go.grpc.v1.model.ListTeamsResponse
+	68, // 83: keeper.go.grpc.v1.TeamService.ListMembers:output_type -> keeper.go.grpc.v1.model.ListTeamMembersResponse
+	69, // 84: keeper.go.grpc.v1.ServiceAccountService.CreateServiceAccount:output_type -> keeper.go.grpc.v1.model.ServiceAccount
+	70, // 85: keeper.go.grpc.v1.ServiceAccountService.ListServiceAccounts:output_type -> keeper.go.grpc.v1.model.ListServiceAccountsResponse
+	71, // 86: keeper.go.grpc.v1.ServiceAccountService.DeleteServiceAccount:output_type -> keeper.go.grpc.v1.model.ServiceAccountResponse
+	72, // 87: keeper.go.grpc.v1.ServiceAccountService.CreateAPIKey:output_type -> keeper.go.grpc.v1.model.CreateAPIKeyResponse
+	73, // 88: keeper.go.grpc.v1.ServiceAccountService.ListAPIKeys:output_type -> keeper.go.grpc.v1.model.ListAPIKeysResponse
+	71, // 89: keeper.go.grpc.v1.ServiceAccountService.RevokeAPIKey:output_type -> keeper.go.grpc.v1.model.ServiceAccountResponse
+	74, // 90: keeper.go.grpc.v1.WrapService.Wrap:output_type -> keeper.go.grpc.v1.model.WrapResponse
+	75, // 91: keeper.go.grpc.v1.WrapService.Unwrap:output_type -> keeper.go.grpc.v1.model.UnwrapResponse
+	76, // 92: keeper.go.grpc.v1.DatabaseService.GenerateCredentials:output_type -> keeper.go.grpc.v1.model.DatabaseCredentials
+	77, // 93: keeper.go.grpc.v1.DatabaseService.ListLeases:output_type -> keeper.go.grpc.v1.model.ListLeasesResponse
+	78, // 94: keeper.go.grpc.v1.DatabaseService.RevokeLease:output_type -> keeper.go.grpc.v1.model.RevokeLeaseResponse
+	79, // 95: keeper.go.grpc.v1.TransitService.CreateKey:output_type -> keeper.go.grpc.v1.model.TransitKey
+	79, // 96: keeper.go.grpc.v1.TransitService.RotateKey:output_type -> keeper.go.grpc.v1.model.TransitKey
+	80, // 97: keeper.go.grpc.v1.TransitService.ListKeys:output_type -> keeper.go.grpc.v1.model.ListTransitKeysResponse
+	81, // 98: keeper.go.grpc.v1.TransitService.Encrypt:output_type -> keeper.go.grpc.v1.model.EncryptResponse
+	82, // 99: keeper.go.grpc.v1.TransitService.Decrypt:output_type -> keeper.go.grpc.v1.model.DecryptResponse
+	81, // 100: keeper.go.grpc.v1.TransitService.Rewrap:output_type -> keeper.go.grpc.v1.model.EncryptResponse
+	83, // 101: keeper.go.grpc.v1.TransitService.Sign:output_type -> keeper.go.grpc.v1.model.SignResponse
+	84, // 102: keeper.go.grpc.v1.TransitService.Verify:output_type -> keeper.go.grpc.v1.model.VerifyResponse
+	85, // 103: keeper.go.grpc.v1.TransitService.HMAC:output_type -> keeper.go.grpc.v1.model.HMACResponse
+	86, // 104: keeper.go.grpc.v1.PKIService.IssueCertificate:output_type -> keeper.go.grpc.v1.model.IssueCertificateResponse
+	87, // 105: keeper.go.grpc.v1.PKIService.RevokeCertificate:output_type -> keeper.go.grpc.v1.model.RevokeCertificateResponse
+	88, // 106: keeper.go.grpc.v1.SSHService.SignKey:output_type -> keeper.go.grpc.v1.model.SignSSHKeyResponse
+	89, // 107: keeper.go.grpc.v1.SSHService.GetCAPublicKey:output_type -> keeper.go.grpc.v1.model.GetSSHCAResponse
+	54, // [54:108] is the sub-list for method output_type
+	0,  // [0:54] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -102,7 +324,7 @@ func file_service_proto_init() {
 			NumEnums:      0,
 			NumMessages:   0,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   10,
 		},
 		GoTypes:           file_service_proto_goTypes,
 		DependencyIndexes: file_service_proto_depIdxs,
@@ -21,8 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_Register_FullMethodName = "/keeper.go.grpc.v1.AuthService/Register"
-	AuthService_Login_FullMethodName    = "/keeper.go.grpc.v1.AuthService/Login"
+	AuthService_Register_FullMethodName             = "/keeper.go.grpc.v1.AuthService/Register"
+	AuthService_Login_FullMethodName                = "/keeper.go.grpc.v1.AuthService/Login"
+	AuthService_RefreshToken_FullMethodName         = "/keeper.go.grpc.v1.AuthService/RefreshToken"
+	AuthService_Logout_FullMethodName               = "/keeper.go.grpc.v1.AuthService/Logout"
+	AuthService_ListSessions_FullMethodName         = "/keeper.go.grpc.v1.AuthService/ListSessions"
+	AuthService_RevokeSession_FullMethodName        = "/keeper.go.grpc.v1.AuthService/RevokeSession"
+	AuthService_EnrollTOTP_FullMethodName           = "/keeper.go.grpc.v1.AuthService/EnrollTOTP"
+	AuthService_ConfirmTOTP_FullMethodName          = "/keeper.go.grpc.v1.AuthService/ConfirmTOTP"
+	AuthService_VerifyTwoFactor_FullMethodName      = "/keeper.go.grpc.v1.AuthService/VerifyTwoFactor"
+	AuthService_ChangePassword_FullMethodName       = "/keeper.go.grpc.v1.AuthService/ChangePassword"
+	AuthService_LoginWithCertificate_FullMethodName = "/keeper.go.grpc.v1.AuthService/LoginWithCertificate"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -31,6 +40,15 @@ const (
 type AuthServiceClient interface {
 	Register(ctx context.Context, in *model.RegisterRequest, opts ...grpc.CallOption) (*model.RegisterResponse, error)
 	Login(ctx context.Context, in *model.LoginRequest, opts ...grpc.CallOption) (*model.LoginResponse, error)
+	RefreshToken(ctx context.Context, in *model.RefreshTokenRequest, opts ...grpc.CallOption) (*model.RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *model.LogoutRequest, opts ...grpc.CallOption) (*model.SessionResponse, error)
+	ListSessions(ctx context.Context, in *model.ListSessionsRequest, opts ...grpc.CallOption) (*model.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *model.RevokeSessionRequest, opts ...grpc.CallOption) (*model.SessionResponse, error)
+	EnrollTOTP(ctx context.Context, in *model.EnrollTOTPRequest, opts ...grpc.CallOption) (*model.EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, in *model.ConfirmTOTPRequest, opts ...grpc.CallOption) (*model.ConfirmTOTPResponse, error)
+	VerifyTwoFactor(ctx context.Context, in *model.VerifyTwoFactorRequest, opts ...grpc.CallOption) (*model.LoginResponse, error)
+	ChangePassword(ctx context.Context, in *model.ChangePasswordRequest, opts ...grpc.CallOption) (*model.ChangePasswordResponse, error)
+	LoginWithCertificate(ctx context.Context, in *model.CertificateLoginRequest, opts ...grpc.CallOption) (*model.LoginResponse, error)
 }
 
 type authServiceClient struct {
@@ -61,12 +79,111 @@ func (c *authServiceClient) Login(ctx context.Context, in *model.LoginRequest, o
 	return out, nil
 }
 
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *model.RefreshTokenRequest, opts ...grpc.CallOption) (*model.RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *model.LogoutRequest, opts ...grpc.CallOption) (*model.SessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.SessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListSessions(ctx context.Context, in *model.ListSessionsRequest, opts ...grpc.CallOption) (*model.ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListSessionsResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeSession(ctx context.Context, in *model.RevokeSessionRequest, opts ...grpc.CallOption) (*model.SessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.SessionResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) EnrollTOTP(ctx context.Context, in *model.EnrollTOTPRequest, opts ...grpc.CallOption) (*model.EnrollTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, AuthService_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmTOTP(ctx context.Context, in *model.ConfirmTOTPRequest, opts ...grpc.CallOption) (*model.ConfirmTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyTwoFactor(ctx context.Context, in *model.VerifyTwoFactorRequest, opts ...grpc.CallOption) (*model.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_VerifyTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *model.ChangePasswordRequest, opts ...grpc.CallOption) (*model.ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LoginWithCertificate(ctx context.Context, in *model.CertificateLoginRequest, opts ...grpc.CallOption) (*model.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_LoginWithCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
 type AuthServiceServer interface {
 	Register(context.Context, *model.RegisterRequest) (*model.RegisterResponse, error)
 	Login(context.Context, *model.LoginRequest) (*model.LoginResponse, error)
+	RefreshToken(context.Context, *model.RefreshTokenRequest) (*model.RefreshTokenResponse, error)
+	Logout(context.Context, *model.LogoutRequest) (*model.SessionResponse, error)
+	ListSessions(context.Context, *model.ListSessionsRequest) (*model.ListSessionsResponse, error)
+	RevokeSession(context.Context, *model.RevokeSessionRequest) (*model.SessionResponse, error)
+	EnrollTOTP(context.Context, *model.EnrollTOTPRequest) (*model.EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *model.ConfirmTOTPRequest) (*model.ConfirmTOTPResponse, error)
+	VerifyTwoFactor(context.Context, *model.VerifyTwoFactorRequest) (*model.LoginResponse, error)
+	ChangePassword(context.Context, *model.ChangePasswordRequest) (*model.ChangePasswordResponse, error)
+	LoginWithCertificate(context.Context, *model.CertificateLoginRequest) (*model.LoginResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -83,6 +200,33 @@ func (UnimplementedAuthServiceServer) Register(context.Context, *model.RegisterR
 func (UnimplementedAuthServiceServer) Login(context.Context, *model.LoginRequest) (*model.LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *model.RefreshTokenRequest) (*model.RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *model.LogoutRequest) (*model.SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) ListSessions(context.Context, *model.ListSessionsRequest) (*model.ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeSession(context.Context, *model.RevokeSessionRequest) (*model.SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthServiceServer) EnrollTOTP(context.Context, *model.EnrollTOTPRequest) (*model.EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedAuthServiceServer) ConfirmTOTP(context.Context, *model.ConfirmTOTPRequest) (*model.ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedAuthServiceServer) VerifyTwoFactor(context.Context, *model.VerifyTwoFactorRequest) (*model.LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactor not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *model.ChangePasswordRequest) (*model.ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedAuthServiceServer) LoginWithCertificate(context.Context, *model.CertificateLoginRequest) (*model.LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithCertificate not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -140,6 +284,168 @@ func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*model.RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*model.LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListSessions(ctx, req.(*model.ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeSession(ctx, req.(*model.RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).EnrollTOTP(ctx, req.(*model.EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmTOTP(ctx, req.(*model.ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.VerifyTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_VerifyTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyTwoFactor(ctx, req.(*model.VerifyTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*model.ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LoginWithCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.CertificateLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LoginWithCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LoginWithCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LoginWithCertificate(ctx, req.(*model.CertificateLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -155,19 +461,60 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _AuthService_Login_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AuthService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AuthService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _AuthService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _AuthService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "VerifyTwoFactor",
+			Handler:    _AuthService_VerifyTwoFactor_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "LoginWithCertificate",
+			Handler:    _AuthService_LoginWithCertificate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
 
 const (
-	DataService_GetSecret_FullMethodName      = "/keeper.go.grpc.v1.DataService/GetSecret"
-	DataService_ListSecrets_FullMethodName    = "/keeper.go.grpc.v1.DataService/ListSecrets"
-	DataService_SaveSecret_FullMethodName     = "/keeper.go.grpc.v1.DataService/SaveSecret"
-	DataService_DeleteSecret_FullMethodName   = "/keeper.go.grpc.v1.DataService/DeleteSecret"
-	DataService_DestroySecret_FullMethodName  = "/keeper.go.grpc.v1.DataService/DestroySecret"
-	DataService_DeleteMetadata_FullMethodName = "/keeper.go.grpc.v1.DataService/DeleteMetadata"
-	DataService_UndeleteSecret_FullMethodName = "/keeper.go.grpc.v1.DataService/UndeleteSecret"
+	DataService_GetSecret_FullMethodName          = "/keeper.go.grpc.v1.DataService/GetSecret"
+	DataService_ListSecrets_FullMethodName        = "/keeper.go.grpc.v1.DataService/ListSecrets"
+	DataService_SaveSecret_FullMethodName         = "/keeper.go.grpc.v1.DataService/SaveSecret"
+	DataService_DeleteSecret_FullMethodName       = "/keeper.go.grpc.v1.DataService/DeleteSecret"
+	DataService_DestroySecret_FullMethodName      = "/keeper.go.grpc.v1.DataService/DestroySecret"
+	DataService_DeleteMetadata_FullMethodName     = "/keeper.go.grpc.v1.DataService/DeleteMetadata"
+	DataService_UndeleteSecret_FullMethodName     = "/keeper.go.grpc.v1.DataService/UndeleteSecret"
+	DataService_GrantAccess_FullMethodName        = "/keeper.go.grpc.v1.DataService/GrantAccess"
+	DataService_RevokeAccess_FullMethodName       = "/keeper.go.grpc.v1.DataService/RevokeAccess"
+	DataService_ListGrants_FullMethodName         = "/keeper.go.grpc.v1.DataService/ListGrants"
+	DataService_ListSecretVersions_FullMethodName = "/keeper.go.grpc.v1.DataService/ListSecretVersions"
+	DataService_GetChangesSince_FullMethodName    = "/keeper.go.grpc.v1.DataService/GetChangesSince"
 )
 
 // DataServiceClient is the client API for DataService service.
@@ -181,6 +528,11 @@ type DataServiceClient interface {
 	DestroySecret(ctx context.Context, in *model.DeleteSecretRequest, opts ...grpc.CallOption) (*model.DeleteSecretResponse, error)
 	DeleteMetadata(ctx context.Context, in *model.DeleteSecretRequest, opts ...grpc.CallOption) (*model.DeleteSecretResponse, error)
 	UndeleteSecret(ctx context.Context, in *model.UndeleteSecretRequest, opts ...grpc.CallOption) (*model.DeleteSecretResponse, error)
+	GrantAccess(ctx context.Context, in *model.GrantAccessRequest, opts ...grpc.CallOption) (*model.GrantResponse, error)
+	RevokeAccess(ctx context.Context, in *model.RevokeAccessRequest, opts ...grpc.CallOption) (*model.GrantResponse, error)
+	ListGrants(ctx context.Context, in *model.ListGrantsRequest, opts ...grpc.CallOption) (*model.ListGrantsResponse, error)
+	ListSecretVersions(ctx context.Context, in *model.ListSecretVersionsRequest, opts ...grpc.CallOption) (*model.ListSecretVersionsResponse, error)
+	GetChangesSince(ctx context.Context, in *model.GetChangesSinceRequest, opts ...grpc.CallOption) (*model.GetChangesSinceResponse, error)
 }
 
 type dataServiceClient struct {
@@ -261,6 +613,56 @@ func (c *dataServiceClient) UndeleteSecret(ctx context.Context, in *model.Undele
 	return out, nil
 }
 
+func (c *dataServiceClient) GrantAccess(ctx context.Context, in *model.GrantAccessRequest, opts ...grpc.CallOption) (*model.GrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.GrantResponse)
+	err := c.cc.Invoke(ctx, DataService_GrantAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) RevokeAccess(ctx context.Context, in *model.RevokeAccessRequest, opts ...grpc.CallOption) (*model.GrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.GrantResponse)
+	err := c.cc.Invoke(ctx, DataService_RevokeAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ListGrants(ctx context.Context, in *model.ListGrantsRequest, opts ...grpc.CallOption) (*model.ListGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListGrantsResponse)
+	err := c.cc.Invoke(ctx, DataService_ListGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ListSecretVersions(ctx context.Context, in *model.ListSecretVersionsRequest, opts ...grpc.CallOption) (*model.ListSecretVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListSecretVersionsResponse)
+	err := c.cc.Invoke(ctx, DataService_ListSecretVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) GetChangesSince(ctx context.Context, in *model.GetChangesSinceRequest, opts ...grpc.CallOption) (*model.GetChangesSinceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.GetChangesSinceResponse)
+	err := c.cc.Invoke(ctx, DataService_GetChangesSince_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataServiceServer is the server API for DataService service.
 // All implementations must embed UnimplementedDataServiceServer
 // for forward compatibility.
@@ -272,6 +674,11 @@ type DataServiceServer interface {
 	DestroySecret(context.Context, *model.DeleteSecretRequest) (*model.DeleteSecretResponse, error)
 	DeleteMetadata(context.Context, *model.DeleteSecretRequest) (*model.DeleteSecretResponse, error)
 	UndeleteSecret(context.Context, *model.UndeleteSecretRequest) (*model.DeleteSecretResponse, error)
+	GrantAccess(context.Context, *model.GrantAccessRequest) (*model.GrantResponse, error)
+	RevokeAccess(context.Context, *model.RevokeAccessRequest) (*model.GrantResponse, error)
+	ListGrants(context.Context, *model.ListGrantsRequest) (*model.ListGrantsResponse, error)
+	ListSecretVersions(context.Context, *model.ListSecretVersionsRequest) (*model.ListSecretVersionsResponse, error)
+	GetChangesSince(context.Context, *model.GetChangesSinceRequest) (*model.GetChangesSinceResponse, error)
 	mustEmbedUnimplementedDataServiceServer()
 }
 
@@ -303,6 +710,21 @@ func (UnimplementedDataServiceServer) DeleteMetadata(context.Context, *model.Del
 func (UnimplementedDataServiceServer) UndeleteSecret(context.Context, *model.UndeleteSecretRequest) (*model.DeleteSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndeleteSecret not implemented")
 }
+func (UnimplementedDataServiceServer) GrantAccess(context.Context, *model.GrantAccessRequest) (*model.GrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAccess not implemented")
+}
+func (UnimplementedDataServiceServer) RevokeAccess(context.Context, *model.RevokeAccessRequest) (*model.GrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccess not implemented")
+}
+func (UnimplementedDataServiceServer) ListGrants(context.Context, *model.ListGrantsRequest) (*model.ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
+func (UnimplementedDataServiceServer) ListSecretVersions(context.Context, *model.ListSecretVersionsRequest) (*model.ListSecretVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecretVersions not implemented")
+}
+func (UnimplementedDataServiceServer) GetChangesSince(context.Context, *model.GetChangesSinceRequest) (*model.GetChangesSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
 func (UnimplementedDataServiceServer) mustEmbedUnimplementedDataServiceServer() {}
 func (UnimplementedDataServiceServer) testEmbeddedByValue()                     {}
 
@@ -450,13 +872,103 @@ func _DataService_UndeleteSecret_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-// DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var DataService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "keeper.go.grpc.v1.DataService",
-	HandlerType: (*DataServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _DataService_GrantAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.GrantAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GrantAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GrantAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GrantAccess(ctx, req.(*model.GrantAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_RevokeAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RevokeAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).RevokeAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_RevokeAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).RevokeAccess(ctx, req.(*model.RevokeAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListGrants(ctx, req.(*model.ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ListSecretVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListSecretVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListSecretVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListSecretVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListSecretVersions(ctx, req.(*model.ListSecretVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_GetChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.GetChangesSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetChangesSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetChangesSince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetChangesSince(ctx, req.(*model.GetChangesSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.DataService",
+	HandlerType: (*DataServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "GetSecret",
 			Handler:    _DataService_GetSecret_Handler,
@@ -485,6 +997,26 @@ var DataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UndeleteSecret",
 			Handler:    _DataService_UndeleteSecret_Handler,
 		},
+		{
+			MethodName: "GrantAccess",
+			Handler:    _DataService_GrantAccess_Handler,
+		},
+		{
+			MethodName: "RevokeAccess",
+			Handler:    _DataService_RevokeAccess_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _DataService_ListGrants_Handler,
+		},
+		{
+			MethodName: "ListSecretVersions",
+			Handler:    _DataService_ListSecretVersions_Handler,
+		},
+		{
+			MethodName: "GetChangesSince",
+			Handler:    _DataService_GetChangesSince_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -591,3 +1123,1593 @@ var FileService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
+
+const (
+	TeamService_CreateTeam_FullMethodName   = "/keeper.go.grpc.v1.TeamService/CreateTeam"
+	TeamService_DeleteTeam_FullMethodName   = "/keeper.go.grpc.v1.TeamService/DeleteTeam"
+	TeamService_AddMember_FullMethodName    = "/keeper.go.grpc.v1.TeamService/AddMember"
+	TeamService_RemoveMember_FullMethodName = "/keeper.go.grpc.v1.TeamService/RemoveMember"
+	TeamService_ListTeams_FullMethodName    = "/keeper.go.grpc.v1.TeamService/ListTeams"
+	TeamService_ListMembers_FullMethodName  = "/keeper.go.grpc.v1.TeamService/ListMembers"
+)
+
+// TeamServiceClient is the client API for TeamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TeamServiceClient interface {
+	CreateTeam(ctx context.Context, in *model.TeamRequest, opts ...grpc.CallOption) (*model.TeamResponse, error)
+	DeleteTeam(ctx context.Context, in *model.TeamRequest, opts ...grpc.CallOption) (*model.TeamResponse, error)
+	AddMember(ctx context.Context, in *model.TeamMemberRequest, opts ...grpc.CallOption) (*model.TeamResponse, error)
+	RemoveMember(ctx context.Context, in *model.TeamMemberRequest, opts ...grpc.CallOption) (*model.TeamResponse, error)
+	ListTeams(ctx context.Context, in *model.ListTeamsRequest, opts ...grpc.CallOption) (*model.ListTeamsResponse, error)
+	ListMembers(ctx context.Context, in *model.TeamRequest, opts ...grpc.CallOption) (*model.ListTeamMembersResponse, error)
+}
+
+type teamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeamServiceClient(cc grpc.ClientConnInterface) TeamServiceClient {
+	return &teamServiceClient{cc}
+}
+
+func (c *teamServiceClient) CreateTeam(ctx context.Context, in *model.TeamRequest, opts ...grpc.CallOption) (*model.TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.TeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_CreateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) DeleteTeam(ctx context.Context, in *model.TeamRequest, opts ...grpc.CallOption) (*model.TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.TeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) AddMember(ctx context.Context, in *model.TeamMemberRequest, opts ...grpc.CallOption) (*model.TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.TeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_AddMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) RemoveMember(ctx context.Context, in *model.TeamMemberRequest, opts ...grpc.CallOption) (*model.TeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.TeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ListTeams(ctx context.Context, in *model.ListTeamsRequest, opts ...grpc.CallOption) (*model.ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListTeamsResponse)
+	err := c.cc.Invoke(ctx, TeamService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) ListMembers(ctx context.Context, in *model.TeamRequest, opts ...grpc.CallOption) (*model.ListTeamMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListTeamMembersResponse)
+	err := c.cc.Invoke(ctx, TeamService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TeamServiceServer is the server API for TeamService service.
+// All implementations must embed UnimplementedTeamServiceServer
+// for forward compatibility.
+type TeamServiceServer interface {
+	CreateTeam(context.Context, *model.TeamRequest) (*model.TeamResponse, error)
+	DeleteTeam(context.Context, *model.TeamRequest) (*model.TeamResponse, error)
+	AddMember(context.Context, *model.TeamMemberRequest) (*model.TeamResponse, error)
+	RemoveMember(context.Context, *model.TeamMemberRequest) (*model.TeamResponse, error)
+	ListTeams(context.Context, *model.ListTeamsRequest) (*model.ListTeamsResponse, error)
+	ListMembers(context.Context, *model.TeamRequest) (*model.ListTeamMembersResponse, error)
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+// UnimplementedTeamServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeamServiceServer struct{}
+
+func (UnimplementedTeamServiceServer) CreateTeam(context.Context, *model.TeamRequest) (*model.TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) DeleteTeam(context.Context, *model.TeamRequest) (*model.TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) AddMember(context.Context, *model.TeamMemberRequest) (*model.TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedTeamServiceServer) RemoveMember(context.Context, *model.TeamMemberRequest) (*model.TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedTeamServiceServer) ListTeams(context.Context, *model.ListTeamsRequest) (*model.ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedTeamServiceServer) ListMembers(context.Context, *model.TeamRequest) (*model.ListTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
+func (UnimplementedTeamServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTeamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeamServiceServer will
+// result in compilation errors.
+type UnsafeTeamServiceServer interface {
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+func RegisterTeamServiceServer(s grpc.ServiceRegistrar, srv TeamServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeamServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeamService_ServiceDesc, srv)
+}
+
+func _TeamService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.TeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).CreateTeam(ctx, req.(*model.TeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.TeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).DeleteTeam(ctx, req.(*model.TeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.TeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_AddMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).AddMember(ctx, req.(*model.TeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.TeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).RemoveMember(ctx, req.(*model.TeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ListTeams(ctx, req.(*model.ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.TeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).ListMembers(ctx, req.(*model.TeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.TeamService",
+	HandlerType: (*TeamServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeam",
+			Handler:    _TeamService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _TeamService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "AddMember",
+			Handler:    _TeamService_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _TeamService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _TeamService_ListTeams_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _TeamService_ListMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	ServiceAccountService_CreateServiceAccount_FullMethodName = "/keeper.go.grpc.v1.ServiceAccountService/CreateServiceAccount"
+	ServiceAccountService_ListServiceAccounts_FullMethodName  = "/keeper.go.grpc.v1.ServiceAccountService/ListServiceAccounts"
+	ServiceAccountService_DeleteServiceAccount_FullMethodName = "/keeper.go.grpc.v1.ServiceAccountService/DeleteServiceAccount"
+	ServiceAccountService_CreateAPIKey_FullMethodName         = "/keeper.go.grpc.v1.ServiceAccountService/CreateAPIKey"
+	ServiceAccountService_ListAPIKeys_FullMethodName          = "/keeper.go.grpc.v1.ServiceAccountService/ListAPIKeys"
+	ServiceAccountService_RevokeAPIKey_FullMethodName         = "/keeper.go.grpc.v1.ServiceAccountService/RevokeAPIKey"
+)
+
+// ServiceAccountServiceClient is the client API for ServiceAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceAccountServiceClient interface {
+	CreateServiceAccount(ctx context.Context, in *model.CreateServiceAccountRequest, opts ...grpc.CallOption) (*model.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context, in *model.ListServiceAccountsRequest, opts ...grpc.CallOption) (*model.ListServiceAccountsResponse, error)
+	DeleteServiceAccount(ctx context.Context, in *model.DeleteServiceAccountRequest, opts ...grpc.CallOption) (*model.ServiceAccountResponse, error)
+	CreateAPIKey(ctx context.Context, in *model.CreateAPIKeyRequest, opts ...grpc.CallOption) (*model.CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *model.ListAPIKeysRequest, opts ...grpc.CallOption) (*model.ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *model.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*model.ServiceAccountResponse, error)
+}
+
+type serviceAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceAccountServiceClient(cc grpc.ClientConnInterface) ServiceAccountServiceClient {
+	return &serviceAccountServiceClient{cc}
+}
+
+func (c *serviceAccountServiceClient) CreateServiceAccount(ctx context.Context, in *model.CreateServiceAccountRequest, opts ...grpc.CallOption) (*model.ServiceAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ServiceAccount)
+	err := c.cc.Invoke(ctx, ServiceAccountService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) ListServiceAccounts(ctx context.Context, in *model.ListServiceAccountsRequest, opts ...grpc.CallOption) (*model.ListServiceAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountService_ListServiceAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) DeleteServiceAccount(ctx context.Context, in *model.DeleteServiceAccountRequest, opts ...grpc.CallOption) (*model.ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountService_DeleteServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) CreateAPIKey(ctx context.Context, in *model.CreateAPIKeyRequest, opts ...grpc.CallOption) (*model.CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) ListAPIKeys(ctx context.Context, in *model.ListAPIKeysRequest, opts ...grpc.CallOption) (*model.ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) RevokeAPIKey(ctx context.Context, in *model.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*model.ServiceAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ServiceAccountResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceAccountServiceServer is the server API for ServiceAccountService service.
+// All implementations must embed UnimplementedServiceAccountServiceServer
+// for forward compatibility.
+type ServiceAccountServiceServer interface {
+	CreateServiceAccount(context.Context, *model.CreateServiceAccountRequest) (*model.ServiceAccount, error)
+	ListServiceAccounts(context.Context, *model.ListServiceAccountsRequest) (*model.ListServiceAccountsResponse, error)
+	DeleteServiceAccount(context.Context, *model.DeleteServiceAccountRequest) (*model.ServiceAccountResponse, error)
+	CreateAPIKey(context.Context, *model.CreateAPIKeyRequest) (*model.CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *model.ListAPIKeysRequest) (*model.ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *model.RevokeAPIKeyRequest) (*model.ServiceAccountResponse, error)
+	mustEmbedUnimplementedServiceAccountServiceServer()
+}
+
+// UnimplementedServiceAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceAccountServiceServer struct{}
+
+func (UnimplementedServiceAccountServiceServer) CreateServiceAccount(context.Context, *model.CreateServiceAccountRequest) (*model.ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) ListServiceAccounts(context.Context, *model.ListServiceAccountsRequest) (*model.ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) DeleteServiceAccount(context.Context, *model.DeleteServiceAccountRequest) (*model.ServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) CreateAPIKey(context.Context, *model.CreateAPIKeyRequest) (*model.CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) ListAPIKeys(context.Context, *model.ListAPIKeysRequest) (*model.ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) RevokeAPIKey(context.Context, *model.RevokeAPIKeyRequest) (*model.ServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) mustEmbedUnimplementedServiceAccountServiceServer() {}
+func (UnimplementedServiceAccountServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeServiceAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceAccountServiceServer will
+// result in compilation errors.
+type UnsafeServiceAccountServiceServer interface {
+	mustEmbedUnimplementedServiceAccountServiceServer()
+}
+
+func RegisterServiceAccountServiceServer(s grpc.ServiceRegistrar, srv ServiceAccountServiceServer) {
+	// If the following call pancis, it indicates UnimplementedServiceAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceAccountService_ServiceDesc, srv)
+}
+
+func _ServiceAccountService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccount(ctx, req.(*model.CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).ListServiceAccounts(ctx, req.(*model.ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.DeleteServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccount(ctx, req.(*model.DeleteServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).CreateAPIKey(ctx, req.(*model.CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).ListAPIKeys(ctx, req.(*model.ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).RevokeAPIKey(ctx, req.(*model.RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceAccountService_ServiceDesc is the grpc.ServiceDesc for ServiceAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.ServiceAccountService",
+	HandlerType: (*ServiceAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ServiceAccountService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _ServiceAccountService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _ServiceAccountService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _ServiceAccountService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _ServiceAccountService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _ServiceAccountService_RevokeAPIKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	WrapService_Wrap_FullMethodName   = "/keeper.go.grpc.v1.WrapService/Wrap"
+	WrapService_Unwrap_FullMethodName = "/keeper.go.grpc.v1.WrapService/Unwrap"
+)
+
+// WrapServiceClient is the client API for WrapService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WrapServiceClient interface {
+	Wrap(ctx context.Context, in *model.WrapRequest, opts ...grpc.CallOption) (*model.WrapResponse, error)
+	// Unwrap needs no authentication: the wrapping token is the credential.
+	Unwrap(ctx context.Context, in *model.UnwrapRequest, opts ...grpc.CallOption) (*model.UnwrapResponse, error)
+}
+
+type wrapServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWrapServiceClient(cc grpc.ClientConnInterface) WrapServiceClient {
+	return &wrapServiceClient{cc}
+}
+
+func (c *wrapServiceClient) Wrap(ctx context.Context, in *model.WrapRequest, opts ...grpc.CallOption) (*model.WrapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.WrapResponse)
+	err := c.cc.Invoke(ctx, WrapService_Wrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wrapServiceClient) Unwrap(ctx context.Context, in *model.UnwrapRequest, opts ...grpc.CallOption) (*model.UnwrapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.UnwrapResponse)
+	err := c.cc.Invoke(ctx, WrapService_Unwrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WrapServiceServer is the server API for WrapService service.
+// All implementations must embed UnimplementedWrapServiceServer
+// for forward compatibility.
+type WrapServiceServer interface {
+	Wrap(context.Context, *model.WrapRequest) (*model.WrapResponse, error)
+	// Unwrap needs no authentication: the wrapping token is the credential.
+	Unwrap(context.Context, *model.UnwrapRequest) (*model.UnwrapResponse, error)
+	mustEmbedUnimplementedWrapServiceServer()
+}
+
+// UnimplementedWrapServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWrapServiceServer struct{}
+
+func (UnimplementedWrapServiceServer) Wrap(context.Context, *model.WrapRequest) (*model.WrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wrap not implemented")
+}
+func (UnimplementedWrapServiceServer) Unwrap(context.Context, *model.UnwrapRequest) (*model.UnwrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwrap not implemented")
+}
+func (UnimplementedWrapServiceServer) mustEmbedUnimplementedWrapServiceServer() {}
+func (UnimplementedWrapServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeWrapServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WrapServiceServer will
+// result in compilation errors.
+type UnsafeWrapServiceServer interface {
+	mustEmbedUnimplementedWrapServiceServer()
+}
+
+func RegisterWrapServiceServer(s grpc.ServiceRegistrar, srv WrapServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWrapServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WrapService_ServiceDesc, srv)
+}
+
+func _WrapService_Wrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.WrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WrapServiceServer).Wrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WrapService_Wrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WrapServiceServer).Wrap(ctx, req.(*model.WrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WrapService_Unwrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.UnwrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WrapServiceServer).Unwrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WrapService_Unwrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WrapServiceServer).Unwrap(ctx, req.(*model.UnwrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WrapService_ServiceDesc is the grpc.ServiceDesc for WrapService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WrapService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.WrapService",
+	HandlerType: (*WrapServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Wrap",
+			Handler:    _WrapService_Wrap_Handler,
+		},
+		{
+			MethodName: "Unwrap",
+			Handler:    _WrapService_Unwrap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	DatabaseService_GenerateCredentials_FullMethodName = "/keeper.go.grpc.v1.DatabaseService/GenerateCredentials"
+	DatabaseService_ListLeases_FullMethodName          = "/keeper.go.grpc.v1.DatabaseService/ListLeases"
+	DatabaseService_RevokeLease_FullMethodName         = "/keeper.go.grpc.v1.DatabaseService/RevokeLease"
+)
+
+// DatabaseServiceClient is the client API for DatabaseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DatabaseServiceClient interface {
+	GenerateCredentials(ctx context.Context, in *model.GenerateCredentialsRequest, opts ...grpc.CallOption) (*model.DatabaseCredentials, error)
+	ListLeases(ctx context.Context, in *model.ListLeasesRequest, opts ...grpc.CallOption) (*model.ListLeasesResponse, error)
+	RevokeLease(ctx context.Context, in *model.RevokeLeaseRequest, opts ...grpc.CallOption) (*model.RevokeLeaseResponse, error)
+}
+
+type databaseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatabaseServiceClient(cc grpc.ClientConnInterface) DatabaseServiceClient {
+	return &databaseServiceClient{cc}
+}
+
+func (c *databaseServiceClient) GenerateCredentials(ctx context.Context, in *model.GenerateCredentialsRequest, opts ...grpc.CallOption) (*model.DatabaseCredentials, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.DatabaseCredentials)
+	err := c.cc.Invoke(ctx, DatabaseService_GenerateCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListLeases(ctx context.Context, in *model.ListLeasesRequest, opts ...grpc.CallOption) (*model.ListLeasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListLeasesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListLeases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) RevokeLease(ctx context.Context, in *model.RevokeLeaseRequest, opts ...grpc.CallOption) (*model.RevokeLeaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.RevokeLeaseResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_RevokeLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatabaseServiceServer is the server API for DatabaseService service.
+// All implementations must embed UnimplementedDatabaseServiceServer
+// for forward compatibility.
+type DatabaseServiceServer interface {
+	GenerateCredentials(context.Context, *model.GenerateCredentialsRequest) (*model.DatabaseCredentials, error)
+	ListLeases(context.Context, *model.ListLeasesRequest) (*model.ListLeasesResponse, error)
+	RevokeLease(context.Context, *model.RevokeLeaseRequest) (*model.RevokeLeaseResponse, error)
+	mustEmbedUnimplementedDatabaseServiceServer()
+}
+
+// UnimplementedDatabaseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDatabaseServiceServer struct{}
+
+func (UnimplementedDatabaseServiceServer) GenerateCredentials(context.Context, *model.GenerateCredentialsRequest) (*model.DatabaseCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCredentials not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ListLeases(context.Context, *model.ListLeasesRequest) (*model.ListLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
+}
+func (UnimplementedDatabaseServiceServer) RevokeLease(context.Context, *model.RevokeLeaseRequest) (*model.RevokeLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLease not implemented")
+}
+func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
+func (UnimplementedDatabaseServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeDatabaseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatabaseServiceServer will
+// result in compilation errors.
+type UnsafeDatabaseServiceServer interface {
+	mustEmbedUnimplementedDatabaseServiceServer()
+}
+
+func RegisterDatabaseServiceServer(s grpc.ServiceRegistrar, srv DatabaseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDatabaseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DatabaseService_ServiceDesc, srv)
+}
+
+func _DatabaseService_GenerateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.GenerateCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GenerateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GenerateCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GenerateCredentials(ctx, req.(*model.GenerateCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListLeases(ctx, req.(*model.ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_RevokeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RevokeLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).RevokeLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_RevokeLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).RevokeLease(ctx, req.(*model.RevokeLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DatabaseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.DatabaseService",
+	HandlerType: (*DatabaseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateCredentials",
+			Handler:    _DatabaseService_GenerateCredentials_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _DatabaseService_ListLeases_Handler,
+		},
+		{
+			MethodName: "RevokeLease",
+			Handler:    _DatabaseService_RevokeLease_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	TransitService_CreateKey_FullMethodName = "/keeper.go.grpc.v1.TransitService/CreateKey"
+	TransitService_RotateKey_FullMethodName = "/keeper.go.grpc.v1.TransitService/RotateKey"
+	TransitService_ListKeys_FullMethodName  = "/keeper.go.grpc.v1.TransitService/ListKeys"
+	TransitService_Encrypt_FullMethodName   = "/keeper.go.grpc.v1.TransitService/Encrypt"
+	TransitService_Decrypt_FullMethodName   = "/keeper.go.grpc.v1.TransitService/Decrypt"
+	TransitService_Rewrap_FullMethodName    = "/keeper.go.grpc.v1.TransitService/Rewrap"
+	TransitService_Sign_FullMethodName      = "/keeper.go.grpc.v1.TransitService/Sign"
+	TransitService_Verify_FullMethodName    = "/keeper.go.grpc.v1.TransitService/Verify"
+	TransitService_HMAC_FullMethodName      = "/keeper.go.grpc.v1.TransitService/HMAC"
+)
+
+// TransitServiceClient is the client API for TransitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransitServiceClient interface {
+	CreateKey(ctx context.Context, in *model.CreateTransitKeyRequest, opts ...grpc.CallOption) (*model.TransitKey, error)
+	RotateKey(ctx context.Context, in *model.RotateTransitKeyRequest, opts ...grpc.CallOption) (*model.TransitKey, error)
+	ListKeys(ctx context.Context, in *model.ListTransitKeysRequest, opts ...grpc.CallOption) (*model.ListTransitKeysResponse, error)
+	Encrypt(ctx context.Context, in *model.EncryptRequest, opts ...grpc.CallOption) (*model.EncryptResponse, error)
+	Decrypt(ctx context.Context, in *model.DecryptRequest, opts ...grpc.CallOption) (*model.DecryptResponse, error)
+	Rewrap(ctx context.Context, in *model.RewrapRequest, opts ...grpc.CallOption) (*model.EncryptResponse, error)
+	Sign(ctx context.Context, in *model.SignRequest, opts ...grpc.CallOption) (*model.SignResponse, error)
+	Verify(ctx context.Context, in *model.VerifyRequest, opts ...grpc.CallOption) (*model.VerifyResponse, error)
+	HMAC(ctx context.Context, in *model.HMACRequest, opts ...grpc.CallOption) (*model.HMACResponse, error)
+}
+
+type transitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransitServiceClient(cc grpc.ClientConnInterface) TransitServiceClient {
+	return &transitServiceClient{cc}
+}
+
+func (c *transitServiceClient) CreateKey(ctx context.Context, in *model.CreateTransitKeyRequest, opts ...grpc.CallOption) (*model.TransitKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.TransitKey)
+	err := c.cc.Invoke(ctx, TransitService_CreateKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) RotateKey(ctx context.Context, in *model.RotateTransitKeyRequest, opts ...grpc.CallOption) (*model.TransitKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.TransitKey)
+	err := c.cc.Invoke(ctx, TransitService_RotateKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) ListKeys(ctx context.Context, in *model.ListTransitKeysRequest, opts ...grpc.CallOption) (*model.ListTransitKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.ListTransitKeysResponse)
+	err := c.cc.Invoke(ctx, TransitService_ListKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) Encrypt(ctx context.Context, in *model.EncryptRequest, opts ...grpc.CallOption) (*model.EncryptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.EncryptResponse)
+	err := c.cc.Invoke(ctx, TransitService_Encrypt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) Decrypt(ctx context.Context, in *model.DecryptRequest, opts ...grpc.CallOption) (*model.DecryptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.DecryptResponse)
+	err := c.cc.Invoke(ctx, TransitService_Decrypt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) Rewrap(ctx context.Context, in *model.RewrapRequest, opts ...grpc.CallOption) (*model.EncryptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.EncryptResponse)
+	err := c.cc.Invoke(ctx, TransitService_Rewrap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) Sign(ctx context.Context, in *model.SignRequest, opts ...grpc.CallOption) (*model.SignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.SignResponse)
+	err := c.cc.Invoke(ctx, TransitService_Sign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) Verify(ctx context.Context, in *model.VerifyRequest, opts ...grpc.CallOption) (*model.VerifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.VerifyResponse)
+	err := c.cc.Invoke(ctx, TransitService_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transitServiceClient) HMAC(ctx context.Context, in *model.HMACRequest, opts ...grpc.CallOption) (*model.HMACResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.HMACResponse)
+	err := c.cc.Invoke(ctx, TransitService_HMAC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransitServiceServer is the server API for TransitService service.
+// All implementations must embed UnimplementedTransitServiceServer
+// for forward compatibility.
+type TransitServiceServer interface {
+	CreateKey(context.Context, *model.CreateTransitKeyRequest) (*model.TransitKey, error)
+	RotateKey(context.Context, *model.RotateTransitKeyRequest) (*model.TransitKey, error)
+	ListKeys(context.Context, *model.ListTransitKeysRequest) (*model.ListTransitKeysResponse, error)
+	Encrypt(context.Context, *model.EncryptRequest) (*model.EncryptResponse, error)
+	Decrypt(context.Context, *model.DecryptRequest) (*model.DecryptResponse, error)
+	Rewrap(context.Context, *model.RewrapRequest) (*model.EncryptResponse, error)
+	Sign(context.Context, *model.SignRequest) (*model.SignResponse, error)
+	Verify(context.Context, *model.VerifyRequest) (*model.VerifyResponse, error)
+	HMAC(context.Context, *model.HMACRequest) (*model.HMACResponse, error)
+	mustEmbedUnimplementedTransitServiceServer()
+}
+
+// UnimplementedTransitServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransitServiceServer struct{}
+
+func (UnimplementedTransitServiceServer) CreateKey(context.Context, *model.CreateTransitKeyRequest) (*model.TransitKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKey not implemented")
+}
+func (UnimplementedTransitServiceServer) RotateKey(context.Context, *model.RotateTransitKeyRequest) (*model.TransitKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+}
+func (UnimplementedTransitServiceServer) ListKeys(context.Context, *model.ListTransitKeysRequest) (*model.ListTransitKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedTransitServiceServer) Encrypt(context.Context, *model.EncryptRequest) (*model.EncryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
+}
+func (UnimplementedTransitServiceServer) Decrypt(context.Context, *model.DecryptRequest) (*model.DecryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
+}
+func (UnimplementedTransitServiceServer) Rewrap(context.Context, *model.RewrapRequest) (*model.EncryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewrap not implemented")
+}
+func (UnimplementedTransitServiceServer) Sign(context.Context, *model.SignRequest) (*model.SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedTransitServiceServer) Verify(context.Context, *model.VerifyRequest) (*model.VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedTransitServiceServer) HMAC(context.Context, *model.HMACRequest) (*model.HMACResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMAC not implemented")
+}
+func (UnimplementedTransitServiceServer) mustEmbedUnimplementedTransitServiceServer() {}
+func (UnimplementedTransitServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTransitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransitServiceServer will
+// result in compilation errors.
+type UnsafeTransitServiceServer interface {
+	mustEmbedUnimplementedTransitServiceServer()
+}
+
+func RegisterTransitServiceServer(s grpc.ServiceRegistrar, srv TransitServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTransitServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransitService_ServiceDesc, srv)
+}
+
+func _TransitService_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.CreateTransitKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).CreateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_CreateKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).CreateKey(ctx, req.(*model.CreateTransitKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RotateTransitKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).RotateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_RotateKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).RotateKey(ctx, req.(*model.RotateTransitKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.ListTransitKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_ListKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).ListKeys(ctx, req.(*model.ListTransitKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.EncryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).Encrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_Encrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).Encrypt(ctx, req.(*model.EncryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.DecryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).Decrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_Decrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).Decrypt(ctx, req.(*model.DecryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_Rewrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RewrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).Rewrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_Rewrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).Rewrap(ctx, req.(*model.RewrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_Sign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).Sign(ctx, req.(*model.SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).Verify(ctx, req.(*model.VerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransitService_HMAC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.HMACRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransitServiceServer).HMAC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransitService_HMAC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransitServiceServer).HMAC(ctx, req.(*model.HMACRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransitService_ServiceDesc is the grpc.ServiceDesc for TransitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.TransitService",
+	HandlerType: (*TransitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateKey",
+			Handler:    _TransitService_CreateKey_Handler,
+		},
+		{
+			MethodName: "RotateKey",
+			Handler:    _TransitService_RotateKey_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _TransitService_ListKeys_Handler,
+		},
+		{
+			MethodName: "Encrypt",
+			Handler:    _TransitService_Encrypt_Handler,
+		},
+		{
+			MethodName: "Decrypt",
+			Handler:    _TransitService_Decrypt_Handler,
+		},
+		{
+			MethodName: "Rewrap",
+			Handler:    _TransitService_Rewrap_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _TransitService_Sign_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _TransitService_Verify_Handler,
+		},
+		{
+			MethodName: "HMAC",
+			Handler:    _TransitService_HMAC_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	PKIService_IssueCertificate_FullMethodName  = "/keeper.go.grpc.v1.PKIService/IssueCertificate"
+	PKIService_RevokeCertificate_FullMethodName = "/keeper.go.grpc.v1.PKIService/RevokeCertificate"
+)
+
+// PKIServiceClient is the client API for PKIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PKIServiceClient interface {
+	IssueCertificate(ctx context.Context, in *model.IssueCertificateRequest, opts ...grpc.CallOption) (*model.IssueCertificateResponse, error)
+	RevokeCertificate(ctx context.Context, in *model.RevokeCertificateRequest, opts ...grpc.CallOption) (*model.RevokeCertificateResponse, error)
+}
+
+type pKIServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPKIServiceClient(cc grpc.ClientConnInterface) PKIServiceClient {
+	return &pKIServiceClient{cc}
+}
+
+func (c *pKIServiceClient) IssueCertificate(ctx context.Context, in *model.IssueCertificateRequest, opts ...grpc.CallOption) (*model.IssueCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.IssueCertificateResponse)
+	err := c.cc.Invoke(ctx, PKIService_IssueCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pKIServiceClient) RevokeCertificate(ctx context.Context, in *model.RevokeCertificateRequest, opts ...grpc.CallOption) (*model.RevokeCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.RevokeCertificateResponse)
+	err := c.cc.Invoke(ctx, PKIService_RevokeCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PKIServiceServer is the server API for PKIService service.
+// All implementations must embed UnimplementedPKIServiceServer
+// for forward compatibility.
+type PKIServiceServer interface {
+	IssueCertificate(context.Context, *model.IssueCertificateRequest) (*model.IssueCertificateResponse, error)
+	RevokeCertificate(context.Context, *model.RevokeCertificateRequest) (*model.RevokeCertificateResponse, error)
+	mustEmbedUnimplementedPKIServiceServer()
+}
+
+// UnimplementedPKIServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPKIServiceServer struct{}
+
+func (UnimplementedPKIServiceServer) IssueCertificate(context.Context, *model.IssueCertificateRequest) (*model.IssueCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCertificate not implemented")
+}
+func (UnimplementedPKIServiceServer) RevokeCertificate(context.Context, *model.RevokeCertificateRequest) (*model.RevokeCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
+}
+func (UnimplementedPKIServiceServer) mustEmbedUnimplementedPKIServiceServer() {}
+func (UnimplementedPKIServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePKIServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PKIServiceServer will
+// result in compilation errors.
+type UnsafePKIServiceServer interface {
+	mustEmbedUnimplementedPKIServiceServer()
+}
+
+func RegisterPKIServiceServer(s grpc.ServiceRegistrar, srv PKIServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPKIServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PKIService_ServiceDesc, srv)
+}
+
+func _PKIService_IssueCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.IssueCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PKIServiceServer).IssueCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PKIService_IssueCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PKIServiceServer).IssueCertificate(ctx, req.(*model.IssueCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PKIService_RevokeCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.RevokeCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PKIServiceServer).RevokeCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PKIService_RevokeCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PKIServiceServer).RevokeCertificate(ctx, req.(*model.RevokeCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PKIService_ServiceDesc is the grpc.ServiceDesc for PKIService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PKIService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.PKIService",
+	HandlerType: (*PKIServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueCertificate",
+			Handler:    _PKIService_IssueCertificate_Handler,
+		},
+		{
+			MethodName: "RevokeCertificate",
+			Handler:    _PKIService_RevokeCertificate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	SSHService_SignKey_FullMethodName        = "/keeper.go.grpc.v1.SSHService/SignKey"
+	SSHService_GetCAPublicKey_FullMethodName = "/keeper.go.grpc.v1.SSHService/GetCAPublicKey"
+)
+
+// SSHServiceClient is the client API for SSHService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SSHServiceClient interface {
+	SignKey(ctx context.Context, in *model.SignSSHKeyRequest, opts ...grpc.CallOption) (*model.SignSSHKeyResponse, error)
+	GetCAPublicKey(ctx context.Context, in *model.GetSSHCARequest, opts ...grpc.CallOption) (*model.GetSSHCAResponse, error)
+}
+
+type sSHServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSSHServiceClient(cc grpc.ClientConnInterface) SSHServiceClient {
+	return &sSHServiceClient{cc}
+}
+
+func (c *sSHServiceClient) SignKey(ctx context.Context, in *model.SignSSHKeyRequest, opts ...grpc.CallOption) (*model.SignSSHKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.SignSSHKeyResponse)
+	err := c.cc.Invoke(ctx, SSHService_SignKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHServiceClient) GetCAPublicKey(ctx context.Context, in *model.GetSSHCARequest, opts ...grpc.CallOption) (*model.GetSSHCAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(model.GetSSHCAResponse)
+	err := c.cc.Invoke(ctx, SSHService_GetCAPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SSHServiceServer is the server API for SSHService service.
+// All implementations must embed UnimplementedSSHServiceServer
+// for forward compatibility.
+type SSHServiceServer interface {
+	SignKey(context.Context, *model.SignSSHKeyRequest) (*model.SignSSHKeyResponse, error)
+	GetCAPublicKey(context.Context, *model.GetSSHCARequest) (*model.GetSSHCAResponse, error)
+	mustEmbedUnimplementedSSHServiceServer()
+}
+
+// UnimplementedSSHServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSSHServiceServer struct{}
+
+func (UnimplementedSSHServiceServer) SignKey(context.Context, *model.SignSSHKeyRequest) (*model.SignSSHKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignKey not implemented")
+}
+func (UnimplementedSSHServiceServer) GetCAPublicKey(context.Context, *model.GetSSHCARequest) (*model.GetSSHCAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCAPublicKey not implemented")
+}
+func (UnimplementedSSHServiceServer) mustEmbedUnimplementedSSHServiceServer() {}
+func (UnimplementedSSHServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeSSHServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SSHServiceServer will
+// result in compilation errors.
+type UnsafeSSHServiceServer interface {
+	mustEmbedUnimplementedSSHServiceServer()
+}
+
+func RegisterSSHServiceServer(s grpc.ServiceRegistrar, srv SSHServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSSHServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SSHService_ServiceDesc, srv)
+}
+
+func _SSHService_SignKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.SignSSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHServiceServer).SignKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHService_SignKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHServiceServer).SignKey(ctx, req.(*model.SignSSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHService_GetCAPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(model.GetSSHCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHServiceServer).GetCAPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHService_GetCAPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHServiceServer).GetCAPublicKey(ctx, req.(*model.GetSSHCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SSHService_ServiceDesc is the grpc.ServiceDesc for SSHService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SSHService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "keeper.go.grpc.v1.SSHService",
+	HandlerType: (*SSHServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignKey",
+			Handler:    _SSHService_SignKey_Handler,
+		},
+		{
+			MethodName: "GetCAPublicKey",
+			Handler:    _SSHService_GetCAPublicKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
@@ -5,14 +5,24 @@ import (
 	"errors"
 	"fmt"
 	"keeper/internal/entity"
+	"time"
 
 	pgx "github.com/jackc/pgx/v5"
 	pgxpool "github.com/jackc/pgx/v5/pgxpool"
 )
 
+const accessTokenColumns = `id, user_id, token, device_name, last_ip, last_used_at, revoked_at, expires_at, created_at`
+
 type AccessTokenRepository interface {
 	Create(ctx context.Context, tx pgx.Tx, token *entity.AccessToken) error
-	FindValidByUserID(ctx context.Context, tx pgx.Tx, userID int64) (entity.AccessToken, error)
+	FindActive(ctx context.Context, token string) (entity.AccessToken, error)
+	Touch(ctx context.Context, id int64, ip string) error
+	RevokeByToken(ctx context.Context, token string) error
+	RevokeByID(ctx context.Context, userID, id int64) (string, error)
+	ListActiveByUser(ctx context.Context, userID int64) ([]entity.AccessToken, error)
+	Rotate(ctx context.Context, tx pgx.Tx, id int64, token string, expiresAt time.Time) error
+	RevokeTx(ctx context.Context, tx pgx.Tx, id int64) error
+	RevokeOthers(ctx context.Context, tx pgx.Tx, userID int64, keepToken string) (int64, error)
 }
 
 type accessTokenRepository struct {
@@ -27,10 +37,12 @@ func NewAccessRepository(db *pgxpool.Pool) AccessTokenRepository {
 
 func (r *accessTokenRepository) Create(ctx context.Context, tx pgx.Tx, token *entity.AccessToken) error {
 	query := `
-		INSERT INTO access_tokens (user_id, token, expires_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO access_tokens (user_id, token, device_name, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
 	`
-	_, err := tx.Exec(ctx, query, token.UserID, token.Token, token.ExpiresAt)
+	err := tx.QueryRow(ctx, query, token.UserID, token.Token, token.DeviceName, token.ExpiresAt).
+		Scan(&token.ID, &token.CreatedAt)
 
 	if err != nil {
 		return fmt.Errorf("error create Token: %w", err)
@@ -39,35 +51,143 @@ func (r *accessTokenRepository) Create(ctx context.Context, tx pgx.Tx, token *en
 	return nil
 }
 
-func (r *accessTokenRepository) FindValidByUserID(
+// FindActive returns the session for a token that is neither revoked nor expired.
+func (r *accessTokenRepository) FindActive(ctx context.Context, token string) (entity.AccessToken, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE token = $1 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+	session, err := scanAccessToken(r.Pool.QueryRow(ctx, query, token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return session, fmt.Errorf("no active token found: %w", err)
+		}
+		return session, fmt.Errorf("failed to find active token: %w", err)
+	}
+	return session, nil
+}
+
+func (r *accessTokenRepository) Touch(ctx context.Context, id int64, ip string) error {
+	query := `UPDATE access_tokens SET last_ip = $2, last_used_at = NOW() WHERE id = $1`
+	if _, err := r.Pool.Exec(ctx, query, id, ip); err != nil {
+		return fmt.Errorf("failed to update token usage: %w", err)
+	}
+	return nil
+}
+
+func (r *accessTokenRepository) RevokeByToken(ctx context.Context, token string) error {
+	query := `UPDATE access_tokens SET revoked_at = NOW() WHERE token = $1 AND revoked_at IS NULL`
+	ct, err := r.Pool.Exec(ctx, query, token)
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no token revoked")
+	}
+	return nil
+}
+
+// RevokeByID revokes one of the user's sessions and returns its token.
+func (r *accessTokenRepository) RevokeByID(ctx context.Context, userID, id int64) (string, error) {
+	query := `
+		UPDATE access_tokens SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+		RETURNING token
+	`
+	var token string
+	if err := r.Pool.QueryRow(ctx, query, id, userID).Scan(&token); err != nil {
+		return "", fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return token, nil
+}
+
+func (r *accessTokenRepository) ListActiveByUser(ctx context.Context, userID int64) ([]entity.AccessToken, error) {
+	query := `
+		SELECT ` + accessTokenColumns + `
+		FROM access_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY COALESCE(last_used_at, created_at) DESC
+	`
+	rows, err := r.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []entity.AccessToken
+	for rows.Next() {
+		session, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// Rotate replaces the session's access token and extends its lifetime.
+func (r *accessTokenRepository) Rotate(
 	ctx context.Context,
 	tx pgx.Tx,
-	userID int64,
-) (entity.AccessToken, error) {
-	var token entity.AccessToken
+	id int64,
+	token string,
+	expiresAt time.Time,
+) error {
+	query := `UPDATE access_tokens SET token = $2, expires_at = $3 WHERE id = $1 AND revoked_at IS NULL`
+	ct, err := tx.Exec(ctx, query, id, token, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to rotate token: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no token rotated")
+	}
+	return nil
+}
 
+func (r *accessTokenRepository) RevokeTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	query := `UPDATE access_tokens SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// RevokeOthers revokes every active session of the user except the one
+// holding keepToken and returns how many were revoked.
+func (r *accessTokenRepository) RevokeOthers(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID int64,
+	keepToken string,
+) (int64, error) {
 	query := `
-		SELECT id, user_id, token, expires_at, created_at
-		FROM access_tokens
-		WHERE user_id = $1 AND expires_at > NOW()
-		ORDER BY created_at DESC
-		LIMIT 1
+		UPDATE access_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND token <> $2 AND revoked_at IS NULL
 	`
+	ct, err := tx.Exec(ctx, query, userID, keepToken)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return ct.RowsAffected(), nil
+}
 
-	err := tx.QueryRow(ctx, query, userID).Scan(
+func scanAccessToken(row pgx.Row) (entity.AccessToken, error) {
+	var token entity.AccessToken
+	err := row.Scan(
 		&token.ID,
 		&token.UserID,
 		&token.Token,
+		&token.DeviceName,
+		&token.LastIP,
+		&token.LastUsedAt,
+		&token.RevokedAt,
 		&token.ExpiresAt,
 		&token.CreatedAt,
 	)
-
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return token, fmt.Errorf("no valid token found: %w", err)
-		}
-		return token, fmt.Errorf("failed to find valid token: %w", err)
+		return token, fmt.Errorf("failed to scan token: %w", err)
 	}
-
 	return token, nil
 }
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+// auditLockKey serializes appends so every record links to the previous one.
+const auditLockKey = 0x6b656570
+
+type AuditRepository interface {
+	Append(ctx context.Context, event *entity.AuditEvent, seal func(event *entity.AuditEvent) string) error
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]entity.AuditEvent, error)
+	ListRecent(ctx context.Context, limit int) ([]entity.AuditEvent, error)
+}
+
+type auditRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewAuditRepository(db *pgxpool.Pool) AuditRepository {
+	return &auditRepository{Pool: db}
+}
+
+// Append links event to the last record, lets seal compute its hash and
+// stores it, all under a transaction-scoped advisory lock.
+func (r *auditRepository) Append(
+	ctx context.Context,
+	event *entity.AuditEvent,
+	seal func(event *entity.AuditEvent) string,
+) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, auditLockKey); err != nil {
+		return fmt.Errorf("failed to lock audit log: %w", err)
+	}
+
+	var prevHash string
+	err = tx.QueryRow(ctx, `SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1`).Scan(&prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get last audit record: %w", err)
+	}
+	event.PrevHash = prevHash
+	event.Hash = seal(event)
+
+	var userID *int64
+	if event.UserID != 0 {
+		userID = &event.UserID
+	}
+	query := `
+		INSERT INTO audit_log (user_id, method, path_hmac, version, result, client_addr_hmac,
+			request_id, prev_hash, hash, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+	err = tx.QueryRow(ctx, query,
+		userID,
+		event.Method,
+		event.PathHMAC,
+		event.Version,
+		event.Result,
+		event.ClientAddrHMAC,
+		event.RequestID,
+		event.PrevHash,
+		event.Hash,
+		event.CreatedAt,
+	).Scan(&event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to append audit record: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit audit record: %w", err)
+	}
+	return nil
+}
+
+func (r *auditRepository) ListAfter(ctx context.Context, afterID int64, limit int) ([]entity.AuditEvent, error) {
+	query := `
+		SELECT id, COALESCE(user_id, 0), method, path_hmac, version, result, client_addr_hmac,
+			request_id, prev_hash, hash, created_at
+		FROM audit_log
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+	return r.list(ctx, query, afterID, limit)
+}
+
+// ListRecent returns the last limit records in chronological order.
+func (r *auditRepository) ListRecent(ctx context.Context, limit int) ([]entity.AuditEvent, error) {
+	query := `
+		SELECT * FROM (
+			SELECT id, COALESCE(user_id, 0), method, path_hmac, version, result, client_addr_hmac,
+				request_id, prev_hash, hash, created_at
+			FROM audit_log
+			ORDER BY id DESC
+			LIMIT $1
+		) recent
+		ORDER BY id
+	`
+	return r.list(ctx, query, limit)
+}
+
+func (r *auditRepository) list(ctx context.Context, query string, args ...any) ([]entity.AuditEvent, error) {
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit records: %w", err)
+	}
+	defer rows.Close()
+
+	var events []entity.AuditEvent
+	for rows.Next() {
+		var e entity.AuditEvent
+		if err := rows.Scan(
+			&e.ID,
+			&e.UserID,
+			&e.Method,
+			&e.PathHMAC,
+			&e.Version,
+			&e.Result,
+			&e.ClientAddrHMAC,
+			&e.RequestID,
+			&e.PrevHash,
+			&e.Hash,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan audit record: %w", err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
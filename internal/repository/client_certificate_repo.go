@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ClientCertificateRepository interface {
+	Bind(ctx context.Context, subject, login string) error
+	Unbind(ctx context.Context, subject string) (bool, error)
+	FindUserID(ctx context.Context, tx pgx.Tx, subject string) (int64, error)
+	List(ctx context.Context) ([]entity.ClientCertificate, error)
+}
+
+type clientCertificateRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewClientCertificateRepository(db *pgxpool.Pool) ClientCertificateRepository {
+	return &clientCertificateRepository{Pool: db}
+}
+
+// Bind maps subject to the user with login, replacing an earlier binding of
+// the same subject. It returns pgx.ErrNoRows when the login doesn't exist.
+func (r *clientCertificateRepository) Bind(ctx context.Context, subject, login string) error {
+	query := `
+		INSERT INTO client_certificates (subject, user_id)
+		SELECT $1, id FROM users WHERE login = $2
+		ON CONFLICT (subject) DO UPDATE
+		SET user_id = EXCLUDED.user_id, created_at = NOW()
+	`
+	tag, err := r.Pool.Exec(ctx, query, subject, login)
+	if err != nil {
+		return fmt.Errorf("failed to bind client certificate: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+func (r *clientCertificateRepository) Unbind(ctx context.Context, subject string) (bool, error) {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM client_certificates WHERE subject = $1`, subject)
+	if err != nil {
+		return false, fmt.Errorf("failed to unbind client certificate: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *clientCertificateRepository) FindUserID(ctx context.Context, tx pgx.Tx, subject string) (int64, error) {
+	var userID int64
+	err := tx.QueryRow(ctx, `SELECT user_id FROM client_certificates WHERE subject = $1`, subject).Scan(&userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find client certificate: %w", err)
+	}
+	return userID, nil
+}
+
+func (r *clientCertificateRepository) List(ctx context.Context) ([]entity.ClientCertificate, error) {
+	query := `
+		SELECT c.id, c.subject, c.user_id, u.login, c.created_at
+		FROM client_certificates c
+		JOIN users u ON u.id = c.user_id
+		ORDER BY c.id
+	`
+	rows, err := r.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client certificates: %w", err)
+	}
+	defer rows.Close()
+
+	var certs []entity.ClientCertificate
+	for rows.Next() {
+		var c entity.ClientCertificate
+		if err := rows.Scan(&c.ID, &c.Subject, &c.UserID, &c.Login, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan client certificate: %w", err)
+		}
+		certs = append(certs, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list client certificates: %w", err)
+	}
+	return certs, nil
+}
@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DatabaseEngineRepository interface {
+	// SaveConnection creates the connection or replaces the DSN of the one
+	// with the same name.
+	SaveConnection(ctx context.Context, conn *entity.DBConnection) error
+	GetConnection(ctx context.Context, name string) (entity.DBConnection, error)
+	GetConnectionByID(ctx context.Context, id int64) (entity.DBConnection, error)
+	ListConnections(ctx context.Context) ([]entity.DBConnection, error)
+	DeleteConnection(ctx context.Context, id int64) error
+	// SaveRole creates the role or replaces the one with the same name.
+	SaveRole(ctx context.Context, role *entity.DBRole) error
+	GetRole(ctx context.Context, name string) (entity.DBRole, error)
+	GetRoleByID(ctx context.Context, id int64) (entity.DBRole, error)
+	ListRoles(ctx context.Context) ([]entity.DBRole, error)
+	DeleteRole(ctx context.Context, id int64) error
+	CreateLease(ctx context.Context, lease *entity.DBLease) error
+	GetLease(ctx context.Context, leaseID string) (entity.DBLease, error)
+	// ListLeases returns the unrevoked leases of a user, or of everyone when
+	// userID is zero.
+	ListLeases(ctx context.Context, userID int64) ([]entity.DBLease, error)
+	CountActiveLeases(ctx context.Context, roleID int64) (int64, error)
+	ListExpiredLeases(ctx context.Context, limit int) ([]entity.DBLease, error)
+	MarkLeaseRevoked(ctx context.Context, id int64) error
+}
+
+type databaseEngineRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewDatabaseEngineRepository(db *pgxpool.Pool) DatabaseEngineRepository {
+	return &databaseEngineRepository{Pool: db}
+}
+
+func (r *databaseEngineRepository) SaveConnection(ctx context.Context, conn *entity.DBConnection) error {
+	query := `
+		INSERT INTO db_connections (name, dsn)
+		VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET dsn = EXCLUDED.dsn, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	err := r.Pool.QueryRow(ctx, query, conn.Name, conn.DSN).Scan(&conn.ID, &conn.CreatedAt, &conn.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save database connection: %w", err)
+	}
+	return nil
+}
+
+const dbConnectionSelect = `SELECT id, name, dsn, created_at, updated_at FROM db_connections`
+
+func scanDBConnection(row pgx.Row) (entity.DBConnection, error) {
+	var c entity.DBConnection
+	err := row.Scan(&c.ID, &c.Name, &c.DSN, &c.CreatedAt, &c.UpdatedAt)
+	return c, err
+}
+
+func (r *databaseEngineRepository) GetConnection(ctx context.Context, name string) (entity.DBConnection, error) {
+	c, err := scanDBConnection(r.Pool.QueryRow(ctx, dbConnectionSelect+` WHERE name = $1`, name))
+	if err != nil {
+		return c, fmt.Errorf("failed to get database connection: %w", err)
+	}
+	return c, nil
+}
+
+func (r *databaseEngineRepository) GetConnectionByID(ctx context.Context, id int64) (entity.DBConnection, error) {
+	c, err := scanDBConnection(r.Pool.QueryRow(ctx, dbConnectionSelect+` WHERE id = $1`, id))
+	if err != nil {
+		return c, fmt.Errorf("failed to get database connection: %w", err)
+	}
+	return c, nil
+}
+
+func (r *databaseEngineRepository) ListConnections(ctx context.Context) ([]entity.DBConnection, error) {
+	rows, err := r.Pool.Query(ctx, dbConnectionSelect+` ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list database connections: %w", err)
+	}
+	defer rows.Close()
+
+	var conns []entity.DBConnection
+	for rows.Next() {
+		c, err := scanDBConnection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan database connection: %w", err)
+		}
+		conns = append(conns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list database connections: %w", err)
+	}
+	return conns, nil
+}
+
+func (r *databaseEngineRepository) DeleteConnection(ctx context.Context, id int64) error {
+	if _, err := r.Pool.Exec(ctx, `DELETE FROM db_connections WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete database connection: %w", err)
+	}
+	return nil
+}
+
+func (r *databaseEngineRepository) SaveRole(ctx context.Context, role *entity.DBRole) error {
+	query := `
+		INSERT INTO db_roles
+			(name, connection_id, creation_statements, revocation_statements, default_ttl_seconds, max_ttl_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (name) DO UPDATE SET
+			connection_id = EXCLUDED.connection_id,
+			creation_statements = EXCLUDED.creation_statements,
+			revocation_statements = EXCLUDED.revocation_statements,
+			default_ttl_seconds = EXCLUDED.default_ttl_seconds,
+			max_ttl_seconds = EXCLUDED.max_ttl_seconds,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	err := r.Pool.QueryRow(ctx, query,
+		role.Name, role.ConnectionID, role.CreationStatements, role.RevocationStatements,
+		int64(role.DefaultTTL.Seconds()), int64(role.MaxTTL.Seconds()),
+	).Scan(&role.ID, &role.CreatedAt, &role.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save database role: %w", err)
+	}
+	return nil
+}
+
+const dbRoleSelect = `
+	SELECT r.id, r.name, r.connection_id, c.name, r.creation_statements, r.revocation_statements,
+		r.default_ttl_seconds, r.max_ttl_seconds, r.created_at, r.updated_at
+	FROM db_roles r
+	JOIN db_connections c ON c.id = r.connection_id
+`
+
+func scanDBRole(row pgx.Row) (entity.DBRole, error) {
+	var role entity.DBRole
+	var defaultTTL, maxTTL int64
+	err := row.Scan(&role.ID, &role.Name, &role.ConnectionID, &role.Connection,
+		&role.CreationStatements, &role.RevocationStatements,
+		&defaultTTL, &maxTTL, &role.CreatedAt, &role.UpdatedAt)
+	role.DefaultTTL = time.Duration(defaultTTL) * time.Second
+	role.MaxTTL = time.Duration(maxTTL) * time.Second
+	return role, err
+}
+
+func (r *databaseEngineRepository) GetRole(ctx context.Context, name string) (entity.DBRole, error) {
+	role, err := scanDBRole(r.Pool.QueryRow(ctx, dbRoleSelect+` WHERE r.name = $1`, name))
+	if err != nil {
+		return role, fmt.Errorf("failed to get database role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *databaseEngineRepository) GetRoleByID(ctx context.Context, id int64) (entity.DBRole, error) {
+	role, err := scanDBRole(r.Pool.QueryRow(ctx, dbRoleSelect+` WHERE r.id = $1`, id))
+	if err != nil {
+		return role, fmt.Errorf("failed to get database role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *databaseEngineRepository) ListRoles(ctx context.Context) ([]entity.DBRole, error) {
+	rows, err := r.Pool.Query(ctx, dbRoleSelect+` ORDER BY r.name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list database roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []entity.DBRole
+	for rows.Next() {
+		role, err := scanDBRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan database role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list database roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (r *databaseEngineRepository) DeleteRole(ctx context.Context, id int64) error {
+	if _, err := r.Pool.Exec(ctx, `DELETE FROM db_roles WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete database role: %w", err)
+	}
+	return nil
+}
+
+func (r *databaseEngineRepository) CreateLease(ctx context.Context, lease *entity.DBLease) error {
+	query := `
+		INSERT INTO db_leases (lease_id, role_id, user_id, username, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	err := r.Pool.QueryRow(ctx, query,
+		lease.LeaseID, lease.RoleID, lease.UserID, lease.Username, lease.ExpiresAt,
+	).Scan(&lease.ID, &lease.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create lease: %w", err)
+	}
+	return nil
+}
+
+const dbLeaseSelect = `
+	SELECT l.id, l.lease_id, l.role_id, r.name, l.user_id, l.username,
+		l.expires_at, l.revoked_at, l.created_at
+	FROM db_leases l
+	JOIN db_roles r ON r.id = l.role_id
+`
+
+func scanDBLease(row pgx.Row) (entity.DBLease, error) {
+	var l entity.DBLease
+	err := row.Scan(&l.ID, &l.LeaseID, &l.RoleID, &l.Role, &l.UserID, &l.Username,
+		&l.ExpiresAt, &l.RevokedAt, &l.CreatedAt)
+	return l, err
+}
+
+func (r *databaseEngineRepository) queryLeases(ctx context.Context, query string, args ...any) ([]entity.DBLease, error) {
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leases: %w", err)
+	}
+	defer rows.Close()
+
+	var leases []entity.DBLease
+	for rows.Next() {
+		l, err := scanDBLease(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lease: %w", err)
+		}
+		leases = append(leases, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list leases: %w", err)
+	}
+	return leases, nil
+}
+
+func (r *databaseEngineRepository) GetLease(ctx context.Context, leaseID string) (entity.DBLease, error) {
+	l, err := scanDBLease(r.Pool.QueryRow(ctx, dbLeaseSelect+` WHERE l.lease_id = $1`, leaseID))
+	if err != nil {
+		return l, fmt.Errorf("failed to get lease: %w", err)
+	}
+	return l, nil
+}
+
+func (r *databaseEngineRepository) ListLeases(ctx context.Context, userID int64) ([]entity.DBLease, error) {
+	return r.queryLeases(ctx, dbLeaseSelect+`
+		WHERE l.revoked_at IS NULL AND ($1 = 0 OR l.user_id = $1)
+		ORDER BY l.expires_at
+	`, userID)
+}
+
+func (r *databaseEngineRepository) CountActiveLeases(ctx context.Context, roleID int64) (int64, error) {
+	var n int64
+	err := r.Pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM db_leases WHERE role_id = $1 AND revoked_at IS NULL`, roleID,
+	).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count leases: %w", err)
+	}
+	return n, nil
+}
+
+func (r *databaseEngineRepository) ListExpiredLeases(ctx context.Context, limit int) ([]entity.DBLease, error) {
+	return r.queryLeases(ctx, dbLeaseSelect+`
+		WHERE l.revoked_at IS NULL AND l.expires_at <= NOW()
+		ORDER BY l.expires_at
+		LIMIT $1
+	`, limit)
+}
+
+func (r *databaseEngineRepository) MarkLeaseRevoked(ctx context.Context, id int64) error {
+	_, err := r.Pool.Exec(ctx, `UPDATE db_leases SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke lease: %w", err)
+	}
+	return nil
+}
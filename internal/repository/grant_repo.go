@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type GrantRepository interface {
+	Save(ctx context.Context, grant *entity.SecretGrant) error
+	Delete(ctx context.Context, ownerID, granteeID int64, path string) error
+	ListByOwner(ctx context.Context, ownerID int64) ([]entity.SecretGrant, error)
+	ListByGrantee(ctx context.Context, granteeID int64) ([]entity.SecretGrant, error)
+	FindAccess(ctx context.Context, ownerID, granteeID int64, path string) (string, error)
+	ListSharedSecrets(ctx context.Context, granteeID int64) ([]entity.SharedSecret, error)
+}
+
+type grantRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewGrantRepository(db *pgxpool.Pool) GrantRepository {
+	return &grantRepository{Pool: db}
+}
+
+func (r *grantRepository) Save(ctx context.Context, grant *entity.SecretGrant) error {
+	query := `
+		INSERT INTO secret_grants (owner_id, grantee_id, path_prefix, access)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (owner_id, grantee_id, path_prefix) DO UPDATE
+		SET access = EXCLUDED.access
+		RETURNING id, created_at
+	`
+	err := r.Pool.QueryRow(ctx, query, grant.OwnerID, grant.GranteeID, grant.Path, grant.Access).
+		Scan(&grant.ID, &grant.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save grant: %w", err)
+	}
+	return nil
+}
+
+func (r *grantRepository) Delete(ctx context.Context, ownerID, granteeID int64, path string) error {
+	query := `
+		DELETE FROM secret_grants
+		WHERE owner_id = $1 AND grantee_id = $2 AND path_prefix = $3
+	`
+	ct, err := r.Pool.Exec(ctx, query, ownerID, granteeID, path)
+	if err != nil {
+		return fmt.Errorf("failed to delete grant: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no grant deleted")
+	}
+	return nil
+}
+
+func (r *grantRepository) ListByOwner(ctx context.Context, ownerID int64) ([]entity.SecretGrant, error) {
+	query := `
+		SELECT g.id, g.owner_id, o.login, g.grantee_id, u.login, g.path_prefix, g.access, g.created_at
+		FROM secret_grants g
+		JOIN users o ON o.id = g.owner_id
+		JOIN users u ON u.id = g.grantee_id
+		WHERE g.owner_id = $1
+		ORDER BY g.path_prefix, u.login
+	`
+	return r.list(ctx, query, ownerID)
+}
+
+func (r *grantRepository) ListByGrantee(ctx context.Context, granteeID int64) ([]entity.SecretGrant, error) {
+	query := `
+		SELECT g.id, g.owner_id, o.login, g.grantee_id, u.login, g.path_prefix, g.access, g.created_at
+		FROM secret_grants g
+		JOIN users o ON o.id = g.owner_id
+		JOIN users u ON u.id = g.grantee_id
+		WHERE g.grantee_id = $1
+		ORDER BY o.login, g.path_prefix
+	`
+	return r.list(ctx, query, granteeID)
+}
+
+func (r *grantRepository) list(ctx context.Context, query string, id int64) ([]entity.SecretGrant, error) {
+	rows, err := r.Pool.Query(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grants: %w", err)
+	}
+	defer rows.Close()
+
+	var grants []entity.SecretGrant
+	for rows.Next() {
+		var g entity.SecretGrant
+		if err := rows.Scan(
+			&g.ID, &g.OwnerID, &g.OwnerLogin, &g.GranteeID, &g.GranteeLogin, &g.Path, &g.Access, &g.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan grant: %w", err)
+		}
+		grants = append(grants, g)
+	}
+	return grants, nil
+}
+
+// FindAccess returns the strongest access level granted by ownerID to granteeID
+// on path. A grant covers its own path and every path below it, so a grant on
+// db covers db and db/password but not db2. It returns an error wrapping
+// pgx.ErrNoRows when no grant covers path.
+func (r *grantRepository) FindAccess(ctx context.Context, ownerID, granteeID int64, path string) (string, error) {
+	var access string
+	query := `
+		SELECT access
+		FROM secret_grants
+		WHERE owner_id = $1 AND grantee_id = $2
+			AND ($3 = path_prefix OR starts_with($3, rtrim(path_prefix, '/') || '/'))
+		ORDER BY access = 'write' DESC
+		LIMIT 1
+	`
+	err := r.Pool.QueryRow(ctx, query, ownerID, granteeID, path).Scan(&access)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("no grant found: %w", err)
+		}
+		return "", fmt.Errorf("failed to find grant: %w", err)
+	}
+	return access, nil
+}
+
+func (r *grantRepository) ListSharedSecrets(ctx context.Context, granteeID int64) ([]entity.SharedSecret, error) {
+	query := `
+		SELECT o.login, sm.title, MAX(g.access)
+		FROM secret_grants g
+		JOIN users o ON o.id = g.owner_id
+		JOIN secrets_metadata sm ON sm.user_id = g.owner_id
+			AND (sm.title = g.path_prefix OR starts_with(sm.title, rtrim(g.path_prefix, '/') || '/'))
+		WHERE g.grantee_id = $1 AND sm.deleted_at IS NULL
+		GROUP BY o.login, sm.title
+		ORDER BY o.login, sm.title
+	`
+	rows, err := r.Pool.Query(ctx, query, granteeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared secrets: %w", err)
+	}
+	defer rows.Close()
+
+	var secrets []entity.SharedSecret
+	for rows.Next() {
+		var s entity.SharedSecret
+		if err := rows.Scan(&s.OwnerLogin, &s.Path, &s.Access); err != nil {
+			return nil, fmt.Errorf("failed to scan shared secret: %w", err)
+		}
+		secrets = append(secrets, s)
+	}
+	return secrets, nil
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type JWTKeyRepository interface {
+	// List returns the active key and the keys retired after retiredAfter,
+	// newest first.
+	List(ctx context.Context, retiredAfter time.Time) ([]entity.JWTKey, error)
+	// Rotate retires the active key and stores key as the new one.
+	Rotate(ctx context.Context, key *entity.JWTKey) error
+	// Prune deletes keys retired before retiredBefore.
+	Prune(ctx context.Context, retiredBefore time.Time) (int64, error)
+}
+
+type jwtKeyRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewJWTKeyRepository(db *pgxpool.Pool) JWTKeyRepository {
+	return &jwtKeyRepository{Pool: db}
+}
+
+func (r *jwtKeyRepository) List(ctx context.Context, retiredAfter time.Time) ([]entity.JWTKey, error) {
+	query := `
+		SELECT id, kid, algorithm, private_key, public_key, created_at, retired_at
+		FROM jwt_keys
+		WHERE retired_at IS NULL OR retired_at > $1
+		ORDER BY id DESC
+	`
+	rows, err := r.Pool.Query(ctx, query, retiredAfter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jwt keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []entity.JWTKey
+	for rows.Next() {
+		var k entity.JWTKey
+		if err := rows.Scan(&k.ID, &k.KID, &k.Algorithm, &k.PrivateKey, &k.PublicKey,
+			&k.CreatedAt, &k.RetiredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan jwt key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list jwt keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *jwtKeyRepository) Rotate(ctx context.Context, key *entity.JWTKey) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	if _, err = tx.Exec(ctx, `UPDATE jwt_keys SET retired_at = NOW() WHERE retired_at IS NULL`); err != nil {
+		return fmt.Errorf("failed to retire jwt key: %w", err)
+	}
+
+	query := `
+		INSERT INTO jwt_keys (kid, algorithm, private_key, public_key)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRow(ctx, query, key.KID, key.Algorithm, key.PrivateKey, key.PublicKey).
+		Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store jwt key: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *jwtKeyRepository) Prune(ctx context.Context, retiredBefore time.Time) (int64, error) {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM jwt_keys WHERE retired_at < $1`, retiredBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune jwt keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
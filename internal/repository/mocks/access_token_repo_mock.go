@@ -8,6 +8,7 @@ import (
 	context "context"
 	entity "keeper/internal/entity"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	pgx "github.com/jackc/pgx/v5"
@@ -50,17 +51,118 @@ func (mr *MockAccessTokenRepositoryMockRecorder) Create(ctx, tx, token interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccessTokenRepository)(nil).Create), ctx, tx, token)
 }
 
-// FindValidByUserID mocks base method.
-func (m *MockAccessTokenRepository) FindValidByUserID(ctx context.Context, tx pgx.Tx, userID int64) (entity.AccessToken, error) {
+// FindActive mocks base method.
+func (m *MockAccessTokenRepository) FindActive(ctx context.Context, token string) (entity.AccessToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindValidByUserID", ctx, tx, userID)
+	ret := m.ctrl.Call(m, "FindActive", ctx, token)
 	ret0, _ := ret[0].(entity.AccessToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindValidByUserID indicates an expected call of FindValidByUserID.
-func (mr *MockAccessTokenRepositoryMockRecorder) FindValidByUserID(ctx, tx, userID interface{}) *gomock.Call {
+// FindActive indicates an expected call of FindActive.
+func (mr *MockAccessTokenRepositoryMockRecorder) FindActive(ctx, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindValidByUserID", reflect.TypeOf((*MockAccessTokenRepository)(nil).FindValidByUserID), ctx, tx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockAccessTokenRepository)(nil).FindActive), ctx, token)
+}
+
+// ListActiveByUser mocks base method.
+func (m *MockAccessTokenRepository) ListActiveByUser(ctx context.Context, userID int64) ([]entity.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByUser", ctx, userID)
+	ret0, _ := ret[0].([]entity.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByUser indicates an expected call of ListActiveByUser.
+func (mr *MockAccessTokenRepositoryMockRecorder) ListActiveByUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByUser", reflect.TypeOf((*MockAccessTokenRepository)(nil).ListActiveByUser), ctx, userID)
+}
+
+// RevokeByID mocks base method.
+func (m *MockAccessTokenRepository) RevokeByID(ctx context.Context, userID, id int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByID", ctx, userID, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeByID indicates an expected call of RevokeByID.
+func (mr *MockAccessTokenRepositoryMockRecorder) RevokeByID(ctx, userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByID", reflect.TypeOf((*MockAccessTokenRepository)(nil).RevokeByID), ctx, userID, id)
+}
+
+// RevokeByToken mocks base method.
+func (m *MockAccessTokenRepository) RevokeByToken(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeByToken indicates an expected call of RevokeByToken.
+func (mr *MockAccessTokenRepositoryMockRecorder) RevokeByToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByToken", reflect.TypeOf((*MockAccessTokenRepository)(nil).RevokeByToken), ctx, token)
+}
+
+// RevokeOthers mocks base method.
+func (m *MockAccessTokenRepository) RevokeOthers(ctx context.Context, tx pgx.Tx, userID int64, keepToken string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOthers", ctx, tx, userID, keepToken)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOthers indicates an expected call of RevokeOthers.
+func (mr *MockAccessTokenRepositoryMockRecorder) RevokeOthers(ctx, tx, userID, keepToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOthers", reflect.TypeOf((*MockAccessTokenRepository)(nil).RevokeOthers), ctx, tx, userID, keepToken)
+}
+
+// RevokeTx mocks base method.
+func (m *MockAccessTokenRepository) RevokeTx(ctx context.Context, tx pgx.Tx, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTx", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeTx indicates an expected call of RevokeTx.
+func (mr *MockAccessTokenRepositoryMockRecorder) RevokeTx(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTx", reflect.TypeOf((*MockAccessTokenRepository)(nil).RevokeTx), ctx, tx, id)
+}
+
+// Rotate mocks base method.
+func (m *MockAccessTokenRepository) Rotate(ctx context.Context, tx pgx.Tx, id int64, token string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, tx, id, token, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockAccessTokenRepositoryMockRecorder) Rotate(ctx, tx, id, token, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockAccessTokenRepository)(nil).Rotate), ctx, tx, id, token, expiresAt)
+}
+
+// Touch mocks base method.
+func (m *MockAccessTokenRepository) Touch(ctx context.Context, id int64, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, id, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockAccessTokenRepositoryMockRecorder) Touch(ctx, id, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockAccessTokenRepository)(nil).Touch), ctx, id, ip)
 }
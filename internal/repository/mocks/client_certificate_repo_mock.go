@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/client_certificate_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// MockClientCertificateRepository is a mock of ClientCertificateRepository interface.
+type MockClientCertificateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientCertificateRepositoryMockRecorder
+}
+
+// MockClientCertificateRepositoryMockRecorder is the mock recorder for MockClientCertificateRepository.
+type MockClientCertificateRepositoryMockRecorder struct {
+	mock *MockClientCertificateRepository
+}
+
+// NewMockClientCertificateRepository creates a new mock instance.
+func NewMockClientCertificateRepository(ctrl *gomock.Controller) *MockClientCertificateRepository {
+	mock := &MockClientCertificateRepository{ctrl: ctrl}
+	mock.recorder = &MockClientCertificateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientCertificateRepository) EXPECT() *MockClientCertificateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Bind mocks base method.
+func (m *MockClientCertificateRepository) Bind(ctx context.Context, subject, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bind", ctx, subject, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Bind indicates an expected call of Bind.
+func (mr *MockClientCertificateRepositoryMockRecorder) Bind(ctx, subject, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bind", reflect.TypeOf((*MockClientCertificateRepository)(nil).Bind), ctx, subject, login)
+}
+
+// FindUserID mocks base method.
+func (m *MockClientCertificateRepository) FindUserID(ctx context.Context, tx pgx.Tx, subject string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserID", ctx, tx, subject)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserID indicates an expected call of FindUserID.
+func (mr *MockClientCertificateRepositoryMockRecorder) FindUserID(ctx, tx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserID", reflect.TypeOf((*MockClientCertificateRepository)(nil).FindUserID), ctx, tx, subject)
+}
+
+// List mocks base method.
+func (m *MockClientCertificateRepository) List(ctx context.Context) ([]entity.ClientCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]entity.ClientCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockClientCertificateRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClientCertificateRepository)(nil).List), ctx)
+}
+
+// Unbind mocks base method.
+func (m *MockClientCertificateRepository) Unbind(ctx context.Context, subject string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unbind", ctx, subject)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unbind indicates an expected call of Unbind.
+func (mr *MockClientCertificateRepositoryMockRecorder) Unbind(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unbind", reflect.TypeOf((*MockClientCertificateRepository)(nil).Unbind), ctx, subject)
+}
@@ -0,0 +1,270 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/database_engine_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDatabaseEngineRepository is a mock of DatabaseEngineRepository interface.
+type MockDatabaseEngineRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseEngineRepositoryMockRecorder
+}
+
+// MockDatabaseEngineRepositoryMockRecorder is the mock recorder for MockDatabaseEngineRepository.
+type MockDatabaseEngineRepositoryMockRecorder struct {
+	mock *MockDatabaseEngineRepository
+}
+
+// NewMockDatabaseEngineRepository creates a new mock instance.
+func NewMockDatabaseEngineRepository(ctrl *gomock.Controller) *MockDatabaseEngineRepository {
+	mock := &MockDatabaseEngineRepository{ctrl: ctrl}
+	mock.recorder = &MockDatabaseEngineRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseEngineRepository) EXPECT() *MockDatabaseEngineRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActiveLeases mocks base method.
+func (m *MockDatabaseEngineRepository) CountActiveLeases(ctx context.Context, roleID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveLeases", ctx, roleID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveLeases indicates an expected call of CountActiveLeases.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) CountActiveLeases(ctx, roleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveLeases", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).CountActiveLeases), ctx, roleID)
+}
+
+// CreateLease mocks base method.
+func (m *MockDatabaseEngineRepository) CreateLease(ctx context.Context, lease *entity.DBLease) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLease", ctx, lease)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLease indicates an expected call of CreateLease.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) CreateLease(ctx, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLease", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).CreateLease), ctx, lease)
+}
+
+// DeleteConnection mocks base method.
+func (m *MockDatabaseEngineRepository) DeleteConnection(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnection indicates an expected call of DeleteConnection.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) DeleteConnection(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnection", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).DeleteConnection), ctx, id)
+}
+
+// DeleteRole mocks base method.
+func (m *MockDatabaseEngineRepository) DeleteRole(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) DeleteRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).DeleteRole), ctx, id)
+}
+
+// GetConnection mocks base method.
+func (m *MockDatabaseEngineRepository) GetConnection(ctx context.Context, name string) (entity.DBConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnection", ctx, name)
+	ret0, _ := ret[0].(entity.DBConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnection indicates an expected call of GetConnection.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) GetConnection(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnection", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).GetConnection), ctx, name)
+}
+
+// GetConnectionByID mocks base method.
+func (m *MockDatabaseEngineRepository) GetConnectionByID(ctx context.Context, id int64) (entity.DBConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectionByID", ctx, id)
+	ret0, _ := ret[0].(entity.DBConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectionByID indicates an expected call of GetConnectionByID.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) GetConnectionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionByID", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).GetConnectionByID), ctx, id)
+}
+
+// GetLease mocks base method.
+func (m *MockDatabaseEngineRepository) GetLease(ctx context.Context, leaseID string) (entity.DBLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLease", ctx, leaseID)
+	ret0, _ := ret[0].(entity.DBLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLease indicates an expected call of GetLease.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) GetLease(ctx, leaseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLease", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).GetLease), ctx, leaseID)
+}
+
+// GetRole mocks base method.
+func (m *MockDatabaseEngineRepository) GetRole(ctx context.Context, name string) (entity.DBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, name)
+	ret0, _ := ret[0].(entity.DBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) GetRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).GetRole), ctx, name)
+}
+
+// GetRoleByID mocks base method.
+func (m *MockDatabaseEngineRepository) GetRoleByID(ctx context.Context, id int64) (entity.DBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleByID", ctx, id)
+	ret0, _ := ret[0].(entity.DBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleByID indicates an expected call of GetRoleByID.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) GetRoleByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByID", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).GetRoleByID), ctx, id)
+}
+
+// ListConnections mocks base method.
+func (m *MockDatabaseEngineRepository) ListConnections(ctx context.Context) ([]entity.DBConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnections", ctx)
+	ret0, _ := ret[0].([]entity.DBConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnections indicates an expected call of ListConnections.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) ListConnections(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnections", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).ListConnections), ctx)
+}
+
+// ListExpiredLeases mocks base method.
+func (m *MockDatabaseEngineRepository) ListExpiredLeases(ctx context.Context, limit int) ([]entity.DBLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredLeases", ctx, limit)
+	ret0, _ := ret[0].([]entity.DBLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredLeases indicates an expected call of ListExpiredLeases.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) ListExpiredLeases(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredLeases", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).ListExpiredLeases), ctx, limit)
+}
+
+// ListLeases mocks base method.
+func (m *MockDatabaseEngineRepository) ListLeases(ctx context.Context, userID int64) ([]entity.DBLease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeases", ctx, userID)
+	ret0, _ := ret[0].([]entity.DBLease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeases indicates an expected call of ListLeases.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) ListLeases(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeases", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).ListLeases), ctx, userID)
+}
+
+// ListRoles mocks base method.
+func (m *MockDatabaseEngineRepository) ListRoles(ctx context.Context) ([]entity.DBRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]entity.DBRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) ListRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).ListRoles), ctx)
+}
+
+// MarkLeaseRevoked mocks base method.
+func (m *MockDatabaseEngineRepository) MarkLeaseRevoked(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLeaseRevoked", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkLeaseRevoked indicates an expected call of MarkLeaseRevoked.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) MarkLeaseRevoked(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLeaseRevoked", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).MarkLeaseRevoked), ctx, id)
+}
+
+// SaveConnection mocks base method.
+func (m *MockDatabaseEngineRepository) SaveConnection(ctx context.Context, conn *entity.DBConnection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConnection", ctx, conn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConnection indicates an expected call of SaveConnection.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) SaveConnection(ctx, conn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConnection", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).SaveConnection), ctx, conn)
+}
+
+// SaveRole mocks base method.
+func (m *MockDatabaseEngineRepository) SaveRole(ctx context.Context, role *entity.DBRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRole indicates an expected call of SaveRole.
+func (mr *MockDatabaseEngineRepositoryMockRecorder) SaveRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRole", reflect.TypeOf((*MockDatabaseEngineRepository)(nil).SaveRole), ctx, role)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/grant_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGrantRepository is a mock of GrantRepository interface.
+type MockGrantRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGrantRepositoryMockRecorder
+}
+
+// MockGrantRepositoryMockRecorder is the mock recorder for MockGrantRepository.
+type MockGrantRepositoryMockRecorder struct {
+	mock *MockGrantRepository
+}
+
+// NewMockGrantRepository creates a new mock instance.
+func NewMockGrantRepository(ctrl *gomock.Controller) *MockGrantRepository {
+	mock := &MockGrantRepository{ctrl: ctrl}
+	mock.recorder = &MockGrantRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGrantRepository) EXPECT() *MockGrantRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockGrantRepository) Delete(ctx context.Context, ownerID, granteeID int64, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, granteeID, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGrantRepositoryMockRecorder) Delete(ctx, ownerID, granteeID, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGrantRepository)(nil).Delete), ctx, ownerID, granteeID, path)
+}
+
+// FindAccess mocks base method.
+func (m *MockGrantRepository) FindAccess(ctx context.Context, ownerID, granteeID int64, path string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccess", ctx, ownerID, granteeID, path)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccess indicates an expected call of FindAccess.
+func (mr *MockGrantRepositoryMockRecorder) FindAccess(ctx, ownerID, granteeID, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccess", reflect.TypeOf((*MockGrantRepository)(nil).FindAccess), ctx, ownerID, granteeID, path)
+}
+
+// ListByGrantee mocks base method.
+func (m *MockGrantRepository) ListByGrantee(ctx context.Context, granteeID int64) ([]entity.SecretGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByGrantee", ctx, granteeID)
+	ret0, _ := ret[0].([]entity.SecretGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByGrantee indicates an expected call of ListByGrantee.
+func (mr *MockGrantRepositoryMockRecorder) ListByGrantee(ctx, granteeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByGrantee", reflect.TypeOf((*MockGrantRepository)(nil).ListByGrantee), ctx, granteeID)
+}
+
+// ListByOwner mocks base method.
+func (m *MockGrantRepository) ListByOwner(ctx context.Context, ownerID int64) ([]entity.SecretGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwner", ctx, ownerID)
+	ret0, _ := ret[0].([]entity.SecretGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwner indicates an expected call of ListByOwner.
+func (mr *MockGrantRepositoryMockRecorder) ListByOwner(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockGrantRepository)(nil).ListByOwner), ctx, ownerID)
+}
+
+// ListSharedSecrets mocks base method.
+func (m *MockGrantRepository) ListSharedSecrets(ctx context.Context, granteeID int64) ([]entity.SharedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSharedSecrets", ctx, granteeID)
+	ret0, _ := ret[0].([]entity.SharedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedSecrets indicates an expected call of ListSharedSecrets.
+func (mr *MockGrantRepositoryMockRecorder) ListSharedSecrets(ctx, granteeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedSecrets", reflect.TypeOf((*MockGrantRepository)(nil).ListSharedSecrets), ctx, granteeID)
+}
+
+// Save mocks base method.
+func (m *MockGrantRepository) Save(ctx context.Context, grant *entity.SecretGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, grant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockGrantRepositoryMockRecorder) Save(ctx, grant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockGrantRepository)(nil).Save), ctx, grant)
+}
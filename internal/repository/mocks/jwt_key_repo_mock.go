@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/jwt_key_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJWTKeyRepository is a mock of JWTKeyRepository interface.
+type MockJWTKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJWTKeyRepositoryMockRecorder
+}
+
+// MockJWTKeyRepositoryMockRecorder is the mock recorder for MockJWTKeyRepository.
+type MockJWTKeyRepositoryMockRecorder struct {
+	mock *MockJWTKeyRepository
+}
+
+// NewMockJWTKeyRepository creates a new mock instance.
+func NewMockJWTKeyRepository(ctrl *gomock.Controller) *MockJWTKeyRepository {
+	mock := &MockJWTKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockJWTKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJWTKeyRepository) EXPECT() *MockJWTKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockJWTKeyRepository) List(ctx context.Context, retiredAfter time.Time) ([]entity.JWTKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, retiredAfter)
+	ret0, _ := ret[0].([]entity.JWTKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockJWTKeyRepositoryMockRecorder) List(ctx, retiredAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockJWTKeyRepository)(nil).List), ctx, retiredAfter)
+}
+
+// Prune mocks base method.
+func (m *MockJWTKeyRepository) Prune(ctx context.Context, retiredBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prune", ctx, retiredBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockJWTKeyRepositoryMockRecorder) Prune(ctx, retiredBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockJWTKeyRepository)(nil).Prune), ctx, retiredBefore)
+}
+
+// Rotate mocks base method.
+func (m *MockJWTKeyRepository) Rotate(ctx context.Context, key *entity.JWTKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockJWTKeyRepositoryMockRecorder) Rotate(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockJWTKeyRepository)(nil).Rotate), ctx, key)
+}
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/pki_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPKIRepository is a mock of PKIRepository interface.
+type MockPKIRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPKIRepositoryMockRecorder
+}
+
+// MockPKIRepositoryMockRecorder is the mock recorder for MockPKIRepository.
+type MockPKIRepositoryMockRecorder struct {
+	mock *MockPKIRepository
+}
+
+// NewMockPKIRepository creates a new mock instance.
+func NewMockPKIRepository(ctrl *gomock.Controller) *MockPKIRepository {
+	mock := &MockPKIRepository{ctrl: ctrl}
+	mock.recorder = &MockPKIRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPKIRepository) EXPECT() *MockPKIRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCA mocks base method.
+func (m *MockPKIRepository) CreateCA(ctx context.Context, ca *entity.PKICA) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCA", ctx, ca)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCA indicates an expected call of CreateCA.
+func (mr *MockPKIRepositoryMockRecorder) CreateCA(ctx, ca interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCA", reflect.TypeOf((*MockPKIRepository)(nil).CreateCA), ctx, ca)
+}
+
+// CreateCertificate mocks base method.
+func (m *MockPKIRepository) CreateCertificate(ctx context.Context, cert *entity.PKICertificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCertificate", ctx, cert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCertificate indicates an expected call of CreateCertificate.
+func (mr *MockPKIRepositoryMockRecorder) CreateCertificate(ctx, cert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificate", reflect.TypeOf((*MockPKIRepository)(nil).CreateCertificate), ctx, cert)
+}
+
+// DeleteRole mocks base method.
+func (m *MockPKIRepository) DeleteRole(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockPKIRepositoryMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockPKIRepository)(nil).DeleteRole), ctx, name)
+}
+
+// GetCA mocks base method.
+func (m *MockPKIRepository) GetCA(ctx context.Context) (entity.PKICA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCA", ctx)
+	ret0, _ := ret[0].(entity.PKICA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCA indicates an expected call of GetCA.
+func (mr *MockPKIRepositoryMockRecorder) GetCA(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCA", reflect.TypeOf((*MockPKIRepository)(nil).GetCA), ctx)
+}
+
+// GetCertificate mocks base method.
+func (m *MockPKIRepository) GetCertificate(ctx context.Context, serial string) (entity.PKICertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificate", ctx, serial)
+	ret0, _ := ret[0].(entity.PKICertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificate indicates an expected call of GetCertificate.
+func (mr *MockPKIRepositoryMockRecorder) GetCertificate(ctx, serial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificate", reflect.TypeOf((*MockPKIRepository)(nil).GetCertificate), ctx, serial)
+}
+
+// GetRole mocks base method.
+func (m *MockPKIRepository) GetRole(ctx context.Context, name string) (entity.PKIRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, name)
+	ret0, _ := ret[0].(entity.PKIRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockPKIRepositoryMockRecorder) GetRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockPKIRepository)(nil).GetRole), ctx, name)
+}
+
+// ListRevoked mocks base method.
+func (m *MockPKIRepository) ListRevoked(ctx context.Context) ([]entity.PKICertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevoked", ctx)
+	ret0, _ := ret[0].([]entity.PKICertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevoked indicates an expected call of ListRevoked.
+func (mr *MockPKIRepositoryMockRecorder) ListRevoked(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevoked", reflect.TypeOf((*MockPKIRepository)(nil).ListRevoked), ctx)
+}
+
+// ListRoles mocks base method.
+func (m *MockPKIRepository) ListRoles(ctx context.Context) ([]entity.PKIRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]entity.PKIRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockPKIRepositoryMockRecorder) ListRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockPKIRepository)(nil).ListRoles), ctx)
+}
+
+// Revoke mocks base method.
+func (m *MockPKIRepository) Revoke(ctx context.Context, serial string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, serial)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockPKIRepositoryMockRecorder) Revoke(ctx, serial interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockPKIRepository)(nil).Revoke), ctx, serial)
+}
+
+// SaveRole mocks base method.
+func (m *MockPKIRepository) SaveRole(ctx context.Context, role *entity.PKIRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRole indicates an expected call of SaveRole.
+func (mr *MockPKIRepositoryMockRecorder) SaveRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRole", reflect.TypeOf((*MockPKIRepository)(nil).SaveRole), ctx, role)
+}
@@ -0,0 +1,152 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/policy_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPolicyRepository is a mock of PolicyRepository interface.
+type MockPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyRepositoryMockRecorder
+}
+
+// MockPolicyRepositoryMockRecorder is the mock recorder for MockPolicyRepository.
+type MockPolicyRepositoryMockRecorder struct {
+	mock *MockPolicyRepository
+}
+
+// NewMockPolicyRepository creates a new mock instance.
+func NewMockPolicyRepository(ctrl *gomock.Controller) *MockPolicyRepository {
+	mock := &MockPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyRepository) EXPECT() *MockPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockPolicyRepository) Attach(ctx context.Context, policyID int64, subjectType string, subjectID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", ctx, policyID, subjectType, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockPolicyRepositoryMockRecorder) Attach(ctx, policyID, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockPolicyRepository)(nil).Attach), ctx, policyID, subjectType, subjectID)
+}
+
+// Delete mocks base method.
+func (m *MockPolicyRepository) Delete(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPolicyRepositoryMockRecorder) Delete(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPolicyRepository)(nil).Delete), ctx, name)
+}
+
+// Detach mocks base method.
+func (m *MockPolicyRepository) Detach(ctx context.Context, policyID int64, subjectType string, subjectID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detach", ctx, policyID, subjectType, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockPolicyRepositoryMockRecorder) Detach(ctx, policyID, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockPolicyRepository)(nil).Detach), ctx, policyID, subjectType, subjectID)
+}
+
+// GetByName mocks base method.
+func (m *MockPolicyRepository) GetByName(ctx context.Context, name string) (entity.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(entity.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockPolicyRepositoryMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockPolicyRepository)(nil).GetByName), ctx, name)
+}
+
+// List mocks base method.
+func (m *MockPolicyRepository) List(ctx context.Context) ([]entity.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]entity.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPolicyRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPolicyRepository)(nil).List), ctx)
+}
+
+// ListAttachments mocks base method.
+func (m *MockPolicyRepository) ListAttachments(ctx context.Context, policyID int64) ([]entity.PolicyAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, policyID)
+	ret0, _ := ret[0].([]entity.PolicyAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockPolicyRepositoryMockRecorder) ListAttachments(ctx, policyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockPolicyRepository)(nil).ListAttachments), ctx, policyID)
+}
+
+// ListForPrincipal mocks base method.
+func (m *MockPolicyRepository) ListForPrincipal(ctx context.Context, userID int64, token string) ([]entity.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPrincipal", ctx, userID, token)
+	ret0, _ := ret[0].([]entity.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPrincipal indicates an expected call of ListForPrincipal.
+func (mr *MockPolicyRepositoryMockRecorder) ListForPrincipal(ctx, userID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPrincipal", reflect.TypeOf((*MockPolicyRepository)(nil).ListForPrincipal), ctx, userID, token)
+}
+
+// Save mocks base method.
+func (m *MockPolicyRepository) Save(ctx context.Context, policy *entity.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPolicyRepositoryMockRecorder) Save(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPolicyRepository)(nil).Save), ctx, policy)
+}
@@ -35,61 +35,119 @@ func (m *MockVaultRepositoryInterface) EXPECT() *MockVaultRepositoryInterfaceMoc
 	return m.recorder
 }
 
+// ChangeHorizon mocks base method.
+func (m *MockVaultRepositoryInterface) ChangeHorizon(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeHorizon", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeHorizon indicates an expected call of ChangeHorizon.
+func (mr *MockVaultRepositoryInterfaceMockRecorder) ChangeHorizon(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeHorizon", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).ChangeHorizon), ctx)
+}
+
 // Delete mocks base method.
-func (m *MockVaultRepositoryInterface) Delete(ctx context.Context, userID int64, path string) error {
+func (m *MockVaultRepositoryInterface) Delete(ctx context.Context, owner entity.SecretOwner, path string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userID, path)
+	ret := m.ctrl.Call(m, "Delete", ctx, owner, path)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockVaultRepositoryInterfaceMockRecorder) Delete(ctx, userID, path interface{}) *gomock.Call {
+func (mr *MockVaultRepositoryInterfaceMockRecorder) Delete(ctx, owner, path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).Delete), ctx, userID, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).Delete), ctx, owner, path)
 }
 
 // DeleteMetadata mocks base method.
-func (m *MockVaultRepositoryInterface) DeleteMetadata(ctx context.Context, userID int64, path string) error {
+func (m *MockVaultRepositoryInterface) DeleteMetadata(ctx context.Context, owner entity.SecretOwner, path string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMetadata", ctx, userID, path)
+	ret := m.ctrl.Call(m, "DeleteMetadata", ctx, owner, path)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteMetadata indicates an expected call of DeleteMetadata.
-func (mr *MockVaultRepositoryInterfaceMockRecorder) DeleteMetadata(ctx, userID, path interface{}) *gomock.Call {
+func (mr *MockVaultRepositoryInterfaceMockRecorder) DeleteMetadata(ctx, owner, path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetadata", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).DeleteMetadata), ctx, userID, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetadata", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).DeleteMetadata), ctx, owner, path)
+}
+
+// DeleteVersions mocks base method.
+func (m *MockVaultRepositoryInterface) DeleteVersions(ctx context.Context, owner entity.SecretOwner, path string, versions []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVersions", ctx, owner, path, versions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVersions indicates an expected call of DeleteVersions.
+func (mr *MockVaultRepositoryInterfaceMockRecorder) DeleteVersions(ctx, owner, path, versions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersions", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).DeleteVersions), ctx, owner, path, versions)
 }
 
 // DestroySecret mocks base method.
-func (m *MockVaultRepositoryInterface) DestroySecret(ctx context.Context, userID int64, path string) error {
+func (m *MockVaultRepositoryInterface) DestroySecret(ctx context.Context, owner entity.SecretOwner, path string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DestroySecret", ctx, userID, path)
+	ret := m.ctrl.Call(m, "DestroySecret", ctx, owner, path)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DestroySecret indicates an expected call of DestroySecret.
-func (mr *MockVaultRepositoryInterfaceMockRecorder) DestroySecret(ctx, userID, path interface{}) *gomock.Call {
+func (mr *MockVaultRepositoryInterfaceMockRecorder) DestroySecret(ctx, owner, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).DestroySecret), ctx, owner, path)
+}
+
+// DestroyVersions mocks base method.
+func (m *MockVaultRepositoryInterface) DestroyVersions(ctx context.Context, owner entity.SecretOwner, path string, versions []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVersions", ctx, owner, path, versions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyVersions indicates an expected call of DestroyVersions.
+func (mr *MockVaultRepositoryInterfaceMockRecorder) DestroyVersions(ctx, owner, path, versions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySecret", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).DestroySecret), ctx, userID, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVersions", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).DestroyVersions), ctx, owner, path, versions)
 }
 
 // GetByUserAndPath mocks base method.
-func (m *MockVaultRepositoryInterface) GetByUserAndPath(ctx context.Context, userID int64, path string) (entity.OneSecretVersionWithMetadata, error) {
+func (m *MockVaultRepositoryInterface) GetByUserAndPath(ctx context.Context, owner entity.SecretOwner, path string) (entity.OneSecretVersionWithMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByUserAndPath", ctx, userID, path)
+	ret := m.ctrl.Call(m, "GetByUserAndPath", ctx, owner, path)
 	ret0, _ := ret[0].(entity.OneSecretVersionWithMetadata)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByUserAndPath indicates an expected call of GetByUserAndPath.
-func (mr *MockVaultRepositoryInterfaceMockRecorder) GetByUserAndPath(ctx, userID, path interface{}) *gomock.Call {
+func (mr *MockVaultRepositoryInterfaceMockRecorder) GetByUserAndPath(ctx, owner, path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserAndPath", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).GetByUserAndPath), ctx, userID, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserAndPath", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).GetByUserAndPath), ctx, owner, path)
+}
+
+// GetVersion mocks base method.
+func (m *MockVaultRepositoryInterface) GetVersion(ctx context.Context, owner entity.SecretOwner, path string, version int64) (entity.OneSecretVersionWithMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, owner, path, version)
+	ret0, _ := ret[0].(entity.OneSecretVersionWithMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockVaultRepositoryInterfaceMockRecorder) GetVersion(ctx, owner, path, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).GetVersion), ctx, owner, path, version)
 }
 
 // ListByUser mocks base method.
@@ -107,6 +165,36 @@ func (mr *MockVaultRepositoryInterfaceMockRecorder) ListByUser(ctx, userID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).ListByUser), ctx, userID)
 }
 
+// ListChanges mocks base method.
+func (m *MockVaultRepositoryInterface) ListChanges(ctx context.Context, userID, from, horizon, afterID int64, limit int) ([]entity.SecretChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChanges", ctx, userID, from, horizon, afterID, limit)
+	ret0, _ := ret[0].([]entity.SecretChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChanges indicates an expected call of ListChanges.
+func (mr *MockVaultRepositoryInterfaceMockRecorder) ListChanges(ctx, userID, from, horizon, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChanges", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).ListChanges), ctx, userID, from, horizon, afterID, limit)
+}
+
+// ListVersions mocks base method.
+func (m *MockVaultRepositoryInterface) ListVersions(ctx context.Context, owner entity.SecretOwner, path string) ([]entity.SecretVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, owner, path)
+	ret0, _ := ret[0].([]entity.SecretVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockVaultRepositoryInterfaceMockRecorder) ListVersions(ctx, owner, path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).ListVersions), ctx, owner, path)
+}
+
 // SaveOrUpdate mocks base method.
 func (m *MockVaultRepositoryInterface) SaveOrUpdate(ctx context.Context, secretMetadata *entity.SecretMetadata, secretVersion *entity.SecretVersion) (entity.SecretMetadata, error) {
 	m.ctrl.T.Helper()
@@ -123,15 +211,15 @@ func (mr *MockVaultRepositoryInterfaceMockRecorder) SaveOrUpdate(ctx, secretMeta
 }
 
 // UndeleteSecret mocks base method.
-func (m *MockVaultRepositoryInterface) UndeleteSecret(ctx context.Context, userID int64, path string, version int64) error {
+func (m *MockVaultRepositoryInterface) UndeleteSecret(ctx context.Context, owner entity.SecretOwner, path string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UndeleteSecret", ctx, userID, path, version)
+	ret := m.ctrl.Call(m, "UndeleteSecret", ctx, owner, path, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UndeleteSecret indicates an expected call of UndeleteSecret.
-func (mr *MockVaultRepositoryInterfaceMockRecorder) UndeleteSecret(ctx, userID, path, version interface{}) *gomock.Call {
+func (mr *MockVaultRepositoryInterfaceMockRecorder) UndeleteSecret(ctx, owner, path, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteSecret", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).UndeleteSecret), ctx, userID, path, version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteSecret", reflect.TypeOf((*MockVaultRepositoryInterface)(nil).UndeleteSecret), ctx, owner, path, version)
 }
@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/service_account_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceAccountRepository is a mock of ServiceAccountRepository interface.
+type MockServiceAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountRepositoryMockRecorder
+}
+
+// MockServiceAccountRepositoryMockRecorder is the mock recorder for MockServiceAccountRepository.
+type MockServiceAccountRepositoryMockRecorder struct {
+	mock *MockServiceAccountRepository
+}
+
+// NewMockServiceAccountRepository creates a new mock instance.
+func NewMockServiceAccountRepository(ctrl *gomock.Controller) *MockServiceAccountRepository {
+	mock := &MockServiceAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountRepository) EXPECT() *MockServiceAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockServiceAccountRepository) Create(ctx context.Context, account *entity.ServiceAccount, teamRole string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, account, teamRole)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceAccountRepositoryMockRecorder) Create(ctx, account, teamRole interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountRepository)(nil).Create), ctx, account, teamRole)
+}
+
+// CreateKey mocks base method.
+func (m *MockServiceAccountRepository) CreateKey(ctx context.Context, key *entity.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKey indicates an expected call of CreateKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) CreateKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).CreateKey), ctx, key)
+}
+
+// Delete mocks base method.
+func (m *MockServiceAccountRepository) Delete(ctx context.Context, account entity.ServiceAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceAccountRepositoryMockRecorder) Delete(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceAccountRepository)(nil).Delete), ctx, account)
+}
+
+// FindKeyByHash mocks base method.
+func (m *MockServiceAccountRepository) FindKeyByHash(ctx context.Context, hash string) (entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindKeyByHash", ctx, hash)
+	ret0, _ := ret[0].(entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindKeyByHash indicates an expected call of FindKeyByHash.
+func (mr *MockServiceAccountRepositoryMockRecorder) FindKeyByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindKeyByHash", reflect.TypeOf((*MockServiceAccountRepository)(nil).FindKeyByHash), ctx, hash)
+}
+
+// GetByName mocks base method.
+func (m *MockServiceAccountRepository) GetByName(ctx context.Context, name string) (entity.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(entity.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockServiceAccountRepositoryMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockServiceAccountRepository)(nil).GetByName), ctx, name)
+}
+
+// GetKey mocks base method.
+func (m *MockServiceAccountRepository) GetKey(ctx context.Context, id int64) (entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", ctx, id)
+	ret0, _ := ret[0].(entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) GetKey(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).GetKey), ctx, id)
+}
+
+// ListForUser mocks base method.
+func (m *MockServiceAccountRepository) ListForUser(ctx context.Context, userID int64) ([]entity.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUser", ctx, userID)
+	ret0, _ := ret[0].([]entity.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUser indicates an expected call of ListForUser.
+func (mr *MockServiceAccountRepositoryMockRecorder) ListForUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUser", reflect.TypeOf((*MockServiceAccountRepository)(nil).ListForUser), ctx, userID)
+}
+
+// ListKeys mocks base method.
+func (m *MockServiceAccountRepository) ListKeys(ctx context.Context, serviceAccountID int64) ([]entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeys", ctx, serviceAccountID)
+	ret0, _ := ret[0].([]entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeys indicates an expected call of ListKeys.
+func (mr *MockServiceAccountRepositoryMockRecorder) ListKeys(ctx, serviceAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeys", reflect.TypeOf((*MockServiceAccountRepository)(nil).ListKeys), ctx, serviceAccountID)
+}
+
+// RevokeKey mocks base method.
+func (m *MockServiceAccountRepository) RevokeKey(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeKey indicates an expected call of RevokeKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) RevokeKey(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).RevokeKey), ctx, id)
+}
+
+// TouchKey mocks base method.
+func (m *MockServiceAccountRepository) TouchKey(ctx context.Context, id int64, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchKey", ctx, id, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchKey indicates an expected call of TouchKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) TouchKey(ctx, id, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).TouchKey), ctx, id, ip)
+}
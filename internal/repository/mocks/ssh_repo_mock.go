@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/ssh_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSSHRepository is a mock of SSHRepository interface.
+type MockSSHRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHRepositoryMockRecorder
+}
+
+// MockSSHRepositoryMockRecorder is the mock recorder for MockSSHRepository.
+type MockSSHRepositoryMockRecorder struct {
+	mock *MockSSHRepository
+}
+
+// NewMockSSHRepository creates a new mock instance.
+func NewMockSSHRepository(ctrl *gomock.Controller) *MockSSHRepository {
+	mock := &MockSSHRepository{ctrl: ctrl}
+	mock.recorder = &MockSSHRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHRepository) EXPECT() *MockSSHRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCA mocks base method.
+func (m *MockSSHRepository) CreateCA(ctx context.Context, ca *entity.SSHCA) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCA", ctx, ca)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCA indicates an expected call of CreateCA.
+func (mr *MockSSHRepositoryMockRecorder) CreateCA(ctx, ca interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCA", reflect.TypeOf((*MockSSHRepository)(nil).CreateCA), ctx, ca)
+}
+
+// DeleteRole mocks base method.
+func (m *MockSSHRepository) DeleteRole(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockSSHRepositoryMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockSSHRepository)(nil).DeleteRole), ctx, name)
+}
+
+// GetCA mocks base method.
+func (m *MockSSHRepository) GetCA(ctx context.Context) (entity.SSHCA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCA", ctx)
+	ret0, _ := ret[0].(entity.SSHCA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCA indicates an expected call of GetCA.
+func (mr *MockSSHRepositoryMockRecorder) GetCA(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCA", reflect.TypeOf((*MockSSHRepository)(nil).GetCA), ctx)
+}
+
+// GetRole mocks base method.
+func (m *MockSSHRepository) GetRole(ctx context.Context, name string) (entity.SSHRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRole", ctx, name)
+	ret0, _ := ret[0].(entity.SSHRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRole indicates an expected call of GetRole.
+func (mr *MockSSHRepositoryMockRecorder) GetRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockSSHRepository)(nil).GetRole), ctx, name)
+}
+
+// ListRoles mocks base method.
+func (m *MockSSHRepository) ListRoles(ctx context.Context) ([]entity.SSHRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", ctx)
+	ret0, _ := ret[0].([]entity.SSHRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockSSHRepositoryMockRecorder) ListRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockSSHRepository)(nil).ListRoles), ctx)
+}
+
+// SaveRole mocks base method.
+func (m *MockSSHRepository) SaveRole(ctx context.Context, role *entity.SSHRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRole", ctx, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRole indicates an expected call of SaveRole.
+func (mr *MockSSHRepositoryMockRecorder) SaveRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRole", reflect.TypeOf((*MockSSHRepository)(nil).SaveRole), ctx, role)
+}
@@ -0,0 +1,110 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/throttle_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockThrottleRepository is a mock of ThrottleRepository interface.
+type MockThrottleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockThrottleRepositoryMockRecorder
+}
+
+// MockThrottleRepositoryMockRecorder is the mock recorder for MockThrottleRepository.
+type MockThrottleRepositoryMockRecorder struct {
+	mock *MockThrottleRepository
+}
+
+// NewMockThrottleRepository creates a new mock instance.
+func NewMockThrottleRepository(ctrl *gomock.Controller) *MockThrottleRepository {
+	mock := &MockThrottleRepository{ctrl: ctrl}
+	mock.recorder = &MockThrottleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockThrottleRepository) EXPECT() *MockThrottleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockThrottleRepository) Get(ctx context.Context, key string) (entity.AuthThrottle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(entity.AuthThrottle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockThrottleRepositoryMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockThrottleRepository)(nil).Get), ctx, key)
+}
+
+// ListLockouts mocks base method.
+func (m *MockThrottleRepository) ListLockouts(ctx context.Context, limit int) ([]entity.LockoutEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLockouts", ctx, limit)
+	ret0, _ := ret[0].([]entity.LockoutEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLockouts indicates an expected call of ListLockouts.
+func (mr *MockThrottleRepositoryMockRecorder) ListLockouts(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLockouts", reflect.TypeOf((*MockThrottleRepository)(nil).ListLockouts), ctx, limit)
+}
+
+// Lock mocks base method.
+func (m *MockThrottleRepository) Lock(ctx context.Context, event entity.LockoutEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockThrottleRepositoryMockRecorder) Lock(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockThrottleRepository)(nil).Lock), ctx, event)
+}
+
+// RecordFailure mocks base method.
+func (m *MockThrottleRepository) RecordFailure(ctx context.Context, key string, now, windowStart time.Time) (entity.AuthThrottle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, key, now, windowStart)
+	ret0, _ := ret[0].(entity.AuthThrottle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockThrottleRepositoryMockRecorder) RecordFailure(ctx, key, now, windowStart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockThrottleRepository)(nil).RecordFailure), ctx, key, now, windowStart)
+}
+
+// Reset mocks base method.
+func (m *MockThrottleRepository) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockThrottleRepositoryMockRecorder) Reset(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockThrottleRepository)(nil).Reset), ctx, key)
+}
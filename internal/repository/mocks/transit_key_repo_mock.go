@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/transit_key_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTransitKeyRepository is a mock of TransitKeyRepository interface.
+type MockTransitKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransitKeyRepositoryMockRecorder
+}
+
+// MockTransitKeyRepositoryMockRecorder is the mock recorder for MockTransitKeyRepository.
+type MockTransitKeyRepositoryMockRecorder struct {
+	mock *MockTransitKeyRepository
+}
+
+// NewMockTransitKeyRepository creates a new mock instance.
+func NewMockTransitKeyRepository(ctrl *gomock.Controller) *MockTransitKeyRepository {
+	mock := &MockTransitKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockTransitKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransitKeyRepository) EXPECT() *MockTransitKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTransitKeyRepository) Create(ctx context.Context, key *entity.TransitKey, version *entity.TransitKeyVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTransitKeyRepositoryMockRecorder) Create(ctx, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransitKeyRepository)(nil).Create), ctx, key, version)
+}
+
+// GetTeamKey mocks base method.
+func (m *MockTransitKeyRepository) GetTeamKey(ctx context.Context, teamID int64, name string) (entity.TransitKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamKey", ctx, teamID, name)
+	ret0, _ := ret[0].(entity.TransitKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamKey indicates an expected call of GetTeamKey.
+func (mr *MockTransitKeyRepositoryMockRecorder) GetTeamKey(ctx, teamID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamKey", reflect.TypeOf((*MockTransitKeyRepository)(nil).GetTeamKey), ctx, teamID, name)
+}
+
+// GetUserKey mocks base method.
+func (m *MockTransitKeyRepository) GetUserKey(ctx context.Context, userID int64, name string) (entity.TransitKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserKey", ctx, userID, name)
+	ret0, _ := ret[0].(entity.TransitKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserKey indicates an expected call of GetUserKey.
+func (mr *MockTransitKeyRepositoryMockRecorder) GetUserKey(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserKey", reflect.TypeOf((*MockTransitKeyRepository)(nil).GetUserKey), ctx, userID, name)
+}
+
+// GetVersion mocks base method.
+func (m *MockTransitKeyRepository) GetVersion(ctx context.Context, keyID int64, version int) (entity.TransitKeyVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, keyID, version)
+	ret0, _ := ret[0].(entity.TransitKeyVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockTransitKeyRepositoryMockRecorder) GetVersion(ctx, keyID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockTransitKeyRepository)(nil).GetVersion), ctx, keyID, version)
+}
+
+// ListForUser mocks base method.
+func (m *MockTransitKeyRepository) ListForUser(ctx context.Context, userID int64) ([]entity.TransitKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForUser", ctx, userID)
+	ret0, _ := ret[0].([]entity.TransitKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForUser indicates an expected call of ListForUser.
+func (mr *MockTransitKeyRepositoryMockRecorder) ListForUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForUser", reflect.TypeOf((*MockTransitKeyRepository)(nil).ListForUser), ctx, userID)
+}
+
+// Rotate mocks base method.
+func (m *MockTransitKeyRepository) Rotate(ctx context.Context, keyID int64, version *entity.TransitKeyVersion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, keyID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockTransitKeyRepositoryMockRecorder) Rotate(ctx, keyID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockTransitKeyRepository)(nil).Rotate), ctx, keyID, version)
+}
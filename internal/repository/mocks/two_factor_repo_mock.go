@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/two_factor_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// MockTwoFactorRepository is a mock of TwoFactorRepository interface.
+type MockTwoFactorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorRepositoryMockRecorder
+}
+
+// MockTwoFactorRepositoryMockRecorder is the mock recorder for MockTwoFactorRepository.
+type MockTwoFactorRepositoryMockRecorder struct {
+	mock *MockTwoFactorRepository
+}
+
+// NewMockTwoFactorRepository creates a new mock instance.
+func NewMockTwoFactorRepository(ctrl *gomock.Controller) *MockTwoFactorRepository {
+	mock := &MockTwoFactorRepository{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorRepository) EXPECT() *MockTwoFactorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockTwoFactorRepository) ConfirmTOTP(ctx context.Context, tx pgx.Tx, userID, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, tx, userID, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockTwoFactorRepositoryMockRecorder) ConfirmTOTP(ctx, tx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockTwoFactorRepository)(nil).ConfirmTOTP), ctx, tx, userID, step)
+}
+
+// CreateChallenge mocks base method.
+func (m *MockTwoFactorRepository) CreateChallenge(ctx context.Context, tx pgx.Tx, challenge entity.LoginChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChallenge", ctx, tx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockTwoFactorRepositoryMockRecorder) CreateChallenge(ctx, tx, challenge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*MockTwoFactorRepository)(nil).CreateChallenge), ctx, tx, challenge)
+}
+
+// DeleteChallenge mocks base method.
+func (m *MockTwoFactorRepository) DeleteChallenge(ctx context.Context, tx pgx.Tx, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChallenge", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChallenge indicates an expected call of DeleteChallenge.
+func (mr *MockTwoFactorRepositoryMockRecorder) DeleteChallenge(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChallenge", reflect.TypeOf((*MockTwoFactorRepository)(nil).DeleteChallenge), ctx, tx, id)
+}
+
+// FindChallengeForUpdate mocks base method.
+func (m *MockTwoFactorRepository) FindChallengeForUpdate(ctx context.Context, tx pgx.Tx, hash string) (entity.LoginChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChallengeForUpdate", ctx, tx, hash)
+	ret0, _ := ret[0].(entity.LoginChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChallengeForUpdate indicates an expected call of FindChallengeForUpdate.
+func (mr *MockTwoFactorRepositoryMockRecorder) FindChallengeForUpdate(ctx, tx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChallengeForUpdate", reflect.TypeOf((*MockTwoFactorRepository)(nil).FindChallengeForUpdate), ctx, tx, hash)
+}
+
+// GetTOTP mocks base method.
+func (m *MockTwoFactorRepository) GetTOTP(ctx context.Context, tx pgx.Tx, userID int64) (entity.TOTPCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTOTP", ctx, tx, userID)
+	ret0, _ := ret[0].(entity.TOTPCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTOTP indicates an expected call of GetTOTP.
+func (mr *MockTwoFactorRepositoryMockRecorder) GetTOTP(ctx, tx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTOTP", reflect.TypeOf((*MockTwoFactorRepository)(nil).GetTOTP), ctx, tx, userID)
+}
+
+// IncrementChallengeAttempts mocks base method.
+func (m *MockTwoFactorRepository) IncrementChallengeAttempts(ctx context.Context, tx pgx.Tx, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementChallengeAttempts", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementChallengeAttempts indicates an expected call of IncrementChallengeAttempts.
+func (mr *MockTwoFactorRepositoryMockRecorder) IncrementChallengeAttempts(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementChallengeAttempts", reflect.TypeOf((*MockTwoFactorRepository)(nil).IncrementChallengeAttempts), ctx, tx, id)
+}
+
+// ReplaceRecoveryCodes mocks base method.
+func (m *MockTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID int64, hashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRecoveryCodes", ctx, tx, userID, hashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRecoveryCodes indicates an expected call of ReplaceRecoveryCodes.
+func (mr *MockTwoFactorRepositoryMockRecorder) ReplaceRecoveryCodes(ctx, tx, userID, hashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRecoveryCodes", reflect.TypeOf((*MockTwoFactorRepository)(nil).ReplaceRecoveryCodes), ctx, tx, userID, hashes)
+}
+
+// SaveTOTP mocks base method.
+func (m *MockTwoFactorRepository) SaveTOTP(ctx context.Context, userID int64, secret []byte) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTOTP", ctx, userID, secret)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTOTP indicates an expected call of SaveTOTP.
+func (mr *MockTwoFactorRepositoryMockRecorder) SaveTOTP(ctx, userID, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTOTP", reflect.TypeOf((*MockTwoFactorRepository)(nil).SaveTOTP), ctx, userID, secret)
+}
+
+// SetLastStep mocks base method.
+func (m *MockTwoFactorRepository) SetLastStep(ctx context.Context, tx pgx.Tx, userID, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastStep", ctx, tx, userID, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastStep indicates an expected call of SetLastStep.
+func (mr *MockTwoFactorRepositoryMockRecorder) SetLastStep(ctx, tx, userID, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastStep", reflect.TypeOf((*MockTwoFactorRepository)(nil).SetLastStep), ctx, tx, userID, step)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockTwoFactorRepository) UseRecoveryCode(ctx context.Context, tx pgx.Tx, userID int64, hash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, tx, userID, hash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockTwoFactorRepositoryMockRecorder) UseRecoveryCode(ctx, tx, userID, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockTwoFactorRepository)(nil).UseRecoveryCode), ctx, tx, userID, hash)
+}
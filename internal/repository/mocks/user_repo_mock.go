@@ -36,6 +36,21 @@ func (m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterfaceMockR
 	return m.recorder
 }
 
+// GetByIDForUpdate mocks base method.
+func (m *MockUserRepositoryInterface) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, id int64) (entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, tx, id)
+	ret0, _ := ret[0].(entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetByIDForUpdate(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByIDForUpdate), ctx, tx, id)
+}
+
 // GetByLogin mocks base method.
 func (m *MockUserRepositoryInterface) GetByLogin(ctx context.Context, tx pgx.Tx, login string) (entity.User, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,36 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) GetByLogin(ctx, tx, login int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLogin", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByLogin), ctx, tx, login)
 }
 
+// GetIDByLogin mocks base method.
+func (m *MockUserRepositoryInterface) GetIDByLogin(ctx context.Context, login string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIDByLogin", ctx, login)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIDByLogin indicates an expected call of GetIDByLogin.
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetIDByLogin(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIDByLogin", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetIDByLogin), ctx, login)
+}
+
+// GetLoginByID mocks base method.
+func (m *MockUserRepositoryInterface) GetLoginByID(ctx context.Context, id int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginByID", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginByID indicates an expected call of GetLoginByID.
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetLoginByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginByID", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetLoginByID), ctx, id)
+}
+
 // Register mocks base method.
 func (m *MockUserRepositoryInterface) Register(ctx context.Context, tx pgx.Tx, user entity.User) (entity.User, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +110,17 @@ func (mr *MockUserRepositoryInterfaceMockRecorder) Register(ctx, tx, user interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Register), ctx, tx, user)
 }
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepositoryInterface) UpdatePassword(ctx context.Context, tx pgx.Tx, id int64, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, tx, id, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepositoryInterfaceMockRecorder) UpdatePassword(ctx, tx, id, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepositoryInterface)(nil).UpdatePassword), ctx, tx, id, passwordHash)
+}
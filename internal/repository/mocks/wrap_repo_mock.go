@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repository/wrap_repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entity "keeper/internal/entity"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWrapRepository is a mock of WrapRepository interface.
+type MockWrapRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWrapRepositoryMockRecorder
+}
+
+// MockWrapRepositoryMockRecorder is the mock recorder for MockWrapRepository.
+type MockWrapRepositoryMockRecorder struct {
+	mock *MockWrapRepository
+}
+
+// NewMockWrapRepository creates a new mock instance.
+func NewMockWrapRepository(ctrl *gomock.Controller) *MockWrapRepository {
+	mock := &MockWrapRepository{ctrl: ctrl}
+	mock.recorder = &MockWrapRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWrapRepository) EXPECT() *MockWrapRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWrapRepository) Create(ctx context.Context, secret *entity.WrappedSecret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWrapRepositoryMockRecorder) Create(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWrapRepository)(nil).Create), ctx, secret)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockWrapRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockWrapRepositoryMockRecorder) DeleteExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockWrapRepository)(nil).DeleteExpired), ctx)
+}
+
+// Take mocks base method.
+func (m *MockWrapRepository) Take(ctx context.Context, tokenHash string) (entity.WrappedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, tokenHash)
+	ret0, _ := ret[0].(entity.WrappedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockWrapRepositoryMockRecorder) Take(ctx, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockWrapRepository)(nil).Take), ctx, tokenHash)
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PKIRepository interface {
+	// GetCA returns pgx.ErrNoRows until a CA is generated or imported.
+	GetCA(ctx context.Context) (entity.PKICA, error)
+	CreateCA(ctx context.Context, ca *entity.PKICA) error
+	SaveRole(ctx context.Context, role *entity.PKIRole) error
+	GetRole(ctx context.Context, name string) (entity.PKIRole, error)
+	ListRoles(ctx context.Context) ([]entity.PKIRole, error)
+	DeleteRole(ctx context.Context, name string) error
+	CreateCertificate(ctx context.Context, cert *entity.PKICertificate) error
+	GetCertificate(ctx context.Context, serial string) (entity.PKICertificate, error)
+	Revoke(ctx context.Context, serial string) error
+	// ListRevoked returns revoked certificates that haven't expired yet.
+	ListRevoked(ctx context.Context) ([]entity.PKICertificate, error)
+}
+
+type pkiRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewPKIRepository(db *pgxpool.Pool) PKIRepository {
+	return &pkiRepository{Pool: db}
+}
+
+func (r *pkiRepository) GetCA(ctx context.Context) (entity.PKICA, error) {
+	var ca entity.PKICA
+	err := r.Pool.QueryRow(ctx,
+		`SELECT certificate, private_key, chain, created_at FROM pki_ca WHERE id = 1`,
+	).Scan(&ca.Certificate, &ca.PrivateKey, &ca.Chain, &ca.CreatedAt)
+	if err != nil {
+		return ca, fmt.Errorf("failed to get ca: %w", err)
+	}
+	return ca, nil
+}
+
+func (r *pkiRepository) CreateCA(ctx context.Context, ca *entity.PKICA) error {
+	err := r.Pool.QueryRow(ctx, `
+		INSERT INTO pki_ca (certificate, private_key, chain)
+		VALUES ($1, $2, $3)
+		RETURNING created_at
+	`, ca.Certificate, ca.PrivateKey, ca.Chain).Scan(&ca.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store ca: %w", err)
+	}
+	return nil
+}
+
+func (r *pkiRepository) SaveRole(ctx context.Context, role *entity.PKIRole) error {
+	query := `
+		INSERT INTO pki_roles
+			(name, allowed_domains, allow_bare_domains, allow_subdomains, allow_ip_sans, key_types,
+			 server_auth, client_auth, default_ttl_seconds, max_ttl_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (name) DO UPDATE SET
+			allowed_domains = EXCLUDED.allowed_domains,
+			allow_bare_domains = EXCLUDED.allow_bare_domains,
+			allow_subdomains = EXCLUDED.allow_subdomains,
+			allow_ip_sans = EXCLUDED.allow_ip_sans,
+			key_types = EXCLUDED.key_types,
+			server_auth = EXCLUDED.server_auth,
+			client_auth = EXCLUDED.client_auth,
+			default_ttl_seconds = EXCLUDED.default_ttl_seconds,
+			max_ttl_seconds = EXCLUDED.max_ttl_seconds,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	err := r.Pool.QueryRow(ctx, query,
+		role.Name, role.AllowedDomains, role.AllowBareDomains, role.AllowSubdomains, role.AllowIPSANs,
+		role.KeyTypes, role.ServerAuth, role.ClientAuth,
+		int64(role.DefaultTTL.Seconds()), int64(role.MaxTTL.Seconds()),
+	).Scan(&role.ID, &role.CreatedAt, &role.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save pki role: %w", err)
+	}
+	return nil
+}
+
+const pkiRoleSelect = `
+	SELECT id, name, allowed_domains, allow_bare_domains, allow_subdomains, allow_ip_sans, key_types,
+		server_auth, client_auth, default_ttl_seconds, max_ttl_seconds, created_at, updated_at
+	FROM pki_roles
+`
+
+func scanPKIRole(row pgx.Row) (entity.PKIRole, error) {
+	var role entity.PKIRole
+	var defaultTTL, maxTTL int64
+	err := row.Scan(&role.ID, &role.Name, &role.AllowedDomains, &role.AllowBareDomains,
+		&role.AllowSubdomains, &role.AllowIPSANs, &role.KeyTypes, &role.ServerAuth, &role.ClientAuth,
+		&defaultTTL, &maxTTL, &role.CreatedAt, &role.UpdatedAt)
+	role.DefaultTTL = time.Duration(defaultTTL) * time.Second
+	role.MaxTTL = time.Duration(maxTTL) * time.Second
+	return role, err
+}
+
+func (r *pkiRepository) GetRole(ctx context.Context, name string) (entity.PKIRole, error) {
+	role, err := scanPKIRole(r.Pool.QueryRow(ctx, pkiRoleSelect+` WHERE name = $1`, name))
+	if err != nil {
+		return role, fmt.Errorf("failed to get pki role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *pkiRepository) ListRoles(ctx context.Context) ([]entity.PKIRole, error) {
+	rows, err := r.Pool.Query(ctx, pkiRoleSelect+` ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pki roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []entity.PKIRole
+	for rows.Next() {
+		role, err := scanPKIRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pki role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list pki roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (r *pkiRepository) DeleteRole(ctx context.Context, name string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM pki_roles WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete pki role: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+func (r *pkiRepository) CreateCertificate(ctx context.Context, cert *entity.PKICertificate) error {
+	err := r.Pool.QueryRow(ctx, `
+		INSERT INTO pki_certificates (serial, role, common_name, issued_by, certificate, not_after)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at
+	`, cert.Serial, cert.Role, cert.CommonName, cert.IssuedBy, cert.Certificate, cert.NotAfter).
+		Scan(&cert.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store certificate: %w", err)
+	}
+	return nil
+}
+
+const pkiCertificateSelect = `
+	SELECT serial, role, common_name, issued_by, certificate, not_after, revoked_at, created_at
+	FROM pki_certificates
+`
+
+func scanPKICertificate(row pgx.Row) (entity.PKICertificate, error) {
+	var c entity.PKICertificate
+	err := row.Scan(&c.Serial, &c.Role, &c.CommonName, &c.IssuedBy, &c.Certificate,
+		&c.NotAfter, &c.RevokedAt, &c.CreatedAt)
+	return c, err
+}
+
+func (r *pkiRepository) GetCertificate(ctx context.Context, serial string) (entity.PKICertificate, error) {
+	c, err := scanPKICertificate(r.Pool.QueryRow(ctx, pkiCertificateSelect+` WHERE serial = $1`, serial))
+	if err != nil {
+		return c, fmt.Errorf("failed to get certificate: %w", err)
+	}
+	return c, nil
+}
+
+func (r *pkiRepository) Revoke(ctx context.Context, serial string) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE pki_certificates SET revoked_at = NOW() WHERE serial = $1 AND revoked_at IS NULL`, serial)
+	if err != nil {
+		return fmt.Errorf("failed to revoke certificate: %w", err)
+	}
+	return nil
+}
+
+func (r *pkiRepository) ListRevoked(ctx context.Context) ([]entity.PKICertificate, error) {
+	rows, err := r.Pool.Query(ctx, pkiCertificateSelect+`
+		WHERE revoked_at IS NOT NULL AND not_after > NOW()
+		ORDER BY revoked_at
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revoked certificates: %w", err)
+	}
+	defer rows.Close()
+
+	var certs []entity.PKICertificate
+	for rows.Next() {
+		c, err := scanPKICertificate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan certificate: %w", err)
+		}
+		certs = append(certs, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list revoked certificates: %w", err)
+	}
+	return certs, nil
+}
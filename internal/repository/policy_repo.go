@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PolicyRepository interface {
+	Save(ctx context.Context, policy *entity.Policy) error
+	GetByName(ctx context.Context, name string) (entity.Policy, error)
+	List(ctx context.Context) ([]entity.Policy, error)
+	Delete(ctx context.Context, name string) error
+	Attach(ctx context.Context, policyID int64, subjectType string, subjectID int64) error
+	Detach(ctx context.Context, policyID int64, subjectType string, subjectID int64) error
+	ListAttachments(ctx context.Context, policyID int64) ([]entity.PolicyAttachment, error)
+	ListForPrincipal(ctx context.Context, userID int64, token string) ([]entity.Policy, error)
+}
+
+type policyRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewPolicyRepository(db *pgxpool.Pool) PolicyRepository {
+	return &policyRepository{Pool: db}
+}
+
+func (r *policyRepository) Save(ctx context.Context, policy *entity.Policy) error {
+	query := `
+		INSERT INTO policies (name, description, rules)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO UPDATE
+		SET description = EXCLUDED.description, rules = EXCLUDED.rules, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	err := r.Pool.QueryRow(ctx, query, policy.Name, policy.Description, policy.Rules).
+		Scan(&policy.ID, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save policy: %w", err)
+	}
+	return nil
+}
+
+func (r *policyRepository) GetByName(ctx context.Context, name string) (entity.Policy, error) {
+	var p entity.Policy
+	query := `
+		SELECT id, name, description, rules, created_at, updated_at
+		FROM policies
+		WHERE name = $1
+	`
+	err := r.Pool.QueryRow(ctx, query, name).
+		Scan(&p.ID, &p.Name, &p.Description, &p.Rules, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return p, fmt.Errorf("failed to get policy: %w", err)
+	}
+	return p, nil
+}
+
+func (r *policyRepository) List(ctx context.Context) ([]entity.Policy, error) {
+	query := `
+		SELECT id, name, description, rules, created_at, updated_at
+		FROM policies
+		ORDER BY name
+	`
+	return r.list(ctx, query)
+}
+
+func (r *policyRepository) Delete(ctx context.Context, name string) error {
+	ct, err := r.Pool.Exec(ctx, `DELETE FROM policies WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no policy deleted")
+	}
+	return nil
+}
+
+func (r *policyRepository) Attach(ctx context.Context, policyID int64, subjectType string, subjectID int64) error {
+	query := `
+		INSERT INTO policy_attachments (policy_id, subject_type, subject_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := r.Pool.Exec(ctx, query, policyID, subjectType, subjectID); err != nil {
+		return fmt.Errorf("failed to attach policy: %w", err)
+	}
+	return nil
+}
+
+func (r *policyRepository) Detach(ctx context.Context, policyID int64, subjectType string, subjectID int64) error {
+	query := `
+		DELETE FROM policy_attachments
+		WHERE policy_id = $1 AND subject_type = $2 AND subject_id = $3
+	`
+	ct, err := r.Pool.Exec(ctx, query, policyID, subjectType, subjectID)
+	if err != nil {
+		return fmt.Errorf("failed to detach policy: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no policy attachment deleted")
+	}
+	return nil
+}
+
+func (r *policyRepository) ListAttachments(ctx context.Context, policyID int64) ([]entity.PolicyAttachment, error) {
+	query := `
+		SELECT pa.policy_id, pa.subject_type, pa.subject_id,
+			CASE pa.subject_type
+				WHEN 'user' THEN (SELECT login FROM users WHERE id = pa.subject_id)
+				WHEN 'team' THEN (SELECT name FROM teams WHERE id = pa.subject_id)
+				ELSE pa.subject_id::TEXT
+			END,
+			pa.created_at
+		FROM policy_attachments pa
+		WHERE pa.policy_id = $1
+		ORDER BY pa.subject_type, pa.subject_id
+	`
+	rows, err := r.Pool.Query(ctx, query, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []entity.PolicyAttachment
+	for rows.Next() {
+		var a entity.PolicyAttachment
+		var name *string
+		if err := rows.Scan(&a.PolicyID, &a.SubjectType, &a.SubjectID, &name, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan policy attachment: %w", err)
+		}
+		if name != nil {
+			a.SubjectName = *name
+		}
+		attachments = append(attachments, a)
+	}
+	return attachments, nil
+}
+
+// ListForPrincipal returns the policies attached to the user, to any team the
+// user belongs to and to the access token used for the request.
+func (r *policyRepository) ListForPrincipal(ctx context.Context, userID int64, token string) ([]entity.Policy, error) {
+	query := `
+		SELECT DISTINCT p.id, p.name, p.description, p.rules, p.created_at, p.updated_at
+		FROM policies p
+		JOIN policy_attachments pa ON pa.policy_id = p.id
+		WHERE (pa.subject_type = 'user' AND pa.subject_id = $1)
+			OR (pa.subject_type = 'team'
+				AND pa.subject_id IN (SELECT team_id FROM team_members WHERE user_id = $1))
+			OR (pa.subject_type = 'token'
+				AND pa.subject_id IN (SELECT id FROM access_tokens WHERE token = $2))
+		ORDER BY p.name
+	`
+	return r.list(ctx, query, userID, token)
+}
+
+func (r *policyRepository) list(ctx context.Context, query string, args ...any) ([]entity.Policy, error) {
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []entity.Policy
+	for rows.Next() {
+		var p entity.Policy
+		if err := rows.Scan(&p.ID, &p.Name, &p.Description, &p.Rules, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, tx pgx.Tx, token *entity.RefreshToken) error
+	FindByHashForUpdate(ctx context.Context, tx pgx.Tx, hash string) (entity.RefreshToken, error)
+	MarkUsed(ctx context.Context, tx pgx.Tx, id int64) error
+}
+
+// refreshTokenRepository methods run in the caller's transaction, since
+// rotation must be atomic with the session update.
+type refreshTokenRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewRefreshTokenRepository(db *pgxpool.Pool) RefreshTokenRepository {
+	return &refreshTokenRepository{Pool: db}
+}
+
+func (r *refreshTokenRepository) Create(ctx context.Context, tx pgx.Tx, token *entity.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (session_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+	err := tx.QueryRow(ctx, query, token.SessionID, token.TokenHash, token.ExpiresAt).
+		Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+// FindByHashForUpdate locks the refresh token row so two concurrent refreshes
+// with the same token can't both succeed.
+func (r *refreshTokenRepository) FindByHashForUpdate(
+	ctx context.Context,
+	tx pgx.Tx,
+	hash string,
+) (entity.RefreshToken, error) {
+	var token entity.RefreshToken
+	query := `
+		SELECT rt.id, rt.session_id, rt.token_hash, rt.expires_at, rt.used_at, rt.created_at,
+			at.user_id, at.revoked_at IS NOT NULL
+		FROM refresh_tokens rt
+		JOIN access_tokens at ON at.id = rt.session_id
+		WHERE rt.token_hash = $1
+		FOR UPDATE OF rt
+	`
+	err := tx.QueryRow(ctx, query, hash).Scan(
+		&token.ID,
+		&token.SessionID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+		&token.UserID,
+		&token.SessionRevoked,
+	)
+	if err != nil {
+		return token, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+	return token, nil
+}
+
+func (r *refreshTokenRepository) MarkUsed(ctx context.Context, tx pgx.Tx, id int64) error {
+	if _, err := tx.Exec(ctx, `UPDATE refresh_tokens SET used_at = NOW() WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+	return nil
+}
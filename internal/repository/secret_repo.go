@@ -12,16 +12,35 @@ import (
 )
 
 type VaultRepositoryInterface interface {
-	GetByUserAndPath(ctx context.Context, userID int64, path string) (entity.OneSecretVersionWithMetadata, error)
+	GetByUserAndPath(
+		ctx context.Context,
+		owner entity.SecretOwner,
+		path string,
+	) (entity.OneSecretVersionWithMetadata, error)
+	GetVersion(
+		ctx context.Context,
+		owner entity.SecretOwner,
+		path string,
+		version int64,
+	) (entity.OneSecretVersionWithMetadata, error)
+	ListVersions(ctx context.Context, owner entity.SecretOwner, path string) ([]entity.SecretVersion, error)
 	ListByUser(ctx context.Context, userID int64) ([]entity.SecretMetadata, error)
 	SaveOrUpdate(ctx context.Context, secretMetadata *entity.SecretMetadata,
 		secretVersion *entity.SecretVersion) (entity.SecretMetadata, error)
-	Delete(ctx context.Context, userID int64, path string) error
-	DestroySecret(ctx context.Context, userID int64, path string) error
-	DeleteMetadata(ctx context.Context, userID int64, path string) error
-	UndeleteSecret(ctx context.Context, userID int64, path string, version int64) error
+	Delete(ctx context.Context, owner entity.SecretOwner, path string) error
+	DeleteVersions(ctx context.Context, owner entity.SecretOwner, path string, versions []int64) error
+	DestroySecret(ctx context.Context, owner entity.SecretOwner, path string) error
+	DestroyVersions(ctx context.Context, owner entity.SecretOwner, path string, versions []int64) error
+	DeleteMetadata(ctx context.Context, owner entity.SecretOwner, path string) error
+	UndeleteSecret(ctx context.Context, owner entity.SecretOwner, path string, version int64) error
+	ChangeHorizon(ctx context.Context) (int64, error)
+	ListChanges(ctx context.Context, userID, from, horizon, afterID int64, limit int) ([]entity.SecretChange, error)
 }
 
+// ErrVersionMismatch is returned by SaveOrUpdate when the check-and-set
+// version is not the latest version of the path.
+var ErrVersionMismatch = errors.New("check-and-set version does not match the latest version")
+
 type vaultRepository struct {
 	Pool *pgxpool.Pool
 }
@@ -30,9 +49,20 @@ func NewVaultRepository(db *pgxpool.Pool) VaultRepositoryInterface {
 	return &vaultRepository{Pool: db}
 }
 
+// ownerCondition matches secrets_metadata rows of a personal vault ($1) or a
+// team namespace ($2); ownerArgs fills in exactly one of the two parameters.
+const ownerCondition = `((sm.team_id IS NULL AND sm.user_id = $1) OR sm.team_id = $2)`
+
+func ownerArgs(owner entity.SecretOwner) (userID, teamID *int64) {
+	if owner.TeamID != 0 {
+		return nil, &owner.TeamID
+	}
+	return &owner.UserID, nil
+}
+
 func (r *vaultRepository) GetByUserAndPath(
 	ctx context.Context,
-	userID int64,
+	owner entity.SecretOwner,
 	path string,
 ) (entity.OneSecretVersionWithMetadata, error) {
 	var secret entity.OneSecretVersionWithMetadata
@@ -41,10 +71,11 @@ func (r *vaultRepository) GetByUserAndPath(
 			sv.content, sv.created_at, sv.version, sv.deleted_at, sv.file_path
 		FROM secrets_metadata sm
 		JOIN secret_versions sv ON sm.id = sv.metadata_id
-		WHERE sm.user_id = $1 AND sm.title = $2 AND sv.deleted_at IS NULL
+		WHERE ` + ownerCondition + ` AND sm.title = $3 AND sv.deleted_at IS NULL
 		ORDER BY sv.version DESC LIMIT 1
 	`
-	err := r.Pool.QueryRow(ctx, query, userID, path).Scan(
+	userID, teamID := ownerArgs(owner)
+	err := r.Pool.QueryRow(ctx, query, userID, teamID, path).Scan(
 		&secret.Path, &secret.ExpiredAt, &secret.Description,
 		&secret.Value, &secret.CreatedAt, &secret.Version, &secret.DeletedAt, &secret.FilePath,
 	)
@@ -54,11 +85,73 @@ func (r *vaultRepository) GetByUserAndPath(
 	return secret, nil
 }
 
+// GetVersion reads one live version, unlike GetByUserAndPath which reads the
+// latest.
+func (r *vaultRepository) GetVersion(
+	ctx context.Context,
+	owner entity.SecretOwner,
+	path string,
+	version int64,
+) (entity.OneSecretVersionWithMetadata, error) {
+	var secret entity.OneSecretVersionWithMetadata
+	query := `
+		SELECT sm.title, sm.expired_at, sm.description,
+			sv.content, sv.created_at, sv.version, sv.deleted_at, sv.file_path
+		FROM secrets_metadata sm
+		JOIN secret_versions sv ON sm.id = sv.metadata_id
+		WHERE ` + ownerCondition + ` AND sm.title = $3 AND sv.version = $4
+			AND sv.deleted_at IS NULL AND sv.destroyed IS NOT TRUE
+	`
+	userID, teamID := ownerArgs(owner)
+	err := r.Pool.QueryRow(ctx, query, userID, teamID, path, version).Scan(
+		&secret.Path, &secret.ExpiredAt, &secret.Description,
+		&secret.Value, &secret.CreatedAt, &secret.Version, &secret.DeletedAt, &secret.FilePath,
+	)
+	if err != nil {
+		return secret, fmt.Errorf("failed to get secret version: %w", err)
+	}
+	return secret, nil
+}
+
+func (r *vaultRepository) ListVersions(
+	ctx context.Context,
+	owner entity.SecretOwner,
+	path string,
+) ([]entity.SecretVersion, error) {
+	query := `
+		SELECT sv.version, sv.created_at, sv.deleted_at, COALESCE(sv.destroyed, FALSE)
+		FROM secrets_metadata sm
+		JOIN secret_versions sv ON sm.id = sv.metadata_id
+		WHERE ` + ownerCondition + ` AND sm.title = $3
+		ORDER BY sv.version
+	`
+	userID, teamID := ownerArgs(owner)
+	rows, err := r.Pool.Query(ctx, query, userID, teamID, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secret versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []entity.SecretVersion
+	for rows.Next() {
+		var v entity.SecretVersion
+		if err := rows.Scan(&v.Version, &v.CreatedAt, &v.DeletedAt, &v.Destroyed); err != nil {
+			return nil, fmt.Errorf("failed to scan secret version: %w", err)
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list secret versions: %w", err)
+	}
+	return versions, nil
+}
+
 func (r *vaultRepository) ListByUser(ctx context.Context, userID int64) ([]entity.SecretMetadata, error) {
 	query := `
 		SELECT sm.title
-		FROM secrets_metadata sm 
-		WHERE sm.user_id = $1
+		FROM secrets_metadata sm
+		WHERE (sm.team_id IS NULL AND sm.user_id = $1)
+			OR sm.team_id IN (SELECT team_id FROM team_members WHERE user_id = $1)
 		ORDER BY sm.title
 	`
 	rows, err := r.Pool.Query(ctx, query, userID)
@@ -90,19 +183,40 @@ func (r *vaultRepository) SaveOrUpdate(ctx context.Context, secretMetadata *enti
 	}(tx, ctx)
 
 	var metadataID int64
+	conflictTarget := `(user_id, title)`
+	if secretMetadata.TeamID != 0 {
+		conflictTarget = `(team_id, title) WHERE team_id IS NOT NULL`
+	}
 	metaUpsert := `
-		INSERT INTO secrets_metadata (user_id, title, expired_at, description)
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (user_id, title) DO UPDATE
-		SET expired_at = EXCLUDED.expired_at, description = EXCLUDED.description, deleted_at = NULL
+		INSERT INTO secrets_metadata (user_id, team_id, title, expired_at, description)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT ` + conflictTarget + ` DO UPDATE
+		SET expired_at = CASE WHEN $6 THEN secrets_metadata.expired_at ELSE EXCLUDED.expired_at END,
+			description = CASE WHEN $6 THEN secrets_metadata.description ELSE EXCLUDED.description END,
+			deleted_at = NULL
 		RETURNING id
 	`
-	err = tx.QueryRow(ctx, metaUpsert, secretMetadata.UserID, secretMetadata.Path,
-		secretMetadata.ExpiredAt, secretMetadata.Description).Scan(&metadataID)
+	userID, teamID := ownerArgs(entity.SecretOwner{UserID: secretMetadata.UserID, TeamID: secretMetadata.TeamID})
+	err = tx.QueryRow(ctx, metaUpsert, userID, teamID, secretMetadata.Path,
+		secretMetadata.ExpiredAt, secretMetadata.Description, secretMetadata.KeepCurrent).Scan(&metadataID)
 	if err != nil {
 		return *secretMetadata, fmt.Errorf("failed to upsert metadata: %w", err)
 	}
 
+	// The upsert locks the metadata row, so concurrent saves of the path
+	// can't both pass this check.
+	if secretVersion.CAS != nil {
+		var latest int64
+		err = tx.QueryRow(ctx, `SELECT COALESCE(MAX(version), 0) FROM secret_versions WHERE metadata_id = $1`,
+			metadataID).Scan(&latest)
+		if err != nil {
+			return *secretMetadata, fmt.Errorf("failed to read latest version: %w", err)
+		}
+		if latest != *secretVersion.CAS {
+			return *secretMetadata, ErrVersionMismatch
+		}
+	}
+
 	versionInsert := `
 		INSERT INTO secret_versions (metadata_id, version, content, file_path)
 		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3
@@ -127,13 +241,14 @@ func (r *vaultRepository) SaveOrUpdate(ctx context.Context, secretMetadata *enti
 	return *secretMetadata, nil
 }
 
-func (r *vaultRepository) Delete(ctx context.Context, userID int64, path string) error {
+func (r *vaultRepository) Delete(ctx context.Context, owner entity.SecretOwner, path string) error {
 	query := `
 		UPDATE secret_versions SET deleted_at = NOW()
-		WHERE metadata_id = (SELECT id FROM secrets_metadata WHERE user_id = $1 AND title = $2)
+		WHERE metadata_id = (SELECT sm.id FROM secrets_metadata sm WHERE ` + ownerCondition + ` AND sm.title = $3)
 		AND deleted_at IS NULL
 	`
-	ct, err := r.Pool.Exec(ctx, query, userID, path)
+	userID, teamID := ownerArgs(owner)
+	ct, err := r.Pool.Exec(ctx, query, userID, teamID, path)
 	if err != nil {
 		return fmt.Errorf("failed to delete secret versions: %w", err)
 	}
@@ -143,13 +258,34 @@ func (r *vaultRepository) Delete(ctx context.Context, userID int64, path string)
 	return nil
 }
 
-func (r *vaultRepository) DestroySecret(ctx context.Context, userID int64, path string) error {
+// DeleteVersions soft-deletes the listed versions; versions that are
+// already deleted are left alone.
+func (r *vaultRepository) DeleteVersions(
+	ctx context.Context,
+	owner entity.SecretOwner,
+	path string,
+	versions []int64,
+) error {
+	query := `
+		UPDATE secret_versions SET deleted_at = NOW()
+		WHERE metadata_id = (SELECT sm.id FROM secrets_metadata sm WHERE ` + ownerCondition + ` AND sm.title = $3)
+		AND version = ANY($4) AND deleted_at IS NULL
+	`
+	userID, teamID := ownerArgs(owner)
+	if _, err := r.Pool.Exec(ctx, query, userID, teamID, path, versions); err != nil {
+		return fmt.Errorf("failed to delete secret versions: %w", err)
+	}
+	return nil
+}
+
+func (r *vaultRepository) DestroySecret(ctx context.Context, owner entity.SecretOwner, path string) error {
 	query := `
 		UPDATE secret_versions SET destroyed = TRUE, content = '', deleted_at = NOW(), file_path = ''
-		WHERE metadata_id = (SELECT id FROM secrets_metadata WHERE user_id = $1 AND title = $2)
+		WHERE metadata_id = (SELECT sm.id FROM secrets_metadata sm WHERE ` + ownerCondition + ` AND sm.title = $3)
 		AND destroyed = FALSE
 	`
-	ct, err := r.Pool.Exec(ctx, query, userID, path)
+	userID, teamID := ownerArgs(owner)
+	ct, err := r.Pool.Exec(ctx, query, userID, teamID, path)
 	if err != nil {
 		return fmt.Errorf("failed to destroy version: %w", err)
 	}
@@ -159,12 +295,34 @@ func (r *vaultRepository) DestroySecret(ctx context.Context, userID int64, path
 	return nil
 }
 
-func (r *vaultRepository) DeleteMetadata(ctx context.Context, userID int64, path string) error {
+// DestroyVersions wipes the listed versions; versions that are already
+// destroyed are left alone.
+func (r *vaultRepository) DestroyVersions(
+	ctx context.Context,
+	owner entity.SecretOwner,
+	path string,
+	versions []int64,
+) error {
+	query := `
+		UPDATE secret_versions SET destroyed = TRUE, content = '', deleted_at = COALESCE(deleted_at, NOW()),
+			file_path = ''
+		WHERE metadata_id = (SELECT sm.id FROM secrets_metadata sm WHERE ` + ownerCondition + ` AND sm.title = $3)
+		AND version = ANY($4) AND destroyed = FALSE
+	`
+	userID, teamID := ownerArgs(owner)
+	if _, err := r.Pool.Exec(ctx, query, userID, teamID, path, versions); err != nil {
+		return fmt.Errorf("failed to destroy versions: %w", err)
+	}
+	return nil
+}
+
+func (r *vaultRepository) DeleteMetadata(ctx context.Context, owner entity.SecretOwner, path string) error {
 	query := `
-		UPDATE secrets_metadata SET deleted_at = NOW()
-		WHERE user_id = $1 AND title = $2 AND deleted_at IS NULL
+		UPDATE secrets_metadata sm SET deleted_at = NOW()
+		WHERE ` + ownerCondition + ` AND sm.title = $3 AND sm.deleted_at IS NULL
 	`
-	ct, err := r.Pool.Exec(ctx, query, userID, path)
+	userID, teamID := ownerArgs(owner)
+	ct, err := r.Pool.Exec(ctx, query, userID, teamID, path)
 	if err != nil {
 		return fmt.Errorf("failed to delete metadata: %w", err)
 	}
@@ -174,13 +332,19 @@ func (r *vaultRepository) DeleteMetadata(ctx context.Context, userID int64, path
 	return nil
 }
 
-func (r *vaultRepository) UndeleteSecret(ctx context.Context, userID int64, path string, version int64) error {
+func (r *vaultRepository) UndeleteSecret(
+	ctx context.Context,
+	owner entity.SecretOwner,
+	path string,
+	version int64,
+) error {
 	query := `
 		UPDATE secret_versions SET deleted_at = NULL
-		WHERE metadata_id = (SELECT id FROM secrets_metadata WHERE user_id = $1 AND title = $2)
-		AND version = $3 AND deleted_at IS NOT NULL
+		WHERE metadata_id = (SELECT sm.id FROM secrets_metadata sm WHERE ` + ownerCondition + ` AND sm.title = $3)
+		AND version = $4 AND deleted_at IS NOT NULL
 	`
-	ct, err := r.Pool.Exec(ctx, query, userID, path, version)
+	userID, teamID := ownerArgs(owner)
+	ct, err := r.Pool.Exec(ctx, query, userID, teamID, path, version)
 	if err != nil {
 		return fmt.Errorf("failed to undelete version: %w", err)
 	}
@@ -189,3 +353,60 @@ func (r *vaultRepository) UndeleteSecret(ctx context.Context, userID int64, path
 	}
 	return nil
 }
+
+// ChangeHorizon is the oldest transaction still running. Every change below
+// it is committed, so a change window that ends there can't miss one.
+func (r *vaultRepository) ChangeHorizon(ctx context.Context) (int64, error) {
+	var horizon int64
+	err := r.Pool.QueryRow(ctx, `SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint`).Scan(&horizon)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read change horizon: %w", err)
+	}
+	return horizon, nil
+}
+
+// ListChanges returns the personal secrets of userID changed by transactions
+// in [from, horizon), ordered by id and starting after afterID, with their
+// latest live version.
+func (r *vaultRepository) ListChanges(
+	ctx context.Context,
+	userID, from, horizon, afterID int64,
+	limit int,
+) ([]entity.SecretChange, error) {
+	query := `
+		SELECT sm.id, sm.title, COALESCE(sm.description, ''), sm.expired_at,
+			COALESCE((SELECT MAX(version) FROM secret_versions WHERE metadata_id = sm.id), 0),
+			COALESCE(sv.version, 0), sv.content, sv.file_path, COALESCE(sv.created_at, sm.created_at)
+		FROM secrets_metadata sm
+		LEFT JOIN LATERAL (
+			SELECT version, content, file_path, created_at
+			FROM secret_versions
+			WHERE metadata_id = sm.id AND deleted_at IS NULL AND destroyed IS NOT TRUE
+			ORDER BY version DESC LIMIT 1
+		) sv ON sm.deleted_at IS NULL
+		WHERE sm.team_id IS NULL AND sm.user_id = $1
+			AND sm.change_xid >= $2::bigint::text::xid8 AND sm.change_xid < $3::bigint::text::xid8
+			AND sm.id > $4
+		ORDER BY sm.id
+		LIMIT $5
+	`
+	rows, err := r.Pool.Query(ctx, query, userID, from, horizon, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secret changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []entity.SecretChange
+	for rows.Next() {
+		var c entity.SecretChange
+		if err := rows.Scan(&c.ID, &c.Path, &c.Description, &c.ExpiredAt,
+			&c.Latest, &c.Version, &c.Value, &c.FilePath, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan secret change: %w", err)
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list secret changes: %w", err)
+	}
+	return changes, nil
+}
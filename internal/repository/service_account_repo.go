@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ServiceAccountRepository interface {
+	Create(ctx context.Context, account *entity.ServiceAccount, teamRole string) error
+	GetByName(ctx context.Context, name string) (entity.ServiceAccount, error)
+	ListForUser(ctx context.Context, userID int64) ([]entity.ServiceAccount, error)
+	Delete(ctx context.Context, account entity.ServiceAccount) error
+	CreateKey(ctx context.Context, key *entity.APIKey) error
+	GetKey(ctx context.Context, id int64) (entity.APIKey, error)
+	ListKeys(ctx context.Context, serviceAccountID int64) ([]entity.APIKey, error)
+	RevokeKey(ctx context.Context, id int64) error
+	FindKeyByHash(ctx context.Context, hash string) (entity.APIKey, error)
+	TouchKey(ctx context.Context, id int64, ip string) error
+}
+
+type serviceAccountRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewServiceAccountRepository(db *pgxpool.Pool) ServiceAccountRepository {
+	return &serviceAccountRepository{Pool: db}
+}
+
+const serviceAccountColumns = `
+	sa.id, sa.user_id, sa.name, u.login, sa.description,
+	sa.owner_user_id, sa.owner_team_id, COALESCE(ou.login, ''), COALESCE(t.name, ''), sa.created_at
+`
+
+const serviceAccountFrom = `
+	FROM service_accounts sa
+	JOIN users u ON u.id = sa.user_id
+	LEFT JOIN users ou ON ou.id = sa.owner_user_id
+	LEFT JOIN teams t ON t.id = sa.owner_team_id
+`
+
+func scanServiceAccount(row pgx.Row) (entity.ServiceAccount, error) {
+	var a entity.ServiceAccount
+	err := row.Scan(&a.ID, &a.UserID, &a.Name, &a.Login, &a.Description,
+		&a.OwnerUserID, &a.OwnerTeamID, &a.OwnerLogin, &a.OwnerTeam, &a.CreatedAt)
+	return a, err
+}
+
+// Create adds the principal to users and the account row. A team-owned
+// account also joins the team with teamRole so it can reach team secrets.
+func (r *serviceAccountRepository) Create(ctx context.Context, account *entity.ServiceAccount, teamRole string) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = tx.QueryRow(ctx,
+		`INSERT INTO users (login, password, kind) VALUES ($1, '', $2) RETURNING id`,
+		account.Login, entity.UserKindService,
+	).Scan(&account.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to create service account user: %w", err)
+	}
+
+	query := `
+		INSERT INTO service_accounts (user_id, name, description, owner_user_id, owner_team_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRow(ctx, query,
+		account.UserID, account.Name, account.Description, account.OwnerUserID, account.OwnerTeamID,
+	).Scan(&account.ID, &account.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	if account.OwnerTeamID != nil {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO team_members (team_id, user_id, role) VALUES ($1, $2, $3)`,
+			*account.OwnerTeamID, account.UserID, teamRole,
+		); err != nil {
+			return fmt.Errorf("failed to add service account to team: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+func (r *serviceAccountRepository) GetByName(ctx context.Context, name string) (entity.ServiceAccount, error) {
+	query := `SELECT ` + serviceAccountColumns + serviceAccountFrom + ` WHERE sa.name = $1`
+	a, err := scanServiceAccount(r.Pool.QueryRow(ctx, query, name))
+	if err != nil {
+		return a, fmt.Errorf("failed to get service account: %w", err)
+	}
+	return a, nil
+}
+
+// ListForUser returns the accounts owned by the user or by a team the user is
+// a member of.
+func (r *serviceAccountRepository) ListForUser(ctx context.Context, userID int64) ([]entity.ServiceAccount, error) {
+	query := `SELECT ` + serviceAccountColumns + serviceAccountFrom + `
+		WHERE sa.owner_user_id = $1
+			OR sa.owner_team_id IN (SELECT team_id FROM team_members WHERE user_id = $1)
+		ORDER BY sa.name
+	`
+	rows, err := r.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []entity.ServiceAccount
+	for rows.Next() {
+		a, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan service account: %w", err)
+		}
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// Delete removes the principal; the account, its keys, secrets and team
+// memberships go with it.
+func (r *serviceAccountRepository) Delete(ctx context.Context, account entity.ServiceAccount) error {
+	if _, err := r.Pool.Exec(ctx, `DELETE FROM users WHERE id = $1 AND kind = $2`,
+		account.UserID, entity.UserKindService); err != nil {
+		return fmt.Errorf("failed to delete service account: %w", err)
+	}
+	return nil
+}
+
+func (r *serviceAccountRepository) CreateKey(ctx context.Context, key *entity.APIKey) error {
+	query := `
+		INSERT INTO api_keys
+			(service_account_id, key_hash, prefix, name, path_prefixes, capabilities, allowed_cidrs, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`
+	err := r.Pool.QueryRow(ctx, query,
+		key.ServiceAccountID, key.Hash, key.Prefix, key.Name,
+		key.PathPrefixes, key.Capabilities, key.AllowedCIDRs, key.ExpiresAt,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+const apiKeySelect = `
+	SELECT k.id, k.service_account_id, sa.user_id, sa.name, k.key_hash, k.prefix, k.name,
+		k.path_prefixes, k.capabilities, k.allowed_cidrs,
+		k.expires_at, k.last_used_at, k.last_ip, k.revoked_at, k.created_at
+	FROM api_keys k
+	JOIN service_accounts sa ON sa.id = k.service_account_id
+`
+
+func scanAPIKey(row pgx.Row) (entity.APIKey, error) {
+	var k entity.APIKey
+	err := row.Scan(&k.ID, &k.ServiceAccountID, &k.UserID, &k.Account, &k.Hash, &k.Prefix, &k.Name,
+		&k.PathPrefixes, &k.Capabilities, &k.AllowedCIDRs,
+		&k.ExpiresAt, &k.LastUsedAt, &k.LastIP, &k.RevokedAt, &k.CreatedAt)
+	return k, err
+}
+
+func (r *serviceAccountRepository) GetKey(ctx context.Context, id int64) (entity.APIKey, error) {
+	k, err := scanAPIKey(r.Pool.QueryRow(ctx, apiKeySelect+` WHERE k.id = $1`, id))
+	if err != nil {
+		return k, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return k, nil
+}
+
+func (r *serviceAccountRepository) ListKeys(ctx context.Context, serviceAccountID int64) ([]entity.APIKey, error) {
+	rows, err := r.Pool.Query(ctx, apiKeySelect+` WHERE k.service_account_id = $1 ORDER BY k.id`, serviceAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []entity.APIKey
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *serviceAccountRepository) RevokeKey(ctx context.Context, id int64) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE api_keys SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
+
+// FindKeyByHash returns pgx.ErrNoRows when no key has this hash.
+func (r *serviceAccountRepository) FindKeyByHash(ctx context.Context, hash string) (entity.APIKey, error) {
+	k, err := scanAPIKey(r.Pool.QueryRow(ctx, apiKeySelect+` WHERE k.key_hash = $1`, hash))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return k, pgx.ErrNoRows
+	}
+	if err != nil {
+		return k, fmt.Errorf("failed to find api key: %w", err)
+	}
+	return k, nil
+}
+
+func (r *serviceAccountRepository) TouchKey(ctx context.Context, id int64, ip string) error {
+	_, err := r.Pool.Exec(ctx,
+		`UPDATE api_keys SET last_used_at = NOW(), last_ip = $2 WHERE id = $1`, id, ip)
+	if err != nil {
+		return fmt.Errorf("failed to update api key usage: %w", err)
+	}
+	return nil
+}
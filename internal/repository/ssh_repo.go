@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SSHRepository interface {
+	// GetCA returns pgx.ErrNoRows until a CA is generated or imported.
+	GetCA(ctx context.Context) (entity.SSHCA, error)
+	CreateCA(ctx context.Context, ca *entity.SSHCA) error
+	SaveRole(ctx context.Context, role *entity.SSHRole) error
+	GetRole(ctx context.Context, name string) (entity.SSHRole, error)
+	ListRoles(ctx context.Context) ([]entity.SSHRole, error)
+	DeleteRole(ctx context.Context, name string) error
+}
+
+type sshRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewSSHRepository(db *pgxpool.Pool) SSHRepository {
+	return &sshRepository{Pool: db}
+}
+
+func (r *sshRepository) GetCA(ctx context.Context) (entity.SSHCA, error) {
+	var ca entity.SSHCA
+	err := r.Pool.QueryRow(ctx,
+		`SELECT public_key, private_key, created_at FROM ssh_ca WHERE id = 1`,
+	).Scan(&ca.PublicKey, &ca.PrivateKey, &ca.CreatedAt)
+	if err != nil {
+		return ca, fmt.Errorf("failed to get ssh ca: %w", err)
+	}
+	return ca, nil
+}
+
+func (r *sshRepository) CreateCA(ctx context.Context, ca *entity.SSHCA) error {
+	err := r.Pool.QueryRow(ctx, `
+		INSERT INTO ssh_ca (public_key, private_key)
+		VALUES ($1, $2)
+		RETURNING created_at
+	`, ca.PublicKey, ca.PrivateKey).Scan(&ca.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store ssh ca: %w", err)
+	}
+	return nil
+}
+
+func (r *sshRepository) SaveRole(ctx context.Context, role *entity.SSHRole) error {
+	query := `
+		INSERT INTO ssh_roles
+			(name, allowed_principals, default_principals, extensions, default_ttl_seconds, max_ttl_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (name) DO UPDATE SET
+			allowed_principals = EXCLUDED.allowed_principals,
+			default_principals = EXCLUDED.default_principals,
+			extensions = EXCLUDED.extensions,
+			default_ttl_seconds = EXCLUDED.default_ttl_seconds,
+			max_ttl_seconds = EXCLUDED.max_ttl_seconds,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+	err := r.Pool.QueryRow(ctx, query,
+		role.Name, role.AllowedPrincipals, role.DefaultPrincipals, role.Extensions,
+		int64(role.DefaultTTL.Seconds()), int64(role.MaxTTL.Seconds()),
+	).Scan(&role.ID, &role.CreatedAt, &role.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save ssh role: %w", err)
+	}
+	return nil
+}
+
+const sshRoleSelect = `
+	SELECT id, name, allowed_principals, default_principals, extensions,
+		default_ttl_seconds, max_ttl_seconds, created_at, updated_at
+	FROM ssh_roles
+`
+
+func scanSSHRole(row pgx.Row) (entity.SSHRole, error) {
+	var role entity.SSHRole
+	var defaultTTL, maxTTL int64
+	err := row.Scan(&role.ID, &role.Name, &role.AllowedPrincipals, &role.DefaultPrincipals, &role.Extensions,
+		&defaultTTL, &maxTTL, &role.CreatedAt, &role.UpdatedAt)
+	role.DefaultTTL = time.Duration(defaultTTL) * time.Second
+	role.MaxTTL = time.Duration(maxTTL) * time.Second
+	return role, err
+}
+
+func (r *sshRepository) GetRole(ctx context.Context, name string) (entity.SSHRole, error) {
+	role, err := scanSSHRole(r.Pool.QueryRow(ctx, sshRoleSelect+` WHERE name = $1`, name))
+	if err != nil {
+		return role, fmt.Errorf("failed to get ssh role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *sshRepository) ListRoles(ctx context.Context) ([]entity.SSHRole, error) {
+	rows, err := r.Pool.Query(ctx, sshRoleSelect+` ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ssh roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []entity.SSHRole
+	for rows.Next() {
+		role, err := scanSSHRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ssh role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list ssh roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (r *sshRepository) DeleteRole(ctx context.Context, name string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM ssh_roles WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete ssh role: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TeamRepository interface {
+	Create(ctx context.Context, name string, ownerID int64) (entity.Team, error)
+	Delete(ctx context.Context, teamID int64) error
+	GetByName(ctx context.Context, name string) (entity.Team, error)
+	GetMember(ctx context.Context, teamID, userID int64) (entity.TeamMember, error)
+	SaveMember(ctx context.Context, teamID, userID int64, role string) error
+	DeleteMember(ctx context.Context, teamID, userID int64) error
+	CountOwners(ctx context.Context, teamID int64) (int64, error)
+	ListMembers(ctx context.Context, teamID int64) ([]entity.TeamMember, error)
+	ListByUser(ctx context.Context, userID int64) ([]entity.TeamMembership, error)
+}
+
+type teamRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewTeamRepository(db *pgxpool.Pool) TeamRepository {
+	return &teamRepository{Pool: db}
+}
+
+func (r *teamRepository) Create(ctx context.Context, name string, ownerID int64) (entity.Team, error) {
+	team := entity.Team{Name: name}
+
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return team, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	query := `
+		INSERT INTO teams (name)
+		VALUES ($1)
+		RETURNING id, created_at
+	`
+	if err = tx.QueryRow(ctx, query, name).Scan(&team.ID, &team.CreatedAt); err != nil {
+		return team, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	memberInsert := `
+		INSERT INTO team_members (team_id, user_id, role)
+		VALUES ($1, $2, $3)
+	`
+	if _, err = tx.Exec(ctx, memberInsert, team.ID, ownerID, entity.TeamRoleOwner); err != nil {
+		return team, fmt.Errorf("failed to add team owner: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return team, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return team, nil
+}
+
+func (r *teamRepository) Delete(ctx context.Context, teamID int64) error {
+	ct, err := r.Pool.Exec(ctx, `DELETE FROM teams WHERE id = $1`, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no team deleted")
+	}
+	return nil
+}
+
+func (r *teamRepository) GetByName(ctx context.Context, name string) (entity.Team, error) {
+	var team entity.Team
+	query := `
+		SELECT id, name, created_at
+		FROM teams
+		WHERE name = $1
+	`
+	err := r.Pool.QueryRow(ctx, query, name).Scan(&team.ID, &team.Name, &team.CreatedAt)
+	if err != nil {
+		return team, fmt.Errorf("failed to get team: %w", err)
+	}
+	return team, nil
+}
+
+func (r *teamRepository) GetMember(ctx context.Context, teamID, userID int64) (entity.TeamMember, error) {
+	var member entity.TeamMember
+	query := `
+		SELECT tm.team_id, tm.user_id, u.login, tm.role, tm.created_at
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_id = $1 AND tm.user_id = $2
+	`
+	err := r.Pool.QueryRow(ctx, query, teamID, userID).Scan(
+		&member.TeamID, &member.UserID, &member.Login, &member.Role, &member.CreatedAt,
+	)
+	if err != nil {
+		return member, fmt.Errorf("failed to get team member: %w", err)
+	}
+	return member, nil
+}
+
+func (r *teamRepository) SaveMember(ctx context.Context, teamID, userID int64, role string) error {
+	query := `
+		INSERT INTO team_members (team_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role
+	`
+	if _, err := r.Pool.Exec(ctx, query, teamID, userID, role); err != nil {
+		return fmt.Errorf("failed to save team member: %w", err)
+	}
+	return nil
+}
+
+func (r *teamRepository) DeleteMember(ctx context.Context, teamID, userID int64) error {
+	query := `
+		DELETE FROM team_members
+		WHERE team_id = $1 AND user_id = $2
+	`
+	ct, err := r.Pool.Exec(ctx, query, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete team member: %w", err)
+	}
+	if ct.RowsAffected() == 0 {
+		return errors.New("no team member deleted")
+	}
+	return nil
+}
+
+func (r *teamRepository) CountOwners(ctx context.Context, teamID int64) (int64, error) {
+	var count int64
+	query := `
+		SELECT COUNT(*)
+		FROM team_members
+		WHERE team_id = $1 AND role = $2
+	`
+	if err := r.Pool.QueryRow(ctx, query, teamID, entity.TeamRoleOwner).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count team owners: %w", err)
+	}
+	return count, nil
+}
+
+func (r *teamRepository) ListMembers(ctx context.Context, teamID int64) ([]entity.TeamMember, error) {
+	query := `
+		SELECT tm.team_id, tm.user_id, u.login, tm.role, tm.created_at
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_id = $1
+		ORDER BY u.login
+	`
+	rows, err := r.Pool.Query(ctx, query, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []entity.TeamMember
+	for rows.Next() {
+		var m entity.TeamMember
+		if err := rows.Scan(&m.TeamID, &m.UserID, &m.Login, &m.Role, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan team member: %w", err)
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+func (r *teamRepository) ListByUser(ctx context.Context, userID int64) ([]entity.TeamMembership, error) {
+	query := `
+		SELECT t.id, t.name, tm.role, t.created_at
+		FROM team_members tm
+		JOIN teams t ON t.id = tm.team_id
+		WHERE tm.user_id = $1
+		ORDER BY t.name
+	`
+	rows, err := r.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []entity.TeamMembership
+	for rows.Next() {
+		var t entity.TeamMembership
+		if err := rows.Scan(&t.TeamID, &t.TeamName, &t.Role, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan team: %w", err)
+		}
+		teams = append(teams, t)
+	}
+	return teams, nil
+}
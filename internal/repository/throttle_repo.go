@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ThrottleRepository interface {
+	Get(ctx context.Context, key string) (entity.AuthThrottle, error)
+	RecordFailure(ctx context.Context, key string, now, windowStart time.Time) (entity.AuthThrottle, error)
+	Lock(ctx context.Context, event entity.LockoutEvent) error
+	Reset(ctx context.Context, key string) error
+	ListLockouts(ctx context.Context, limit int) ([]entity.LockoutEvent, error)
+}
+
+// throttleRepository works outside the login transaction so failures are
+// kept when the login itself is rolled back.
+type throttleRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewThrottleRepository(db *pgxpool.Pool) ThrottleRepository {
+	return &throttleRepository{Pool: db}
+}
+
+// Get returns a zero AuthThrottle for a key without failures.
+func (r *throttleRepository) Get(ctx context.Context, key string) (entity.AuthThrottle, error) {
+	t := entity.AuthThrottle{Key: key}
+	query := `
+		SELECT failures, locked_until, last_failure_at
+		FROM auth_throttle
+		WHERE key = $1
+	`
+	err := r.Pool.QueryRow(ctx, query, key).Scan(&t.Failures, &t.LockedUntil, &t.LastFailureAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return t, nil
+	}
+	if err != nil {
+		return t, fmt.Errorf("failed to get throttle state: %w", err)
+	}
+	return t, nil
+}
+
+// RecordFailure increments the counter, restarting it when the last failure
+// is older than windowStart and no lock is active.
+func (r *throttleRepository) RecordFailure(
+	ctx context.Context,
+	key string,
+	now, windowStart time.Time,
+) (entity.AuthThrottle, error) {
+	t := entity.AuthThrottle{Key: key}
+	query := `
+		INSERT INTO auth_throttle (key, failures, last_failure_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+				WHEN auth_throttle.last_failure_at < $3
+					AND (auth_throttle.locked_until IS NULL OR auth_throttle.locked_until < $2)
+				THEN 1
+				ELSE auth_throttle.failures + 1
+			END,
+			last_failure_at = $2
+		RETURNING failures, locked_until, last_failure_at
+	`
+	err := r.Pool.QueryRow(ctx, query, key, now, windowStart).Scan(&t.Failures, &t.LockedUntil, &t.LastFailureAt)
+	if err != nil {
+		return t, fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+	return t, nil
+}
+
+// Lock sets locked_until on the key and records the event for admins.
+func (r *throttleRepository) Lock(ctx context.Context, event entity.LockoutEvent) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `UPDATE auth_throttle SET locked_until = $2 WHERE key = $1`,
+		event.Key, event.LockedUntil); err != nil {
+		return fmt.Errorf("failed to lock: %w", err)
+	}
+	query := `
+		INSERT INTO lockout_events (key, failures, client_ip, locked_until, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := tx.Exec(ctx, query,
+		event.Key, event.Failures, event.ClientIP, event.LockedUntil, event.CreatedAt); err != nil {
+		return fmt.Errorf("failed to record lockout: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+func (r *throttleRepository) Reset(ctx context.Context, key string) error {
+	if _, err := r.Pool.Exec(ctx, `DELETE FROM auth_throttle WHERE key = $1`, key); err != nil {
+		return fmt.Errorf("failed to reset throttle: %w", err)
+	}
+	return nil
+}
+
+func (r *throttleRepository) ListLockouts(ctx context.Context, limit int) ([]entity.LockoutEvent, error) {
+	query := `
+		SELECT id, key, failures, client_ip, locked_until, created_at
+		FROM lockout_events
+		ORDER BY id DESC
+		LIMIT $1
+	`
+	rows, err := r.Pool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lockouts: %w", err)
+	}
+	defer rows.Close()
+
+	var events []entity.LockoutEvent
+	for rows.Next() {
+		var e entity.LockoutEvent
+		if err := rows.Scan(&e.ID, &e.Key, &e.Failures, &e.ClientIP, &e.LockedUntil, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan lockout: %w", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list lockouts: %w", err)
+	}
+	return events, nil
+}
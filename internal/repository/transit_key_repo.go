@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TransitKeyRepository interface {
+	// Create stores the key with its first version.
+	Create(ctx context.Context, key *entity.TransitKey, version *entity.TransitKeyVersion) error
+	GetUserKey(ctx context.Context, userID int64, name string) (entity.TransitKey, error)
+	GetTeamKey(ctx context.Context, teamID int64, name string) (entity.TransitKey, error)
+	// ListForUser returns the keys owned by the user or by a team the user is
+	// a member of.
+	ListForUser(ctx context.Context, userID int64) ([]entity.TransitKey, error)
+	GetVersion(ctx context.Context, keyID int64, version int) (entity.TransitKeyVersion, error)
+	// Rotate adds version as the next version of the key and makes it the
+	// latest one. It sets version.Version.
+	Rotate(ctx context.Context, keyID int64, version *entity.TransitKeyVersion) error
+}
+
+type transitKeyRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewTransitKeyRepository(db *pgxpool.Pool) TransitKeyRepository {
+	return &transitKeyRepository{Pool: db}
+}
+
+func (r *transitKeyRepository) Create(
+	ctx context.Context,
+	key *entity.TransitKey,
+	version *entity.TransitKeyVersion,
+) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	query := `
+		INSERT INTO transit_keys (name, type, owner_user_id, owner_team_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, latest_version, created_at, updated_at
+	`
+	err = tx.QueryRow(ctx, query, key.Name, key.Type, key.OwnerUserID, key.OwnerTeamID).
+		Scan(&key.ID, &key.LatestVersion, &key.CreatedAt, &key.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create transit key: %w", err)
+	}
+
+	version.KeyID = key.ID
+	version.Version = key.LatestVersion
+	if err := insertTransitKeyVersion(ctx, tx, version); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+func insertTransitKeyVersion(ctx context.Context, tx pgx.Tx, version *entity.TransitKeyVersion) error {
+	query := `
+		INSERT INTO transit_key_versions (key_id, version, material, hmac_key, public_key)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+	err := tx.QueryRow(ctx, query,
+		version.KeyID, version.Version, version.Material, version.HMACKey, version.PublicKey,
+	).Scan(&version.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to store transit key version: %w", err)
+	}
+	return nil
+}
+
+const transitKeySelect = `
+	SELECT k.id, k.name, k.type, k.owner_user_id, k.owner_team_id,
+		COALESCE(u.login, ''), COALESCE(t.name, ''), k.latest_version, k.created_at, k.updated_at
+	FROM transit_keys k
+	LEFT JOIN users u ON u.id = k.owner_user_id
+	LEFT JOIN teams t ON t.id = k.owner_team_id
+`
+
+func scanTransitKey(row pgx.Row) (entity.TransitKey, error) {
+	var k entity.TransitKey
+	err := row.Scan(&k.ID, &k.Name, &k.Type, &k.OwnerUserID, &k.OwnerTeamID,
+		&k.OwnerLogin, &k.OwnerTeam, &k.LatestVersion, &k.CreatedAt, &k.UpdatedAt)
+	return k, err
+}
+
+func (r *transitKeyRepository) GetUserKey(ctx context.Context, userID int64, name string) (entity.TransitKey, error) {
+	k, err := scanTransitKey(r.Pool.QueryRow(ctx,
+		transitKeySelect+` WHERE k.owner_user_id = $1 AND k.name = $2`, userID, name))
+	if err != nil {
+		return k, fmt.Errorf("failed to get transit key: %w", err)
+	}
+	return k, nil
+}
+
+func (r *transitKeyRepository) GetTeamKey(ctx context.Context, teamID int64, name string) (entity.TransitKey, error) {
+	k, err := scanTransitKey(r.Pool.QueryRow(ctx,
+		transitKeySelect+` WHERE k.owner_team_id = $1 AND k.name = $2`, teamID, name))
+	if err != nil {
+		return k, fmt.Errorf("failed to get transit key: %w", err)
+	}
+	return k, nil
+}
+
+func (r *transitKeyRepository) ListForUser(ctx context.Context, userID int64) ([]entity.TransitKey, error) {
+	query := transitKeySelect + `
+		WHERE k.owner_user_id = $1
+			OR k.owner_team_id IN (SELECT team_id FROM team_members WHERE user_id = $1)
+		ORDER BY t.name NULLS FIRST, k.name
+	`
+	rows, err := r.Pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transit keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []entity.TransitKey
+	for rows.Next() {
+		k, err := scanTransitKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transit key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list transit keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *transitKeyRepository) GetVersion(
+	ctx context.Context,
+	keyID int64,
+	version int,
+) (entity.TransitKeyVersion, error) {
+	v := entity.TransitKeyVersion{KeyID: keyID, Version: version}
+	err := r.Pool.QueryRow(ctx, `
+		SELECT material, hmac_key, public_key, created_at
+		FROM transit_key_versions
+		WHERE key_id = $1 AND version = $2
+	`, keyID, version).Scan(&v.Material, &v.HMACKey, &v.PublicKey, &v.CreatedAt)
+	if err != nil {
+		return v, fmt.Errorf("failed to get transit key version: %w", err)
+	}
+	return v, nil
+}
+
+func (r *transitKeyRepository) Rotate(ctx context.Context, keyID int64, version *entity.TransitKeyVersion) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	err = tx.QueryRow(ctx, `
+		UPDATE transit_keys SET latest_version = latest_version + 1, updated_at = NOW()
+		WHERE id = $1
+		RETURNING latest_version
+	`, keyID).Scan(&version.Version)
+	if err != nil {
+		return fmt.Errorf("failed to rotate transit key: %w", err)
+	}
+	version.KeyID = keyID
+	if err := insertTransitKeyVersion(ctx, tx, version); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
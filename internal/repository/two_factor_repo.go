@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TwoFactorRepository interface {
+	SaveTOTP(ctx context.Context, userID int64, secret []byte) (bool, error)
+	GetTOTP(ctx context.Context, tx pgx.Tx, userID int64) (entity.TOTPCredential, error)
+	ConfirmTOTP(ctx context.Context, tx pgx.Tx, userID, step int64) error
+	SetLastStep(ctx context.Context, tx pgx.Tx, userID, step int64) error
+	ReplaceRecoveryCodes(ctx context.Context, tx pgx.Tx, userID int64, hashes []string) error
+	UseRecoveryCode(ctx context.Context, tx pgx.Tx, userID int64, hash string) (bool, error)
+	CreateChallenge(ctx context.Context, tx pgx.Tx, challenge entity.LoginChallenge) error
+	FindChallengeForUpdate(ctx context.Context, tx pgx.Tx, hash string) (entity.LoginChallenge, error)
+	IncrementChallengeAttempts(ctx context.Context, tx pgx.Tx, id int64) error
+	DeleteChallenge(ctx context.Context, tx pgx.Tx, id int64) error
+}
+
+type twoFactorRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewTwoFactorRepository(db *pgxpool.Pool) TwoFactorRepository {
+	return &twoFactorRepository{Pool: db}
+}
+
+// SaveTOTP starts a new enrolment and reports whether it did. An unconfirmed
+// secret is replaced, a confirmed one is left alone so an attacker holding a
+// session can't swap it.
+func (r *twoFactorRepository) SaveTOTP(ctx context.Context, userID int64, secret []byte) (bool, error) {
+	query := `
+		INSERT INTO totp_credentials (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_step = 0, created_at = NOW()
+		WHERE totp_credentials.confirmed_at IS NULL
+	`
+	tag, err := r.Pool.Exec(ctx, query, userID, secret)
+	if err != nil {
+		return false, fmt.Errorf("failed to save TOTP secret: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetTOTP locks the credential so a code can't be accepted by two concurrent
+// logins.
+func (r *twoFactorRepository) GetTOTP(ctx context.Context, tx pgx.Tx, userID int64) (entity.TOTPCredential, error) {
+	var cred entity.TOTPCredential
+	query := `
+		SELECT user_id, secret, confirmed_at, last_step
+		FROM totp_credentials
+		WHERE user_id = $1
+		FOR UPDATE
+	`
+	err := tx.QueryRow(ctx, query, userID).Scan(&cred.UserID, &cred.Secret, &cred.ConfirmedAt, &cred.LastStep)
+	if err != nil {
+		return cred, fmt.Errorf("failed to get TOTP secret: %w", err)
+	}
+	return cred, nil
+}
+
+func (r *twoFactorRepository) ConfirmTOTP(ctx context.Context, tx pgx.Tx, userID, step int64) error {
+	query := `
+		UPDATE totp_credentials
+		SET confirmed_at = NOW(), last_step = $2
+		WHERE user_id = $1
+	`
+	if _, err := tx.Exec(ctx, query, userID, step); err != nil {
+		return fmt.Errorf("failed to confirm TOTP: %w", err)
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) SetLastStep(ctx context.Context, tx pgx.Tx, userID, step int64) error {
+	query := `UPDATE totp_credentials SET last_step = $2 WHERE user_id = $1`
+	if _, err := tx.Exec(ctx, query, userID, step); err != nil {
+		return fmt.Errorf("failed to update TOTP step: %w", err)
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) ReplaceRecoveryCodes(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID int64,
+	hashes []string,
+) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	query := `INSERT INTO recovery_codes (user_id, code_hash) VALUES ($1, $2)`
+	for _, hash := range hashes {
+		if _, err := tx.Exec(ctx, query, userID, hash); err != nil {
+			return fmt.Errorf("failed to save recovery code: %w", err)
+		}
+	}
+	return nil
+}
+
+// UseRecoveryCode marks an unused code as used and reports whether one matched.
+func (r *twoFactorRepository) UseRecoveryCode(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID int64,
+	hash string,
+) (bool, error) {
+	query := `
+		UPDATE recovery_codes
+		SET used_at = NOW()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`
+	tag, err := tx.Exec(ctx, query, userID, hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *twoFactorRepository) CreateChallenge(
+	ctx context.Context,
+	tx pgx.Tx,
+	challenge entity.LoginChallenge,
+) error {
+	query := `
+		INSERT INTO login_challenges (challenge_hash, user_id, device_name, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	_, err := tx.Exec(ctx, query, challenge.ChallengeHash, challenge.UserID, challenge.DeviceName, challenge.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to create login challenge: %w", err)
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) FindChallengeForUpdate(
+	ctx context.Context,
+	tx pgx.Tx,
+	hash string,
+) (entity.LoginChallenge, error) {
+	var c entity.LoginChallenge
+	query := `
+		SELECT id, challenge_hash, user_id, device_name, attempts, expires_at
+		FROM login_challenges
+		WHERE challenge_hash = $1
+		FOR UPDATE
+	`
+	err := tx.QueryRow(ctx, query, hash).
+		Scan(&c.ID, &c.ChallengeHash, &c.UserID, &c.DeviceName, &c.Attempts, &c.ExpiresAt)
+	if err != nil {
+		return c, fmt.Errorf("failed to get login challenge: %w", err)
+	}
+	return c, nil
+}
+
+func (r *twoFactorRepository) IncrementChallengeAttempts(ctx context.Context, tx pgx.Tx, id int64) error {
+	query := `UPDATE login_challenges SET attempts = attempts + 1 WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update login challenge: %w", err)
+	}
+	return nil
+}
+
+func (r *twoFactorRepository) DeleteChallenge(ctx context.Context, tx pgx.Tx, id int64) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM login_challenges WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete login challenge: %w", err)
+	}
+	return nil
+}
@@ -13,6 +13,10 @@ import (
 type UserRepositoryInterface interface {
 	GetByLogin(ctx context.Context, tx pgx.Tx, login string) (entity.User, error)
 	Register(ctx context.Context, tx pgx.Tx, user entity.User) (entity.User, error)
+	GetIDByLogin(ctx context.Context, login string) (int64, error)
+	GetLoginByID(ctx context.Context, id int64) (string, error)
+	GetByIDForUpdate(ctx context.Context, tx pgx.Tx, id int64) (entity.User, error)
+	UpdatePassword(ctx context.Context, tx pgx.Tx, id int64, passwordHash string) error
 }
 
 type userRepository struct {
@@ -25,12 +29,14 @@ func NewUserRepository(db *pgxpool.Pool) UserRepositoryInterface {
 	}
 }
 
+// GetByLogin only finds human users: service accounts have no password and
+// must not be able to log in with one.
 func (r *userRepository) GetByLogin(ctx context.Context, tx pgx.Tx, login string) (entity.User, error) {
 	var user entity.User
 	query := `
 		SELECT id, login, password
 		FROM users
-		WHERE login = $1
+		WHERE login = $1 AND kind = 'user'
 	`
 	err := tx.QueryRow(ctx, query, login).Scan(&user.ID, &user.Login, &user.Password)
 	if err != nil {
@@ -52,3 +58,54 @@ func (r *userRepository) Register(ctx context.Context, tx pgx.Tx, user entity.Us
 
 	return user, nil
 }
+
+func (r *userRepository) GetIDByLogin(ctx context.Context, login string) (int64, error) {
+	var id int64
+	query := `
+		SELECT id
+		FROM users
+		WHERE login = $1
+	`
+	err := r.Pool.QueryRow(ctx, query, login).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user id: %w", err)
+	}
+	return id, nil
+}
+
+func (r *userRepository) GetLoginByID(ctx context.Context, id int64) (string, error) {
+	var login string
+	query := `
+		SELECT login
+		FROM users
+		WHERE id = $1
+	`
+	err := r.Pool.QueryRow(ctx, query, id).Scan(&login)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user login: %w", err)
+	}
+	return login, nil
+}
+
+func (r *userRepository) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, id int64) (entity.User, error) {
+	var user entity.User
+	query := `
+		SELECT id, login, password
+		FROM users
+		WHERE id = $1
+		FOR UPDATE
+	`
+	err := tx.QueryRow(ctx, query, id).Scan(&user.ID, &user.Login, &user.Password)
+	if err != nil {
+		return user, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, tx pgx.Tx, id int64, passwordHash string) error {
+	query := `UPDATE users SET password = $2 WHERE id = $1`
+	if _, err := tx.Exec(ctx, query, id, passwordHash); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WrapRepository interface {
+	Create(ctx context.Context, secret *entity.WrappedSecret) error
+	// Take deletes and returns the unexpired secret with tokenHash, so it can
+	// be taken only once. It returns pgx.ErrNoRows when there is none.
+	Take(ctx context.Context, tokenHash string) (entity.WrappedSecret, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type wrapRepository struct {
+	Pool *pgxpool.Pool
+}
+
+func NewWrapRepository(db *pgxpool.Pool) WrapRepository {
+	return &wrapRepository{Pool: db}
+}
+
+func (r *wrapRepository) Create(ctx context.Context, secret *entity.WrappedSecret) error {
+	query := `
+		INSERT INTO wrapped_secrets (token_hash, created_by, path, description, file_name, payload, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+	err := r.Pool.QueryRow(ctx, query,
+		secret.TokenHash, secret.CreatedBy, secret.Path, secret.Description,
+		secret.FileName, secret.Payload, secret.ExpiresAt,
+	).Scan(&secret.ID, &secret.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to wrap secret: %w", err)
+	}
+	return nil
+}
+
+func (r *wrapRepository) Take(ctx context.Context, tokenHash string) (entity.WrappedSecret, error) {
+	var s entity.WrappedSecret
+	query := `
+		DELETE FROM wrapped_secrets
+		WHERE token_hash = $1 AND expires_at > NOW()
+		RETURNING id, token_hash, created_by, path, description, file_name, payload, expires_at, created_at
+	`
+	err := r.Pool.QueryRow(ctx, query, tokenHash).Scan(
+		&s.ID, &s.TokenHash, &s.CreatedBy, &s.Path, &s.Description,
+		&s.FileName, &s.Payload, &s.ExpiresAt, &s.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return s, pgx.ErrNoRows
+	}
+	if err != nil {
+		return s, fmt.Errorf("failed to unwrap secret: %w", err)
+	}
+	return s, nil
+}
+
+func (r *wrapRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM wrapped_secrets WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired wrapped secrets: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,58 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params keep the tests fast, production defaults come from config.
+var testArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHashPassword_PHCFormatRoundTrip(t *testing.T) {
+	hash, err := HashPassword("correct horse", testArgon2Params)
+	if err != nil {
+		t.Fatalf("hash failed: %v", err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("unexpected hash format: %s", hash)
+	}
+
+	match, rehash, err := VerifyPassword("correct horse", hash, testArgon2Params)
+	if err != nil || !match || rehash {
+		t.Errorf("match=%v rehash=%v err=%v, want match without rehash", match, rehash, err)
+	}
+	match, _, err = VerifyPassword("wrong", hash, testArgon2Params)
+	if err != nil || match {
+		t.Errorf("wrong password: match=%v err=%v", match, err)
+	}
+}
+
+func TestVerifyPassword_RehashWeakerHashes(t *testing.T) {
+	legacy, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt failed: %v", err)
+	}
+	match, rehash, err := VerifyPassword("secret", string(legacy), testArgon2Params)
+	if err != nil || !match || !rehash {
+		t.Errorf("bcrypt: match=%v rehash=%v err=%v, want match with rehash", match, rehash, err)
+	}
+
+	weak, err := HashPassword("secret", testArgon2Params)
+	if err != nil {
+		t.Fatalf("hash failed: %v", err)
+	}
+	stronger := testArgon2Params
+	stronger.Iterations = 2
+	match, rehash, err = VerifyPassword("secret", weak, stronger)
+	if err != nil || !match || !rehash {
+		t.Errorf("weaker argon2id: match=%v rehash=%v err=%v, want match with rehash", match, rehash, err)
+	}
+}
+
+func TestVerifyPassword_UnknownFormat(t *testing.T) {
+	if _, _, err := VerifyPassword("secret", "plaintext", testArgon2Params); err == nil {
+		t.Error("expected an error for an unknown hash format")
+	}
+}
@@ -0,0 +1,40 @@
+package security
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// GenerateEd25519Key returns a new key pair as its 32-byte seed and public
+// key.
+func GenerateEd25519Key() (seed, public []byte, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate ed25519 key: %w", err)
+	}
+	return priv.Seed(), pub, nil
+}
+
+func SignEd25519(seed, message []byte) ([]byte, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.New("invalid ed25519 seed length")
+	}
+	return ed25519.Sign(ed25519.NewKeyFromSeed(seed), message), nil
+}
+
+func VerifyEd25519(public, message, signature []byte) bool {
+	if len(public) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(public, message, signature)
+}
+
+func HMACSHA256(key, message []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,39 @@
+package security
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSignVerifyEd25519(t *testing.T) {
+	seed, public, err := GenerateEd25519Key()
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	message := []byte("release v1.2.3")
+	signature, err := SignEd25519(seed, message)
+	if err != nil {
+		t.Fatalf("signing failed: %v", err)
+	}
+
+	if !VerifyEd25519(public, message, signature) {
+		t.Error("valid signature was rejected")
+	}
+	if VerifyEd25519(public, []byte("release v1.2.4"), signature) {
+		t.Error("signature of another message was accepted")
+	}
+	if VerifyEd25519(public[:10], message, signature) {
+		t.Error("truncated public key was accepted")
+	}
+}
+
+func TestHMACSHA256(t *testing.T) {
+	a := HMACSHA256([]byte("key"), []byte("data"))
+	if !bytes.Equal(a, HMACSHA256([]byte("key"), []byte("data"))) {
+		t.Error("hmac is not deterministic")
+	}
+	if bytes.Equal(a, HMACSHA256([]byte("other"), []byte("data"))) {
+		t.Error("hmac does not depend on the key")
+	}
+}
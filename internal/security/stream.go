@@ -0,0 +1,203 @@
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Encrypted streams start with a magic, a format version and a random salt.
+// The salt and the caller's key derive a fresh AES-256-GCM key, so one key can
+// seal many streams. The plaintext is split into chunks sealed with a counter
+// nonce whose last byte marks the final chunk: reordered, dropped or trailing
+// chunks fail authentication, and so does a stream cut at a chunk boundary.
+const (
+	streamMagic     = "KPRSTRM"
+	streamVersion   = 1
+	streamSaltSize  = 32
+	streamChunkSize = 64 * 1024
+	streamKeyInfo   = "keeper stream v1"
+)
+
+var ErrStreamCorrupt = errors.New("encrypted stream is corrupt, truncated or the key is wrong")
+
+type streamWriter struct {
+	w       io.Writer
+	gcm     cipher.AEAD
+	buf     []byte
+	out     []byte
+	counter uint64
+	closed  bool
+}
+
+// NewStreamWriter encrypts everything written to it with key and writes the
+// result to w. Close must be called to seal the final chunk; it does not close w.
+func NewStreamWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	salt := make([]byte, streamSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	gcm, err := streamCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+	header := append([]byte(streamMagic), streamVersion)
+	if _, err := w.Write(append(header, salt...)); err != nil {
+		return nil, fmt.Errorf("failed to write stream header: %w", err)
+	}
+	return &streamWriter{
+		w:   w,
+		gcm: gcm,
+		buf: make([]byte, 0, streamChunkSize),
+		out: make([]byte, 0, streamChunkSize+gcm.Overhead()),
+	}, nil
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, errors.New("write to closed stream")
+	}
+	n := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data arrives, so the final
+		// chunk is never sealed as an ordinary one.
+		if len(s.buf) == streamChunkSize {
+			if err := s.seal(false); err != nil {
+				return n, err
+			}
+		}
+		k := copy(s.buf[len(s.buf):streamChunkSize], p)
+		s.buf = s.buf[:len(s.buf)+k]
+		p = p[k:]
+		n += k
+	}
+	return n, nil
+}
+
+func (s *streamWriter) Close() error {
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.seal(true)
+}
+
+func (s *streamWriter) seal(last bool) error {
+	s.out = s.gcm.Seal(s.out[:0], streamNonce(s.counter, last), s.buf, nil)
+	s.counter++
+	s.buf = s.buf[:0]
+	if _, err := s.w.Write(s.out); err != nil {
+		return fmt.Errorf("failed to write stream chunk: %w", err)
+	}
+	return nil
+}
+
+type streamReader struct {
+	r       *bufio.Reader
+	gcm     cipher.AEAD
+	in      []byte
+	plain   []byte
+	counter uint64
+	done    bool
+}
+
+// NewStreamReader decrypts a stream produced by NewStreamWriter. Reads return
+// ErrStreamCorrupt if any chunk was altered or the stream ends early, and
+// io.EOF only after the final chunk has been authenticated.
+func NewStreamReader(r io.Reader, key []byte) (io.Reader, error) {
+	header := make([]byte, len(streamMagic)+1+streamSaltSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read stream header: %w", err)
+	}
+	if !bytes.Equal(header[:len(streamMagic)], []byte(streamMagic)) {
+		return nil, errors.New("not an encrypted stream")
+	}
+	if header[len(streamMagic)] != streamVersion {
+		return nil, fmt.Errorf("unsupported stream version %d", header[len(streamMagic)])
+	}
+	gcm, err := streamCipher(key, header[len(streamMagic)+1:])
+	if err != nil {
+		return nil, err
+	}
+	return &streamReader{
+		r:   bufio.NewReaderSize(r, streamChunkSize+gcm.Overhead()+1),
+		gcm: gcm,
+		in:  make([]byte, streamChunkSize+gcm.Overhead()),
+	}, nil
+}
+
+func (s *streamReader) Read(p []byte) (int, error) {
+	for len(s.plain) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, s.plain)
+	s.plain = s.plain[n:]
+	return n, nil
+}
+
+func (s *streamReader) open() error {
+	n, err := io.ReadFull(s.r, s.in)
+	switch {
+	case errors.Is(err, io.EOF):
+		// Every stream ends with a sealed final chunk, even an empty one.
+		return ErrStreamCorrupt
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		s.done = true
+	case err != nil:
+		return fmt.Errorf("failed to read stream chunk: %w", err)
+	default:
+		if _, err := s.r.Peek(1); errors.Is(err, io.EOF) {
+			s.done = true
+		} else if err != nil {
+			return fmt.Errorf("failed to read stream chunk: %w", err)
+		}
+	}
+	plain, err := s.gcm.Open(s.in[:0], streamNonce(s.counter, s.done), s.in[:n], nil)
+	if err != nil {
+		return ErrStreamCorrupt
+	}
+	s.counter++
+	s.plain = plain
+	return nil
+}
+
+func streamCipher(key, salt []byte) (cipher.AEAD, error) {
+	if len(key) == 0 {
+		return nil, errors.New("empty stream key")
+	}
+	derived, err := hkdf.Key(sha256.New, key, salt, streamKeyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive stream key: %w", err)
+	}
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, fmt.Errorf(errorInitCipher, err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf(errorInitCipher, err)
+	}
+	return gcm, nil
+}
+
+func streamNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[3:11], counter)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
@@ -0,0 +1,97 @@
+package security
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func sealStream(t *testing.T, key, plaintext []byte) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	w, err := NewStreamWriter(&out, key)
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	// Uneven writes exercise chunk boundaries.
+	for len(plaintext) > 0 {
+		n := min(len(plaintext), 10007)
+		if _, err := w.Write(plaintext[:n]); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+		plaintext = plaintext[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	return out.Bytes()
+}
+
+func openStream(key, sealed []byte) ([]byte, error) {
+	r, err := NewStreamReader(bytes.NewReader(sealed), key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestStream_RoundTrip(t *testing.T) {
+	key := []byte("operator backup key")
+	for _, size := range []int{0, 1, streamChunkSize - 1, streamChunkSize, streamChunkSize + 1, 3*streamChunkSize + 17} {
+		plaintext := make([]byte, size)
+		_, _ = rand.Read(plaintext)
+
+		got, err := openStream(key, sealStream(t, key, plaintext))
+		if err != nil {
+			t.Fatalf("size %d: decryption failed: %v", size, err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Fatalf("size %d: decrypted data does not match", size)
+		}
+	}
+}
+
+func TestStream_Tampering(t *testing.T) {
+	key := []byte("operator backup key")
+	plaintext := make([]byte, 2*streamChunkSize+100)
+	_, _ = rand.Read(plaintext)
+	sealed := sealStream(t, key, plaintext)
+	header := len(streamMagic) + 1 + streamSaltSize
+	chunk := streamChunkSize + 16
+
+	flipped := bytes.Clone(sealed)
+	flipped[header+chunk+5] ^= 1
+
+	cases := map[string][]byte{
+		"flipped bit":       flipped,
+		"truncated inside":  sealed[:len(sealed)-10],
+		"truncated at edge": sealed[:header+2*chunk],
+		"no chunks":         sealed[:header],
+		"trailing data":     append(bytes.Clone(sealed), 0),
+		"reordered": append(append(append(bytes.Clone(sealed[:header]),
+			sealed[header+chunk:header+2*chunk]...), sealed[header:header+chunk]...), sealed[header+2*chunk:]...),
+	}
+	for name, data := range cases {
+		if _, err := openStream(key, data); !errors.Is(err, ErrStreamCorrupt) {
+			t.Errorf("%s: expected ErrStreamCorrupt, got %v", name, err)
+		}
+	}
+
+	if _, err := openStream([]byte("another key"), sealed); !errors.Is(err, ErrStreamCorrupt) {
+		t.Errorf("wrong key: expected ErrStreamCorrupt, got %v", err)
+	}
+	if _, err := openStream(key, []byte("plain text that is long enough to hold a header")); err == nil {
+		t.Error("expected an error for data without a stream header")
+	}
+}
+
+func TestStream_SaltPerStream(t *testing.T) {
+	key := []byte("operator backup key")
+	a := sealStream(t, key, []byte("same"))
+	b := sealStream(t, key, []byte("same"))
+	if bytes.Equal(a, b) {
+		t.Fatal("two streams sealed with the same key must differ")
+	}
+}
@@ -0,0 +1,69 @@
+package security
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 test key from RFC 6238 appendix B, base32 encoded.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	// The RFC lists 8-digit codes, a 6-digit code is their last six digits.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(rfcSecret, TOTPStep(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode(%d) failed: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTP_AcceptsAdjacentStepsOnly(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("failed to generate secret: %v", err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	current := TOTPStep(now)
+
+	for _, step := range []int64{current - 1, current, current + 1} {
+		code, _ := TOTPCode(secret, step)
+		matched, ok, err := ValidateTOTP(secret, code, now)
+		if err != nil || !ok || matched != step {
+			t.Errorf("code for step %d: matched=%d ok=%v err=%v", step, matched, ok, err)
+		}
+	}
+
+	stale, _ := TOTPCode(secret, current-2)
+	if _, ok, _ := ValidateTOTP(secret, stale, now); ok {
+		t.Error("code two steps old must be rejected")
+	}
+	if _, ok, _ := ValidateTOTP(secret, "12345", now); ok {
+		t.Error("short code must be rejected")
+	}
+}
+
+func TestTOTPURI(t *testing.T) {
+	uri := TOTPURI("Keeper", "alice", rfcSecret)
+	if !strings.HasPrefix(uri, "otpauth://totp/Keeper:alice?") {
+		t.Errorf("unexpected URI prefix: %s", uri)
+	}
+	for _, part := range []string{"secret=" + rfcSecret, "issuer=Keeper", "digits=6", "period=30"} {
+		if !strings.Contains(uri, part) {
+			t.Errorf("URI %s does not contain %s", uri, part)
+		}
+	}
+}
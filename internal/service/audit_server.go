@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeper/internal/audit"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/logger"
+	"keeper/internal/repository"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const auditVerifyBatch = 500
+
+var (
+	ErrAuditChainBroken = errors.New("audit chain broken")
+	ErrAuditUnavailable = errors.New("no audit sink accepted the record")
+)
+
+type AuditService interface {
+	Record(ctx context.Context, record dto.AuditRecord) error
+	Verify(ctx context.Context) (int64, error)
+	Recent(ctx context.Context, limit int) ([]entity.AuditEvent, error)
+	After(ctx context.Context, afterID int64, limit int) ([]entity.AuditEvent, error)
+}
+
+type auditService struct {
+	repo    repository.AuditRepository
+	l       *logger.ZapLogger
+	sinks   []audit.Sink
+	hmacKey []byte
+	// lastHash is the head of the chain as last seen by this process. It links
+	// records sealed locally while Postgres is unavailable.
+	lastHash string
+	mu       sync.Mutex
+}
+
+// NewAuditService records events in Postgres and copies them to sinks. The
+// logger may be nil when the service is only used to read the log.
+func NewAuditService(
+	repo repository.AuditRepository,
+	hmacKey string,
+	sinks []audit.Sink,
+	l *logger.ZapLogger,
+) AuditService {
+	return &auditService{
+		repo:    repo,
+		hmacKey: []byte(hmacKey),
+		sinks:   sinks,
+		l:       l,
+	}
+}
+
+// auditHash chains an event to its predecessor: SHA-256 over the JSON form of
+// the event, which includes PrevHash.
+func auditHash(event *entity.AuditEvent) string {
+	canonical := *event
+	canonical.CreatedAt = canonical.CreatedAt.UTC()
+	// Marshalling a struct of plain fields can't fail.
+	raw, _ := json.Marshal(canonical)
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *auditService) mac(value string) string {
+	if value == "" {
+		return ""
+	}
+	h := hmac.New(sha256.New, s.hmacKey)
+	h.Write([]byte(value))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (s *auditService) Record(ctx context.Context, record dto.AuditRecord) error {
+	event := &entity.AuditEvent{
+		// Postgres keeps microseconds, the hash must match what is read back.
+		CreatedAt:      time.Now().UTC().Truncate(time.Microsecond),
+		UserID:         record.UserID,
+		Method:         record.Method,
+		PathHMAC:       s.mac(record.Path),
+		Version:        record.Version,
+		Result:         record.Result,
+		ClientAddrHMAC: s.mac(record.ClientAddr),
+		RequestID:      record.RequestID,
+	}
+
+	var errs []error
+	accepted := 0
+
+	s.mu.Lock()
+	if err := s.repo.Append(ctx, event, auditHash); err != nil {
+		errs = append(errs, fmt.Errorf("postgres: %w", err))
+		event.PrevHash = s.lastHash
+		event.Hash = auditHash(event)
+	} else {
+		accepted++
+	}
+	s.lastHash = event.Hash
+	s.mu.Unlock()
+
+	for _, sink := range s.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+			continue
+		}
+		accepted++
+	}
+
+	if accepted == 0 {
+		if s.l != nil {
+			s.l.InfoCtx(ctx, "audit record was lost",
+				zap.String("request_id", event.RequestID), zap.Error(errors.Join(errs...)))
+		}
+		return fmt.Errorf("%w: %w", ErrAuditUnavailable, errors.Join(errs...))
+	}
+	if len(errs) > 0 && s.l != nil {
+		s.l.InfoCtx(ctx, "audit record was not written to every sink",
+			zap.String("request_id", event.RequestID), zap.Error(errors.Join(errs...)))
+	}
+	return nil
+}
+
+// Verify walks the whole log and returns the number of records checked. It
+// fails on the first record whose hash or link to the previous record differs.
+func (s *auditService) Verify(ctx context.Context) (int64, error) {
+	var (
+		checked  int64
+		lastID   int64
+		prevHash string
+	)
+	for {
+		events, err := s.repo.ListAfter(ctx, lastID, auditVerifyBatch)
+		if err != nil {
+			return checked, fmt.Errorf("failed to read audit log: %w", err)
+		}
+		for i := range events {
+			event := &events[i]
+			if event.PrevHash != prevHash {
+				return checked, fmt.Errorf("%w at record %d: previous record missing or altered", ErrAuditChainBroken, event.ID)
+			}
+			if auditHash(event) != event.Hash {
+				return checked, fmt.Errorf("%w at record %d: record altered", ErrAuditChainBroken, event.ID)
+			}
+			prevHash = event.Hash
+			lastID = event.ID
+			checked++
+		}
+		if len(events) < auditVerifyBatch {
+			return checked, nil
+		}
+	}
+}
+
+func (s *auditService) Recent(ctx context.Context, limit int) ([]entity.AuditEvent, error) {
+	events, err := s.repo.ListRecent(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return events, nil
+}
+
+func (s *auditService) After(ctx context.Context, afterID int64, limit int) ([]entity.AuditEvent, error) {
+	events, err := s.repo.ListAfter(ctx, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"keeper/internal/audit"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordChain appends n events through Record, sealing them like the
+// repository would, and returns the resulting log.
+func recordChain(t *testing.T, n int) []entity.AuditEvent {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockAuditRepository(ctrl)
+	svc := NewAuditService(repo, "key", nil, nil)
+
+	var chain []entity.AuditEvent
+	repo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, event *entity.AuditEvent, seal func(*entity.AuditEvent) string) error {
+			if len(chain) > 0 {
+				event.PrevHash = chain[len(chain)-1].Hash
+			}
+			event.Hash = seal(event)
+			event.ID = int64(len(chain) + 1)
+			chain = append(chain, *event)
+			return nil
+		}).Times(n)
+
+	for i := range n {
+		err := svc.Record(t.Context(), dto.AuditRecord{
+			UserID:     1,
+			Method:     "/keeper.go.grpc.v1.DataService/GetSecret",
+			Path:       "db/password",
+			Result:     "OK",
+			ClientAddr: "127.0.0.1:5000",
+			RequestID:  "req",
+			Version:    int64(i),
+		})
+		require.NoError(t, err)
+	}
+	return chain
+}
+
+func TestAuditService_RecordHMACsSensitiveFields(t *testing.T) {
+	chain := recordChain(t, 1)
+
+	assert.NotContains(t, chain[0].PathHMAC, "db/password")
+	assert.Len(t, chain[0].PathHMAC, 64)
+	assert.Len(t, chain[0].ClientAddrHMAC, 64)
+	assert.Empty(t, chain[0].PrevHash)
+}
+
+func TestAuditService_Verify(t *testing.T) {
+	tests := []struct {
+		tamper func([]entity.AuditEvent) []entity.AuditEvent
+		name   string
+		wantOK bool
+	}{
+		{
+			name:   "intact",
+			tamper: func(c []entity.AuditEvent) []entity.AuditEvent { return c },
+			wantOK: true,
+		},
+		{
+			name: "edited",
+			tamper: func(c []entity.AuditEvent) []entity.AuditEvent {
+				c[1].Result = "PermissionDenied"
+				return c
+			},
+		},
+		{
+			name: "deleted",
+			tamper: func(c []entity.AuditEvent) []entity.AuditEvent {
+				return append(c[:1], c[2:]...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := tt.tamper(recordChain(t, 3))
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockAuditRepository(ctrl)
+			svc := NewAuditService(repo, "key", nil, nil)
+			repo.EXPECT().ListAfter(gomock.Any(), int64(0), auditVerifyBatch).Return(chain, nil)
+
+			checked, err := svc.Verify(t.Context())
+			if tt.wantOK {
+				require.NoError(t, err)
+				assert.Equal(t, int64(len(chain)), checked)
+				return
+			}
+			require.ErrorIs(t, err, ErrAuditChainBroken)
+		})
+	}
+}
+
+type stubSink struct {
+	err    error
+	events []entity.AuditEvent
+}
+
+func (s *stubSink) Name() string { return "stub" }
+
+func (s *stubSink) Write(_ context.Context, event *entity.AuditEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, *event)
+	return nil
+}
+
+func (s *stubSink) Close() error { return nil }
+
+func TestAuditService_RecordFallsBackToSinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAuditRepository(ctrl)
+	sink := &stubSink{}
+	svc := NewAuditService(repo, "key", []audit.Sink{sink}, nil)
+
+	repo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db down")).Times(2)
+
+	require.NoError(t, svc.Record(t.Context(), dto.AuditRecord{Method: "m", RequestID: "1"}))
+	require.NoError(t, svc.Record(t.Context(), dto.AuditRecord{Method: "m", RequestID: "2"}))
+
+	require.Len(t, sink.events, 2)
+	assert.NotEmpty(t, sink.events[0].Hash)
+	assert.Equal(t, sink.events[0].Hash, sink.events[1].PrevHash)
+}
+
+func TestAuditService_RecordFailsClosed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAuditRepository(ctrl)
+	sink := &stubSink{err: errors.New("unreachable")}
+	svc := NewAuditService(repo, "key", []audit.Sink{sink}, nil)
+
+	repo.EXPECT().Append(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+	err := svc.Record(t.Context(), dto.AuditRecord{Method: "m"})
+	require.ErrorIs(t, err, ErrAuditUnavailable)
+}
@@ -3,14 +3,23 @@ package service
 import (
 	"context"
 	"fmt"
+	"keeper/internal/client"
 	"keeper/internal/dto"
 	pb "keeper/internal/proto/v1"
 	pbModel "keeper/internal/proto/v1/model"
 )
 
 type RemoteAuthService interface {
-	Register(ctx context.Context, req dto.RegisterUser) (string, error)
-	Login(ctx context.Context, req dto.LoginUser) (string, error)
+	Register(ctx context.Context, req dto.RegisterUser) (dto.AgentTokens, error)
+	Login(ctx context.Context, req dto.LoginUser) (dto.AgentTokens, error)
+	LoginWithCertificate(ctx context.Context, deviceName string) (dto.AgentTokens, error)
+	Logout(ctx context.Context, token string) error
+	ListSessions(ctx context.Context, token string) ([]dto.AgentSession, error)
+	RevokeSession(ctx context.Context, token string, sessionID int64) error
+	VerifyTwoFactor(ctx context.Context, challenge, code string) (dto.AgentTokens, error)
+	EnrollTOTP(ctx context.Context, token string) (dto.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, token, code string) ([]string, error)
+	ChangePassword(ctx context.Context, token, oldPassword, newPassword string) (int64, error)
 }
 
 type remoteAuthService struct {
@@ -21,34 +30,138 @@ func NewRemoteAuthService(client pb.AuthServiceClient) RemoteAuthService {
 	return &remoteAuthService{client: client}
 }
 
-func (s *remoteAuthService) Register(ctx context.Context, req dto.RegisterUser) (string, error) {
+func (s *remoteAuthService) Register(ctx context.Context, req dto.RegisterUser) (dto.AgentTokens, error) {
 	requestDto := &pbModel.RegisterRequest{}
 	requestDto.SetLogin(req.Login)
 	requestDto.SetPassword(req.Password)
+	requestDto.SetDeviceName(req.DeviceName)
 	resp, err := s.client.Register(ctx, requestDto)
 	if err != nil {
-		return "", fmt.Errorf("register error: %w", err)
+		return dto.AgentTokens{}, fmt.Errorf("register error: %w", err)
 	}
 
 	if !resp.GetSuccess() {
-		return "", fmt.Errorf("register failed: %s", resp.GetMessage())
+		return dto.AgentTokens{}, fmt.Errorf("register failed: %s", resp.GetMessage())
 	}
 
-	return resp.GetToken(), nil
+	return dto.AgentTokens{Token: resp.GetToken(), RefreshToken: resp.GetRefreshToken()}, nil
 }
 
-func (s *remoteAuthService) Login(ctx context.Context, req dto.LoginUser) (string, error) {
+func (s *remoteAuthService) Login(ctx context.Context, req dto.LoginUser) (dto.AgentTokens, error) {
 	requestDto := &pbModel.LoginRequest{}
 	requestDto.SetLogin(req.Login)
 	requestDto.SetPassword(req.Password)
+	requestDto.SetDeviceName(req.DeviceName)
 	resp, err := s.client.Login(ctx, requestDto)
 	if err != nil {
-		return "", fmt.Errorf("login error: %w", err)
+		return dto.AgentTokens{}, fmt.Errorf("login error: %w", err)
+	}
+
+	if resp.GetTwoFactorRequired() {
+		return dto.AgentTokens{TwoFactorChallenge: resp.GetChallenge()}, nil
 	}
 
 	if !resp.GetSuccess() {
-		return "", fmt.Errorf("login failed: %s", resp.GetMessage())
+		return dto.AgentTokens{}, fmt.Errorf("login failed: %s", resp.GetMessage())
+	}
+
+	return dto.AgentTokens{Token: resp.GetToken(), RefreshToken: resp.GetRefreshToken()}, nil
+}
+
+func (s *remoteAuthService) LoginWithCertificate(ctx context.Context, deviceName string) (dto.AgentTokens, error) {
+	req := &pbModel.CertificateLoginRequest{}
+	req.SetDeviceName(deviceName)
+	resp, err := s.client.LoginWithCertificate(ctx, req)
+	if err != nil {
+		return dto.AgentTokens{}, fmt.Errorf("certificate login error: %w", err)
+	}
+
+	return dto.AgentTokens{Token: resp.GetToken(), RefreshToken: resp.GetRefreshToken()}, nil
+}
+
+func (s *remoteAuthService) Logout(ctx context.Context, token string) error {
+	req := &pbModel.LogoutRequest{}
+	if _, err := s.client.Logout(ctx, req, client.WithToken(token)); err != nil {
+		return fmt.Errorf("logout error: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteAuthService) ListSessions(ctx context.Context, token string) ([]dto.AgentSession, error) {
+	req := &pbModel.ListSessionsRequest{}
+	resp, err := s.client.ListSessions(ctx, req, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("list sessions error: %w", err)
+	}
+
+	sessions := make([]dto.AgentSession, 0, len(resp.GetSessions()))
+	for _, item := range resp.GetSessions() {
+		session := dto.AgentSession{
+			ID:         item.GetId(),
+			DeviceName: item.GetDeviceName(),
+			LastIP:     item.GetLastIp(),
+			CreatedAt:  item.GetCreatedAt().AsTime(),
+			ExpiresAt:  item.GetExpiresAt().AsTime(),
+			Current:    item.GetCurrent(),
+		}
+		if item.HasLastUsedAt() {
+			session.LastUsedAt = item.GetLastUsedAt().AsTime()
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (s *remoteAuthService) RevokeSession(ctx context.Context, token string, sessionID int64) error {
+	req := &pbModel.RevokeSessionRequest{}
+	req.SetSessionId(sessionID)
+	if _, err := s.client.RevokeSession(ctx, req, client.WithToken(token)); err != nil {
+		return fmt.Errorf("revoke session error: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteAuthService) VerifyTwoFactor(ctx context.Context, challenge, code string) (dto.AgentTokens, error) {
+	req := &pbModel.VerifyTwoFactorRequest{}
+	req.SetChallenge(challenge)
+	req.SetCode(code)
+	resp, err := s.client.VerifyTwoFactor(ctx, req)
+	if err != nil {
+		return dto.AgentTokens{}, fmt.Errorf("two-factor verification error: %w", err)
+	}
+
+	return dto.AgentTokens{Token: resp.GetToken(), RefreshToken: resp.GetRefreshToken()}, nil
+}
+
+func (s *remoteAuthService) EnrollTOTP(ctx context.Context, token string) (dto.TOTPEnrollment, error) {
+	req := &pbModel.EnrollTOTPRequest{}
+	resp, err := s.client.EnrollTOTP(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.TOTPEnrollment{}, fmt.Errorf("enroll TOTP error: %w", err)
+	}
+
+	return dto.TOTPEnrollment{Secret: resp.GetSecret(), URI: resp.GetUri()}, nil
+}
+
+func (s *remoteAuthService) ConfirmTOTP(ctx context.Context, token, code string) ([]string, error) {
+	req := &pbModel.ConfirmTOTPRequest{}
+	req.SetCode(code)
+	resp, err := s.client.ConfirmTOTP(ctx, req, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("confirm TOTP error: %w", err)
+	}
+
+	return resp.GetRecoveryCodes(), nil
+}
+
+func (s *remoteAuthService) ChangePassword(ctx context.Context, token, oldPassword, newPassword string) (int64, error) {
+	req := &pbModel.ChangePasswordRequest{}
+	req.SetOldPassword(oldPassword)
+	req.SetNewPassword(newPassword)
+	resp, err := s.client.ChangePassword(ctx, req, client.WithToken(token))
+	if err != nil {
+		return 0, fmt.Errorf("change password error: %w", err)
 	}
 
-	return resp.GetToken(), nil
+	return resp.GetRevokedSessions(), nil
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"keeper/internal/client"
 	"keeper/internal/dto"
 	"keeper/internal/proto/v1/mock"
 	pbModel "keeper/internal/proto/v1/model"
@@ -25,6 +26,7 @@ func TestRemoteAuthService_Register_Login(t *testing.T) {
 		resp := &pbModel.RegisterResponse{}
 		resp.SetMessage("")
 		resp.SetToken("token123")
+		resp.SetRefreshToken("refresh123")
 		resp.SetSuccess(true)
 		mockClient.EXPECT().
 			Register(ctx, gomock.Any()).
@@ -32,7 +34,8 @@ func TestRemoteAuthService_Register_Login(t *testing.T) {
 
 		token, err := svc.Register(ctx, req)
 		assert.NoError(t, err)
-		assert.Equal(t, "token123", token)
+		assert.Equal(t, "token123", token.Token)
+		assert.Equal(t, "refresh123", token.RefreshToken)
 	})
 
 	t.Run("rpc error", func(t *testing.T) {
@@ -68,6 +71,7 @@ func TestRemoteAuthService_Register_Login(t *testing.T) {
 		resp := &pbModel.LoginResponse{}
 		resp.SetMessage("")
 		resp.SetToken("token456")
+		resp.SetRefreshToken("refresh456")
 		resp.SetSuccess(true)
 		mockClient.EXPECT().
 			Login(ctx, gomock.Any()).
@@ -75,7 +79,8 @@ func TestRemoteAuthService_Register_Login(t *testing.T) {
 
 		token, err := svc.Login(ctx, reqLogin)
 		assert.NoError(t, err)
-		assert.Equal(t, "token456", token)
+		assert.Equal(t, "token456", token.Token)
+		assert.Equal(t, "refresh456", token.RefreshToken)
 	})
 
 	t.Run("rpc error", func(t *testing.T) {
@@ -103,4 +108,44 @@ func TestRemoteAuthService_Register_Login(t *testing.T) {
 		assert.Contains(t, err.Error(), "login failed")
 		assert.Empty(t, token)
 	})
+
+	t.Run("two-factor required", func(t *testing.T) {
+		resp := &pbModel.LoginResponse{}
+		resp.SetTwoFactorRequired(true)
+		resp.SetChallenge("challenge-1")
+		mockClient.EXPECT().
+			Login(ctx, gomock.Any()).
+			Return(resp, nil)
+
+		token, err := svc.Login(ctx, reqLogin)
+		assert.NoError(t, err)
+		assert.Equal(t, "challenge-1", token.TwoFactorChallenge)
+		assert.Empty(t, token.Token)
+	})
+}
+
+func TestRemoteAuthService_ListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockAuthServiceClient(ctrl)
+	svc := NewRemoteAuthService(mockClient)
+	ctx := t.Context()
+
+	session := &pbModel.Session{}
+	session.SetId(3)
+	session.SetDeviceName("laptop")
+	session.SetLastIp("10.0.0.1")
+	session.SetCurrent(true)
+	resp := &pbModel.ListSessionsResponse{}
+	resp.SetSessions([]*pbModel.Session{session})
+	mockClient.EXPECT().ListSessions(ctx, gomock.Any(), client.WithToken("token")).Return(resp, nil)
+
+	sessions, err := svc.ListSessions(ctx, "token")
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, int64(3), sessions[0].ID)
+	assert.Equal(t, "laptop", sessions[0].DeviceName)
+	assert.True(t, sessions[0].Current)
+	assert.True(t, sessions[0].LastUsedAt.IsZero())
 }
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"keeper/internal/config"
@@ -9,47 +11,81 @@ import (
 	"keeper/internal/entity"
 	"keeper/internal/logger"
 	"keeper/internal/repository"
+	"keeper/internal/security"
+	"strings"
+	"sync"
 	"time"
 
 	pgx "github.com/jackc/pgx/v5"
 	pgxpool "github.com/jackc/pgx/v5/pgxpool"
-
-	"golang.org/x/crypto/bcrypt"
+	"go.uber.org/zap"
 )
 
 type AuthService interface {
 	Login(ctx context.Context, requestDto *dto.LoginUser) (entity.AccessToken, error)
 	Register(ctx context.Context, requestDto *dto.RegisterUser) (entity.AccessToken, error)
+	Refresh(ctx context.Context, refreshToken string) (entity.AccessToken, error)
+	VerifyTwoFactor(ctx context.Context, challenge, code, clientIP string) (entity.AccessToken, error)
+	ChangePassword(ctx context.Context, req dto.ChangePassword) (int64, error)
+	LoginWithCertificate(ctx context.Context, req dto.CertificateLogin) (entity.AccessToken, error)
 }
 
 type authService struct {
-	UserRepo   repository.UserRepositoryInterface
-	AccessRepo repository.AccessTokenRepository
-	JwtService JwtService
-	db         *pgxpool.Pool
-	l          *logger.ZapLogger
-	cfg        config.SecurityConfig
+	UserRepo    repository.UserRepositoryInterface
+	AccessRepo  repository.AccessTokenRepository
+	RefreshRepo repository.RefreshTokenRepository
+	CertRepo    repository.ClientCertificateRepository
+	Sessions    SessionService
+	JwtService  JwtService
+	TwoFactor   TwoFactorService
+	Throttle    ThrottleService
+	db          *pgxpool.Pool
+	l           *logger.ZapLogger
+	cfg         config.SecurityConfig
+	argon2      security.Argon2Params
+	dummyOnce   sync.Once
+	dummyHash   string
 }
 
 func NewAuthService(
 	db *pgxpool.Pool,
 	userRepo repository.UserRepositoryInterface,
 	accessRepo repository.AccessTokenRepository,
+	refreshRepo repository.RefreshTokenRepository,
+	certRepo repository.ClientCertificateRepository,
+	sessions SessionService,
 	jwtService JwtService,
+	twoFactor TwoFactorService,
+	throttle ThrottleService,
 	cfg config.SecurityConfig,
 	l *logger.ZapLogger,
 ) AuthService {
 	return &authService{
-		db:         db,
-		UserRepo:   userRepo,
-		AccessRepo: accessRepo,
-		JwtService: jwtService,
-		cfg:        cfg,
-		l:          l,
+		db:          db,
+		UserRepo:    userRepo,
+		AccessRepo:  accessRepo,
+		RefreshRepo: refreshRepo,
+		CertRepo:    certRepo,
+		Sessions:    sessions,
+		JwtService:  jwtService,
+		TwoFactor:   twoFactor,
+		Throttle:    throttle,
+		cfg:         cfg,
+		argon2:      security.Argon2Params(cfg.Argon2),
+		l:           l,
 	}
 }
 
-const errCreateToken = "failed to create token: %w"
+const (
+	errCreateToken    = "failed to create token: %w"
+	refreshTokenBytes = 32
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reused, session revoked")
+	ErrEmptyPassword       = errors.New("new password must not be empty")
+)
 
 func (a *authService) Login(ctx context.Context, requestDto *dto.LoginUser) (entity.AccessToken, error) {
 	tx, err := a.db.Begin(ctx)
@@ -60,43 +96,104 @@ func (a *authService) Login(ctx context.Context, requestDto *dto.LoginUser) (ent
 		err = tx.Rollback(ctx)
 	}(tx, ctx)
 
+	if err := a.Throttle.CheckLogin(ctx, requestDto.Login, requestDto.ClientIP); err != nil {
+		return entity.AccessToken{}, err
+	}
+
 	user, err := a.UserRepo.GetByLogin(ctx, tx, requestDto.Login)
-	if err != nil {
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return entity.AccessToken{}, fmt.Errorf("failed to GetByLogin: %w", err)
 	}
+	known := err == nil
+	passwordHash := user.Password
+	if !known {
+		// Compare against a dummy hash so an unknown login takes as long as a
+		// wrong password.
+		passwordHash = a.dummyPasswordHash()
+	}
+	match, rehash, err := security.VerifyPassword(requestDto.Password, passwordHash, a.argon2)
+	if err != nil && known {
+		return entity.AccessToken{}, fmt.Errorf("failed to check password: %w", err)
+	}
+	if !match || !known {
+		if err := a.Throttle.LoginFailed(ctx, requestDto.Login, requestDto.ClientIP); err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to login: %w", err)
+		}
+		return entity.AccessToken{}, ErrInvalidCredentials
+	}
+	if err := a.Throttle.LoginSucceeded(ctx, requestDto.Login); err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to login: %w", err)
+	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(requestDto.Password))
-	if err != nil {
-		return entity.AccessToken{}, fmt.Errorf("invalid credentials: %w", err)
+	// The plain password is only available now, so this is when bcrypt and
+	// outdated Argon2id hashes get upgraded.
+	if rehash {
+		if err := a.storePassword(ctx, tx, user.ID, requestDto.Password); err != nil {
+			return entity.AccessToken{}, err
+		}
 	}
 
-	token, err := a.AccessRepo.FindValidByUserID(ctx, tx, user.ID)
+	challenge, err := a.TwoFactor.Challenge(ctx, tx, user.ID, requestDto.DeviceName)
 	if err != nil {
-		if !errors.Is(err, pgx.ErrNoRows) {
-			return entity.AccessToken{}, fmt.Errorf("failed to check token: %w", err)
+		return entity.AccessToken{}, fmt.Errorf("failed to login: %w", err)
+	}
+	if challenge != "" {
+		if err := tx.Commit(ctx); err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
 		}
+		return entity.AccessToken{}, &TwoFactorRequiredError{Challenge: challenge}
+	}
 
-		tokenString, err := a.JwtService.CreateJwt(user.ID)
-		if err != nil {
-			return entity.AccessToken{}, fmt.Errorf(errCreateToken, err)
-		}
-		newToken := &entity.AccessToken{
-			UserID:    user.ID,
-			Token:     tokenString,
-			ExpiresAt: time.Now().Add(a.cfg.TokenTTL),
-		}
+	// Every login starts its own session so it can be listed and revoked
+	// independently of the user's other devices.
+	token, err := a.createSession(ctx, tx, user.ID, requestDto.DeviceName)
+	if err != nil {
+		return entity.AccessToken{}, err
+	}
 
-		if err := a.AccessRepo.Create(ctx, tx, newToken); err != nil {
-			return entity.AccessToken{}, fmt.Errorf(errCreateToken, err)
-		}
+	if err := tx.Commit(ctx); err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return token, nil
+}
+
+// LoginWithCertificate starts a session for the user bound to the client
+// certificate. The certificate was already verified against the client CA
+// during the handshake, so there is no password and no TOTP step: the key is
+// the credential, which is what machines and CI runners need.
+func (a *authService) LoginWithCertificate(ctx context.Context, req dto.CertificateLogin) (entity.AccessToken, error) {
+	if req.Subject == "" {
+		return entity.AccessToken{}, ErrCertificateNotBound
+	}
 
-		token = *newToken
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	userID, err := a.CertRepo.FindUserID(ctx, tx, req.Subject)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.AccessToken{}, ErrCertificateNotBound
+	}
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to login with certificate: %w", err)
+	}
+
+	token, err := a.createSession(ctx, tx, userID, req.DeviceName)
+	if err != nil {
+		return entity.AccessToken{}, err
 	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
 	}
 
+	a.l.InfoCtx(ctx, "certificate login",
+		zap.Int64("user_id", userID), zap.String("subject", req.Subject), zap.String("client_ip", req.ClientIP))
 	return token, nil
 }
 
@@ -109,31 +206,270 @@ func (a *authService) Register(ctx context.Context, requestDto *dto.RegisterUser
 		err = tx.Rollback(ctx)
 	}(tx, ctx)
 
-	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(requestDto.Password), bcrypt.DefaultCost)
-	user := entity.User{Login: requestDto.Login, Password: string(hashedPassword)}
+	if err := a.Throttle.AllowRegister(ctx, requestDto.ClientIP); err != nil {
+		return entity.AccessToken{}, err
+	}
+	if strings.HasPrefix(requestDto.Login, entity.ServiceAccountLoginPrefix) {
+		return entity.AccessToken{}, fmt.Errorf("%w: reserved login prefix", ErrRegistrationFailed)
+	}
+
+	hashedPassword, err := security.HashPassword(requestDto.Password, a.argon2)
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to hash password: %w", err)
+	}
+	user := entity.User{Login: requestDto.Login, Password: hashedPassword}
 
 	newUser, err := a.UserRepo.Register(ctx, tx, user)
 	if err != nil {
-		return entity.AccessToken{}, fmt.Errorf("failed to register user: %w", err)
+		// A taken login gets the same answer as any other failure.
+		return entity.AccessToken{}, fmt.Errorf("%w: %w", ErrRegistrationFailed, err)
+	}
+
+	token, err := a.createSession(ctx, tx, newUser.ID, requestDto.DeviceName)
+	if err != nil {
+		return entity.AccessToken{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return token, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return token, nil
+}
+
+// ChangePassword replaces the password after checking the current one and
+// revokes every other session of the user. It returns the number of sessions
+// revoked.
+func (a *authService) ChangePassword(ctx context.Context, req dto.ChangePassword) (int64, error) {
+	if req.NewPassword == "" {
+		return 0, ErrEmptyPassword
 	}
 
-	tokenString, err := a.JwtService.CreateJwt(newUser.ID)
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	user, err := a.UserRepo.GetByIDForUpdate(ctx, tx, req.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to change password: %w", err)
+	}
+	if err := a.Throttle.CheckLogin(ctx, user.Login, req.ClientIP); err != nil {
+		return 0, err
+	}
+
+	match, _, err := security.VerifyPassword(req.OldPassword, user.Password, a.argon2)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check password: %w", err)
+	}
+	if !match {
+		if err := a.Throttle.LoginFailed(ctx, user.Login, req.ClientIP); err != nil {
+			return 0, fmt.Errorf("failed to change password: %w", err)
+		}
+		return 0, ErrInvalidCredentials
+	}
+
+	if err := a.storePassword(ctx, tx, user.ID, req.NewPassword); err != nil {
+		return 0, err
+	}
+	revoked, err := a.AccessRepo.RevokeOthers(ctx, tx, user.ID, req.Token)
+	if err != nil {
+		return 0, fmt.Errorf("failed to change password: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit tx: %w", err)
+	}
+	a.Sessions.ForgetUser(user.ID, req.Token)
+	return revoked, nil
+}
+
+func (a *authService) storePassword(ctx context.Context, tx pgx.Tx, userID int64, password string) error {
+	hash, err := security.HashPassword(password, a.argon2)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	if err := a.UserRepo.UpdatePassword(ctx, tx, userID, hash); err != nil {
+		return fmt.Errorf("failed to store password: %w", err)
+	}
+	return nil
+}
+
+// dummyPasswordHash is compared against when the login doesn't exist. It uses
+// the configured cost so both paths take the same time.
+func (a *authService) dummyPasswordHash() string {
+	a.dummyOnce.Do(func() {
+		hash, err := security.HashPassword("keeper-dummy-password", a.argon2)
+		if err == nil {
+			a.dummyHash = hash
+		}
+	})
+	return a.dummyHash
+}
+
+func (a *authService) createSession(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID int64,
+	deviceName string,
+) (entity.AccessToken, error) {
+	tokenString, err := a.JwtService.CreateJwt(ctx, userID)
 	if err != nil {
 		return entity.AccessToken{}, fmt.Errorf(errCreateToken, err)
 	}
 
 	token := &entity.AccessToken{
-		UserID:    newUser.ID,
-		Token:     tokenString,
-		ExpiresAt: time.Now().Add(a.cfg.TokenTTL),
+		UserID:     userID,
+		Token:      tokenString,
+		DeviceName: deviceName,
+		ExpiresAt:  time.Now().Add(a.cfg.RefreshTokenTTL),
 	}
 	if err := a.AccessRepo.Create(ctx, tx, token); err != nil {
-		return *token, fmt.Errorf(errCreateToken, err)
+		return entity.AccessToken{}, fmt.Errorf(errCreateToken, err)
+	}
+
+	token.RefreshToken, err = a.issueRefreshToken(ctx, tx, token.ID, token.ExpiresAt)
+	if err != nil {
+		return entity.AccessToken{}, err
+	}
+	return *token, nil
+}
+
+// VerifyTwoFactor completes a login that Login answered with a
+// TwoFactorRequiredError and starts the session.
+func (a *authService) VerifyTwoFactor(ctx context.Context, challenge, code, clientIP string) (entity.AccessToken, error) {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	pending, err := a.TwoFactor.Verify(ctx, tx, challenge, code)
+	if errors.Is(err, ErrInvalidTwoFactorCode) {
+		// Keep the failed attempt so the challenge runs out, and count it
+		// against the account so new challenges don't give new guesses.
+		if err := tx.Commit(ctx); err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
+		}
+		login, err := a.UserRepo.GetLoginByID(ctx, pending.UserID)
+		if err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to verify two-factor code: %w", err)
+		}
+		if err := a.Throttle.LoginFailed(ctx, login, clientIP); err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to verify two-factor code: %w", err)
+		}
+		return entity.AccessToken{}, ErrInvalidTwoFactorCode
+	}
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+
+	token, err := a.createSession(ctx, tx, pending.UserID, pending.DeviceName)
+	if err != nil {
+		return entity.AccessToken{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return token, nil
+}
+
+// Refresh exchanges a single-use refresh token for a new access and refresh
+// token pair. Presenting an already used refresh token means it was copied, so
+// the whole session is revoked.
+func (a *authService) Refresh(ctx context.Context, refreshToken string) (entity.AccessToken, error) {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	stored, err := a.RefreshRepo.FindByHashForUpdate(ctx, tx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf("%w: %w", ErrInvalidRefreshToken, err)
+	}
+
+	if stored.UsedAt != nil {
+		if err := a.AccessRepo.RevokeTx(ctx, tx, stored.SessionID); err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to revoke session: %w", err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
+		}
+		a.Sessions.ForgetSession(stored.SessionID)
+		a.l.InfoCtx(ctx, "refresh token reuse detected, session revoked",
+			zap.Int64("user_id", stored.UserID), zap.Int64("session_id", stored.SessionID))
+		return entity.AccessToken{}, ErrRefreshTokenReused
+	}
+	if stored.SessionRevoked || time.Now().After(stored.ExpiresAt) {
+		return entity.AccessToken{}, ErrInvalidRefreshToken
+	}
+
+	if err := a.RefreshRepo.MarkUsed(ctx, tx, stored.ID); err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to mark refresh token used: %w", err)
+	}
+
+	tokenString, err := a.JwtService.CreateJwt(ctx, stored.UserID)
+	if err != nil {
+		return entity.AccessToken{}, fmt.Errorf(errCreateToken, err)
+	}
+	expiresAt := time.Now().Add(a.cfg.RefreshTokenTTL)
+	if err := a.AccessRepo.Rotate(ctx, tx, stored.SessionID, tokenString, expiresAt); err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to rotate session token: %w", err)
+	}
+	newRefresh, err := a.issueRefreshToken(ctx, tx, stored.SessionID, expiresAt)
+	if err != nil {
+		return entity.AccessToken{}, err
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return *token, fmt.Errorf("failed to commit tx: %w", err)
+		return entity.AccessToken{}, fmt.Errorf("failed to commit tx: %w", err)
 	}
+	// The old access token no longer matches the session.
+	a.Sessions.ForgetSession(stored.SessionID)
 
-	return *token, nil
+	return entity.AccessToken{
+		ID:           stored.SessionID,
+		UserID:       stored.UserID,
+		Token:        tokenString,
+		RefreshToken: newRefresh,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+func (a *authService) issueRefreshToken(
+	ctx context.Context,
+	tx pgx.Tx,
+	sessionID int64,
+	expiresAt time.Time,
+) (string, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	plain := base64.RawURLEncoding.EncodeToString(raw)
+
+	err := a.RefreshRepo.Create(ctx, tx, &entity.RefreshToken{
+		SessionID: sessionID,
+		TokenHash: hashRefreshToken(plain),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return plain, nil
+}
+
+// hashRefreshToken uses plain SHA-256: refresh tokens are 256 random bits, so
+// a slow password hash would add nothing.
+func hashRefreshToken(token string) string {
+	return sha256Hex(token)
 }
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrCertificateNotBound = errors.New("client certificate is not bound to a user")
+	ErrUserNotFound        = errors.New("user not found")
+)
+
+// ClientCertificateService manages which client certificate subjects may log
+// in as which user. Bindings are made by an admin on the server host.
+type ClientCertificateService interface {
+	Bind(ctx context.Context, subject, login string) error
+	Unbind(ctx context.Context, subject string) error
+	List(ctx context.Context) ([]entity.ClientCertificate, error)
+}
+
+type clientCertificateService struct {
+	repo repository.ClientCertificateRepository
+}
+
+func NewClientCertificateService(repo repository.ClientCertificateRepository) ClientCertificateService {
+	return &clientCertificateService{repo: repo}
+}
+
+func (s *clientCertificateService) Bind(ctx context.Context, subject, login string) error {
+	err := s.repo.Bind(ctx, subject, login)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %s", ErrUserNotFound, login)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to bind %s: %w", subject, err)
+	}
+	return nil
+}
+
+func (s *clientCertificateService) Unbind(ctx context.Context, subject string) error {
+	removed, err := s.repo.Unbind(ctx, subject)
+	if err != nil {
+		return fmt.Errorf("failed to unbind %s: %w", subject, err)
+	}
+	if !removed {
+		return ErrCertificateNotBound
+	}
+	return nil
+}
+
+func (s *clientCertificateService) List(ctx context.Context) ([]entity.ClientCertificate, error) {
+	certs, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list client certificates: %w", err)
+	}
+	return certs, nil
+}
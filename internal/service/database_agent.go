@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+	"time"
+)
+
+type RemoteDatabaseService interface {
+	GenerateCredentials(ctx context.Context, token, role string, ttl time.Duration) (dto.DatabaseCredentials, error)
+	ListLeases(ctx context.Context, token string) ([]dto.DatabaseLease, error)
+	RevokeLease(ctx context.Context, token, leaseID string) error
+}
+
+type remoteDatabaseService struct {
+	client pb.DatabaseServiceClient
+}
+
+func NewRemoteDatabaseService(client pb.DatabaseServiceClient) RemoteDatabaseService {
+	return &remoteDatabaseService{client: client}
+}
+
+func (s *remoteDatabaseService) GenerateCredentials(
+	ctx context.Context,
+	token, role string,
+	ttl time.Duration,
+) (dto.DatabaseCredentials, error) {
+	req := &pbModel.GenerateCredentialsRequest{}
+	req.SetRole(role)
+	req.SetTtlSeconds(int64(ttl.Seconds()))
+	resp, err := s.client.GenerateCredentials(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.DatabaseCredentials{}, fmt.Errorf("failed to generate credentials: %w", err)
+	}
+	return dto.DatabaseCredentials{
+		LeaseID:   resp.GetLeaseId(),
+		Role:      resp.GetRole(),
+		Username:  resp.GetUsername(),
+		Password:  resp.GetPassword(),
+		ExpiresAt: resp.GetExpiresAt().AsTime(),
+	}, nil
+}
+
+func (s *remoteDatabaseService) ListLeases(ctx context.Context, token string) ([]dto.DatabaseLease, error) {
+	resp, err := s.client.ListLeases(ctx, &pbModel.ListLeasesRequest{}, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leases: %w", err)
+	}
+	leases := make([]dto.DatabaseLease, 0, len(resp.GetLeases()))
+	for _, l := range resp.GetLeases() {
+		leases = append(leases, dto.DatabaseLease{
+			LeaseID:   l.GetLeaseId(),
+			Role:      l.GetRole(),
+			Username:  l.GetUsername(),
+			ExpiresAt: l.GetExpiresAt().AsTime(),
+			CreatedAt: l.GetCreatedAt().AsTime(),
+		})
+	}
+	return leases, nil
+}
+
+func (s *remoteDatabaseService) RevokeLease(ctx context.Context, token, leaseID string) error {
+	req := &pbModel.RevokeLeaseRequest{}
+	req.SetLeaseId(leaseID)
+	if _, err := s.client.RevokeLease(ctx, req, client.WithToken(token)); err != nil {
+		return fmt.Errorf("failed to revoke lease: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/logger"
+	"keeper/internal/repository"
+	"regexp"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// DatabaseCredsPathPrefix is the policy path of a role: users need read on
+// database/creds/<role> to generate credentials for it.
+const DatabaseCredsPathPrefix = "database/creds/"
+
+const (
+	DefaultDBRoleTTL = time.Hour
+	DefaultDBRoleMax = 24 * time.Hour
+	// DefaultDBCreationStatements creates a user that can log in and nothing
+	// more; roles normally append GRANT statements.
+	DefaultDBCreationStatements = `CREATE ROLE "{{name}}" WITH LOGIN PASSWORD '{{password}}' VALID UNTIL '{{expiration}}';`
+	// DefaultDBRevocationStatements drops the user together with whatever it
+	// owns or was granted in the connected database.
+	DefaultDBRevocationStatements = `REASSIGN OWNED BY "{{name}}" TO CURRENT_USER; ` +
+		`DROP OWNED BY "{{name}}"; DROP ROLE IF EXISTS "{{name}}";`
+
+	leaseReaperInterval = 30 * time.Second
+	leaseReaperBatch    = 100
+	dbPasswordBytes     = 24
+	dbUsernameRoleLen   = 20
+	// pgUndefinedObject is reported when the user to drop no longer exists.
+	pgUndefinedObject = "42704"
+)
+
+var (
+	ErrUnknownDBRole     = errors.New("unknown database role")
+	ErrLeaseNotFound     = errors.New("lease not found")
+	ErrDBRoleInUse       = errors.New("database role has active leases")
+	ErrDBConnectionInUse = errors.New("database connection is used by roles")
+)
+
+var (
+	dbNamePattern     = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	dbUsernameInvalid = regexp.MustCompile(`[^a-z0-9_]`)
+)
+
+// DatabaseCredentialsService is the dynamic PostgreSQL secrets engine. Admins
+// register target connections and role templates; users get a new database
+// user per request, tracked as a lease and dropped when it expires or is
+// revoked.
+type DatabaseCredentialsService interface {
+	WriteConnection(ctx context.Context, name, dsn string) error
+	ListConnections(ctx context.Context) ([]entity.DBConnection, error)
+	DeleteConnection(ctx context.Context, name string) error
+	WriteRole(ctx context.Context, role *entity.DBRole) error
+	ListRoles(ctx context.Context) ([]entity.DBRole, error)
+	DeleteRole(ctx context.Context, name string) error
+	GenerateCredentials(
+		ctx context.Context, userID int64, token, role string, ttl time.Duration,
+	) (dto.DatabaseCredentials, error)
+	// ListLeases returns the active leases of userID, or all of them when
+	// userID is zero.
+	ListLeases(ctx context.Context, userID int64) ([]entity.DBLease, error)
+	// RevokeLease drops the user of a lease owned by userID; zero skips the
+	// owner check.
+	RevokeLease(ctx context.Context, userID int64, leaseID string) error
+	RevokeExpired(ctx context.Context) (int, error)
+	// Run revokes expired leases periodically until ctx is done.
+	Run(ctx context.Context)
+}
+
+type databaseCredentialsService struct {
+	repo          repository.DatabaseEngineRepository
+	policyService PolicyService
+	cryptoService CryptoService
+	l             *logger.ZapLogger
+	now           func() time.Time
+	// exec runs statements in one transaction on the target server.
+	exec func(ctx context.Context, dsn, statements string) error
+}
+
+// NewDatabaseCredentialsService returns the engine. The logger may be nil
+// when the service is only used by admin commands.
+func NewDatabaseCredentialsService(
+	repo repository.DatabaseEngineRepository,
+	policyService PolicyService,
+	cryptoService CryptoService,
+	l *logger.ZapLogger,
+) DatabaseCredentialsService {
+	return &databaseCredentialsService{
+		repo:          repo,
+		policyService: policyService,
+		cryptoService: cryptoService,
+		l:             l,
+		now:           time.Now,
+		exec:          execOnTarget,
+	}
+}
+
+func execOnTarget(ctx context.Context, dsn, statements string) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to target database: %w", err)
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Without arguments pgx uses the simple protocol, which accepts several
+	// statements at once.
+	if _, err := tx.Exec(ctx, statements); err != nil {
+		return fmt.Errorf("failed to run statements: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+// renderStatements fills the templates. name and password only contain
+// characters that are safe inside quoted identifiers and literals.
+func renderStatements(statements, name, password string, expiresAt time.Time) string {
+	return strings.NewReplacer(
+		"{{name}}", name,
+		"{{password}}", password,
+		"{{expiration}}", expiresAt.UTC().Format("2006-01-02 15:04:05+00"),
+	).Replace(statements)
+}
+
+func (s *databaseCredentialsService) WriteConnection(ctx context.Context, name, dsn string) error {
+	if !dbNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid connection name %q", name)
+	}
+	if err := s.exec(ctx, dsn, "SELECT 1"); err != nil {
+		return fmt.Errorf("failed to verify connection: %w", err)
+	}
+	encrypted, err := s.cryptoService.Encode([]byte(dsn))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt dsn: %w", err)
+	}
+	if err := s.repo.SaveConnection(ctx, &entity.DBConnection{Name: name, DSN: encrypted}); err != nil {
+		return fmt.Errorf("failed to save connection: %w", err)
+	}
+	return nil
+}
+
+func (s *databaseCredentialsService) ListConnections(ctx context.Context) ([]entity.DBConnection, error) {
+	conns, err := s.repo.ListConnections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+	return conns, nil
+}
+
+func (s *databaseCredentialsService) DeleteConnection(ctx context.Context, name string) error {
+	conn, err := s.repo.GetConnection(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to find connection: %w", err)
+	}
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+	for _, role := range roles {
+		if role.ConnectionID == conn.ID {
+			return fmt.Errorf("%w: %s", ErrDBConnectionInUse, role.Name)
+		}
+	}
+	if err := s.repo.DeleteConnection(ctx, conn.ID); err != nil {
+		return fmt.Errorf("failed to delete connection: %w", err)
+	}
+	return nil
+}
+
+func (s *databaseCredentialsService) WriteRole(ctx context.Context, role *entity.DBRole) error {
+	if !dbNamePattern.MatchString(role.Name) {
+		return fmt.Errorf("invalid role name %q", role.Name)
+	}
+	if role.CreationStatements == "" {
+		role.CreationStatements = DefaultDBCreationStatements
+	}
+	if !strings.Contains(role.CreationStatements, "{{name}}") ||
+		!strings.Contains(role.CreationStatements, "{{password}}") {
+		return errors.New("creation statements must use {{name}} and {{password}}")
+	}
+	if role.RevocationStatements == "" {
+		role.RevocationStatements = DefaultDBRevocationStatements
+	}
+	if !strings.Contains(role.RevocationStatements, "{{name}}") {
+		return errors.New("revocation statements must use {{name}}")
+	}
+	if role.DefaultTTL == 0 {
+		role.DefaultTTL = DefaultDBRoleTTL
+	}
+	if role.MaxTTL == 0 {
+		role.MaxTTL = max(DefaultDBRoleMax, role.DefaultTTL)
+	}
+	if role.DefaultTTL < time.Second || role.DefaultTTL > role.MaxTTL {
+		return fmt.Errorf("default ttl must be between 1s and the max ttl %s", role.MaxTTL)
+	}
+
+	conn, err := s.repo.GetConnection(ctx, role.Connection)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("unknown database connection %q", role.Connection)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find connection: %w", err)
+	}
+	role.ConnectionID = conn.ID
+	if err := s.repo.SaveRole(ctx, role); err != nil {
+		return fmt.Errorf("failed to save role: %w", err)
+	}
+	return nil
+}
+
+func (s *databaseCredentialsService) ListRoles(ctx context.Context) ([]entity.DBRole, error) {
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (s *databaseCredentialsService) DeleteRole(ctx context.Context, name string) error {
+	role, err := s.repo.GetRole(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUnknownDBRole
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find role: %w", err)
+	}
+	active, err := s.repo.CountActiveLeases(ctx, role.ID)
+	if err != nil {
+		return fmt.Errorf("failed to count leases: %w", err)
+	}
+	if active > 0 {
+		return fmt.Errorf("%w: %d, revoke them first", ErrDBRoleInUse, active)
+	}
+	if err := s.repo.DeleteRole(ctx, role.ID); err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return nil
+}
+
+func (s *databaseCredentialsService) dsn(ctx context.Context, connectionID int64) (string, error) {
+	conn, err := s.repo.GetConnectionByID(ctx, connectionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find connection: %w", err)
+	}
+	dsn, err := s.cryptoService.Decode(conn.DSN)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt dsn: %w", err)
+	}
+	return string(dsn), nil
+}
+
+func randomToken(n int) (string, error) {
+	raw := make([]byte, n)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// dbUsername builds a unique PostgreSQL user name such as
+// k_readonly_7_3fa9c01b2d from the role and the requesting user.
+func dbUsername(role string, userID int64) (string, error) {
+	suffix := make([]byte, 5)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate user name: %w", err)
+	}
+	name := dbUsernameInvalid.ReplaceAllString(strings.ToLower(role), "_")
+	if len(name) > dbUsernameRoleLen {
+		name = name[:dbUsernameRoleLen]
+	}
+	return fmt.Sprintf("k_%s_%d_%s", name, userID, hex.EncodeToString(suffix)), nil
+}
+
+// GenerateCredentials creates a user from the role template. The lease is
+// stored before the user is created so a crash in between still gets it
+// cleaned up by the reaper.
+func (s *databaseCredentialsService) GenerateCredentials(
+	ctx context.Context,
+	userID int64,
+	token, roleName string,
+	ttl time.Duration,
+) (dto.DatabaseCredentials, error) {
+	role, err := s.repo.GetRole(ctx, roleName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dto.DatabaseCredentials{}, ErrUnknownDBRole
+	}
+	if err != nil {
+		return dto.DatabaseCredentials{}, fmt.Errorf("failed to find role: %w", err)
+	}
+	if err := s.policyService.Require(
+		ctx, userID, token, DatabaseCredsPathPrefix+role.Name, entity.CapabilityRead,
+	); err != nil {
+		return dto.DatabaseCredentials{}, fmt.Errorf("no access to role %s: %w", role.Name, err)
+	}
+
+	switch {
+	case ttl < 0:
+		return dto.DatabaseCredentials{}, errors.New("ttl can't be negative")
+	case ttl == 0:
+		ttl = role.DefaultTTL
+	case ttl > role.MaxTTL:
+		ttl = role.MaxTTL
+	}
+
+	dsn, err := s.dsn(ctx, role.ConnectionID)
+	if err != nil {
+		return dto.DatabaseCredentials{}, err
+	}
+	username, err := dbUsername(role.Name, userID)
+	if err != nil {
+		return dto.DatabaseCredentials{}, err
+	}
+	password, err := randomToken(dbPasswordBytes)
+	if err != nil {
+		return dto.DatabaseCredentials{}, err
+	}
+	leaseSuffix, err := randomToken(12)
+	if err != nil {
+		return dto.DatabaseCredentials{}, err
+	}
+
+	lease := entity.DBLease{
+		LeaseID:   DatabaseCredsPathPrefix + role.Name + "/" + leaseSuffix,
+		RoleID:    role.ID,
+		Role:      role.Name,
+		UserID:    userID,
+		Username:  username,
+		ExpiresAt: s.now().Add(ttl),
+	}
+	if err := s.repo.CreateLease(ctx, &lease); err != nil {
+		return dto.DatabaseCredentials{}, fmt.Errorf("failed to record lease: %w", err)
+	}
+
+	statements := renderStatements(role.CreationStatements, username, password, lease.ExpiresAt)
+	if err := s.exec(ctx, dsn, statements); err != nil {
+		// The transaction was rolled back, there is no user to drop.
+		_ = s.repo.MarkLeaseRevoked(ctx, lease.ID)
+		return dto.DatabaseCredentials{}, fmt.Errorf("failed to create database user: %w", err)
+	}
+
+	return dto.DatabaseCredentials{
+		LeaseID:   lease.LeaseID,
+		Role:      role.Name,
+		Username:  username,
+		Password:  password,
+		ExpiresAt: lease.ExpiresAt,
+	}, nil
+}
+
+func (s *databaseCredentialsService) ListLeases(ctx context.Context, userID int64) ([]entity.DBLease, error) {
+	leases, err := s.repo.ListLeases(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leases: %w", err)
+	}
+	return leases, nil
+}
+
+func (s *databaseCredentialsService) RevokeLease(ctx context.Context, userID int64, leaseID string) error {
+	lease, err := s.repo.GetLease(ctx, leaseID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && userID != 0 && lease.UserID != userID) {
+		return ErrLeaseNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find lease: %w", err)
+	}
+	if lease.RevokedAt != nil {
+		return nil
+	}
+	return s.revoke(ctx, lease)
+}
+
+func (s *databaseCredentialsService) revoke(ctx context.Context, lease entity.DBLease) error {
+	role, err := s.repo.GetRoleByID(ctx, lease.RoleID)
+	if err != nil {
+		return fmt.Errorf("failed to find role: %w", err)
+	}
+	dsn, err := s.dsn(ctx, role.ConnectionID)
+	if err != nil {
+		return err
+	}
+
+	statements := renderStatements(role.RevocationStatements, lease.Username, "", lease.ExpiresAt)
+	err = s.exec(ctx, dsn, statements)
+	var pgErr *pgconn.PgError
+	if err != nil && !(errors.As(err, &pgErr) && pgErr.Code == pgUndefinedObject) {
+		return fmt.Errorf("failed to drop database user %s: %w", lease.Username, err)
+	}
+	if err := s.repo.MarkLeaseRevoked(ctx, lease.ID); err != nil {
+		return fmt.Errorf("failed to mark lease revoked: %w", err)
+	}
+	return nil
+}
+
+// RevokeExpired drops the users of expired leases. A lease that fails is
+// kept and retried on the next call.
+func (s *databaseCredentialsService) RevokeExpired(ctx context.Context) (int, error) {
+	leases, err := s.repo.ListExpiredLeases(ctx, leaseReaperBatch)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired leases: %w", err)
+	}
+	revoked := 0
+	var errs []error
+	for _, lease := range leases {
+		if err := s.revoke(ctx, lease); err != nil {
+			errs = append(errs, fmt.Errorf("lease %s: %w", lease.LeaseID, err))
+			continue
+		}
+		revoked++
+	}
+	return revoked, errors.Join(errs...)
+}
+
+func (s *databaseCredentialsService) Run(ctx context.Context) {
+	ticker := time.NewTicker(leaseReaperInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RevokeExpired(ctx); err != nil && s.l != nil {
+				s.l.InfoCtx(ctx, "failed to revoke expired database leases", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"strings"
+)
+
+var ErrAccessDenied = errors.New("access denied")
+
+type GrantService interface {
+	Grant(ctx context.Context, ownerID int64, grantee, path, access string) (entity.SecretGrant, error)
+	Revoke(ctx context.Context, ownerID int64, grantee, path string) error
+	ListGrants(ctx context.Context, userID int64) (granted, received []entity.SecretGrant, err error)
+}
+
+type grantService struct {
+	grantRepo repository.GrantRepository
+	userRepo  repository.UserRepositoryInterface
+}
+
+func NewGrantService(grantRepo repository.GrantRepository, userRepo repository.UserRepositoryInterface) GrantService {
+	return &grantService{
+		grantRepo: grantRepo,
+		userRepo:  userRepo,
+	}
+}
+
+func (s *grantService) Grant(
+	ctx context.Context,
+	ownerID int64,
+	grantee, path, access string,
+) (entity.SecretGrant, error) {
+	if access != entity.AccessRead && access != entity.AccessWrite {
+		return entity.SecretGrant{}, fmt.Errorf("unknown access level %q", access)
+	}
+	if strings.TrimSpace(path) == "" {
+		return entity.SecretGrant{}, errors.New("path is required")
+	}
+	if strings.HasPrefix(path, TeamPathPrefix) {
+		return entity.SecretGrant{}, errors.New("team secrets are shared through team membership")
+	}
+
+	granteeID, err := s.userRepo.GetIDByLogin(ctx, grantee)
+	if err != nil {
+		return entity.SecretGrant{}, fmt.Errorf("failed to find grantee: %w", err)
+	}
+	if granteeID == ownerID {
+		return entity.SecretGrant{}, errors.New("cannot grant access to yourself")
+	}
+
+	grant := &entity.SecretGrant{
+		OwnerID:      ownerID,
+		GranteeID:    granteeID,
+		GranteeLogin: grantee,
+		Path:         path,
+		Access:       access,
+	}
+	if err := s.grantRepo.Save(ctx, grant); err != nil {
+		return entity.SecretGrant{}, fmt.Errorf("failed to save grant: %w", err)
+	}
+	return *grant, nil
+}
+
+func (s *grantService) Revoke(ctx context.Context, ownerID int64, grantee, path string) error {
+	granteeID, err := s.userRepo.GetIDByLogin(ctx, grantee)
+	if err != nil {
+		return fmt.Errorf("failed to find grantee: %w", err)
+	}
+	if err := s.grantRepo.Delete(ctx, ownerID, granteeID, path); err != nil {
+		return fmt.Errorf("failed to revoke grant: %w", err)
+	}
+	return nil
+}
+
+func (s *grantService) ListGrants(
+	ctx context.Context,
+	userID int64,
+) (granted, received []entity.SecretGrant, err error) {
+	granted, err = s.grantRepo.ListByOwner(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list granted access: %w", err)
+	}
+	received, err = s.grantRepo.ListByGrantee(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list received access: %w", err)
+	}
+	return granted, received, nil
+}
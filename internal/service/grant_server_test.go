@@ -0,0 +1,108 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantService_Grant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grantRepo := mocks.NewMockGrantRepository(ctrl)
+	userRepo := mocks.NewMockUserRepositoryInterface(ctrl)
+	svc := NewGrantService(grantRepo, userRepo)
+	ctx := t.Context()
+
+	t.Run("success", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "bob").Return(int64(2), nil)
+		grantRepo.EXPECT().
+			Save(ctx, gomock.Any()).
+			DoAndReturn(func(_ any, g *entity.SecretGrant) error {
+				require.Equal(t, int64(1), g.OwnerID)
+				require.Equal(t, int64(2), g.GranteeID)
+				require.Equal(t, "db/", g.Path)
+				return nil
+			})
+
+		grant, err := svc.Grant(ctx, 1, "bob", "db/", entity.AccessRead)
+		require.NoError(t, err)
+		require.Equal(t, "bob", grant.GranteeLogin)
+	})
+
+	t.Run("unknown access level", func(t *testing.T) {
+		_, err := svc.Grant(ctx, 1, "bob", "db/", "admin")
+		require.ErrorContains(t, err, "unknown access level")
+	})
+
+	t.Run("grant to yourself", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+
+		_, err := svc.Grant(ctx, 1, "alice", "db/", entity.AccessWrite)
+		require.ErrorContains(t, err, "yourself")
+	})
+}
+
+func TestVaultService_SharedAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockVaultRepositoryInterface(ctrl)
+	grantRepo := mocks.NewMockGrantRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	userRepo := mocks.NewMockUserRepositoryInterface(ctrl)
+	svc := NewVaultService(repo, grantRepo, teamRepo, userRepo, nil, nil)
+	ctx := t.Context()
+
+	t.Run("read-only grant cannot delete", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+		grantRepo.EXPECT().FindAccess(ctx, int64(1), int64(2), "db/password").Return(entity.AccessRead, nil)
+
+		err := svc.DeleteSecret(ctx, 2, "alice", "db/password")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("write grant deletes in owner vault", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+		grantRepo.EXPECT().FindAccess(ctx, int64(1), int64(2), "db/password").Return(entity.AccessWrite, nil)
+		repo.EXPECT().Delete(ctx, entity.SecretOwner{UserID: 1}, "db/password").Return(nil)
+
+		err := svc.DeleteSecret(ctx, 2, "alice", "db/password")
+		require.NoError(t, err)
+	})
+
+	t.Run("revoked grant denies read", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+		grantRepo.EXPECT().
+			FindAccess(ctx, int64(1), int64(2), "db/password").
+			Return("", fmt.Errorf("no grant found: %w", pgx.ErrNoRows))
+
+		_, err := svc.GetSecret(ctx, 2, "alice", "db/password")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("grant lookup failure is not a denial", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+		grantRepo.EXPECT().
+			FindAccess(ctx, int64(1), int64(2), "db/password").
+			Return("", errors.New("connection reset"))
+
+		_, err := svc.GetSecret(ctx, 2, "alice", "db/password")
+		require.ErrorContains(t, err, "connection reset")
+		require.NotErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("destroy is owner only", func(t *testing.T) {
+		userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+
+		err := svc.DestroySecret(ctx, 2, "alice", "db/password")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+}
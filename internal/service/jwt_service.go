@@ -1,38 +1,104 @@
 package service
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"keeper/internal/config"
 	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"sync"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v4"
 )
 
+var ErrUnknownSigningKey = errors.New("token signed by an unknown key")
+
+// JwtService issues and verifies access tokens signed with the keyring in
+// jwt_keys. The keyring is cached and reloaded every KeyringRefresh, or
+// sooner when a token names a key that isn't cached yet, so a rotation made
+// by another instance is picked up without a restart.
 type JwtService interface {
-	CreateJwt(userID int64) (string, error)
-	GetUserID(tokenString string) (int64, error)
+	CreateJwt(ctx context.Context, userID int64) (string, error)
+	GetUserID(ctx context.Context, tokenString string) (int64, error)
+	// JWKS returns the public keys that verify current tokens.
+	JWKS(ctx context.Context) (dto.JWKS, error)
+	// Rotate makes a new key with algorithm the signing key and drops keys
+	// that can no longer verify an unexpired token.
+	Rotate(ctx context.Context, algorithm string) (entity.JWTKey, error)
+}
+
+// minKeyringReload limits how often a token with an unknown kid can force a
+// reload.
+const minKeyringReload = 5 * time.Second
+
+// kidBytes is how much of the SHA-256 of the public key forms the kid.
+const kidBytes = 16
+
+type signingKey struct {
+	method jwt.SigningMethod
+	key    crypto.Signer
+	kid    string
 }
 
 type jwtService struct {
-	Cfg config.SecurityConfig
+	repo     repository.JWTKeyRepository
+	crypto   CryptoService
+	now      func() time.Time
+	signing  *signingKey
+	verify   map[string]entity.JWTKey
+	loadedAt time.Time
+	cfg      config.SecurityConfig
+	mu       sync.Mutex
 }
 
-func NewJwtService(cfg config.SecurityConfig) JwtService {
+func NewJwtService(cfg config.SecurityConfig, repo repository.JWTKeyRepository, crypto CryptoService) JwtService {
 	return &jwtService{
-		Cfg: cfg,
+		repo:   repo,
+		crypto: crypto,
+		now:    time.Now,
+		cfg:    cfg,
 	}
 }
 
-func (o *jwtService) CreateJwt(userID int64) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, dto.Claims{
+// jtiBytes is the size of the random token id that keeps tokens issued to the
+// same user within one second distinct.
+const jtiBytes = 16
+
+func (o *jwtService) CreateJwt(ctx context.Context, userID int64) (string, error) {
+	signer, err := o.signer(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	jti := make([]byte, jtiBytes)
+	if _, err := rand.Read(jti); err != nil {
+		return "", fmt.Errorf("failed to generate token id: %w", err)
+	}
+
+	now := o.now()
+	token := jwt.NewWithClaims(signer.method, dto.Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(o.Cfg.TokenTTL)),
+			ID:        hex.EncodeToString(jti),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(o.cfg.TokenTTL)),
 		},
 		UserID: userID,
 	})
+	token.Header["kid"] = signer.kid
 
-	tokenString, err := token.SignedString([]byte(o.Cfg.EncryptionKey))
+	tokenString, err := token.SignedString(signer.key)
 	if err != nil {
 		return "", fmt.Errorf("failed to SignedString: %w", err)
 	}
@@ -40,22 +106,222 @@ func (o *jwtService) CreateJwt(userID int64) (string, error) {
 	return tokenString, nil
 }
 
-func (o *jwtService) GetUserID(tokenString string) (int64, error) {
+func (o *jwtService) GetUserID(ctx context.Context, tokenString string) (int64, error) {
 	claims := &dto.Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims,
 		func(t *jwt.Token) (interface{}, error) {
-			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			kid, _ := t.Header["kid"].(string)
+			key, err := o.verificationKey(ctx, kid)
+			if err != nil {
+				return nil, err
+			}
+			if t.Method.Alg() != key.Algorithm {
 				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
 			}
-			return []byte(o.Cfg.EncryptionKey), nil
+			return x509.ParsePKIXPublicKey(key.PublicKey)
 		})
 	if err != nil {
 		return 0, fmt.Errorf("failed to ParseWithClaims: %w", err)
 	}
 
 	if !token.Valid {
-		return 0, fmt.Errorf("failed auth token not valid: %w", err)
+		return 0, errors.New("failed auth token not valid")
 	}
 
 	return claims.UserID, nil
 }
+
+func (o *jwtService) JWKS(ctx context.Context) (dto.JWKS, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.loadLocked(ctx, false); err != nil {
+		return dto.JWKS{}, err
+	}
+
+	set := dto.JWKS{Keys: make([]dto.JWK, 0, len(o.verify))}
+	for _, key := range o.verify {
+		jwk, err := publicJWK(key)
+		if err != nil {
+			return dto.JWKS{}, err
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
+
+func (o *jwtService) Rotate(ctx context.Context, algorithm string) (entity.JWTKey, error) {
+	key, err := o.generateKey(algorithm)
+	if err != nil {
+		return entity.JWTKey{}, err
+	}
+	if err := o.repo.Rotate(ctx, &key); err != nil {
+		return entity.JWTKey{}, fmt.Errorf("failed to rotate jwt key: %w", err)
+	}
+	if _, err := o.repo.Prune(ctx, o.now().Add(-o.cfg.TokenTTL)); err != nil {
+		return key, fmt.Errorf("failed to prune jwt keys: %w", err)
+	}
+
+	o.mu.Lock()
+	o.loadedAt = time.Time{}
+	o.mu.Unlock()
+	return key, nil
+}
+
+func (o *jwtService) signer(ctx context.Context) (*signingKey, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.loadLocked(ctx, false); err != nil {
+		return nil, err
+	}
+	return o.signing, nil
+}
+
+func (o *jwtService) verificationKey(ctx context.Context, kid string) (entity.JWTKey, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := o.loadLocked(ctx, false); err != nil {
+		return entity.JWTKey{}, err
+	}
+	if key, ok := o.verify[kid]; ok {
+		return key, nil
+	}
+	if o.now().Sub(o.loadedAt) >= minKeyringReload {
+		if err := o.loadLocked(ctx, true); err != nil {
+			return entity.JWTKey{}, err
+		}
+		if key, ok := o.verify[kid]; ok {
+			return key, nil
+		}
+	}
+	return entity.JWTKey{}, ErrUnknownSigningKey
+}
+
+// loadLocked reloads the keyring when it is stale or force is set. The first
+// load on an empty keyring creates a key with the configured algorithm.
+func (o *jwtService) loadLocked(ctx context.Context, force bool) error {
+	now := o.now()
+	if !force && o.signing != nil && now.Sub(o.loadedAt) < o.cfg.KeyringRefresh {
+		return nil
+	}
+
+	keys, err := o.repo.List(ctx, now.Add(-o.cfg.TokenTTL))
+	if err != nil {
+		if o.signing != nil && !force {
+			// Keep serving with the cached keyring while the database is away.
+			return nil
+		}
+		return fmt.Errorf("failed to load jwt keys: %w", err)
+	}
+	if len(keys) == 0 || keys[0].RetiredAt != nil {
+		key, err := o.generateKey(o.cfg.JWTAlgorithm)
+		if err != nil {
+			return err
+		}
+		if err := o.repo.Rotate(ctx, &key); err != nil {
+			return fmt.Errorf("failed to create jwt key: %w", err)
+		}
+		keys = append([]entity.JWTKey{key}, keys...)
+	}
+
+	signing, err := o.decodeSigningKey(keys[0])
+	if err != nil {
+		return err
+	}
+	verify := make(map[string]entity.JWTKey, len(keys))
+	for _, key := range keys {
+		verify[key.KID] = key
+	}
+
+	o.signing = signing
+	o.verify = verify
+	o.loadedAt = now
+	return nil
+}
+
+func (o *jwtService) generateKey(algorithm string) (entity.JWTKey, error) {
+	var private crypto.Signer
+	var err error
+	switch algorithm {
+	case entity.JWTAlgorithmEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	case entity.JWTAlgorithmES256:
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return entity.JWTKey{}, fmt.Errorf("unsupported jwt algorithm %q, expected %s or %s",
+			algorithm, entity.JWTAlgorithmEdDSA, entity.JWTAlgorithmES256)
+	}
+	if err != nil {
+		return entity.JWTKey{}, fmt.Errorf("failed to generate jwt key: %w", err)
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return entity.JWTKey{}, fmt.Errorf("failed to encode jwt key: %w", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(private.Public())
+	if err != nil {
+		return entity.JWTKey{}, fmt.Errorf("failed to encode jwt public key: %w", err)
+	}
+	encrypted, err := o.crypto.Encode(privateDER)
+	if err != nil {
+		return entity.JWTKey{}, fmt.Errorf("failed to encrypt jwt key: %w", err)
+	}
+
+	sum := sha256.Sum256(publicDER)
+	return entity.JWTKey{
+		KID:        hex.EncodeToString(sum[:kidBytes]),
+		Algorithm:  algorithm,
+		PrivateKey: encrypted,
+		PublicKey:  publicDER,
+	}, nil
+}
+
+func (o *jwtService) decodeSigningKey(key entity.JWTKey) (*signingKey, error) {
+	der, err := o.crypto.Decode(key.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt jwt key %s: %w", key.KID, err)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse jwt key %s: %w", key.KID, err)
+	}
+	signer, ok := parsed.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("jwt key %s is not a signing key", key.KID)
+	}
+	method := jwt.GetSigningMethod(key.Algorithm)
+	if method == nil {
+		return nil, fmt.Errorf("jwt key %s has unsupported algorithm %q", key.KID, key.Algorithm)
+	}
+	return &signingKey{method: method, key: signer, kid: key.KID}, nil
+}
+
+// publicJWK encodes key as RFC 7517 JSON Web Key.
+func publicJWK(key entity.JWTKey) (dto.JWK, error) {
+	public, err := x509.ParsePKIXPublicKey(key.PublicKey)
+	if err != nil {
+		return dto.JWK{}, fmt.Errorf("failed to parse jwt public key %s: %w", key.KID, err)
+	}
+	jwk := dto.JWK{KeyID: key.KID, Algorithm: key.Algorithm, Use: "sig"}
+	switch pub := public.(type) {
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	case *ecdsa.PublicKey:
+		ecdh, err := pub.ECDH()
+		if err != nil {
+			return dto.JWK{}, fmt.Errorf("failed to encode jwt public key %s: %w", key.KID, err)
+		}
+		// Uncompressed point: 0x04 || X || Y, 32 bytes each for P-256.
+		point := ecdh.Bytes()[1:]
+		size := len(point) / 2
+		jwk.KeyType = "EC"
+		jwk.Curve = "P-256"
+		jwk.X = base64.RawURLEncoding.EncodeToString(point[:size])
+		jwk.Y = base64.RawURLEncoding.EncodeToString(point[size:])
+	default:
+		return dto.JWK{}, fmt.Errorf("jwt key %s has unsupported type %T", key.KID, public)
+	}
+	return jwk, nil
+}
@@ -1,47 +1,83 @@
 package service
 
 import (
+	"context"
 	"keeper/internal/config"
 	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
 	"testing"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestJwtService(t *testing.T) {
-	cfg := &config.SecurityConfig{
-		EncryptionKey: "test_secret",
-		TokenTTL:      time.Hour,
-	}
+// newKeyringRepo backs the JWTKeyRepository mock with an in-memory table.
+func newKeyringRepo(ctrl *gomock.Controller) *mocks.MockJWTKeyRepository {
+	var keys []entity.JWTKey
+	repo := mocks.NewMockJWTKeyRepository(ctrl)
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ time.Time) ([]entity.JWTKey, error) {
+			result := make([]entity.JWTKey, len(keys))
+			for i := range keys {
+				result[len(keys)-1-i] = keys[i]
+			}
+			return result, nil
+		}).AnyTimes()
+	repo.EXPECT().Rotate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key *entity.JWTKey) error {
+			now := time.Now()
+			for i := range keys {
+				if keys[i].RetiredAt == nil {
+					keys[i].RetiredAt = &now
+				}
+			}
+			key.ID = int64(len(keys) + 1)
+			keys = append(keys, *key)
+			return nil
+		}).AnyTimes()
+	repo.EXPECT().Prune(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
+	return repo
+}
 
-	jwtService := NewJwtService(*cfg)
+func newTestJwtService(t *testing.T, algorithm string) JwtService {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	cfg := config.NewServerConfig().Security
+	cfg.JWTAlgorithm = algorithm
+	cfg.TokenTTL = time.Hour
+	crypto, err := NewCryptoService(cfg)
+	require.NoError(t, err)
+	return NewJwtService(cfg, newKeyringRepo(ctrl), crypto)
+}
+
+func TestJwtService(t *testing.T) {
+	ctx := t.Context()
+	jwtService := newTestJwtService(t, entity.JWTAlgorithmEdDSA)
 
-	t.Run("CreateJwt should return a valid token", func(t *testing.T) {
+	t.Run("CreateJwt should sign with the active key", func(t *testing.T) {
 		var userID int64 = 123
-		tokenString, err := jwtService.CreateJwt(userID)
+		tokenString, err := jwtService.CreateJwt(ctx, userID)
 		require.NoError(t, err)
 		require.NotEmpty(t, tokenString)
 
-		claims := &dto.Claims{}
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-			return []byte(cfg.EncryptionKey), nil
-		})
-
+		token, _, err := jwt.NewParser().ParseUnverified(tokenString, &dto.Claims{})
 		require.NoError(t, err)
-		assert.True(t, token.Valid)
-		assert.Equal(t, userID, claims.UserID)
+		assert.Equal(t, "EdDSA", token.Header["alg"])
+		assert.NotEmpty(t, token.Header["kid"])
+		assert.Equal(t, userID, token.Claims.(*dto.Claims).UserID)
 	})
 
 	t.Run("GetUserID should extract correct user ID from a valid token", func(t *testing.T) {
 		var userID int64 = 456
-		tokenString, err := jwtService.CreateJwt(userID)
+		tokenString, err := jwtService.CreateJwt(ctx, userID)
 		require.NoError(t, err)
 
-		extractedUserID, err := jwtService.GetUserID(tokenString)
+		extractedUserID, err := jwtService.GetUserID(ctx, tokenString)
 		require.NoError(t, err)
 		assert.Equal(t, userID, extractedUserID)
 	})
@@ -49,8 +85,60 @@ func TestJwtService(t *testing.T) {
 	t.Run("GetUserID should return error for invalid token", func(t *testing.T) {
 		invalidToken := "invalid.token.string"
 
-		userID, err := jwtService.GetUserID(invalidToken)
+		userID, err := jwtService.GetUserID(ctx, invalidToken)
 		assert.Error(t, err)
 		assert.Zero(t, userID)
 	})
+
+	t.Run("GetUserID should reject HS256 tokens", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, dto.Claims{UserID: 1})
+		tokenString, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = jwtService.GetUserID(ctx, tokenString)
+		assert.Error(t, err)
+	})
+}
+
+func TestJwtService_Rotate(t *testing.T) {
+	ctx := t.Context()
+	jwtService := newTestJwtService(t, entity.JWTAlgorithmEdDSA)
+
+	oldToken, err := jwtService.CreateJwt(ctx, 1)
+	require.NoError(t, err)
+
+	key, err := jwtService.Rotate(ctx, entity.JWTAlgorithmES256)
+	require.NoError(t, err)
+
+	newToken, err := jwtService.CreateJwt(ctx, 2)
+	require.NoError(t, err)
+	token, _, err := jwt.NewParser().ParseUnverified(newToken, &dto.Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, key.KID, token.Header["kid"])
+	assert.Equal(t, "ES256", token.Header["alg"])
+
+	userID, err := jwtService.GetUserID(ctx, oldToken)
+	require.NoError(t, err, "tokens signed by a retired key keep verifying")
+	assert.Equal(t, int64(1), userID)
+
+	userID, err = jwtService.GetUserID(ctx, newToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), userID)
+
+	set, err := jwtService.JWKS(ctx)
+	require.NoError(t, err)
+	require.Len(t, set.Keys, 2)
+	types := map[string]string{}
+	for _, k := range set.Keys {
+		types[k.Algorithm] = k.KeyType
+		assert.NotEmpty(t, k.X)
+	}
+	assert.Equal(t, map[string]string{"EdDSA": "OKP", "ES256": "EC"}, types)
+}
+
+func TestJwtService_UnsupportedAlgorithm(t *testing.T) {
+	jwtService := newTestJwtService(t, entity.JWTAlgorithmEdDSA)
+
+	_, err := jwtService.Rotate(t.Context(), "HS256")
+	assert.Error(t, err)
 }
@@ -36,6 +36,21 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthService) ChangePassword(ctx context.Context, req dto.ChangePassword) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, req)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceMockRecorder) ChangePassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthService)(nil).ChangePassword), ctx, req)
+}
+
 // Login mocks base method.
 func (m *MockAuthService) Login(ctx context.Context, requestDto *dto.LoginUser) (entity.AccessToken, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,36 @@ func (mr *MockAuthServiceMockRecorder) Login(ctx, requestDto interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, requestDto)
 }
 
+// LoginWithCertificate mocks base method.
+func (m *MockAuthService) LoginWithCertificate(ctx context.Context, req dto.CertificateLogin) (entity.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginWithCertificate", ctx, req)
+	ret0, _ := ret[0].(entity.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginWithCertificate indicates an expected call of LoginWithCertificate.
+func (mr *MockAuthServiceMockRecorder) LoginWithCertificate(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithCertificate", reflect.TypeOf((*MockAuthService)(nil).LoginWithCertificate), ctx, req)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthService) Refresh(ctx context.Context, refreshToken string) (entity.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(entity.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthServiceMockRecorder) Refresh(ctx, refreshToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthService)(nil).Refresh), ctx, refreshToken)
+}
+
 // Register mocks base method.
 func (m *MockAuthService) Register(ctx context.Context, requestDto *dto.RegisterUser) (entity.AccessToken, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +110,18 @@ func (mr *MockAuthServiceMockRecorder) Register(ctx, requestDto interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthService)(nil).Register), ctx, requestDto)
 }
+
+// VerifyTwoFactor mocks base method.
+func (m *MockAuthService) VerifyTwoFactor(ctx context.Context, challenge, code, clientIP string) (entity.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTwoFactor", ctx, challenge, code, clientIP)
+	ret0, _ := ret[0].(entity.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactor indicates an expected call of VerifyTwoFactor.
+func (mr *MockAuthServiceMockRecorder) VerifyTwoFactor(ctx, challenge, code, clientIP interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactor", reflect.TypeOf((*MockAuthService)(nil).VerifyTwoFactor), ctx, challenge, code, clientIP)
+}
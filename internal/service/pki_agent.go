@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+)
+
+type RemotePKIService interface {
+	IssueCertificate(ctx context.Context, token string, req dto.IssueCertificate) (dto.IssuedCertificate, error)
+	RevokeCertificate(ctx context.Context, token, serial string) error
+}
+
+type remotePKIService struct {
+	client pb.PKIServiceClient
+}
+
+func NewRemotePKIService(client pb.PKIServiceClient) RemotePKIService {
+	return &remotePKIService{client: client}
+}
+
+func (s *remotePKIService) IssueCertificate(
+	ctx context.Context,
+	token string,
+	issue dto.IssueCertificate,
+) (dto.IssuedCertificate, error) {
+	req := &pbModel.IssueCertificateRequest{}
+	req.SetRole(issue.Role)
+	req.SetCommonName(issue.CommonName)
+	req.SetAltNames(issue.AltNames)
+	req.SetIpSans(issue.IPSANs)
+	req.SetKeyType(issue.KeyType)
+	req.SetTtlSeconds(int64(issue.TTL.Seconds()))
+	resp, err := s.client.IssueCertificate(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.IssuedCertificate{}, fmt.Errorf("failed to issue certificate: %w", err)
+	}
+	return dto.IssuedCertificate{
+		ExpiresAt:   resp.GetExpiresAt().AsTime(),
+		Serial:      resp.GetSerial(),
+		Certificate: resp.GetCertificate(),
+		PrivateKey:  resp.GetPrivateKey(),
+		IssuingCA:   resp.GetIssuingCa(),
+		Chain:       resp.GetChain(),
+	}, nil
+}
+
+func (s *remotePKIService) RevokeCertificate(ctx context.Context, token, serial string) error {
+	req := &pbModel.RevokeCertificateRequest{}
+	req.SetSerial(serial)
+	if _, err := s.client.RevokeCertificate(ctx, req, client.WithToken(token)); err != nil {
+		return fmt.Errorf("failed to revoke certificate: %w", err)
+	}
+	return nil
+}
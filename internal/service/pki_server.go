@@ -0,0 +1,653 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"math/big"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// PKIIssuePathPrefix is the policy path of a role: users need create on
+// pki/issue/<role> to get certificates from it.
+const PKIIssuePathPrefix = "pki/issue/"
+
+// PKICRLPath and PKICAPath are where the HTTP server publishes the CRL (DER)
+// and the CA chain (PEM).
+const (
+	PKICRLPath = "/pki/crl"
+	PKICAPath  = "/pki/ca.pem"
+)
+
+const (
+	DefaultPKIRoleTTL = 72 * time.Hour
+	DefaultPKIRoleMax = 30 * 24 * time.Hour
+	DefaultPKICATTL   = 10 * 365 * 24 * time.Hour
+
+	pkiSerialBits = 128
+	pkiRSABits    = 2048
+	// pkiBackdate covers clock skew between the server and relying parties.
+	pkiBackdate = 30 * time.Second
+	// pkiCRLLifetime is how long a fetched CRL stays valid. It is rebuilt on
+	// every request, so revocations show up immediately.
+	pkiCRLLifetime = 24 * time.Hour
+)
+
+var (
+	ErrPKINoCA             = errors.New("pki ca is not configured")
+	ErrPKICAExists         = errors.New("pki ca already exists")
+	ErrUnknownPKIRole      = errors.New("unknown pki role")
+	ErrCertificateNotFound = errors.New("certificate not found")
+	ErrPKINameNotAllowed   = errors.New("name is not allowed by the role")
+)
+
+var (
+	pkiRoleNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	pkiHostnamePattern = regexp.MustCompile(`^(\*\.)?([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	pkiKeyTypes        = []string{entity.PKIKeyEC, entity.PKIKeyRSA, entity.PKIKeyEd25519}
+)
+
+// PKIService is the X.509 engine. It keeps one CA, a generated root or an
+// imported intermediate, and issues leaf certificates through roles that limit
+// names, key types and lifetimes.
+type PKIService interface {
+	GenerateRoot(ctx context.Context, commonName, keyType string, ttl time.Duration) (string, error)
+	// ImportCA stores an intermediate signed elsewhere. chainPEM holds its
+	// issuers and may be empty.
+	ImportCA(ctx context.Context, certPEM, keyPEM, chainPEM string) error
+	// CAChain returns the CA certificate followed by its issuers.
+	CAChain(ctx context.Context) (string, error)
+	WriteRole(ctx context.Context, role *entity.PKIRole) error
+	ListRoles(ctx context.Context) ([]entity.PKIRole, error)
+	DeleteRole(ctx context.Context, name string) error
+	IssueCertificate(
+		ctx context.Context, userID int64, token string, req dto.IssueCertificate,
+	) (dto.IssuedCertificate, error)
+	// RevokeCertificate revokes a certificate issued to userID; zero skips the
+	// owner check.
+	RevokeCertificate(ctx context.Context, userID int64, serial string) error
+	// CRL returns a DER encoded revocation list signed by the CA.
+	CRL(ctx context.Context) ([]byte, error)
+}
+
+type pkiService struct {
+	repo          repository.PKIRepository
+	policyService PolicyService
+	cryptoService CryptoService
+	// crlURL is put into issued certificates when the public URL is known.
+	crlURL string
+	now    func() time.Time
+}
+
+func NewPKIService(
+	repo repository.PKIRepository,
+	policyService PolicyService,
+	cryptoService CryptoService,
+	publicURL string,
+) PKIService {
+	s := &pkiService{
+		repo:          repo,
+		policyService: policyService,
+		cryptoService: cryptoService,
+		now:           time.Now,
+	}
+	if publicURL != "" {
+		s.crlURL = strings.TrimSuffix(publicURL, "/") + PKICRLPath
+	}
+	return s
+}
+
+func generatePKIKey(keyType string) (crypto.Signer, error) {
+	switch keyType {
+	case entity.PKIKeyEC:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case entity.PKIKeyRSA:
+		return rsa.GenerateKey(rand.Reader, pkiRSABits)
+	case entity.PKIKeyEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf("unknown key type %q", keyType)
+	}
+}
+
+func encodePKIKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// parsePKIKey accepts PKCS#8 as well as the older PKCS#1 and SEC 1 blocks.
+func parsePKIKey(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM private key found")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key type")
+	}
+	return signer, nil
+}
+
+func parsePKICertificate(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no PEM certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	return cert, nil
+}
+
+func pkiSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), pkiSerialBits))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	return serial, nil
+}
+
+func (s *pkiService) ensureNoCA(ctx context.Context) error {
+	_, err := s.repo.GetCA(ctx)
+	if err == nil {
+		return ErrPKICAExists
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to check for a ca: %w", err)
+	}
+	return nil
+}
+
+func (s *pkiService) storeCA(ctx context.Context, certPEM []byte, key crypto.Signer, chainPEM string) error {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ca key: %w", err)
+	}
+	encrypted, err := s.cryptoService.Encode(der)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt ca key: %w", err)
+	}
+	ca := entity.PKICA{Certificate: string(certPEM), PrivateKey: encrypted, Chain: chainPEM}
+	if err := s.repo.CreateCA(ctx, &ca); err != nil {
+		return fmt.Errorf("failed to store ca: %w", err)
+	}
+	return nil
+}
+
+// GenerateRoot creates a self-signed root CA and returns its certificate.
+func (s *pkiService) GenerateRoot(ctx context.Context, commonName, keyType string, ttl time.Duration) (string, error) {
+	if commonName == "" {
+		return "", errors.New("common name is required")
+	}
+	if ttl == 0 {
+		ttl = DefaultPKICATTL
+	}
+	if ttl < 0 {
+		return "", errors.New("ttl can't be negative")
+	}
+	if err := s.ensureNoCA(ctx); err != nil {
+		return "", err
+	}
+
+	key, err := generatePKIKey(keyType)
+	if err != nil {
+		return "", err
+	}
+	serial, err := pkiSerial()
+	if err != nil {
+		return "", err
+	}
+	now := s.now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-pkiBackdate),
+		NotAfter:              now.Add(ttl),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create ca certificate: %w", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if err := s.storeCA(ctx, certPEM, key, ""); err != nil {
+		return "", err
+	}
+	return string(certPEM), nil
+}
+
+func (s *pkiService) ImportCA(ctx context.Context, certPEM, keyPEM, chainPEM string) error {
+	cert, err := parsePKICertificate([]byte(certPEM))
+	if err != nil {
+		return err
+	}
+	if !cert.IsCA || cert.KeyUsage&x509.KeyUsageCertSign == 0 || cert.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return errors.New("certificate must be a CA allowed to sign certificates and CRLs")
+	}
+	if s.now().After(cert.NotAfter) {
+		return errors.New("ca certificate has expired")
+	}
+	key, err := parsePKIKey([]byte(keyPEM))
+	if err != nil {
+		return err
+	}
+	pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(cert.PublicKey) {
+		return errors.New("private key doesn't match the certificate")
+	}
+	chain := pemBundle([]byte(chainPEM))
+	for i, der := range chain {
+		issuer, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("failed to parse chain certificate %d: %w", i+1, err)
+		}
+		if i == 0 {
+			if err := cert.CheckSignatureFrom(issuer); err != nil {
+				return fmt.Errorf("ca certificate isn't signed by the first chain certificate: %w", err)
+			}
+		}
+	}
+	if err := s.ensureNoCA(ctx); err != nil {
+		return err
+	}
+	block, _ := pem.Decode([]byte(certPEM))
+	return s.storeCA(ctx, pem.EncodeToMemory(block), key, strings.TrimSpace(chainPEM))
+}
+
+// loadCA returns the CA certificate and its decrypted key.
+func (s *pkiService) loadCA(ctx context.Context) (entity.PKICA, *x509.Certificate, crypto.Signer, error) {
+	ca, err := s.repo.GetCA(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ca, nil, nil, ErrPKINoCA
+	}
+	if err != nil {
+		return ca, nil, nil, fmt.Errorf("failed to load ca: %w", err)
+	}
+	cert, err := parsePKICertificate([]byte(ca.Certificate))
+	if err != nil {
+		return ca, nil, nil, err
+	}
+	der, err := s.cryptoService.Decode(ca.PrivateKey)
+	if err != nil {
+		return ca, nil, nil, fmt.Errorf("failed to decrypt ca key: %w", err)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return ca, nil, nil, fmt.Errorf("failed to parse ca key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return ca, nil, nil, errors.New("unsupported ca key type")
+	}
+	return ca, cert, signer, nil
+}
+
+func caChain(ca entity.PKICA) string {
+	chain := ca.Certificate
+	if ca.Chain != "" {
+		chain = strings.TrimRight(chain, "\n") + "\n" + ca.Chain + "\n"
+	}
+	return chain
+}
+
+func (s *pkiService) CAChain(ctx context.Context) (string, error) {
+	ca, err := s.repo.GetCA(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrPKINoCA
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to load ca: %w", err)
+	}
+	return caChain(ca), nil
+}
+
+func (s *pkiService) WriteRole(ctx context.Context, role *entity.PKIRole) error {
+	if !pkiRoleNamePattern.MatchString(role.Name) {
+		return fmt.Errorf("invalid role name %q", role.Name)
+	}
+	for i, domain := range role.AllowedDomains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if !pkiHostnamePattern.MatchString(domain) || strings.HasPrefix(domain, "*.") {
+			return fmt.Errorf("invalid allowed domain %q", domain)
+		}
+		role.AllowedDomains[i] = domain
+	}
+	if len(role.AllowedDomains) > 0 && !role.AllowBareDomains && !role.AllowSubdomains {
+		return errors.New("allowed domains need bare domains, subdomains or both to be allowed")
+	}
+	if len(role.AllowedDomains) == 0 && !role.AllowIPSANs {
+		return errors.New("role allows neither domains nor ip addresses")
+	}
+	if len(role.KeyTypes) == 0 {
+		role.KeyTypes = []string{entity.PKIKeyEC}
+	}
+	for _, keyType := range role.KeyTypes {
+		if !slices.Contains(pkiKeyTypes, keyType) {
+			return fmt.Errorf("unknown key type %q, expected one of %s", keyType, strings.Join(pkiKeyTypes, ", "))
+		}
+	}
+	if !role.ServerAuth && !role.ClientAuth {
+		return errors.New("role must allow server auth, client auth or both")
+	}
+	if role.DefaultTTL == 0 {
+		role.DefaultTTL = DefaultPKIRoleTTL
+	}
+	if role.MaxTTL == 0 {
+		role.MaxTTL = max(DefaultPKIRoleMax, role.DefaultTTL)
+	}
+	if role.DefaultTTL < time.Second || role.DefaultTTL > role.MaxTTL {
+		return fmt.Errorf("default ttl must be between 1s and the max ttl %s", role.MaxTTL)
+	}
+	if err := s.repo.SaveRole(ctx, role); err != nil {
+		return fmt.Errorf("failed to save role: %w", err)
+	}
+	return nil
+}
+
+func (s *pkiService) ListRoles(ctx context.Context) ([]entity.PKIRole, error) {
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (s *pkiService) DeleteRole(ctx context.Context, name string) error {
+	err := s.repo.DeleteRole(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUnknownPKIRole
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return nil
+}
+
+// domainAllowed reports whether a DNS name, possibly a wildcard, matches one
+// of the role's domains.
+func domainAllowed(role entity.PKIRole, name string) bool {
+	for _, domain := range role.AllowedDomains {
+		if role.AllowBareDomains && name == domain {
+			return true
+		}
+		if role.AllowSubdomains && strings.HasSuffix(name, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNames validates the requested names against the role and splits them
+// into DNS and IP SANs. The common name is included as a SAN.
+func checkNames(role entity.PKIRole, req dto.IssueCertificate) ([]string, []net.IP, error) {
+	var dnsNames []string
+	var ips []net.IP
+	ipSANs := slices.Clone(req.IPSANs)
+	for _, raw := range append([]string{req.CommonName}, req.AltNames...) {
+		name := strings.ToLower(strings.TrimSpace(raw))
+		if ip := net.ParseIP(name); ip != nil {
+			ipSANs = append(ipSANs, name)
+			continue
+		}
+		if !pkiHostnamePattern.MatchString(name) {
+			return nil, nil, fmt.Errorf("invalid dns name %q", raw)
+		}
+		if !domainAllowed(role, name) {
+			return nil, nil, fmt.Errorf("%w: %s", ErrPKINameNotAllowed, name)
+		}
+		if !slices.Contains(dnsNames, name) {
+			dnsNames = append(dnsNames, name)
+		}
+	}
+	for _, raw := range ipSANs {
+		ip := net.ParseIP(strings.TrimSpace(raw))
+		if ip == nil {
+			return nil, nil, fmt.Errorf("invalid ip address %q", raw)
+		}
+		if !role.AllowIPSANs {
+			return nil, nil, fmt.Errorf("%w: %s", ErrPKINameNotAllowed, ip)
+		}
+		if !slices.ContainsFunc(ips, ip.Equal) {
+			ips = append(ips, ip)
+		}
+	}
+	return dnsNames, ips, nil
+}
+
+// IssueCertificate generates a key pair and a leaf certificate for it. The
+// lifetime is capped by the role and by the CA's own expiry.
+func (s *pkiService) IssueCertificate(
+	ctx context.Context,
+	userID int64,
+	token string,
+	req dto.IssueCertificate,
+) (dto.IssuedCertificate, error) {
+	role, err := s.repo.GetRole(ctx, req.Role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dto.IssuedCertificate{}, ErrUnknownPKIRole
+	}
+	if err != nil {
+		return dto.IssuedCertificate{}, fmt.Errorf("failed to find role: %w", err)
+	}
+	if err := s.policyService.Require(
+		ctx, userID, token, PKIIssuePathPrefix+role.Name, entity.CapabilityCreate,
+	); err != nil {
+		return dto.IssuedCertificate{}, fmt.Errorf("no access to role %s: %w", role.Name, err)
+	}
+
+	if strings.TrimSpace(req.CommonName) == "" {
+		return dto.IssuedCertificate{}, errors.New("common name is required")
+	}
+	dnsNames, ips, err := checkNames(role, req)
+	if err != nil {
+		return dto.IssuedCertificate{}, err
+	}
+	keyType := req.KeyType
+	if keyType == "" {
+		keyType = role.KeyTypes[0]
+	}
+	if !slices.Contains(role.KeyTypes, keyType) {
+		return dto.IssuedCertificate{}, fmt.Errorf("key type %q is not allowed by the role", keyType)
+	}
+	ttl := req.TTL
+	switch {
+	case ttl < 0:
+		return dto.IssuedCertificate{}, errors.New("ttl can't be negative")
+	case ttl == 0:
+		ttl = role.DefaultTTL
+	case ttl > role.MaxTTL:
+		ttl = role.MaxTTL
+	}
+
+	ca, caCert, caKey, err := s.loadCA(ctx)
+	if err != nil {
+		return dto.IssuedCertificate{}, err
+	}
+	now := s.now()
+	notAfter := now.Add(ttl)
+	if notAfter.After(caCert.NotAfter) {
+		notAfter = caCert.NotAfter
+	}
+	if !notAfter.After(now) {
+		return dto.IssuedCertificate{}, errors.New("ca certificate has expired")
+	}
+
+	key, err := generatePKIKey(keyType)
+	if err != nil {
+		return dto.IssuedCertificate{}, err
+	}
+	serial, err := pkiSerial()
+	if err != nil {
+		return dto.IssuedCertificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: req.CommonName},
+		DNSNames:              dnsNames,
+		IPAddresses:           ips,
+		NotBefore:             now.Add(-pkiBackdate),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	if keyType == entity.PKIKeyRSA {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+	if role.ServerAuth {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+	}
+	if role.ClientAuth {
+		template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+	}
+	if s.crlURL != "" {
+		template.CRLDistributionPoints = []string{s.crlURL}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return dto.IssuedCertificate{}, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM, err := encodePKIKey(key)
+	if err != nil {
+		return dto.IssuedCertificate{}, err
+	}
+
+	record := entity.PKICertificate{
+		Serial:      serial.Text(16),
+		Role:        role.Name,
+		CommonName:  req.CommonName,
+		IssuedBy:    &userID,
+		Certificate: string(certPEM),
+		NotAfter:    notAfter,
+	}
+	if err := s.repo.CreateCertificate(ctx, &record); err != nil {
+		return dto.IssuedCertificate{}, fmt.Errorf("failed to record certificate: %w", err)
+	}
+	return dto.IssuedCertificate{
+		ExpiresAt:   notAfter,
+		Serial:      record.Serial,
+		Certificate: string(certPEM),
+		PrivateKey:  string(keyPEM),
+		IssuingCA:   ca.Certificate,
+		Chain:       caChain(ca),
+	}, nil
+}
+
+// normalizeSerial accepts serials with colons or upper-case digits as printed
+// by openssl.
+func normalizeSerial(serial string) (string, error) {
+	n, ok := new(big.Int).SetString(strings.ReplaceAll(strings.TrimSpace(serial), ":", ""), 16)
+	if !ok {
+		return "", fmt.Errorf("invalid serial number %q", serial)
+	}
+	return n.Text(16), nil
+}
+
+func (s *pkiService) RevokeCertificate(ctx context.Context, userID int64, serial string) error {
+	serial, err := normalizeSerial(serial)
+	if err != nil {
+		return err
+	}
+	cert, err := s.repo.GetCertificate(ctx, serial)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrCertificateNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find certificate: %w", err)
+	}
+	// Other users' certificates are reported as missing.
+	if userID != 0 && (cert.IssuedBy == nil || *cert.IssuedBy != userID) {
+		return ErrCertificateNotFound
+	}
+	if err := s.repo.Revoke(ctx, serial); err != nil {
+		return fmt.Errorf("failed to revoke certificate: %w", err)
+	}
+	return nil
+}
+
+func (s *pkiService) CRL(ctx context.Context) ([]byte, error) {
+	_, caCert, caKey, err := s.loadCA(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.repo.ListRevoked(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revoked certificates: %w", err)
+	}
+	entries := make([]x509.RevocationListEntry, 0, len(revoked))
+	for _, cert := range revoked {
+		serial, ok := new(big.Int).SetString(cert.Serial, 16)
+		if !ok || cert.RevokedAt == nil {
+			continue
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: *cert.RevokedAt,
+		})
+	}
+	now := s.now()
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		// Numbers only have to grow, and the list is rebuilt on every request.
+		Number:     big.NewInt(now.UnixNano()),
+		ThisUpdate: now,
+		NextUpdate: now.Add(pkiCRLLifetime),
+	}, caCert, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create crl: %w", err)
+	}
+	return crl, nil
+}
+
+// pemBundle returns the DER contents of concatenated PEM certificates.
+func pemBundle(data []byte) [][]byte {
+	var blocks [][]byte
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return blocks
+		}
+		if block.Type == "CERTIFICATE" {
+			blocks = append(blocks, block.Bytes)
+		}
+	}
+}
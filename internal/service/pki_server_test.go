@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pkiFixture struct {
+	svc        *pkiService
+	repo       *mocks.MockPKIRepository
+	policyRepo *mocks.MockPolicyRepository
+	ca         *entity.PKICA
+	certs      map[string]*entity.PKICertificate
+}
+
+// newPKIFixture backs the CA and certificate tables with maps so issued
+// certificates can be revoked and listed in the CRL.
+func newPKIFixture(t *testing.T) *pkiFixture {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	crypto, err := NewCryptoService(config.SecurityConfig{DataEncryptionKey: "6368616e676520746869732070617373"})
+	require.NoError(t, err)
+
+	f := &pkiFixture{
+		repo:       mocks.NewMockPKIRepository(ctrl),
+		policyRepo: mocks.NewMockPolicyRepository(ctrl),
+		certs:      map[string]*entity.PKICertificate{},
+	}
+	svc := NewPKIService(f.repo, NewPolicyService(f.policyRepo, nil, nil), crypto, "https://keeper.example.com/")
+	f.svc = svc.(*pkiService)
+
+	f.repo.EXPECT().GetCA(gomock.Any()).AnyTimes().DoAndReturn(func(context.Context) (entity.PKICA, error) {
+		if f.ca == nil {
+			return entity.PKICA{}, pgx.ErrNoRows
+		}
+		return *f.ca, nil
+	})
+	f.repo.EXPECT().CreateCA(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, ca *entity.PKICA) error {
+			f.ca = ca
+			return nil
+		})
+	f.repo.EXPECT().CreateCertificate(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, c *entity.PKICertificate) error {
+			f.certs[c.Serial] = c
+			return nil
+		})
+	f.repo.EXPECT().GetCertificate(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, serial string) (entity.PKICertificate, error) {
+			c, ok := f.certs[serial]
+			if !ok {
+				return entity.PKICertificate{}, pgx.ErrNoRows
+			}
+			return *c, nil
+		})
+	f.repo.EXPECT().Revoke(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, serial string) error {
+			now := time.Now()
+			f.certs[serial].RevokedAt = &now
+			return nil
+		})
+	f.repo.EXPECT().ListRevoked(gomock.Any()).AnyTimes().
+		DoAndReturn(func(context.Context) ([]entity.PKICertificate, error) {
+			var revoked []entity.PKICertificate
+			for _, c := range f.certs {
+				if c.RevokedAt != nil {
+					revoked = append(revoked, *c)
+				}
+			}
+			return revoked, nil
+		})
+	return f
+}
+
+var webRole = entity.PKIRole{
+	Name:            "web",
+	AllowedDomains:  []string{"example.com"},
+	AllowSubdomains: true,
+	KeyTypes:        []string{entity.PKIKeyEC, entity.PKIKeyEd25519},
+	ServerAuth:      true,
+	DefaultTTL:      24 * time.Hour,
+	MaxTTL:          48 * time.Hour,
+}
+
+func (f *pkiFixture) allowIssue(ctx context.Context) {
+	f.repo.EXPECT().GetRole(ctx, "web").Return(webRole, nil)
+	f.policyRepo.EXPECT().ListForPrincipal(ctx, int64(7), "token").Return([]entity.Policy{
+		{Name: "pki", Rules: []entity.PolicyRule{
+			{Path: "pki/issue/web", Capabilities: []string{entity.CapabilityCreate}},
+		}},
+	}, nil)
+}
+
+func parsePEMCert(t *testing.T, data string) *x509.Certificate {
+	t.Helper()
+	cert, err := parsePKICertificate([]byte(data))
+	require.NoError(t, err)
+	return cert
+}
+
+func TestPKI_IssueCertificate(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+
+	rootPEM, err := f.svc.GenerateRoot(ctx, "Keeper Root", entity.PKIKeyEC, 0)
+	require.NoError(t, err)
+	root := parsePEMCert(t, rootPEM)
+	assert.True(t, root.IsCA)
+
+	f.allowIssue(ctx)
+	issued, err := f.svc.IssueCertificate(ctx, 7, "token", dto.IssueCertificate{
+		Role:       "web",
+		CommonName: "api.example.com",
+		AltNames:   []string{"*.api.example.com", "API.example.com"},
+		TTL:        30 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	leaf := parsePEMCert(t, issued.Certificate)
+	assert.Equal(t, []string{"api.example.com", "*.api.example.com"}, leaf.DNSNames)
+	assert.Equal(t, []string{"https://keeper.example.com/pki/crl"}, leaf.CRLDistributionPoints)
+	assert.Equal(t, leaf.SerialNumber.Text(16), issued.Serial)
+	// The requested ttl is capped at the role's max.
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), leaf.NotAfter, time.Minute)
+	assert.Equal(t, rootPEM, issued.Chain)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName:   "v1.api.example.com",
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	require.NoError(t, err)
+
+	key, err := parsePKIKey([]byte(issued.PrivateKey))
+	require.NoError(t, err)
+	assert.True(t, key.Public().(*ecdsa.PublicKey).Equal(leaf.PublicKey))
+	assert.Equal(t, int64(7), *f.certs[issued.Serial].IssuedBy)
+}
+
+func TestPKI_IssueCertificateRejected(t *testing.T) {
+	cases := []struct {
+		name string
+		req  dto.IssueCertificate
+		err  error
+	}{
+		{"other domain", dto.IssueCertificate{CommonName: "api.example.org"}, ErrPKINameNotAllowed},
+		{"bare domain", dto.IssueCertificate{CommonName: "example.com"}, ErrPKINameNotAllowed},
+		{"alt name", dto.IssueCertificate{
+			CommonName: "api.example.com", AltNames: []string{"evil.com"},
+		}, ErrPKINameNotAllowed},
+		{"ip san", dto.IssueCertificate{
+			CommonName: "api.example.com", IPSANs: []string{"10.0.0.1"},
+		}, ErrPKINameNotAllowed},
+		{"ip common name", dto.IssueCertificate{CommonName: "10.0.0.1"}, ErrPKINameNotAllowed},
+		{"key type", dto.IssueCertificate{CommonName: "api.example.com", KeyType: entity.PKIKeyRSA}, nil},
+		{"invalid name", dto.IssueCertificate{CommonName: "api example.com"}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newPKIFixture(t)
+			ctx := t.Context()
+			f.allowIssue(ctx)
+
+			tc.req.Role = "web"
+			_, err := f.svc.IssueCertificate(ctx, 7, "token", tc.req)
+			require.Error(t, err)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			}
+			assert.Empty(t, f.certs)
+		})
+	}
+}
+
+func TestPKI_IssueCertificateIPSANs(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+	_, err := f.svc.GenerateRoot(ctx, "Keeper Root", entity.PKIKeyEd25519, 0)
+	require.NoError(t, err)
+
+	role := webRole
+	role.AllowIPSANs = true
+	f.repo.EXPECT().GetRole(ctx, "web").Return(role, nil)
+	f.policyRepo.EXPECT().ListForPrincipal(ctx, int64(7), "token").Return([]entity.Policy{
+		{Name: "pki", Rules: []entity.PolicyRule{
+			{Path: "pki/issue/*", Capabilities: []string{entity.CapabilityCreate}},
+		}},
+	}, nil)
+
+	issued, err := f.svc.IssueCertificate(ctx, 7, "token", dto.IssueCertificate{
+		Role:       "web",
+		CommonName: "db.example.com",
+		IPSANs:     []string{"10.0.0.5", "::1"},
+		KeyType:    entity.PKIKeyEd25519,
+	})
+	require.NoError(t, err)
+	leaf := parsePEMCert(t, issued.Certificate)
+	require.Len(t, leaf.IPAddresses, 2)
+	assert.True(t, leaf.IPAddresses[0].Equal(net.ParseIP("10.0.0.5")))
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), leaf.NotAfter, time.Minute)
+}
+
+func TestPKI_IssueCertificateRequiresPolicy(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+
+	f.repo.EXPECT().GetRole(ctx, "web").Return(webRole, nil)
+	f.policyRepo.EXPECT().ListForPrincipal(ctx, int64(7), "token").Return(nil, nil)
+
+	_, err := f.svc.IssueCertificate(ctx, 7, "token", dto.IssueCertificate{Role: "web", CommonName: "api.example.com"})
+	assert.ErrorIs(t, err, ErrPolicyDenied)
+}
+
+func TestPKI_IssueCertificateWithoutCA(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+	f.allowIssue(ctx)
+
+	_, err := f.svc.IssueCertificate(ctx, 7, "token", dto.IssueCertificate{Role: "web", CommonName: "api.example.com"})
+	assert.ErrorIs(t, err, ErrPKINoCA)
+}
+
+func TestPKI_RevokeAndCRL(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+	rootPEM, err := f.svc.GenerateRoot(ctx, "Keeper Root", entity.PKIKeyRSA, 0)
+	require.NoError(t, err)
+
+	f.allowIssue(ctx)
+	issued, err := f.svc.IssueCertificate(ctx, 7, "token", dto.IssueCertificate{Role: "web", CommonName: "a.example.com"})
+	require.NoError(t, err)
+
+	// Someone else's certificate looks like it doesn't exist.
+	assert.ErrorIs(t, f.svc.RevokeCertificate(ctx, 8, issued.Serial), ErrCertificateNotFound)
+	assert.ErrorIs(t, f.svc.RevokeCertificate(ctx, 7, "abcdef"), ErrCertificateNotFound)
+
+	leaf := parsePEMCert(t, issued.Certificate)
+	// Serials are accepted in the colon separated form openssl prints.
+	octets := make([]string, 0, len(leaf.SerialNumber.Bytes()))
+	for _, b := range leaf.SerialNumber.Bytes() {
+		octets = append(octets, fmt.Sprintf("%02X", b))
+	}
+	require.NoError(t, f.svc.RevokeCertificate(ctx, 7, strings.Join(octets, ":")))
+
+	der, err := f.svc.CRL(ctx)
+	require.NoError(t, err)
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(t, err)
+	require.NoError(t, crl.CheckSignatureFrom(parsePEMCert(t, rootPEM)))
+	require.Len(t, crl.RevokedCertificateEntries, 1)
+	assert.Equal(t, 0, crl.RevokedCertificateEntries[0].SerialNumber.Cmp(leaf.SerialNumber))
+}
+
+func TestPKI_GenerateRootTwice(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+
+	_, err := f.svc.GenerateRoot(ctx, "Keeper Root", entity.PKIKeyEC, 0)
+	require.NoError(t, err)
+	_, err = f.svc.GenerateRoot(ctx, "Keeper Root", entity.PKIKeyEC, 0)
+	assert.ErrorIs(t, err, ErrPKICAExists)
+}
+
+// externalCA signs a certificate for key with the given parent; a nil parent
+// self-signs.
+func externalCA(
+	t *testing.T, name string, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, string) {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestPKI_ImportIntermediate(t *testing.T) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	root, rootPEM := externalCA(t, "Corp Root", rootKey, nil, nil)
+	interKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, interPEM := externalCA(t, "Keeper Intermediate", interKey, root, rootKey)
+	keyDER, err := x509.MarshalECPrivateKey(interKey)
+	require.NoError(t, err)
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	t.Run("mismatched key", func(t *testing.T) {
+		f := newPKIFixture(t)
+		rootKeyDER, err := x509.MarshalECPrivateKey(rootKey)
+		require.NoError(t, err)
+		wrongKey := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rootKeyDER}))
+		require.Error(t, f.svc.ImportCA(t.Context(), interPEM, wrongKey, rootPEM))
+		assert.Nil(t, f.ca)
+	})
+
+	t.Run("wrong chain", func(t *testing.T) {
+		f := newPKIFixture(t)
+		require.Error(t, f.svc.ImportCA(t.Context(), interPEM, keyPEM, interPEM))
+	})
+
+	t.Run("issues under the external root", func(t *testing.T) {
+		f := newPKIFixture(t)
+		ctx := t.Context()
+		require.NoError(t, f.svc.ImportCA(ctx, interPEM, keyPEM, rootPEM))
+
+		f.allowIssue(ctx)
+		issued, err := f.svc.IssueCertificate(ctx, 7, "token", dto.IssueCertificate{
+			Role: "web", CommonName: "svc.example.com",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, interPEM, issued.IssuingCA)
+
+		roots := x509.NewCertPool()
+		roots.AddCert(root)
+		intermediates := x509.NewCertPool()
+		require.True(t, intermediates.AppendCertsFromPEM([]byte(issued.Chain)))
+		_, err = parsePEMCert(t, issued.Certificate).Verify(x509.VerifyOptions{
+			DNSName:       "svc.example.com",
+			Roots:         roots,
+			Intermediates: intermediates,
+		})
+		require.NoError(t, err)
+		// The leaf can't outlive the intermediate.
+		assert.False(t, parsePEMCert(t, issued.Certificate).NotAfter.After(parsePEMCert(t, interPEM).NotAfter))
+	})
+}
+
+func TestPKI_WriteRoleValidation(t *testing.T) {
+	f := newPKIFixture(t)
+	ctx := t.Context()
+
+	for _, role := range []entity.PKIRole{
+		{Name: "bad name!", AllowedDomains: []string{"example.com"}, AllowSubdomains: true, ServerAuth: true},
+		{Name: "web", ServerAuth: true},
+		{Name: "web", AllowedDomains: []string{"example.com"}, ServerAuth: true},
+		{Name: "web", AllowedDomains: []string{"*.example.com"}, AllowSubdomains: true, ServerAuth: true},
+		{Name: "web", AllowedDomains: []string{"example.com"}, AllowSubdomains: true},
+		{Name: "web", AllowIPSANs: true, ServerAuth: true, KeyTypes: []string{"dsa"}},
+		{Name: "web", AllowIPSANs: true, ServerAuth: true, DefaultTTL: 2 * time.Hour, MaxTTL: time.Hour},
+	} {
+		assert.Error(t, f.svc.WriteRole(ctx, &role), role)
+	}
+
+	f.repo.EXPECT().SaveRole(ctx, gomock.Any()).Return(nil)
+	role := entity.PKIRole{
+		Name: "web", AllowedDomains: []string{" Example.COM "}, AllowBareDomains: true, ClientAuth: true,
+	}
+	require.NoError(t, f.svc.WriteRole(ctx, &role))
+	assert.Equal(t, []string{"example.com"}, role.AllowedDomains)
+	assert.Equal(t, []string{entity.PKIKeyEC}, role.KeyTypes)
+	assert.Equal(t, DefaultPKIRoleTTL, role.DefaultTTL)
+	assert.Equal(t, DefaultPKIRoleMax, role.MaxTTL)
+}
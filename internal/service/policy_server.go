@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var ErrPolicyDenied = errors.New("denied by policy")
+
+var policyNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,100}$`)
+
+var policyCapabilities = []string{
+	entity.CapabilityRead,
+	entity.CapabilityCreate,
+	entity.CapabilityUpdate,
+	entity.CapabilityDelete,
+	entity.CapabilityDestroy,
+	entity.CapabilityList,
+	entity.CapabilityDeny,
+}
+
+// PolicyService manages ACL policies and evaluates them for a request.
+// Principals with no attached policies keep the default behaviour where only
+// ownership, grants and team roles apply.
+type PolicyService interface {
+	WritePolicy(ctx context.Context, policy *entity.Policy) error
+	GetPolicy(ctx context.Context, name string) (entity.Policy, []entity.PolicyAttachment, error)
+	ListPolicies(ctx context.Context) ([]entity.Policy, error)
+	DeletePolicy(ctx context.Context, name string) error
+	Attach(ctx context.Context, name, subjectType, subject string) error
+	Detach(ctx context.Context, name, subjectType, subject string) error
+	Evaluate(ctx context.Context, userID int64, token, path, capability string) error
+	Require(ctx context.Context, userID int64, token, path, capability string) error
+	Filter(ctx context.Context, userID int64, token string, paths []string, capability string) ([]string, error)
+}
+
+type policyService struct {
+	policyRepo repository.PolicyRepository
+	userRepo   repository.UserRepositoryInterface
+	teamRepo   repository.TeamRepository
+}
+
+func NewPolicyService(
+	policyRepo repository.PolicyRepository,
+	userRepo repository.UserRepositoryInterface,
+	teamRepo repository.TeamRepository,
+) PolicyService {
+	return &policyService{
+		policyRepo: policyRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+	}
+}
+
+// compileGlob turns a policy path glob into an anchored regexp.
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		if glob[i] != '*' {
+			b.WriteString(regexp.QuoteMeta(string(glob[i])))
+			continue
+		}
+		if i+1 < len(glob) && glob[i+1] == '*' {
+			b.WriteString(".*")
+			i++
+			continue
+		}
+		b.WriteString("[^/]*")
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid path glob %q: %w", glob, err)
+	}
+	return re, nil
+}
+
+func validatePolicy(policy *entity.Policy) error {
+	if !policyNamePattern.MatchString(policy.Name) {
+		return fmt.Errorf("invalid policy name %q", policy.Name)
+	}
+	if len(policy.Rules) == 0 {
+		return errors.New("policy must contain at least one rule")
+	}
+	for _, rule := range policy.Rules {
+		if rule.Path == "" {
+			return errors.New("rule path can't be empty")
+		}
+		if _, err := compileGlob(rule.Path); err != nil {
+			return err
+		}
+		if len(rule.Capabilities) == 0 {
+			return fmt.Errorf("rule for %q has no capabilities", rule.Path)
+		}
+		for _, c := range rule.Capabilities {
+			if !slices.Contains(policyCapabilities, c) {
+				return fmt.Errorf("unknown capability %q", c)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *policyService) WritePolicy(ctx context.Context, policy *entity.Policy) error {
+	if err := validatePolicy(policy); err != nil {
+		return err
+	}
+	if err := s.policyRepo.Save(ctx, policy); err != nil {
+		return fmt.Errorf("failed to write policy: %w", err)
+	}
+	return nil
+}
+
+func (s *policyService) GetPolicy(
+	ctx context.Context,
+	name string,
+) (entity.Policy, []entity.PolicyAttachment, error) {
+	policy, err := s.policyRepo.GetByName(ctx, name)
+	if err != nil {
+		return entity.Policy{}, nil, fmt.Errorf("failed to find policy: %w", err)
+	}
+	attachments, err := s.policyRepo.ListAttachments(ctx, policy.ID)
+	if err != nil {
+		return entity.Policy{}, nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return policy, attachments, nil
+}
+
+func (s *policyService) ListPolicies(ctx context.Context) ([]entity.Policy, error) {
+	policies, err := s.policyRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+	return policies, nil
+}
+
+func (s *policyService) DeletePolicy(ctx context.Context, name string) error {
+	if err := s.policyRepo.Delete(ctx, name); err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+	return nil
+}
+
+func (s *policyService) Attach(ctx context.Context, name, subjectType, subject string) error {
+	policyID, subjectID, err := s.resolveAttachment(ctx, name, subjectType, subject)
+	if err != nil {
+		return err
+	}
+	if err := s.policyRepo.Attach(ctx, policyID, subjectType, subjectID); err != nil {
+		return fmt.Errorf("failed to attach policy: %w", err)
+	}
+	return nil
+}
+
+func (s *policyService) Detach(ctx context.Context, name, subjectType, subject string) error {
+	policyID, subjectID, err := s.resolveAttachment(ctx, name, subjectType, subject)
+	if err != nil {
+		return err
+	}
+	if err := s.policyRepo.Detach(ctx, policyID, subjectType, subjectID); err != nil {
+		return fmt.Errorf("failed to detach policy: %w", err)
+	}
+	return nil
+}
+
+// resolveAttachment maps a user login, team name or access token id to the
+// subject id stored in policy_attachments.
+func (s *policyService) resolveAttachment(
+	ctx context.Context,
+	name, subjectType, subject string,
+) (policyID, subjectID int64, err error) {
+	policy, err := s.policyRepo.GetByName(ctx, name)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to find policy: %w", err)
+	}
+
+	switch subjectType {
+	case entity.SubjectUser:
+		subjectID, err = s.userRepo.GetIDByLogin(ctx, subject)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to find user: %w", err)
+		}
+	case entity.SubjectTeam:
+		team, err := s.teamRepo.GetByName(ctx, subject)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to find team: %w", err)
+		}
+		subjectID = team.ID
+	case entity.SubjectToken:
+		if _, err := fmt.Sscan(subject, &subjectID); err != nil {
+			return 0, 0, fmt.Errorf("invalid token id %q", subject)
+		}
+	default:
+		return 0, 0, fmt.Errorf("unknown subject type %q", subjectType)
+	}
+	return policy.ID, subjectID, nil
+}
+
+// Evaluate checks capability on path against every policy attached to the
+// user, the user's teams and the request token. A matching deny rule wins over
+// any grant; otherwise at least one matching rule must carry the capability.
+func (s *policyService) Evaluate(ctx context.Context, userID int64, token, path, capability string) error {
+	return s.evaluate(ctx, userID, token, path, capability, false)
+}
+
+// Require is Evaluate without the default: a principal with no policies is
+// denied. It guards paths that aren't owned by anyone, such as database
+// credentials.
+func (s *policyService) Require(ctx context.Context, userID int64, token, path, capability string) error {
+	return s.evaluate(ctx, userID, token, path, capability, true)
+}
+
+func (s *policyService) evaluate(
+	ctx context.Context,
+	userID int64,
+	token, path, capability string,
+	explicit bool,
+) error {
+	policies, err := s.policyRepo.ListForPrincipal(ctx, userID, token)
+	if err != nil {
+		return fmt.Errorf("failed to load policies: %w", err)
+	}
+	if len(policies) == 0 {
+		if explicit {
+			return fmt.Errorf("%w: no policy grants %s on %q", ErrPolicyDenied, capability, path)
+		}
+		return nil
+	}
+	return checkPolicies(policies, path, capability)
+}
+
+// Filter returns the paths Evaluate allows capability on, in order, loading
+// the policies once. It filters listings, where each path is allowed or not
+// on its own.
+func (s *policyService) Filter(
+	ctx context.Context,
+	userID int64,
+	token string,
+	paths []string,
+	capability string,
+) ([]string, error) {
+	policies, err := s.policyRepo.ListForPrincipal(ctx, userID, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies: %w", err)
+	}
+	if len(policies) == 0 {
+		return paths, nil
+	}
+	allowed := make([]string, 0, len(paths))
+	for _, path := range paths {
+		err := checkPolicies(policies, path, capability)
+		if errors.Is(err, ErrPolicyDenied) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		allowed = append(allowed, path)
+	}
+	return allowed, nil
+}
+
+// checkPolicies allows capability on path if a rule grants it and no rule
+// matching path denies it.
+func checkPolicies(policies []entity.Policy, path, capability string) error {
+	allowed := false
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		names = append(names, policy.Name)
+		for _, rule := range policy.Rules {
+			re, err := compileGlob(rule.Path)
+			if err != nil {
+				return fmt.Errorf("policy %q: %w", policy.Name, err)
+			}
+			if !re.MatchString(path) {
+				continue
+			}
+			if slices.Contains(rule.Capabilities, entity.CapabilityDeny) {
+				return fmt.Errorf("%w %q: %s on %q is denied", ErrPolicyDenied, policy.Name, capability, path)
+			}
+			if slices.Contains(rule.Capabilities, capability) {
+				allowed = true
+			}
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("%w %q: no rule grants %s on %q",
+			ErrPolicyDenied, strings.Join(names, ","), capability, path)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileGlob(t *testing.T) {
+	tests := []struct {
+		glob  string
+		path  string
+		match bool
+	}{
+		{"db/*", "db/password", true},
+		{"db/*", "db/prod/password", false},
+		{"db/**", "db/prod/password", true},
+		{"team/*/api-*", "team/ops/api-key", true},
+		{"team/*/api-*", "team/ops/db", false},
+		{"db.password", "dbxpassword", false},
+	}
+	for _, tt := range tests {
+		re, err := compileGlob(tt.glob)
+		require.NoError(t, err)
+		assert.Equal(t, tt.match, re.MatchString(tt.path), "%s ~ %s", tt.glob, tt.path)
+	}
+}
+
+func TestPolicyService_WritePolicyValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := NewPolicyService(mocks.NewMockPolicyRepository(ctrl), nil, nil)
+
+	err := svc.WritePolicy(t.Context(), &entity.Policy{
+		Name:  "ops",
+		Rules: []entity.PolicyRule{{Path: "db/**", Capabilities: []string{"sudo"}}},
+	})
+	assert.ErrorContains(t, err, "unknown capability")
+
+	err = svc.WritePolicy(t.Context(), &entity.Policy{Name: "bad name"})
+	assert.ErrorContains(t, err, "invalid policy name")
+}
+
+func TestPolicyService_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepository(ctrl)
+	svc := NewPolicyService(repo, nil, nil)
+	ctx := t.Context()
+
+	policies := []entity.Policy{
+		{Name: "readers", Rules: []entity.PolicyRule{
+			{Path: "app/**", Capabilities: []string{entity.CapabilityRead, entity.CapabilityList}},
+		}},
+		{Name: "no-prod", Rules: []entity.PolicyRule{
+			{Path: "app/prod/*", Capabilities: []string{entity.CapabilityDeny}},
+		}},
+	}
+	repo.EXPECT().ListForPrincipal(ctx, int64(1), "token").Return(policies, nil).Times(3)
+
+	require.NoError(t, svc.Evaluate(ctx, 1, "token", "app/dev/key", entity.CapabilityRead))
+
+	err := svc.Evaluate(ctx, 1, "token", "app/dev/key", entity.CapabilityUpdate)
+	require.ErrorIs(t, err, ErrPolicyDenied)
+
+	err = svc.Evaluate(ctx, 1, "token", "app/prod/key", entity.CapabilityRead)
+	require.ErrorIs(t, err, ErrPolicyDenied)
+	assert.Contains(t, err.Error(), "no-prod")
+}
+
+func TestPolicyService_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepository(ctrl)
+	svc := NewPolicyService(repo, nil, nil)
+	ctx := t.Context()
+
+	policies := []entity.Policy{
+		{Name: "ci", Rules: []entity.PolicyRule{
+			{Path: "ci/**", Capabilities: []string{entity.CapabilityList}},
+			{Path: "ci/admin", Capabilities: []string{entity.CapabilityDeny}},
+		}},
+	}
+	repo.EXPECT().ListForPrincipal(ctx, int64(1), "token").Return(policies, nil)
+
+	// A path-scoped policy lists its own paths instead of denying the listing.
+	paths, err := svc.Filter(ctx, 1, "token", []string{"ci/deploy", "ci/admin", "prod/db"}, entity.CapabilityList)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci/deploy"}, paths)
+
+	repo.EXPECT().ListForPrincipal(ctx, int64(2), "token").Return(nil, nil)
+	paths, err = svc.Filter(ctx, 2, "token", []string{"ci/deploy", "prod/db"}, entity.CapabilityList)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci/deploy", "prod/db"}, paths, "no policies keep the default")
+}
+
+func TestPolicyService_EvaluateWithoutPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepository(ctrl)
+	svc := NewPolicyService(repo, nil, nil)
+	ctx := t.Context()
+
+	repo.EXPECT().ListForPrincipal(ctx, int64(1), "token").Return(nil, nil)
+
+	assert.NoError(t, svc.Evaluate(ctx, 1, "token", "anything", entity.CapabilityDestroy))
+}
+
+func TestPolicyService_RequireWithoutPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockPolicyRepository(ctrl)
+	svc := NewPolicyService(repo, nil, nil)
+	ctx := t.Context()
+
+	repo.EXPECT().ListForPrincipal(ctx, int64(1), "token").Return(nil, nil)
+
+	err := svc.Require(ctx, 1, "token", "database/creds/readonly", entity.CapabilityRead)
+	require.ErrorIs(t, err, ErrPolicyDenied)
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+)
+
+type RemoteServiceAccountService interface {
+	CreateAccount(ctx context.Context, token string, req dto.CreateServiceAccount) (dto.AgentServiceAccount, error)
+	ListAccounts(ctx context.Context, token string) ([]dto.AgentServiceAccount, error)
+	DeleteAccount(ctx context.Context, token, name string) error
+	CreateKey(ctx context.Context, token string, req dto.CreateAPIKey) (string, dto.AgentAPIKey, error)
+	ListKeys(ctx context.Context, token, account string) ([]dto.AgentAPIKey, error)
+	RevokeKey(ctx context.Context, token string, id int64) error
+}
+
+type remoteServiceAccountService struct {
+	client pb.ServiceAccountServiceClient
+}
+
+func NewRemoteServiceAccountService(client pb.ServiceAccountServiceClient) RemoteServiceAccountService {
+	return &remoteServiceAccountService{client: client}
+}
+
+func (s *remoteServiceAccountService) CreateAccount(
+	ctx context.Context,
+	token string,
+	account dto.CreateServiceAccount,
+) (dto.AgentServiceAccount, error) {
+	req := &pbModel.CreateServiceAccountRequest{}
+	req.SetName(account.Name)
+	req.SetDescription(account.Description)
+	req.SetTeam(account.Team)
+	req.SetTeamRole(account.TeamRole)
+	resp, err := s.client.CreateServiceAccount(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.AgentServiceAccount{}, fmt.Errorf("failed to create service account: %w", err)
+	}
+	return serviceAccountFromProto(resp), nil
+}
+
+func (s *remoteServiceAccountService) ListAccounts(ctx context.Context, token string) ([]dto.AgentServiceAccount, error) {
+	resp, err := s.client.ListServiceAccounts(ctx, &pbModel.ListServiceAccountsRequest{}, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	accounts := make([]dto.AgentServiceAccount, 0, len(resp.GetAccounts()))
+	for _, a := range resp.GetAccounts() {
+		accounts = append(accounts, serviceAccountFromProto(a))
+	}
+	return accounts, nil
+}
+
+func (s *remoteServiceAccountService) DeleteAccount(ctx context.Context, token, name string) error {
+	req := &pbModel.DeleteServiceAccountRequest{}
+	req.SetName(name)
+	if _, err := s.client.DeleteServiceAccount(ctx, req, client.WithToken(token)); err != nil {
+		return fmt.Errorf("failed to delete service account: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteServiceAccountService) CreateKey(
+	ctx context.Context,
+	token string,
+	key dto.CreateAPIKey,
+) (string, dto.AgentAPIKey, error) {
+	req := &pbModel.CreateAPIKeyRequest{}
+	req.SetAccount(key.Account)
+	req.SetName(key.Name)
+	req.SetPathPrefixes(key.PathPrefixes)
+	req.SetCapabilities(key.Capabilities)
+	req.SetAllowedCidrs(key.AllowedCIDRs)
+	req.SetTtlSeconds(int64(key.TTL.Seconds()))
+	resp, err := s.client.CreateAPIKey(ctx, req, client.WithToken(token))
+	if err != nil {
+		return "", dto.AgentAPIKey{}, fmt.Errorf("failed to create api key: %w", err)
+	}
+	return resp.GetKey(), apiKeyFromProto(resp.GetInfo()), nil
+}
+
+func (s *remoteServiceAccountService) ListKeys(ctx context.Context, token, account string) ([]dto.AgentAPIKey, error) {
+	req := &pbModel.ListAPIKeysRequest{}
+	req.SetAccount(account)
+	resp, err := s.client.ListAPIKeys(ctx, req, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	keys := make([]dto.AgentAPIKey, 0, len(resp.GetKeys()))
+	for _, k := range resp.GetKeys() {
+		keys = append(keys, apiKeyFromProto(k))
+	}
+	return keys, nil
+}
+
+func (s *remoteServiceAccountService) RevokeKey(ctx context.Context, token string, id int64) error {
+	req := &pbModel.RevokeAPIKeyRequest{}
+	req.SetId(id)
+	if _, err := s.client.RevokeAPIKey(ctx, req, client.WithToken(token)); err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
+
+func serviceAccountFromProto(a *pbModel.ServiceAccount) dto.AgentServiceAccount {
+	return dto.AgentServiceAccount{
+		Name:        a.GetName(),
+		Login:       a.GetLogin(),
+		Description: a.GetDescription(),
+		Owner:       a.GetOwner(),
+		CreatedAt:   a.GetCreatedAt().AsTime(),
+	}
+}
+
+// apiKeyFromProto leaves unset timestamps as the zero time.
+func apiKeyFromProto(k *pbModel.APIKey) dto.AgentAPIKey {
+	key := dto.AgentAPIKey{
+		ID:           k.GetId(),
+		Account:      k.GetAccount(),
+		Name:         k.GetName(),
+		Prefix:       k.GetPrefix(),
+		PathPrefixes: k.GetPathPrefixes(),
+		Capabilities: k.GetCapabilities(),
+		AllowedCIDRs: k.GetAllowedCidrs(),
+		LastIP:       k.GetLastIp(),
+		CreatedAt:    k.GetCreatedAt().AsTime(),
+	}
+	if k.HasExpiresAt() {
+		key.ExpiresAt = k.GetExpiresAt().AsTime()
+	}
+	if k.HasLastUsedAt() {
+		key.LastUsedAt = k.GetLastUsedAt().AsTime()
+	}
+	if k.HasRevokedAt() {
+		key.RevokedAt = k.GetRevokedAt().AsTime()
+	}
+	return key
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// APIKeyPrefix starts every API key, so the auth interceptor can tell keys
+// from JWTs without trying to parse them.
+const APIKeyPrefix = "kpr_"
+
+const (
+	apiKeyBytes = 32
+	// apiKeyDisplayLength is how much of a key is kept in clear for listings.
+	apiKeyDisplayLength = 12
+)
+
+var (
+	ErrInvalidAPIKey        = errors.New("invalid, revoked or expired api key")
+	ErrAPIKeyScope          = errors.New("api key scope does not allow this")
+	ErrServiceAccountExists = errors.New("service account already exists")
+)
+
+var serviceAccountNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,99}$`)
+
+// ServiceAccountService manages non-human principals and their API keys.
+// Only the owning user, or an owner of the owning team, can manage an account.
+type ServiceAccountService interface {
+	CreateAccount(ctx context.Context, userID int64, req dto.CreateServiceAccount) (entity.ServiceAccount, error)
+	ListAccounts(ctx context.Context, userID int64) ([]entity.ServiceAccount, error)
+	DeleteAccount(ctx context.Context, userID int64, name string) error
+	CreateKey(ctx context.Context, userID int64, req dto.CreateAPIKey) (string, entity.APIKey, error)
+	ListKeys(ctx context.Context, userID int64, account string) ([]entity.APIKey, error)
+	RevokeKey(ctx context.Context, userID, keyID int64) error
+	// Authenticate resolves a plain API key used from clientIP.
+	Authenticate(ctx context.Context, key, clientIP string) (entity.APIKey, error)
+}
+
+type serviceAccountService struct {
+	repo     repository.ServiceAccountRepository
+	teamRepo repository.TeamRepository
+}
+
+func NewServiceAccountService(
+	repo repository.ServiceAccountRepository,
+	teamRepo repository.TeamRepository,
+) ServiceAccountService {
+	return &serviceAccountService{repo: repo, teamRepo: teamRepo}
+}
+
+func (s *serviceAccountService) CreateAccount(
+	ctx context.Context,
+	userID int64,
+	req dto.CreateServiceAccount,
+) (entity.ServiceAccount, error) {
+	if !serviceAccountNamePattern.MatchString(req.Name) {
+		return entity.ServiceAccount{}, fmt.Errorf("invalid service account name %q", req.Name)
+	}
+	account := entity.ServiceAccount{
+		Name:        req.Name,
+		Login:       entity.ServiceAccountLoginPrefix + req.Name,
+		Description: req.Description,
+	}
+
+	role := req.TeamRole
+	if req.Team == "" {
+		account.OwnerUserID = &userID
+	} else {
+		if role == "" {
+			role = entity.TeamRoleViewer
+		}
+		if role != entity.TeamRoleViewer && role != entity.TeamRoleEditor {
+			return entity.ServiceAccount{}, fmt.Errorf("invalid team role %q, expected viewer or editor", role)
+		}
+		team, err := s.requireTeamOwner(ctx, userID, req.Team)
+		if err != nil {
+			return entity.ServiceAccount{}, err
+		}
+		account.OwnerTeamID = &team.ID
+		account.OwnerTeam = team.Name
+	}
+
+	if _, err := s.repo.GetByName(ctx, req.Name); err == nil {
+		return entity.ServiceAccount{}, fmt.Errorf("%w: %s", ErrServiceAccountExists, req.Name)
+	}
+	if err := s.repo.Create(ctx, &account, role); err != nil {
+		return entity.ServiceAccount{}, fmt.Errorf("failed to create service account: %w", err)
+	}
+	return account, nil
+}
+
+func (s *serviceAccountService) ListAccounts(ctx context.Context, userID int64) ([]entity.ServiceAccount, error) {
+	accounts, err := s.repo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+func (s *serviceAccountService) DeleteAccount(ctx context.Context, userID int64, name string) error {
+	account, err := s.manageable(ctx, userID, name)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, account); err != nil {
+		return fmt.Errorf("failed to delete service account: %w", err)
+	}
+	return nil
+}
+
+// CreateKey returns the plain key, which is not stored and can't be shown
+// again.
+func (s *serviceAccountService) CreateKey(
+	ctx context.Context,
+	userID int64,
+	req dto.CreateAPIKey,
+) (string, entity.APIKey, error) {
+	if err := validateKeyScope(req); err != nil {
+		return "", entity.APIKey{}, err
+	}
+	cidrs, err := normalizeCIDRs(req.AllowedCIDRs)
+	if err != nil {
+		return "", entity.APIKey{}, err
+	}
+	if req.TTL < 0 {
+		return "", entity.APIKey{}, errors.New("key lifetime must not be negative")
+	}
+
+	account, err := s.manageable(ctx, userID, req.Account)
+	if err != nil {
+		return "", entity.APIKey{}, err
+	}
+
+	raw := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", entity.APIKey{}, fmt.Errorf("failed to generate api key: %w", err)
+	}
+	plain := APIKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	key := entity.APIKey{
+		ServiceAccountID: account.ID,
+		UserID:           account.UserID,
+		Account:          account.Name,
+		Hash:             sha256Hex(plain),
+		Prefix:           plain[:apiKeyDisplayLength],
+		Name:             req.Name,
+		PathPrefixes:     req.PathPrefixes,
+		Capabilities:     req.Capabilities,
+		AllowedCIDRs:     cidrs,
+	}
+	if req.TTL > 0 {
+		expiresAt := time.Now().UTC().Add(req.TTL)
+		key.ExpiresAt = &expiresAt
+	}
+	if err := s.repo.CreateKey(ctx, &key); err != nil {
+		return "", entity.APIKey{}, fmt.Errorf("failed to create api key: %w", err)
+	}
+	return plain, key, nil
+}
+
+func (s *serviceAccountService) ListKeys(ctx context.Context, userID int64, accountName string) ([]entity.APIKey, error) {
+	account, err := s.manageable(ctx, userID, accountName)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.repo.ListKeys(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *serviceAccountService) RevokeKey(ctx context.Context, userID, keyID int64) error {
+	key, err := s.repo.GetKey(ctx, keyID)
+	if err != nil {
+		return fmt.Errorf("failed to find api key %d: %w", keyID, err)
+	}
+	if _, err := s.manageable(ctx, userID, key.Account); err != nil {
+		return err
+	}
+	if err := s.repo.RevokeKey(ctx, keyID); err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	return nil
+}
+
+func (s *serviceAccountService) Authenticate(ctx context.Context, key, clientIP string) (entity.APIKey, error) {
+	found, err := s.repo.FindKeyByHash(ctx, sha256Hex(key))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.APIKey{}, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("failed to find api key: %w", err)
+	}
+	if found.RevokedAt != nil || (found.ExpiresAt != nil && !time.Now().UTC().Before(*found.ExpiresAt)) {
+		return entity.APIKey{}, ErrInvalidAPIKey
+	}
+	if !ipAllowed(found.AllowedCIDRs, clientIP) {
+		return entity.APIKey{}, fmt.Errorf("%w: address %s not allowed", ErrInvalidAPIKey, clientIP)
+	}
+	if err := s.repo.TouchKey(ctx, found.ID, clientIP); err != nil {
+		return entity.APIKey{}, fmt.Errorf("failed to record api key use: %w", err)
+	}
+	return found, nil
+}
+
+// CheckAPIKeyScope reports whether key may use capability on path.
+func CheckAPIKeyScope(key entity.APIKey, path, capability string) error {
+	if err := CheckAPIKeyCapability(key, capability); err != nil {
+		return err
+	}
+	if !APIKeyCovers(key, path) {
+		return fmt.Errorf("%w: path %q is outside %s", ErrAPIKeyScope, path, strings.Join(key.PathPrefixes, ","))
+	}
+	return nil
+}
+
+// CheckAPIKeyCapability reports whether key has capability at all. Calls
+// that aren't about one path, such as ListSecrets, check only this and drop
+// the paths APIKeyCovers rejects from their result.
+func CheckAPIKeyCapability(key entity.APIKey, capability string) error {
+	if !slices.Contains(key.Capabilities, capability) {
+		return fmt.Errorf("%w: no %s capability", ErrAPIKeyScope, capability)
+	}
+	return nil
+}
+
+// APIKeyCovers reports whether path is under one of the key's prefixes.
+// Prefixes match whole path segments, so ci and ci/ cover ci/token but not
+// ci2/token.
+func APIKeyCovers(key entity.APIKey, path string) bool {
+	for _, prefix := range key.PathPrefixes {
+		if underPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func underPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// manageable returns the named account if userID may manage it.
+func (s *serviceAccountService) manageable(ctx context.Context, userID int64, name string) (entity.ServiceAccount, error) {
+	account, err := s.repo.GetByName(ctx, name)
+	if err != nil {
+		return entity.ServiceAccount{}, fmt.Errorf("failed to find service account %s: %w", name, err)
+	}
+	if account.OwnerUserID != nil {
+		if *account.OwnerUserID != userID {
+			return entity.ServiceAccount{}, fmt.Errorf("%w: not the owner of %s", ErrAccessDenied, name)
+		}
+		return account, nil
+	}
+	if _, err := s.requireTeamOwner(ctx, userID, account.OwnerTeam); err != nil {
+		return entity.ServiceAccount{}, err
+	}
+	return account, nil
+}
+
+func (s *serviceAccountService) requireTeamOwner(ctx context.Context, userID int64, teamName string) (entity.Team, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("failed to find team: %w", err)
+	}
+	member, err := s.teamRepo.GetMember(ctx, team.ID, userID)
+	if err != nil || member.Role != entity.TeamRoleOwner {
+		return entity.Team{}, fmt.Errorf("%w: only owners of team %s manage its service accounts", ErrAccessDenied, teamName)
+	}
+	return team, nil
+}
+
+func validateKeyScope(req dto.CreateAPIKey) error {
+	if len(req.PathPrefixes) == 0 {
+		return errors.New("at least one path prefix is required")
+	}
+	for _, prefix := range req.PathPrefixes {
+		if prefix == "" {
+			return errors.New("path prefix must not be empty")
+		}
+	}
+	if len(req.Capabilities) == 0 {
+		return errors.New("at least one capability is required")
+	}
+	for _, c := range req.Capabilities {
+		if c == entity.CapabilityDeny || !slices.Contains(policyCapabilities, c) {
+			return fmt.Errorf("unknown capability %q", c)
+		}
+	}
+	return nil
+}
+
+// normalizeCIDRs accepts CIDRs and bare addresses, which become single-host
+// prefixes.
+func normalizeCIDRs(values []string) ([]string, error) {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if addr, err := netip.ParseAddr(value); err == nil {
+			result = append(result, netip.PrefixFrom(addr, addr.BitLen()).String())
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address or CIDR %q", value)
+		}
+		result = append(result, prefix.Masked().String())
+	}
+	return result, nil
+}
+
+func ipAllowed(cidrs []string, clientIP string) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(clientIP)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
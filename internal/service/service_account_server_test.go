@@ -0,0 +1,152 @@
+package service
+
+import (
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAccountService_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockServiceAccountRepository(ctrl)
+	svc := NewServiceAccountService(repo, mocks.NewMockTeamRepository(ctrl))
+	ctx := t.Context()
+
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	t.Run("valid", func(t *testing.T) {
+		key := entity.APIKey{ID: 1, UserID: 5, AllowedCIDRs: []string{"10.0.0.0/8"}, ExpiresAt: &future}
+		repo.EXPECT().FindKeyByHash(ctx, sha256Hex("kpr_ok")).Return(key, nil)
+		repo.EXPECT().TouchKey(ctx, int64(1), "10.1.2.3").Return(nil)
+
+		got, err := svc.Authenticate(ctx, "kpr_ok", "10.1.2.3")
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), got.UserID)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		repo.EXPECT().FindKeyByHash(ctx, sha256Hex("kpr_missing")).Return(entity.APIKey{}, pgx.ErrNoRows)
+
+		_, err := svc.Authenticate(ctx, "kpr_missing", "10.1.2.3")
+		require.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		repo.EXPECT().FindKeyByHash(ctx, sha256Hex("kpr_old")).Return(entity.APIKey{ExpiresAt: &past}, nil)
+
+		_, err := svc.Authenticate(ctx, "kpr_old", "10.1.2.3")
+		require.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		repo.EXPECT().FindKeyByHash(ctx, sha256Hex("kpr_revoked")).Return(entity.APIKey{RevokedAt: &past}, nil)
+
+		_, err := svc.Authenticate(ctx, "kpr_revoked", "10.1.2.3")
+		require.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("address not allowed", func(t *testing.T) {
+		key := entity.APIKey{AllowedCIDRs: []string{"10.0.0.0/8"}}
+		repo.EXPECT().FindKeyByHash(ctx, sha256Hex("kpr_ip")).Return(key, nil)
+
+		_, err := svc.Authenticate(ctx, "kpr_ip", "192.168.1.1")
+		require.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+}
+
+func TestCheckAPIKeyScope(t *testing.T) {
+	key := entity.APIKey{
+		PathPrefixes: []string{"ci/"},
+		Capabilities: []string{entity.CapabilityRead, entity.CapabilityList},
+	}
+
+	require.NoError(t, CheckAPIKeyScope(key, "ci/deploy-token", entity.CapabilityRead))
+	require.NoError(t, CheckAPIKeyCapability(key, entity.CapabilityList))
+	require.ErrorIs(t, CheckAPIKeyScope(key, "", entity.CapabilityList), ErrAPIKeyScope)
+	require.ErrorIs(t, CheckAPIKeyScope(key, "prod/db", entity.CapabilityRead), ErrAPIKeyScope)
+	require.ErrorIs(t, CheckAPIKeyScope(key, "ci2/token", entity.CapabilityRead), ErrAPIKeyScope)
+
+	key.PathPrefixes = []string{"ci"}
+	require.NoError(t, CheckAPIKeyScope(key, "ci", entity.CapabilityRead))
+	require.NoError(t, CheckAPIKeyScope(key, "ci/deploy-token", entity.CapabilityRead))
+	require.ErrorIs(t, CheckAPIKeyScope(key, "cicd/token", entity.CapabilityRead), ErrAPIKeyScope)
+	require.ErrorIs(t, CheckAPIKeyScope(key, "ci/deploy-token", entity.CapabilityUpdate), ErrAPIKeyScope)
+}
+
+func TestServiceAccountService_CreateKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockServiceAccountRepository(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	svc := NewServiceAccountService(repo, teamRepo)
+	ctx := t.Context()
+
+	owner := int64(1)
+	account := entity.ServiceAccount{ID: 3, UserID: 9, Name: "ci", OwnerUserID: &owner}
+
+	t.Run("stores only the hash", func(t *testing.T) {
+		repo.EXPECT().GetByName(ctx, "ci").Return(account, nil)
+		var stored *entity.APIKey
+		repo.EXPECT().CreateKey(ctx, gomock.Any()).DoAndReturn(func(_ any, key *entity.APIKey) error {
+			stored = key
+			return nil
+		})
+
+		plain, key, err := svc.CreateKey(ctx, owner, dto.CreateAPIKey{
+			Account:      "ci",
+			PathPrefixes: []string{"ci/"},
+			Capabilities: []string{entity.CapabilityRead},
+			AllowedCIDRs: []string{"203.0.113.7"},
+			TTL:          time.Hour,
+		})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(plain, APIKeyPrefix))
+		assert.Equal(t, sha256Hex(plain), stored.Hash)
+		assert.Equal(t, plain[:apiKeyDisplayLength], key.Prefix)
+		assert.Equal(t, []string{"203.0.113.7/32"}, key.AllowedCIDRs)
+		assert.NotNil(t, key.ExpiresAt)
+	})
+
+	t.Run("not the owner", func(t *testing.T) {
+		repo.EXPECT().GetByName(ctx, "ci").Return(account, nil)
+
+		_, _, err := svc.CreateKey(ctx, 2, dto.CreateAPIKey{
+			Account:      "ci",
+			PathPrefixes: []string{"ci/"},
+			Capabilities: []string{entity.CapabilityRead},
+		})
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		_, _, err := svc.CreateKey(ctx, owner, dto.CreateAPIKey{Account: "ci", Capabilities: []string{"read"}})
+		require.Error(t, err)
+
+		_, _, err = svc.CreateKey(ctx, owner, dto.CreateAPIKey{
+			Account:      "ci",
+			PathPrefixes: []string{"ci/"},
+			Capabilities: []string{entity.CapabilityDeny},
+		})
+		require.Error(t, err)
+
+		_, _, err = svc.CreateKey(ctx, owner, dto.CreateAPIKey{
+			Account:      "ci",
+			PathPrefixes: []string{"ci/"},
+			Capabilities: []string{entity.CapabilityRead},
+			AllowedCIDRs: []string{"not-an-ip"},
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"sync"
+	"time"
+)
+
+var ErrSessionInactive = errors.New("session revoked or expired")
+
+// SessionService tracks the access tokens issued at login. Every
+// authenticated request goes through Authenticate, which is backed by a
+// short-lived cache so revocation is checked without a query per call.
+// Whatever revokes or rotates a token in the database outside this service
+// must call ForgetSession or ForgetUser once committed.
+type SessionService interface {
+	Authenticate(ctx context.Context, token, ip string) (entity.AccessToken, error)
+	Logout(ctx context.Context, token string) error
+	ListSessions(ctx context.Context, userID int64) ([]entity.AccessToken, error)
+	RevokeSession(ctx context.Context, userID, sessionID int64) error
+	ForgetSession(sessionID int64)
+	ForgetUser(userID int64, keepToken string)
+}
+
+type cachedSession struct {
+	checkedAt time.Time
+	session   entity.AccessToken
+}
+
+type sessionService struct {
+	repo  repository.AccessTokenRepository
+	cache map[string]cachedSession
+	now   func() time.Time
+	ttl   time.Duration
+	mu    sync.Mutex
+	// evictions counts evictions, so a lookup that raced one doesn't cache
+	// what it read before the revocation committed.
+	evictions uint64
+}
+
+func NewSessionService(repo repository.AccessTokenRepository, cfg config.SecurityConfig) SessionService {
+	return &sessionService{
+		repo:  repo,
+		cache: make(map[string]cachedSession),
+		now:   time.Now,
+		ttl:   cfg.SessionCacheTTL,
+	}
+}
+
+// Authenticate returns the active session for token. On a cache miss the
+// session is loaded from the database and its last IP and use time updated.
+func (s *sessionService) Authenticate(ctx context.Context, token, ip string) (entity.AccessToken, error) {
+	now := s.now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[token]
+	evictions := s.evictions
+	s.mu.Unlock()
+	if ok && now.Sub(cached.checkedAt) < s.ttl && now.Before(cached.session.ExpiresAt) {
+		return cached.session, nil
+	}
+
+	session, err := s.repo.FindActive(ctx, token)
+	if err != nil {
+		s.forget(token)
+		return entity.AccessToken{}, fmt.Errorf("%w: %w", ErrSessionInactive, err)
+	}
+	if err := s.repo.Touch(ctx, session.ID, ip); err != nil {
+		return entity.AccessToken{}, fmt.Errorf("failed to track session: %w", err)
+	}
+	session.LastIP = ip
+	session.LastUsedAt = &now
+
+	s.mu.Lock()
+	s.evictExpired(now)
+	if s.evictions == evictions {
+		s.cache[token] = cachedSession{checkedAt: now, session: session}
+	}
+	s.mu.Unlock()
+
+	return session, nil
+}
+
+func (s *sessionService) Logout(ctx context.Context, token string) error {
+	s.forget(token)
+	if err := s.repo.RevokeByToken(ctx, token); err != nil {
+		return fmt.Errorf("failed to logout: %w", err)
+	}
+	return nil
+}
+
+func (s *sessionService) ListSessions(ctx context.Context, userID int64) ([]entity.AccessToken, error) {
+	sessions, err := s.repo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+func (s *sessionService) RevokeSession(ctx context.Context, userID, sessionID int64) error {
+	token, err := s.repo.RevokeByID(ctx, userID, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	s.forget(token)
+	return nil
+}
+
+// ForgetSession drops the cached token of a session revoked or rotated
+// elsewhere, such as on refresh.
+func (s *sessionService) ForgetSession(sessionID int64) {
+	s.forgetWhere(func(_ string, session entity.AccessToken) bool { return session.ID == sessionID })
+}
+
+// ForgetUser drops the cached tokens of userID except keepToken, after the
+// user's other sessions were revoked.
+func (s *sessionService) ForgetUser(userID int64, keepToken string) {
+	s.forgetWhere(func(token string, session entity.AccessToken) bool {
+		return session.UserID == userID && token != keepToken
+	})
+}
+
+func (s *sessionService) forget(token string) {
+	s.forgetWhere(func(cached string, _ entity.AccessToken) bool { return cached == token })
+}
+
+func (s *sessionService) forgetWhere(match func(token string, session entity.AccessToken) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evictions++
+	for token, cached := range s.cache {
+		if match(token, cached.session) {
+			delete(s.cache, token)
+		}
+	}
+}
+
+// evictExpired drops stale entries so the cache doesn't grow with every token
+// ever seen. Callers hold s.mu.
+func (s *sessionService) evictExpired(now time.Time) {
+	for token, cached := range s.cache {
+		if now.Sub(cached.checkedAt) >= s.ttl {
+			delete(s.cache, token)
+		}
+	}
+}
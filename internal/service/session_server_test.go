@@ -0,0 +1,110 @@
+package service
+
+import (
+	"errors"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_AuthenticateUsesCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAccessTokenRepository(ctrl)
+	svc := NewSessionService(repo, config.SecurityConfig{SessionCacheTTL: time.Minute})
+	ctx := t.Context()
+
+	session := entity.AccessToken{ID: 1, UserID: 2, Token: "jwt", ExpiresAt: time.Now().Add(time.Hour)}
+	repo.EXPECT().FindActive(ctx, "jwt").Return(session, nil).Times(1)
+	repo.EXPECT().Touch(ctx, int64(1), "10.0.0.1").Return(nil).Times(1)
+
+	for range 3 {
+		got, err := svc.Authenticate(ctx, "jwt", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), got.UserID)
+	}
+}
+
+func TestSessionService_RevokedTokenIsRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAccessTokenRepository(ctrl)
+	svc := NewSessionService(repo, config.SecurityConfig{SessionCacheTTL: time.Minute})
+	ctx := t.Context()
+
+	session := entity.AccessToken{ID: 1, UserID: 2, Token: "jwt", ExpiresAt: time.Now().Add(time.Hour)}
+	gomock.InOrder(
+		repo.EXPECT().FindActive(ctx, "jwt").Return(session, nil),
+		repo.EXPECT().Touch(ctx, int64(1), "").Return(nil),
+		repo.EXPECT().RevokeByID(ctx, int64(2), int64(1)).Return("jwt", nil),
+		repo.EXPECT().FindActive(ctx, "jwt").Return(entity.AccessToken{}, errors.New("no rows")),
+	)
+
+	_, err := svc.Authenticate(ctx, "jwt", "")
+	require.NoError(t, err)
+
+	require.NoError(t, svc.RevokeSession(ctx, 2, 1))
+
+	_, err = svc.Authenticate(ctx, "jwt", "")
+	require.ErrorIs(t, err, ErrSessionInactive)
+}
+
+func TestSessionService_ForgetUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAccessTokenRepository(ctrl)
+	svc := NewSessionService(repo, config.SecurityConfig{SessionCacheTTL: time.Minute})
+	ctx := t.Context()
+
+	expires := time.Now().Add(time.Hour)
+	current := entity.AccessToken{ID: 1, UserID: 2, Token: "current", ExpiresAt: expires}
+	other := entity.AccessToken{ID: 3, UserID: 2, Token: "other", ExpiresAt: expires}
+	repo.EXPECT().FindActive(ctx, "current").Return(current, nil)
+	repo.EXPECT().FindActive(ctx, "other").Return(other, nil)
+	repo.EXPECT().Touch(ctx, gomock.Any(), "").Return(nil).Times(2)
+	for _, token := range []string{"current", "other"} {
+		_, err := svc.Authenticate(ctx, token, "")
+		require.NoError(t, err)
+	}
+
+	// The password change revoked every other session in the database.
+	svc.ForgetUser(2, "current")
+	repo.EXPECT().FindActive(ctx, "other").Return(entity.AccessToken{}, errors.New("no rows"))
+	_, err := svc.Authenticate(ctx, "other", "")
+	require.ErrorIs(t, err, ErrSessionInactive)
+	_, err = svc.Authenticate(ctx, "current", "")
+	require.NoError(t, err, "the caller's own session stays cached")
+}
+
+func TestSessionService_ForgetDuringLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAccessTokenRepository(ctrl)
+	svc := NewSessionService(repo, config.SecurityConfig{SessionCacheTTL: time.Minute})
+	ctx := t.Context()
+
+	session := entity.AccessToken{ID: 1, UserID: 2, Token: "jwt", ExpiresAt: time.Now().Add(time.Hour)}
+	// The session is revoked while its lookup is in flight, so what the
+	// lookup read must not be cached.
+	repo.EXPECT().FindActive(ctx, "jwt").DoAndReturn(func(_ any, _ string) (entity.AccessToken, error) {
+		svc.ForgetSession(1)
+		return session, nil
+	})
+	repo.EXPECT().Touch(ctx, int64(1), "").Return(nil)
+	_, err := svc.Authenticate(ctx, "jwt", "")
+	require.NoError(t, err)
+
+	repo.EXPECT().FindActive(ctx, "jwt").Return(entity.AccessToken{}, errors.New("no rows"))
+	_, err = svc.Authenticate(ctx, "jwt", "")
+	require.ErrorIs(t, err, ErrSessionInactive)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+)
+
+type RemoteSSHService interface {
+	SignKey(ctx context.Context, token string, req dto.SignSSHKey) (dto.SignedSSHKey, error)
+	CAPublicKey(ctx context.Context, token string) (string, error)
+}
+
+type remoteSSHService struct {
+	client pb.SSHServiceClient
+}
+
+func NewRemoteSSHService(client pb.SSHServiceClient) RemoteSSHService {
+	return &remoteSSHService{client: client}
+}
+
+func (s *remoteSSHService) SignKey(ctx context.Context, token string, sign dto.SignSSHKey) (dto.SignedSSHKey, error) {
+	req := &pbModel.SignSSHKeyRequest{}
+	req.SetRole(sign.Role)
+	req.SetPublicKey(sign.PublicKey)
+	req.SetPrincipals(sign.Principals)
+	req.SetTtlSeconds(int64(sign.TTL.Seconds()))
+	resp, err := s.client.SignKey(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.SignedSSHKey{}, fmt.Errorf("failed to sign key: %w", err)
+	}
+	return dto.SignedSSHKey{
+		ExpiresAt:   resp.GetExpiresAt().AsTime(),
+		Certificate: resp.GetCertificate(),
+		KeyID:       resp.GetKeyId(),
+		Principals:  resp.GetPrincipals(),
+		Serial:      resp.GetSerial(),
+	}, nil
+}
+
+func (s *remoteSSHService) CAPublicKey(ctx context.Context, token string) (string, error) {
+	resp, err := s.client.GetCAPublicKey(ctx, &pbModel.GetSSHCARequest{}, client.WithToken(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to get ca key: %w", err)
+	}
+	return resp.GetPublicKey(), nil
+}
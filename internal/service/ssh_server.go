@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHSignPathPrefix is the policy path of a role: users need create on
+// ssh/sign/<role> to get certificates from it.
+const SSHSignPathPrefix = "ssh/sign/"
+
+// SSHCAPath is where the HTTP server publishes the CA public key for
+// TrustedUserCAKeys.
+const SSHCAPath = "/ssh/ca.pub"
+
+const (
+	DefaultSSHRoleTTL = 8 * time.Hour
+	DefaultSSHRoleMax = 24 * time.Hour
+
+	sshMinRSABits = 2048
+)
+
+var (
+	ErrSSHNoCA                = errors.New("ssh ca is not configured")
+	ErrSSHCAExists            = errors.New("ssh ca already exists")
+	ErrUnknownSSHRole         = errors.New("unknown ssh role")
+	ErrSSHPrincipalNotAllowed = errors.New("principal is not allowed by the role")
+)
+
+var (
+	sshRoleNamePattern  = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	sshPrincipalPattern = regexp.MustCompile(`^[A-Za-z0-9_.@-]{1,64}$`)
+	// sshExtensions are the extensions OpenSSH understands in user
+	// certificates.
+	sshExtensions = []string{
+		"permit-X11-forwarding",
+		"permit-agent-forwarding",
+		"permit-port-forwarding",
+		"permit-pty",
+		"permit-user-rc",
+	}
+)
+
+// SSHService is the SSH user certificate engine. It keeps one CA key and signs
+// users' public keys for principals and lifetimes limited by roles.
+type SSHService interface {
+	GenerateCA(ctx context.Context, keyType string) (string, error)
+	// ImportCA stores an existing unencrypted OpenSSH or PEM private key.
+	ImportCA(ctx context.Context, keyPEM string) (string, error)
+	// CAPublicKey returns the CA key in authorized_keys format.
+	CAPublicKey(ctx context.Context) (string, error)
+	WriteRole(ctx context.Context, role *entity.SSHRole) error
+	ListRoles(ctx context.Context) ([]entity.SSHRole, error)
+	DeleteRole(ctx context.Context, name string) error
+	SignKey(ctx context.Context, userID int64, token string, req dto.SignSSHKey) (dto.SignedSSHKey, error)
+}
+
+type sshService struct {
+	repo          repository.SSHRepository
+	userRepo      repository.UserRepositoryInterface
+	policyService PolicyService
+	cryptoService CryptoService
+	now           func() time.Time
+}
+
+func NewSSHService(
+	repo repository.SSHRepository,
+	userRepo repository.UserRepositoryInterface,
+	policyService PolicyService,
+	cryptoService CryptoService,
+) SSHService {
+	return &sshService{
+		repo:          repo,
+		userRepo:      userRepo,
+		policyService: policyService,
+		cryptoService: cryptoService,
+		now:           time.Now,
+	}
+}
+
+// sshSigner wraps the CA key; RSA keys sign with SHA-512 since OpenSSH
+// rejects ssh-rsa (SHA-1) certificate signatures by default.
+func sshSigner(key crypto.Signer) (ssh.Signer, error) {
+	signer, err := ssh.NewSignerFromSigner(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ssh signer: %w", err)
+	}
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		algSigner, ok := signer.(ssh.AlgorithmSigner)
+		if !ok {
+			return nil, errors.New("rsa signer doesn't support sha-2")
+		}
+		signer, err = ssh.NewSignerWithAlgorithms(algSigner, []string{ssh.KeyAlgoRSASHA512})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ssh signer: %w", err)
+		}
+	}
+	return signer, nil
+}
+
+func (s *sshService) ensureNoCA(ctx context.Context) error {
+	_, err := s.repo.GetCA(ctx)
+	if err == nil {
+		return ErrSSHCAExists
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to check for a ca: %w", err)
+	}
+	return nil
+}
+
+func (s *sshService) storeCA(ctx context.Context, key crypto.Signer) (string, error) {
+	signer, err := sshSigner(key)
+	if err != nil {
+		return "", err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ca key: %w", err)
+	}
+	encrypted, err := s.cryptoService.Encode(der)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt ca key: %w", err)
+	}
+
+	ca := entity.SSHCA{
+		PublicKey:  strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey()))),
+		PrivateKey: encrypted,
+	}
+	if err := s.repo.CreateCA(ctx, &ca); err != nil {
+		return "", fmt.Errorf("failed to store ca: %w", err)
+	}
+	return ca.PublicKey, nil
+}
+
+func (s *sshService) GenerateCA(ctx context.Context, keyType string) (string, error) {
+	if err := s.ensureNoCA(ctx); err != nil {
+		return "", err
+	}
+	key, err := generatePKIKey(keyType)
+	if err != nil {
+		return "", err
+	}
+	return s.storeCA(ctx, key)
+}
+
+func (s *sshService) ImportCA(ctx context.Context, keyPEM string) (string, error) {
+	raw, err := ssh.ParseRawPrivateKey([]byte(keyPEM))
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		return "", errors.New("ca key is encrypted, remove the passphrase before importing")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to parse ca key: %w", err)
+	}
+	// The OpenSSH format yields a pointer for ed25519 keys.
+	if key, ok := raw.(*ed25519.PrivateKey); ok {
+		raw = *key
+	}
+	key, ok := raw.(crypto.Signer)
+	if !ok {
+		return "", errors.New("unsupported ca key type")
+	}
+	if err := s.ensureNoCA(ctx); err != nil {
+		return "", err
+	}
+	return s.storeCA(ctx, key)
+}
+
+func (s *sshService) CAPublicKey(ctx context.Context) (string, error) {
+	ca, err := s.repo.GetCA(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrSSHNoCA
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to load ca: %w", err)
+	}
+	return ca.PublicKey, nil
+}
+
+func (s *sshService) loadSigner(ctx context.Context) (ssh.Signer, error) {
+	ca, err := s.repo.GetCA(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSSHNoCA
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ca: %w", err)
+	}
+	der, err := s.cryptoService.Decode(ca.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt ca key: %w", err)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ca key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported ca key type")
+	}
+	return sshSigner(signer)
+}
+
+func validPrincipal(p string) bool {
+	return p == entity.SSHPrincipalLogin || sshPrincipalPattern.MatchString(p)
+}
+
+func (s *sshService) WriteRole(ctx context.Context, role *entity.SSHRole) error {
+	if !sshRoleNamePattern.MatchString(role.Name) {
+		return fmt.Errorf("invalid role name %q", role.Name)
+	}
+	if len(role.AllowedPrincipals) == 0 {
+		return errors.New("role must allow at least one principal")
+	}
+	for _, p := range role.AllowedPrincipals {
+		if p != "*" && !validPrincipal(p) {
+			return fmt.Errorf("invalid principal %q", p)
+		}
+	}
+	for _, p := range role.DefaultPrincipals {
+		if !validPrincipal(p) {
+			return fmt.Errorf("invalid principal %q", p)
+		}
+		if !slices.Contains(role.AllowedPrincipals, "*") && !slices.Contains(role.AllowedPrincipals, p) {
+			return fmt.Errorf("default principal %q isn't allowed by the role", p)
+		}
+	}
+	for _, ext := range role.Extensions {
+		if !slices.Contains(sshExtensions, ext) {
+			return fmt.Errorf("unknown extension %q, expected one of %s", ext, strings.Join(sshExtensions, ", "))
+		}
+	}
+	if role.DefaultTTL == 0 {
+		role.DefaultTTL = DefaultSSHRoleTTL
+	}
+	if role.MaxTTL == 0 {
+		role.MaxTTL = max(DefaultSSHRoleMax, role.DefaultTTL)
+	}
+	if role.DefaultTTL < time.Second || role.DefaultTTL > role.MaxTTL {
+		return fmt.Errorf("default ttl must be between 1s and the max ttl %s", role.MaxTTL)
+	}
+	if err := s.repo.SaveRole(ctx, role); err != nil {
+		return fmt.Errorf("failed to save role: %w", err)
+	}
+	return nil
+}
+
+func (s *sshService) ListRoles(ctx context.Context) ([]entity.SSHRole, error) {
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return roles, nil
+}
+
+func (s *sshService) DeleteRole(ctx context.Context, name string) error {
+	err := s.repo.DeleteRole(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrUnknownSSHRole
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return nil
+}
+
+// principals expands {{login}} and checks the requested principals against
+// the role, falling back to its defaults when none are requested.
+func principals(role entity.SSHRole, login string, requested []string) ([]string, error) {
+	expand := func(list []string) []string {
+		out := make([]string, 0, len(list))
+		for _, p := range list {
+			out = append(out, strings.ReplaceAll(p, entity.SSHPrincipalLogin, login))
+		}
+		return out
+	}
+	allowed := expand(role.AllowedPrincipals)
+	if len(requested) == 0 {
+		requested = expand(role.DefaultPrincipals)
+	}
+	if len(requested) == 0 {
+		return nil, errors.New("no principals requested and the role has no defaults")
+	}
+
+	var result []string
+	for _, p := range requested {
+		if !sshPrincipalPattern.MatchString(p) {
+			return nil, fmt.Errorf("invalid principal %q", p)
+		}
+		if !slices.Contains(allowed, "*") && !slices.Contains(allowed, p) {
+			return nil, fmt.Errorf("%w: %s", ErrSSHPrincipalNotAllowed, p)
+		}
+		if !slices.Contains(result, p) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func parseUserKey(publicKey string) (ssh.PublicKey, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	if _, ok := key.(*ssh.Certificate); ok {
+		return nil, errors.New("expected a public key, got a certificate")
+	}
+	if key.Type() == ssh.KeyAlgoDSA {
+		return nil, errors.New("dsa keys are not supported")
+	}
+	if cryptoKey, ok := key.(ssh.CryptoPublicKey); ok {
+		if rsaKey, ok := cryptoKey.CryptoPublicKey().(*rsa.PublicKey); ok && rsaKey.N.BitLen() < sshMinRSABits {
+			return nil, fmt.Errorf("rsa keys must have at least %d bits", sshMinRSABits)
+		}
+	}
+	return key, nil
+}
+
+// SignKey signs a user certificate. The key id names the keeper user and the
+// role so sshd logs show who a certificate was issued to.
+func (s *sshService) SignKey(
+	ctx context.Context,
+	userID int64,
+	token string,
+	req dto.SignSSHKey,
+) (dto.SignedSSHKey, error) {
+	role, err := s.repo.GetRole(ctx, req.Role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dto.SignedSSHKey{}, ErrUnknownSSHRole
+	}
+	if err != nil {
+		return dto.SignedSSHKey{}, fmt.Errorf("failed to find role: %w", err)
+	}
+	if err := s.policyService.Require(
+		ctx, userID, token, SSHSignPathPrefix+role.Name, entity.CapabilityCreate,
+	); err != nil {
+		return dto.SignedSSHKey{}, fmt.Errorf("no access to role %s: %w", role.Name, err)
+	}
+
+	key, err := parseUserKey(req.PublicKey)
+	if err != nil {
+		return dto.SignedSSHKey{}, err
+	}
+	login, err := s.userRepo.GetLoginByID(ctx, userID)
+	if err != nil {
+		return dto.SignedSSHKey{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	granted, err := principals(role, login, req.Principals)
+	if err != nil {
+		return dto.SignedSSHKey{}, err
+	}
+	ttl := req.TTL
+	switch {
+	case ttl < 0:
+		return dto.SignedSSHKey{}, errors.New("ttl can't be negative")
+	case ttl == 0:
+		ttl = role.DefaultTTL
+	case ttl > role.MaxTTL:
+		ttl = role.MaxTTL
+	}
+
+	signer, err := s.loadSigner(ctx)
+	if err != nil {
+		return dto.SignedSSHKey{}, err
+	}
+	var serial [8]byte
+	if _, err := rand.Read(serial[:]); err != nil {
+		return dto.SignedSSHKey{}, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	extensions := make(map[string]string, len(role.Extensions))
+	for _, ext := range role.Extensions {
+		extensions[ext] = ""
+	}
+	now := s.now()
+	cert := &ssh.Certificate{
+		Key:             key,
+		Serial:          binary.BigEndian.Uint64(serial[:]),
+		CertType:        ssh.UserCert,
+		KeyId:           fmt.Sprintf("keeper:%s:%s", login, role.Name),
+		ValidPrincipals: granted,
+		ValidAfter:      uint64(now.Add(-pkiBackdate).Unix()),
+		ValidBefore:     uint64(now.Add(ttl).Unix()),
+		Permissions:     ssh.Permissions{Extensions: extensions},
+	}
+	if err := cert.SignCert(rand.Reader, signer); err != nil {
+		return dto.SignedSSHKey{}, fmt.Errorf("failed to sign certificate: %w", err)
+	}
+	return dto.SignedSSHKey{
+		ExpiresAt:   time.Unix(int64(cert.ValidBefore), 0),
+		Certificate: string(ssh.MarshalAuthorizedKey(cert)),
+		KeyID:       cert.KeyId,
+		Principals:  granted,
+		Serial:      cert.Serial,
+	}, nil
+}
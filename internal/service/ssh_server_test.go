@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"keeper/internal/config"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+type sshFixture struct {
+	svc        *sshService
+	repo       *mocks.MockSSHRepository
+	userRepo   *mocks.MockUserRepositoryInterface
+	policyRepo *mocks.MockPolicyRepository
+	ca         *entity.SSHCA
+}
+
+func newSSHFixture(t *testing.T) *sshFixture {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	crypto, err := NewCryptoService(config.SecurityConfig{DataEncryptionKey: "6368616e676520746869732070617373"})
+	require.NoError(t, err)
+
+	f := &sshFixture{
+		repo:       mocks.NewMockSSHRepository(ctrl),
+		userRepo:   mocks.NewMockUserRepositoryInterface(ctrl),
+		policyRepo: mocks.NewMockPolicyRepository(ctrl),
+	}
+	svc := NewSSHService(f.repo, f.userRepo, NewPolicyService(f.policyRepo, nil, nil), crypto)
+	f.svc = svc.(*sshService)
+
+	f.repo.EXPECT().GetCA(gomock.Any()).AnyTimes().DoAndReturn(func(context.Context) (entity.SSHCA, error) {
+		if f.ca == nil {
+			return entity.SSHCA{}, pgx.ErrNoRows
+		}
+		return *f.ca, nil
+	})
+	f.repo.EXPECT().CreateCA(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, ca *entity.SSHCA) error {
+			f.ca = ca
+			return nil
+		})
+	return f
+}
+
+var devRole = entity.SSHRole{
+	Name:              "dev",
+	AllowedPrincipals: []string{entity.SSHPrincipalLogin, "deploy"},
+	DefaultPrincipals: []string{entity.SSHPrincipalLogin},
+	Extensions:        []string{"permit-pty"},
+	DefaultTTL:        time.Hour,
+	MaxTTL:            4 * time.Hour,
+}
+
+func (f *sshFixture) allowSign(ctx context.Context, role entity.SSHRole) {
+	f.repo.EXPECT().GetRole(ctx, role.Name).Return(role, nil)
+	f.policyRepo.EXPECT().ListForPrincipal(ctx, int64(7), "token").Return([]entity.Policy{
+		{Name: "ssh", Rules: []entity.PolicyRule{
+			{Path: "ssh/sign/*", Capabilities: []string{entity.CapabilityCreate}},
+		}},
+	}, nil)
+	f.userRepo.EXPECT().GetLoginByID(ctx, int64(7)).Return("alice", nil).AnyTimes()
+}
+
+func userPublicKey(t *testing.T) string {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return string(ssh.MarshalAuthorizedKey(key))
+}
+
+func parseSSHCert(t *testing.T, data string) *ssh.Certificate {
+	t.Helper()
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(data))
+	require.NoError(t, err)
+	cert, ok := key.(*ssh.Certificate)
+	require.True(t, ok)
+	return cert
+}
+
+func TestSSH_SignKey(t *testing.T) {
+	for _, keyType := range []string{entity.PKIKeyEd25519, entity.PKIKeyEC, entity.PKIKeyRSA} {
+		t.Run(keyType, func(t *testing.T) {
+			f := newSSHFixture(t)
+			ctx := t.Context()
+
+			caPub, err := f.svc.GenerateCA(ctx, keyType)
+			require.NoError(t, err)
+			caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(caPub))
+			require.NoError(t, err)
+
+			f.allowSign(ctx, devRole)
+			pubkey := userPublicKey(t)
+			signed, err := f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{
+				Role: "dev", PublicKey: pubkey, TTL: 24 * time.Hour,
+			})
+			require.NoError(t, err)
+
+			cert := parseSSHCert(t, signed.Certificate)
+			assert.Equal(t, uint32(ssh.UserCert), cert.CertType)
+			assert.Equal(t, []string{"alice"}, cert.ValidPrincipals)
+			assert.Equal(t, "keeper:alice:dev", cert.KeyId)
+			assert.Equal(t, map[string]string{"permit-pty": ""}, cert.Extensions)
+			assert.Equal(t, signed.Serial, cert.Serial)
+			// The requested validity is capped at the role's max.
+			assert.WithinDuration(t, time.Now().Add(4*time.Hour), signed.ExpiresAt, time.Minute)
+
+			checker := ssh.CertChecker{
+				IsUserAuthority: func(auth ssh.PublicKey) bool {
+					return string(auth.Marshal()) == string(caKey.Marshal())
+				},
+			}
+			require.NoError(t, checker.CheckCert("alice", cert))
+			assert.Error(t, checker.CheckCert("root", cert))
+			if keyType == entity.PKIKeyRSA {
+				assert.Equal(t, ssh.KeyAlgoRSASHA512, cert.Signature.Format)
+			}
+		})
+	}
+}
+
+func TestSSH_SignKeyPrincipals(t *testing.T) {
+	f := newSSHFixture(t)
+	ctx := t.Context()
+	_, err := f.svc.GenerateCA(ctx, entity.PKIKeyEd25519)
+	require.NoError(t, err)
+
+	f.allowSign(ctx, devRole)
+	signed, err := f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{
+		Role: "dev", PublicKey: userPublicKey(t), Principals: []string{"deploy", "alice", "deploy"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deploy", "alice"}, signed.Principals)
+
+	f.allowSign(ctx, devRole)
+	_, err = f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{
+		Role: "dev", PublicKey: userPublicKey(t), Principals: []string{"root"},
+	})
+	assert.ErrorIs(t, err, ErrSSHPrincipalNotAllowed)
+
+	open := devRole
+	open.AllowedPrincipals = []string{"*"}
+	open.DefaultPrincipals = nil
+	f.allowSign(ctx, open)
+	_, err = f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{Role: "dev", PublicKey: userPublicKey(t)})
+	assert.Error(t, err, "no principals and no defaults")
+}
+
+func TestSSH_SignKeyRejectsKeys(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	weakPub, err := ssh.NewPublicKey(&weak.PublicKey)
+	require.NoError(t, err)
+
+	for name, pubkey := range map[string]string{
+		"garbage":  "ssh-ed25519 AAAA",
+		"weak rsa": string(ssh.MarshalAuthorizedKey(weakPub)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := newSSHFixture(t)
+			ctx := t.Context()
+			f.allowSign(ctx, devRole)
+			_, err := f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{Role: "dev", PublicKey: pubkey})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSSH_SignKeyRequiresPolicy(t *testing.T) {
+	f := newSSHFixture(t)
+	ctx := t.Context()
+
+	f.repo.EXPECT().GetRole(ctx, "dev").Return(devRole, nil)
+	f.policyRepo.EXPECT().ListForPrincipal(ctx, int64(7), "token").Return(nil, nil)
+
+	_, err := f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{Role: "dev", PublicKey: userPublicKey(t)})
+	assert.ErrorIs(t, err, ErrPolicyDenied)
+}
+
+func TestSSH_SignKeyWithoutCA(t *testing.T) {
+	f := newSSHFixture(t)
+	ctx := t.Context()
+	f.allowSign(ctx, devRole)
+
+	_, err := f.svc.SignKey(ctx, 7, "token", dto.SignSSHKey{Role: "dev", PublicKey: userPublicKey(t)})
+	assert.ErrorIs(t, err, ErrSSHNoCA)
+}
+
+func TestSSH_ImportCA(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.NoError(t, err)
+
+	f := newSSHFixture(t)
+	ctx := t.Context()
+	pub, err := f.svc.ImportCA(ctx, string(pem.EncodeToMemory(block)))
+	require.NoError(t, err)
+
+	expected, err := ssh.NewPublicKey(priv.Public())
+	require.NoError(t, err)
+	got, _, _, _, err := ssh.ParseAuthorizedKey([]byte(pub))
+	require.NoError(t, err)
+	assert.Equal(t, expected.Marshal(), got.Marshal())
+
+	shown, err := f.svc.CAPublicKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, pub, shown)
+
+	_, err = f.svc.GenerateCA(ctx, entity.PKIKeyEd25519)
+	assert.ErrorIs(t, err, ErrSSHCAExists)
+
+	encrypted, err := ssh.MarshalPrivateKeyWithPassphrase(priv, "", []byte("secret"))
+	require.NoError(t, err)
+	_, err = newSSHFixture(t).svc.ImportCA(ctx, string(pem.EncodeToMemory(encrypted)))
+	assert.ErrorContains(t, err, "passphrase")
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	_, err = newSSHFixture(t).svc.ImportCA(ctx, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	require.NoError(t, err)
+}
+
+func TestSSH_WriteRoleValidation(t *testing.T) {
+	f := newSSHFixture(t)
+	ctx := t.Context()
+
+	for _, role := range []entity.SSHRole{
+		{Name: "bad name!", AllowedPrincipals: []string{"alice"}},
+		{Name: "dev"},
+		{Name: "dev", AllowedPrincipals: []string{"root user"}},
+		{Name: "dev", AllowedPrincipals: []string{"alice"}, DefaultPrincipals: []string{"bob"}},
+		{Name: "dev", AllowedPrincipals: []string{"alice"}, Extensions: []string{"permit-everything"}},
+		{Name: "dev", AllowedPrincipals: []string{"alice"}, DefaultTTL: 2 * time.Hour, MaxTTL: time.Hour},
+	} {
+		assert.Error(t, f.svc.WriteRole(ctx, &role), role)
+	}
+
+	f.repo.EXPECT().SaveRole(ctx, gomock.Any()).Return(nil)
+	role := entity.SSHRole{Name: "ops", AllowedPrincipals: []string{"*"}, DefaultPrincipals: []string{"{{login}}"}}
+	require.NoError(t, f.svc.WriteRole(ctx, &role))
+	assert.Equal(t, DefaultSSHRoleTTL, role.DefaultTTL)
+	assert.Equal(t, DefaultSSHRoleMax, role.MaxTTL)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+)
+
+type RemoteTeamService interface {
+	CreateTeam(ctx context.Context, token, team string) error
+	DeleteTeam(ctx context.Context, token, team string) error
+	AddMember(ctx context.Context, token, team, login, role string) error
+	RemoveMember(ctx context.Context, token, team, login string) error
+	ListTeams(ctx context.Context, token string) ([]dto.AgentTeam, error)
+	ListMembers(ctx context.Context, token, team string) ([]dto.AgentTeamMember, error)
+}
+
+type remoteTeamService struct {
+	client pb.TeamServiceClient
+}
+
+func NewRemoteTeamService(client pb.TeamServiceClient) RemoteTeamService {
+	return &remoteTeamService{client: client}
+}
+
+func newTeamRequest(team string) *pbModel.TeamRequest {
+	req := &pbModel.TeamRequest{}
+	req.SetTeam(team)
+	return req
+}
+
+func (s *remoteTeamService) CreateTeam(ctx context.Context, token, team string) error {
+	_, err := s.client.CreateTeam(ctx, newTeamRequest(team), client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to create team: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteTeamService) DeleteTeam(ctx context.Context, token, team string) error {
+	_, err := s.client.DeleteTeam(ctx, newTeamRequest(team), client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteTeamService) AddMember(ctx context.Context, token, team, login, role string) error {
+	req := &pbModel.TeamMemberRequest{}
+	req.SetTeam(team)
+	req.SetLogin(login)
+	req.SetRole(role)
+	_, err := s.client.AddMember(ctx, req, client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to add member: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteTeamService) RemoveMember(ctx context.Context, token, team, login string) error {
+	req := &pbModel.TeamMemberRequest{}
+	req.SetTeam(team)
+	req.SetLogin(login)
+	_, err := s.client.RemoveMember(ctx, req, client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteTeamService) ListTeams(ctx context.Context, token string) ([]dto.AgentTeam, error) {
+	req := &pbModel.ListTeamsRequest{}
+	resp, err := s.client.ListTeams(ctx, req, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	teams := make([]dto.AgentTeam, 0, len(resp.GetTeams()))
+	for _, t := range resp.GetTeams() {
+		teams = append(teams, dto.AgentTeam{
+			Name:      t.GetName(),
+			Role:      t.GetRole(),
+			CreatedAt: t.GetCreatedAt().AsTime(),
+		})
+	}
+	return teams, nil
+}
+
+func (s *remoteTeamService) ListMembers(ctx context.Context, token, team string) ([]dto.AgentTeamMember, error) {
+	resp, err := s.client.ListMembers(ctx, newTeamRequest(team), client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	members := make([]dto.AgentTeamMember, 0, len(resp.GetMembers()))
+	for _, m := range resp.GetMembers() {
+		members = append(members, dto.AgentTeamMember{
+			Login:     m.GetLogin(),
+			Role:      m.GetRole(),
+			CreatedAt: m.GetCreatedAt().AsTime(),
+		})
+	}
+	return members, nil
+}
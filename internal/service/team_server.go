@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"regexp"
+	"strings"
+)
+
+// TeamPathPrefix is the namespace under which team-owned secrets are stored:
+// team/<name>/<key>.
+const TeamPathPrefix = "team/"
+
+// minOwnersBeforeDemote counts the owner being demoted or removed plus one more.
+const minOwnersBeforeDemote = 2
+
+var teamNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,100}$`)
+
+type TeamService interface {
+	CreateTeam(ctx context.Context, userID int64, name string) (entity.Team, error)
+	DeleteTeam(ctx context.Context, userID int64, name string) error
+	AddMember(ctx context.Context, userID int64, team, login, role string) error
+	RemoveMember(ctx context.Context, userID int64, team, login string) error
+	ListTeams(ctx context.Context, userID int64) ([]entity.TeamMembership, error)
+	ListMembers(ctx context.Context, userID int64, team string) ([]entity.TeamMember, error)
+}
+
+type teamService struct {
+	teamRepo repository.TeamRepository
+	userRepo repository.UserRepositoryInterface
+}
+
+func NewTeamService(teamRepo repository.TeamRepository, userRepo repository.UserRepositoryInterface) TeamService {
+	return &teamService{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+	}
+}
+
+// teamFromPath extracts the team name from a team/<name>/<key> path.
+// ok is false for paths outside the team namespace.
+func teamFromPath(path string) (name string, ok bool, err error) {
+	rest, found := strings.CutPrefix(path, TeamPathPrefix)
+	if !found {
+		return "", false, nil
+	}
+	name, key, found := strings.Cut(rest, "/")
+	if !found || name == "" || key == "" {
+		return "", true, fmt.Errorf("invalid team path %q, expected %s<name>/<key>", path, TeamPathPrefix)
+	}
+	return name, true, nil
+}
+
+// roleAllows reports whether a team role permits the given access level.
+func roleAllows(role, access string) bool {
+	switch access {
+	case entity.AccessRead:
+		return role == entity.TeamRoleViewer || role == entity.TeamRoleEditor || role == entity.TeamRoleOwner
+	case entity.AccessWrite:
+		return role == entity.TeamRoleEditor || role == entity.TeamRoleOwner
+	default:
+		return role == entity.TeamRoleOwner
+	}
+}
+
+func (s *teamService) CreateTeam(ctx context.Context, userID int64, name string) (entity.Team, error) {
+	if !teamNamePattern.MatchString(name) {
+		return entity.Team{}, fmt.Errorf("invalid team name %q", name)
+	}
+	team, err := s.teamRepo.Create(ctx, name, userID)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("failed to create team: %w", err)
+	}
+	return team, nil
+}
+
+func (s *teamService) DeleteTeam(ctx context.Context, userID int64, name string) error {
+	team, err := s.requireRole(ctx, userID, name, entity.AccessManage)
+	if err != nil {
+		return err
+	}
+	if err := s.teamRepo.Delete(ctx, team.ID); err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+	return nil
+}
+
+func (s *teamService) AddMember(ctx context.Context, userID int64, teamName, login, role string) error {
+	if role != entity.TeamRoleOwner && role != entity.TeamRoleEditor && role != entity.TeamRoleViewer {
+		return fmt.Errorf("unknown team role %q", role)
+	}
+	team, err := s.requireRole(ctx, userID, teamName, entity.AccessManage)
+	if err != nil {
+		return err
+	}
+
+	memberID, err := s.userRepo.GetIDByLogin(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+	if memberID == userID && role != entity.TeamRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, team.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.teamRepo.SaveMember(ctx, team.ID, memberID, role); err != nil {
+		return fmt.Errorf("failed to add member: %w", err)
+	}
+	return nil
+}
+
+// RemoveMember lets owners remove anyone and any member remove themselves.
+func (s *teamService) RemoveMember(ctx context.Context, userID int64, teamName, login string) error {
+	memberID, err := s.userRepo.GetIDByLogin(ctx, login)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	required := entity.AccessManage
+	if memberID == userID {
+		required = entity.AccessRead
+	}
+	team, err := s.requireRole(ctx, userID, teamName, required)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.teamRepo.GetMember(ctx, team.ID, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to find member: %w", err)
+	}
+	if member.Role == entity.TeamRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, team.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.teamRepo.DeleteMember(ctx, team.ID, memberID); err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+	return nil
+}
+
+func (s *teamService) ListTeams(ctx context.Context, userID int64) ([]entity.TeamMembership, error) {
+	teams, err := s.teamRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	return teams, nil
+}
+
+func (s *teamService) ListMembers(ctx context.Context, userID int64, teamName string) ([]entity.TeamMember, error) {
+	team, err := s.requireRole(ctx, userID, teamName, entity.AccessRead)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.teamRepo.ListMembers(ctx, team.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+	return members, nil
+}
+
+func (s *teamService) requireRole(ctx context.Context, userID int64, teamName, access string) (entity.Team, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("failed to find team: %w", err)
+	}
+	member, err := s.teamRepo.GetMember(ctx, team.ID, userID)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("%w: not a member of team %s", ErrAccessDenied, teamName)
+	}
+	if !roleAllows(member.Role, access) {
+		return entity.Team{}, fmt.Errorf("%w: role %s in team %s", ErrAccessDenied, member.Role, teamName)
+	}
+	return team, nil
+}
+
+func (s *teamService) ensureAnotherOwner(ctx context.Context, teamID int64) error {
+	owners, err := s.teamRepo.CountOwners(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to count owners: %w", err)
+	}
+	if owners < minOwnersBeforeDemote {
+		return errors.New("team must keep at least one owner")
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"errors"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamFromPath(t *testing.T) {
+	name, ok, err := teamFromPath("team/ops/db/password")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "ops", name)
+
+	_, ok, err = teamFromPath("db/password")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = teamFromPath("team/ops")
+	assert.True(t, ok)
+	assert.Error(t, err)
+}
+
+func TestRoleAllows(t *testing.T) {
+	assert.True(t, roleAllows(entity.TeamRoleViewer, entity.AccessRead))
+	assert.False(t, roleAllows(entity.TeamRoleViewer, entity.AccessWrite))
+	assert.True(t, roleAllows(entity.TeamRoleEditor, entity.AccessWrite))
+	assert.False(t, roleAllows(entity.TeamRoleEditor, entity.AccessManage))
+	assert.True(t, roleAllows(entity.TeamRoleOwner, entity.AccessManage))
+}
+
+func TestTeamService_RemoveLastOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	userRepo := mocks.NewMockUserRepositoryInterface(ctrl)
+	svc := NewTeamService(teamRepo, userRepo)
+	ctx := t.Context()
+
+	owner := entity.TeamMember{TeamID: 7, UserID: 1, Role: entity.TeamRoleOwner}
+	userRepo.EXPECT().GetIDByLogin(ctx, "alice").Return(int64(1), nil)
+	teamRepo.EXPECT().GetByName(ctx, "ops").Return(entity.Team{ID: 7, Name: "ops"}, nil)
+	teamRepo.EXPECT().GetMember(ctx, int64(7), int64(1)).Return(owner, nil).Times(2)
+	teamRepo.EXPECT().CountOwners(ctx, int64(7)).Return(int64(1), nil)
+
+	err := svc.RemoveMember(ctx, 1, "ops", "alice")
+	require.ErrorContains(t, err, "at least one owner")
+}
+
+func TestVaultService_TeamAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockVaultRepositoryInterface(ctrl)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	svc := NewVaultService(repo, nil, teamRepo, nil, nil, nil)
+	ctx := t.Context()
+	team := entity.Team{ID: 7, Name: "ops"}
+
+	t.Run("viewer cannot delete", func(t *testing.T) {
+		teamRepo.EXPECT().GetByName(ctx, "ops").Return(team, nil)
+		teamRepo.EXPECT().
+			GetMember(ctx, int64(7), int64(2)).
+			Return(entity.TeamMember{Role: entity.TeamRoleViewer}, nil)
+
+		err := svc.DeleteSecret(ctx, 2, "", "team/ops/db")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("editor deletes in team namespace", func(t *testing.T) {
+		teamRepo.EXPECT().GetByName(ctx, "ops").Return(team, nil)
+		teamRepo.EXPECT().
+			GetMember(ctx, int64(7), int64(2)).
+			Return(entity.TeamMember{Role: entity.TeamRoleEditor}, nil)
+		repo.EXPECT().Delete(ctx, entity.SecretOwner{TeamID: 7}, "team/ops/db").Return(nil)
+
+		err := svc.DeleteSecret(ctx, 2, "", "team/ops/db")
+		require.NoError(t, err)
+	})
+
+	t.Run("non member is denied", func(t *testing.T) {
+		teamRepo.EXPECT().GetByName(ctx, "ops").Return(team, nil)
+		teamRepo.EXPECT().
+			GetMember(ctx, int64(7), int64(3)).
+			Return(entity.TeamMember{}, errors.New("no rows"))
+
+		_, err := svc.GetSecret(ctx, 3, "", "team/ops/db")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+}
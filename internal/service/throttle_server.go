@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/logger"
+	"keeper/internal/repository"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ThrottleAccountPrefix  = "account:"
+	ThrottleIPPrefix       = "ip:"
+	ThrottleRegisterPrefix = "register:"
+)
+
+var (
+	// ErrInvalidCredentials is the only error a caller sees for a wrong
+	// password or an unknown login, so logins can't be enumerated.
+	ErrInvalidCredentials = errors.New("invalid login or password")
+	ErrTooManyAttempts    = errors.New("too many attempts, try again later")
+	ErrRegistrationFailed = errors.New("registration failed")
+)
+
+// ThrottleService counts failed logins per account and per client IP and
+// locks a key out with exponential backoff, see config.LockoutConfig.
+type ThrottleService interface {
+	CheckLogin(ctx context.Context, login, ip string) error
+	LoginFailed(ctx context.Context, login, ip string) error
+	LoginSucceeded(ctx context.Context, login string) error
+	AllowRegister(ctx context.Context, ip string) error
+	ListLockouts(ctx context.Context, limit int) ([]entity.LockoutEvent, error)
+	Unlock(ctx context.Context, key string) error
+}
+
+type throttleService struct {
+	repo repository.ThrottleRepository
+	l    *logger.ZapLogger
+	now  func() time.Time
+	cfg  config.LockoutConfig
+}
+
+// NewThrottleService returns the login throttle. The logger may be nil when
+// the service is only used by admin commands.
+func NewThrottleService(
+	repo repository.ThrottleRepository,
+	cfg config.LockoutConfig,
+	l *logger.ZapLogger,
+) ThrottleService {
+	return &throttleService{
+		repo: repo,
+		cfg:  cfg,
+		l:    l,
+		now:  time.Now,
+	}
+}
+
+// CheckLogin fails with ErrTooManyAttempts while the account or the address
+// is locked. It runs before the password is checked.
+func (s *throttleService) CheckLogin(ctx context.Context, login, ip string) error {
+	now := s.now().UTC()
+	for _, key := range loginKeys(login, ip) {
+		state, err := s.repo.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to check lockout: %w", err)
+		}
+		if state.LockedUntil != nil && now.Before(*state.LockedUntil) {
+			return ErrTooManyAttempts
+		}
+	}
+	return nil
+}
+
+func (s *throttleService) LoginFailed(ctx context.Context, login, ip string) error {
+	thresholds := map[string]int{
+		ThrottleAccountPrefix + login: s.cfg.AccountThreshold,
+		ThrottleIPPrefix + ip:         s.cfg.IPThreshold,
+	}
+	for _, key := range loginKeys(login, ip) {
+		if _, err := s.recordFailure(ctx, key, ip, thresholds[key], s.cfg.Window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoginSucceeded clears the account counter. The address counter is kept so
+// an attacker with one valid account can't use it to reset their budget.
+func (s *throttleService) LoginSucceeded(ctx context.Context, login string) error {
+	if err := s.repo.Reset(ctx, ThrottleAccountPrefix+login); err != nil {
+		return fmt.Errorf("failed to reset lockout: %w", err)
+	}
+	return nil
+}
+
+// AllowRegister counts every registration attempt from ip, successful or
+// not, and refuses more than RegisterPerIP per RegisterWindow.
+func (s *throttleService) AllowRegister(ctx context.Context, ip string) error {
+	if ip == "" {
+		return nil
+	}
+	key := ThrottleRegisterPrefix + ip
+	state, err := s.repo.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to check registration limit: %w", err)
+	}
+	if state.LockedUntil != nil && s.now().UTC().Before(*state.LockedUntil) {
+		return ErrTooManyAttempts
+	}
+
+	locked, err := s.recordFailure(ctx, key, ip, s.cfg.RegisterPerIP+1, s.cfg.RegisterWindow)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return ErrTooManyAttempts
+	}
+	return nil
+}
+
+func (s *throttleService) ListLockouts(ctx context.Context, limit int) ([]entity.LockoutEvent, error) {
+	events, err := s.repo.ListLockouts(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lockouts: %w", err)
+	}
+	return events, nil
+}
+
+func (s *throttleService) Unlock(ctx context.Context, key string) error {
+	if err := s.repo.Reset(ctx, key); err != nil {
+		return fmt.Errorf("failed to unlock %s: %w", key, err)
+	}
+	return nil
+}
+
+// recordFailure locks key once it reaches threshold failures within window
+// and reports whether it did. Each failure past the threshold doubles the
+// lock, up to MaxLockout.
+func (s *throttleService) recordFailure(
+	ctx context.Context,
+	key, ip string,
+	threshold int,
+	window time.Duration,
+) (bool, error) {
+	now := s.now().UTC()
+	state, err := s.repo.RecordFailure(ctx, key, now, now.Add(-window))
+	if err != nil {
+		return false, fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+	if threshold <= 0 || state.Failures < threshold {
+		return false, nil
+	}
+
+	event := entity.LockoutEvent{
+		Key:         key,
+		Failures:    state.Failures,
+		ClientIP:    ip,
+		LockedUntil: now.Add(s.lockoutFor(state.Failures - threshold)),
+		CreatedAt:   now,
+	}
+	if err := s.repo.Lock(ctx, event); err != nil {
+		return false, fmt.Errorf("failed to lock out %s: %w", key, err)
+	}
+	if s.l != nil {
+		s.l.InfoCtx(ctx, "authentication locked out",
+			zap.String("key", key),
+			zap.Int("failures", state.Failures),
+			zap.String("client_ip", ip),
+			zap.Time("locked_until", event.LockedUntil))
+	}
+	return true, nil
+}
+
+func (s *throttleService) lockoutFor(extraFailures int) time.Duration {
+	lockout := s.cfg.BaseLockout
+	for range extraFailures {
+		lockout *= 2
+		if lockout >= s.cfg.MaxLockout {
+			return s.cfg.MaxLockout
+		}
+	}
+	return min(lockout, s.cfg.MaxLockout)
+}
+
+func loginKeys(login, ip string) []string {
+	keys := []string{ThrottleAccountPrefix + login}
+	if ip != "" {
+		keys = append(keys, ThrottleIPPrefix+ip)
+	}
+	return keys
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLockoutConfig() config.LockoutConfig {
+	return config.LockoutConfig{
+		Window:           15 * time.Minute,
+		BaseLockout:      time.Minute,
+		MaxLockout:       time.Hour,
+		RegisterWindow:   time.Hour,
+		AccountThreshold: 5,
+		IPThreshold:      20,
+		RegisterPerIP:    2,
+	}
+}
+
+func TestThrottleService_LockoutBackoff(t *testing.T) {
+	svc := &throttleService{cfg: testLockoutConfig()}
+
+	assert.Equal(t, time.Minute, svc.lockoutFor(0))
+	assert.Equal(t, 2*time.Minute, svc.lockoutFor(1))
+	assert.Equal(t, 32*time.Minute, svc.lockoutFor(5))
+	assert.Equal(t, time.Hour, svc.lockoutFor(6))
+	assert.Equal(t, time.Hour, svc.lockoutFor(100))
+}
+
+func TestThrottleService_LoginFailedLocksAccountAtThreshold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockThrottleRepository(ctrl)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &throttleService{repo: repo, cfg: testLockoutConfig(), now: func() time.Time { return now }}
+	ctx := t.Context()
+
+	windowStart := now.Add(-15 * time.Minute)
+	repo.EXPECT().RecordFailure(ctx, "account:alice", now, windowStart).
+		Return(entity.AuthThrottle{Key: "account:alice", Failures: 6}, nil)
+	repo.EXPECT().RecordFailure(ctx, "ip:10.0.0.1", now, windowStart).
+		Return(entity.AuthThrottle{Key: "ip:10.0.0.1", Failures: 6}, nil)
+	repo.EXPECT().Lock(ctx, entity.LockoutEvent{
+		Key:         "account:alice",
+		Failures:    6,
+		ClientIP:    "10.0.0.1",
+		LockedUntil: now.Add(2 * time.Minute),
+		CreatedAt:   now,
+	}).Return(nil)
+
+	require.NoError(t, svc.LoginFailed(ctx, "alice", "10.0.0.1"))
+}
+
+func TestThrottleService_CheckLoginRejectsLockedKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockThrottleRepository(ctrl)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &throttleService{repo: repo, cfg: testLockoutConfig(), now: func() time.Time { return now }}
+	ctx := t.Context()
+
+	until := now.Add(time.Minute)
+	repo.EXPECT().Get(ctx, "account:alice").Return(entity.AuthThrottle{}, nil)
+	repo.EXPECT().Get(ctx, "ip:10.0.0.1").Return(entity.AuthThrottle{LockedUntil: &until}, nil)
+
+	require.ErrorIs(t, svc.CheckLogin(ctx, "alice", "10.0.0.1"), ErrTooManyAttempts)
+
+	expired := now.Add(-time.Second)
+	repo.EXPECT().Get(ctx, "account:alice").Return(entity.AuthThrottle{LockedUntil: &expired}, nil)
+	repo.EXPECT().Get(ctx, "ip:10.0.0.1").Return(entity.AuthThrottle{}, nil)
+
+	require.NoError(t, svc.CheckLogin(ctx, "alice", "10.0.0.1"))
+}
+
+func TestThrottleService_AllowRegisterLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockThrottleRepository(ctrl)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := &throttleService{repo: repo, cfg: testLockoutConfig(), now: func() time.Time { return now }}
+	ctx := t.Context()
+
+	windowStart := now.Add(-time.Hour)
+	repo.EXPECT().Get(ctx, "register:10.0.0.1").Return(entity.AuthThrottle{}, nil).Times(2)
+	gomock.InOrder(
+		repo.EXPECT().RecordFailure(ctx, "register:10.0.0.1", now, windowStart).
+			Return(entity.AuthThrottle{Failures: 2}, nil),
+		repo.EXPECT().RecordFailure(ctx, "register:10.0.0.1", now, windowStart).
+			Return(entity.AuthThrottle{Failures: 3}, nil),
+	)
+	repo.EXPECT().Lock(ctx, gomock.Any()).Return(nil)
+
+	require.NoError(t, svc.AllowRegister(ctx, "10.0.0.1"))
+	require.ErrorIs(t, svc.AllowRegister(ctx, "10.0.0.1"), ErrTooManyAttempts)
+}
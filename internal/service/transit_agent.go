@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+)
+
+type RemoteTransitService interface {
+	CreateKey(ctx context.Context, token string, ref dto.TransitKeyRef, keyType string) (dto.TransitKey, error)
+	RotateKey(ctx context.Context, token string, ref dto.TransitKeyRef) (dto.TransitKey, error)
+	ListKeys(ctx context.Context, token string) ([]dto.TransitKey, error)
+	Encrypt(ctx context.Context, token string, ref dto.TransitKeyRef, plaintext []byte) (string, error)
+	Decrypt(ctx context.Context, token string, ref dto.TransitKeyRef, ciphertext string) ([]byte, error)
+}
+
+type remoteTransitService struct {
+	client pb.TransitServiceClient
+}
+
+func NewRemoteTransitService(client pb.TransitServiceClient) RemoteTransitService {
+	return &remoteTransitService{client: client}
+}
+
+func transitKeyFromProto(key *pbModel.TransitKey) dto.TransitKey {
+	return dto.TransitKey{
+		Name:          key.GetName(),
+		Type:          key.GetType(),
+		Owner:         key.GetOwner(),
+		LatestVersion: int(key.GetLatestVersion()),
+		UpdatedAt:     key.GetUpdatedAt().AsTime(),
+	}
+}
+
+func (s *remoteTransitService) CreateKey(
+	ctx context.Context,
+	token string,
+	ref dto.TransitKeyRef,
+	keyType string,
+) (dto.TransitKey, error) {
+	req := &pbModel.CreateTransitKeyRequest{}
+	req.SetName(ref.Name)
+	req.SetTeam(ref.Team)
+	req.SetType(keyType)
+	resp, err := s.client.CreateKey(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.TransitKey{}, fmt.Errorf("failed to create transit key: %w", err)
+	}
+	return transitKeyFromProto(resp), nil
+}
+
+func (s *remoteTransitService) RotateKey(ctx context.Context, token string, ref dto.TransitKeyRef) (dto.TransitKey, error) {
+	req := &pbModel.RotateTransitKeyRequest{}
+	req.SetName(ref.Name)
+	req.SetTeam(ref.Team)
+	resp, err := s.client.RotateKey(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.TransitKey{}, fmt.Errorf("failed to rotate transit key: %w", err)
+	}
+	return transitKeyFromProto(resp), nil
+}
+
+func (s *remoteTransitService) ListKeys(ctx context.Context, token string) ([]dto.TransitKey, error) {
+	resp, err := s.client.ListKeys(ctx, &pbModel.ListTransitKeysRequest{}, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transit keys: %w", err)
+	}
+	keys := make([]dto.TransitKey, 0, len(resp.GetKeys()))
+	for _, k := range resp.GetKeys() {
+		keys = append(keys, transitKeyFromProto(k))
+	}
+	return keys, nil
+}
+
+func (s *remoteTransitService) Encrypt(
+	ctx context.Context,
+	token string,
+	ref dto.TransitKeyRef,
+	plaintext []byte,
+) (string, error) {
+	req := &pbModel.EncryptRequest{}
+	req.SetName(ref.Name)
+	req.SetTeam(ref.Team)
+	req.SetPlaintext(plaintext)
+	resp, err := s.client.Encrypt(ctx, req, client.WithToken(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt: %w", err)
+	}
+	return resp.GetCiphertext(), nil
+}
+
+func (s *remoteTransitService) Decrypt(
+	ctx context.Context,
+	token string,
+	ref dto.TransitKeyRef,
+	ciphertext string,
+) ([]byte, error) {
+	req := &pbModel.DecryptRequest{}
+	req.SetName(ref.Name)
+	req.SetTeam(ref.Team)
+	req.SetCiphertext(ciphertext)
+	resp, err := s.client.Decrypt(ctx, req, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return resp.GetPlaintext(), nil
+}
@@ -0,0 +1,424 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/security"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// TransitPathPrefix is the path API key scopes are matched against:
+// transit/<key> for personal keys and transit/team/<team>/<key> for team keys.
+const TransitPathPrefix = "transit/"
+
+// transitPrefix starts every ciphertext, signature and HMAC, followed by the
+// key version: keeper:v<version>:<base64>.
+const transitPrefix = "keeper"
+
+const transitKeyBytes = 32
+
+var (
+	ErrTransitKeyNotFound  = errors.New("transit key not found")
+	ErrTransitKeyExists    = errors.New("transit key already exists")
+	ErrTransitKeyType      = errors.New("operation is not supported by the key type")
+	ErrInvalidTransitValue = errors.New("invalid transit value, expected keeper:v<version>:<base64>")
+)
+
+var transitKeyNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,100}$`)
+
+// TransitService is encryption as a service: applications send data to be
+// encrypted, signed or hashed with named keys that never leave the server.
+// Members of a team can use its keys; editors and owners create and rotate
+// them.
+type TransitService interface {
+	CreateKey(ctx context.Context, userID int64, ref dto.TransitKeyRef, keyType string) (entity.TransitKey, error)
+	RotateKey(ctx context.Context, userID int64, ref dto.TransitKeyRef) (entity.TransitKey, error)
+	ListKeys(ctx context.Context, userID int64) ([]entity.TransitKey, error)
+	Encrypt(ctx context.Context, userID int64, ref dto.TransitKeyRef, plaintext []byte) (string, error)
+	Decrypt(ctx context.Context, userID int64, ref dto.TransitKeyRef, ciphertext string) ([]byte, error)
+	// Rewrap re-encrypts a ciphertext with the latest key version without
+	// revealing the plaintext to the caller.
+	Rewrap(ctx context.Context, userID int64, ref dto.TransitKeyRef, ciphertext string) (string, error)
+	Sign(ctx context.Context, userID int64, ref dto.TransitKeyRef, input []byte) (string, error)
+	Verify(ctx context.Context, userID int64, ref dto.TransitKeyRef, input []byte, signature string) (bool, error)
+	HMAC(ctx context.Context, userID int64, ref dto.TransitKeyRef, input []byte) (string, error)
+}
+
+type transitService struct {
+	repo          repository.TransitKeyRepository
+	teamRepo      repository.TeamRepository
+	cryptoService CryptoService
+}
+
+func NewTransitService(
+	repo repository.TransitKeyRepository,
+	teamRepo repository.TeamRepository,
+	cryptoService CryptoService,
+) TransitService {
+	return &transitService{
+		repo:          repo,
+		teamRepo:      teamRepo,
+		cryptoService: cryptoService,
+	}
+}
+
+// TransitKeyPath is the scope path of a key reference.
+func TransitKeyPath(ref dto.TransitKeyRef) string {
+	if ref.Team != "" {
+		return TransitPathPrefix + TeamPathPrefix + ref.Team + "/" + ref.Name
+	}
+	return TransitPathPrefix + ref.Name
+}
+
+func encodeTransitValue(version int, raw []byte) string {
+	return fmt.Sprintf("%s:v%d:%s", transitPrefix, version, base64.StdEncoding.EncodeToString(raw))
+}
+
+func decodeTransitValue(value string) (int, []byte, error) {
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 || parts[0] != transitPrefix || !strings.HasPrefix(parts[1], "v") {
+		return 0, nil, ErrInvalidTransitValue
+	}
+	version, err := strconv.Atoi(parts[1][1:])
+	if err != nil || version < 1 {
+		return 0, nil, ErrInvalidTransitValue
+	}
+	raw, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return 0, nil, ErrInvalidTransitValue
+	}
+	return version, raw, nil
+}
+
+// resolve finds the referenced key and checks that userID has access to it.
+func (s *transitService) resolve(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+	access string,
+) (entity.TransitKey, error) {
+	var (
+		key entity.TransitKey
+		err error
+	)
+	if ref.Team == "" {
+		key, err = s.repo.GetUserKey(ctx, userID, ref.Name)
+	} else {
+		var team entity.Team
+		team, err = s.requireTeamAccess(ctx, userID, ref.Team, access)
+		if err != nil {
+			return entity.TransitKey{}, err
+		}
+		key, err = s.repo.GetTeamKey(ctx, team.ID, ref.Name)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.TransitKey{}, fmt.Errorf("%w: %s", ErrTransitKeyNotFound, TransitKeyPath(ref))
+	}
+	if err != nil {
+		return entity.TransitKey{}, fmt.Errorf("failed to find transit key: %w", err)
+	}
+	return key, nil
+}
+
+func (s *transitService) requireTeamAccess(ctx context.Context, userID int64, teamName, access string) (entity.Team, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("failed to find team: %w", err)
+	}
+	member, err := s.teamRepo.GetMember(ctx, team.ID, userID)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("%w: not a member of team %s", ErrAccessDenied, teamName)
+	}
+	if !roleAllows(member.Role, access) {
+		return entity.Team{}, fmt.Errorf("%w: role %s in team %s", ErrAccessDenied, member.Role, teamName)
+	}
+	return team, nil
+}
+
+// newVersion generates the material of a key version, encrypted with the
+// data key.
+func (s *transitService) newVersion(keyType string) (entity.TransitKeyVersion, error) {
+	var material, public []byte
+	switch keyType {
+	case entity.TransitKeyAES256GCM:
+		material = make([]byte, transitKeyBytes)
+		if _, err := rand.Read(material); err != nil {
+			return entity.TransitKeyVersion{}, fmt.Errorf("failed to generate key: %w", err)
+		}
+	case entity.TransitKeyEd25519:
+		var err error
+		material, public, err = security.GenerateEd25519Key()
+		if err != nil {
+			return entity.TransitKeyVersion{}, fmt.Errorf("failed to generate key: %w", err)
+		}
+	default:
+		return entity.TransitKeyVersion{}, fmt.Errorf("unknown key type %q, expected %s or %s",
+			keyType, entity.TransitKeyAES256GCM, entity.TransitKeyEd25519)
+	}
+	hmacKey := make([]byte, transitKeyBytes)
+	if _, err := rand.Read(hmacKey); err != nil {
+		return entity.TransitKeyVersion{}, fmt.Errorf("failed to generate hmac key: %w", err)
+	}
+
+	encMaterial, err := s.cryptoService.Encode(material)
+	if err != nil {
+		return entity.TransitKeyVersion{}, fmt.Errorf("failed to encrypt key: %w", err)
+	}
+	encHMACKey, err := s.cryptoService.Encode(hmacKey)
+	if err != nil {
+		return entity.TransitKeyVersion{}, fmt.Errorf("failed to encrypt hmac key: %w", err)
+	}
+	return entity.TransitKeyVersion{Material: encMaterial, HMACKey: encHMACKey, PublicKey: public}, nil
+}
+
+// material returns the decrypted key and hmac key of a version. Version zero
+// selects the latest one.
+func (s *transitService) material(
+	ctx context.Context,
+	key entity.TransitKey,
+	version int,
+) (material, hmacKey, publicKey []byte, err error) {
+	if version == 0 {
+		version = key.LatestVersion
+	}
+	if version > key.LatestVersion {
+		return nil, nil, nil, fmt.Errorf("%w: key %s has no version %d", ErrInvalidTransitValue, key.Name, version)
+	}
+	v, err := s.repo.GetVersion(ctx, key.ID, version)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load key version: %w", err)
+	}
+	material, err = s.cryptoService.Decode(v.Material)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decrypt key: %w", err)
+	}
+	hmacKey, err = s.cryptoService.Decode(v.HMACKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decrypt hmac key: %w", err)
+	}
+	return material, hmacKey, v.PublicKey, nil
+}
+
+func (s *transitService) CreateKey(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+	keyType string,
+) (entity.TransitKey, error) {
+	if !transitKeyNamePattern.MatchString(ref.Name) {
+		return entity.TransitKey{}, fmt.Errorf("invalid transit key name %q", ref.Name)
+	}
+	if keyType == "" {
+		keyType = entity.TransitKeyAES256GCM
+	}
+
+	key := entity.TransitKey{Name: ref.Name, Type: keyType}
+	if ref.Team == "" {
+		key.OwnerUserID = &userID
+		if _, err := s.repo.GetUserKey(ctx, userID, ref.Name); err == nil {
+			return entity.TransitKey{}, fmt.Errorf("%w: %s", ErrTransitKeyExists, TransitKeyPath(ref))
+		}
+	} else {
+		team, err := s.requireTeamAccess(ctx, userID, ref.Team, entity.AccessWrite)
+		if err != nil {
+			return entity.TransitKey{}, err
+		}
+		key.OwnerTeamID = &team.ID
+		key.OwnerTeam = team.Name
+		if _, err := s.repo.GetTeamKey(ctx, team.ID, ref.Name); err == nil {
+			return entity.TransitKey{}, fmt.Errorf("%w: %s", ErrTransitKeyExists, TransitKeyPath(ref))
+		}
+	}
+
+	version, err := s.newVersion(keyType)
+	if err != nil {
+		return entity.TransitKey{}, err
+	}
+	if err := s.repo.Create(ctx, &key, &version); err != nil {
+		return entity.TransitKey{}, fmt.Errorf("failed to create transit key: %w", err)
+	}
+	return key, nil
+}
+
+func (s *transitService) RotateKey(ctx context.Context, userID int64, ref dto.TransitKeyRef) (entity.TransitKey, error) {
+	key, err := s.resolve(ctx, userID, ref, entity.AccessWrite)
+	if err != nil {
+		return entity.TransitKey{}, err
+	}
+	version, err := s.newVersion(key.Type)
+	if err != nil {
+		return entity.TransitKey{}, err
+	}
+	if err := s.repo.Rotate(ctx, key.ID, &version); err != nil {
+		return entity.TransitKey{}, fmt.Errorf("failed to rotate transit key: %w", err)
+	}
+	key.LatestVersion = version.Version
+	return key, nil
+}
+
+func (s *transitService) ListKeys(ctx context.Context, userID int64) ([]entity.TransitKey, error) {
+	keys, err := s.repo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transit keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *transitService) encrypt(ctx context.Context, key entity.TransitKey, plaintext []byte) (string, error) {
+	material, _, _, err := s.material(ctx, key, 0)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := security.EncryptAESGCM(plaintext, material)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt: %w", err)
+	}
+	return encodeTransitValue(key.LatestVersion, ciphertext), nil
+}
+
+func (s *transitService) decrypt(ctx context.Context, key entity.TransitKey, ciphertext string) ([]byte, error) {
+	version, raw, err := decodeTransitValue(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	material, _, _, err := s.material(ctx, key, version)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := security.DecryptAESGCM(material, raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+// encryptionKey resolves a key usable for Encrypt, Decrypt and Rewrap.
+func (s *transitService) encryptionKey(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+) (entity.TransitKey, error) {
+	key, err := s.resolve(ctx, userID, ref, entity.AccessRead)
+	if err != nil {
+		return entity.TransitKey{}, err
+	}
+	if key.Type != entity.TransitKeyAES256GCM {
+		return entity.TransitKey{}, fmt.Errorf("%w: %s can't encrypt", ErrTransitKeyType, key.Type)
+	}
+	return key, nil
+}
+
+func (s *transitService) Encrypt(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+	plaintext []byte,
+) (string, error) {
+	key, err := s.encryptionKey(ctx, userID, ref)
+	if err != nil {
+		return "", err
+	}
+	return s.encrypt(ctx, key, plaintext)
+}
+
+func (s *transitService) Decrypt(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+	ciphertext string,
+) ([]byte, error) {
+	key, err := s.encryptionKey(ctx, userID, ref)
+	if err != nil {
+		return nil, err
+	}
+	return s.decrypt(ctx, key, ciphertext)
+}
+
+func (s *transitService) Rewrap(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+	ciphertext string,
+) (string, error) {
+	key, err := s.encryptionKey(ctx, userID, ref)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := s.decrypt(ctx, key, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return s.encrypt(ctx, key, plaintext)
+}
+
+func (s *transitService) signingKey(ctx context.Context, userID int64, ref dto.TransitKeyRef) (entity.TransitKey, error) {
+	key, err := s.resolve(ctx, userID, ref, entity.AccessRead)
+	if err != nil {
+		return entity.TransitKey{}, err
+	}
+	if key.Type != entity.TransitKeyEd25519 {
+		return entity.TransitKey{}, fmt.Errorf("%w: %s can't sign", ErrTransitKeyType, key.Type)
+	}
+	return key, nil
+}
+
+func (s *transitService) Sign(ctx context.Context, userID int64, ref dto.TransitKeyRef, input []byte) (string, error) {
+	key, err := s.signingKey(ctx, userID, ref)
+	if err != nil {
+		return "", err
+	}
+	seed, _, _, err := s.material(ctx, key, 0)
+	if err != nil {
+		return "", err
+	}
+	signature, err := security.SignEd25519(seed, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign: %w", err)
+	}
+	return encodeTransitValue(key.LatestVersion, signature), nil
+}
+
+func (s *transitService) Verify(
+	ctx context.Context,
+	userID int64,
+	ref dto.TransitKeyRef,
+	input []byte,
+	signature string,
+) (bool, error) {
+	key, err := s.signingKey(ctx, userID, ref)
+	if err != nil {
+		return false, err
+	}
+	version, raw, err := decodeTransitValue(signature)
+	if err != nil {
+		return false, err
+	}
+	_, _, public, err := s.material(ctx, key, version)
+	if err != nil {
+		return false, err
+	}
+	return security.VerifyEd25519(public, input, raw), nil
+}
+
+// HMAC works with keys of any type; every version has its own hmac key.
+func (s *transitService) HMAC(ctx context.Context, userID int64, ref dto.TransitKeyRef, input []byte) (string, error) {
+	key, err := s.resolve(ctx, userID, ref, entity.AccessRead)
+	if err != nil {
+		return "", err
+	}
+	_, hmacKey, _, err := s.material(ctx, key, 0)
+	if err != nil {
+		return "", err
+	}
+	return encodeTransitValue(key.LatestVersion, security.HMACSHA256(hmacKey, input)), nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"keeper/internal/config"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTransitKeyStore backs a mock repository with an in-memory keyring of
+// personal keys.
+func newTransitKeyStore(ctrl *gomock.Controller) *mocks.MockTransitKeyRepository {
+	repo := mocks.NewMockTransitKeyRepository(ctrl)
+	keys := map[string]*entity.TransitKey{}
+	versions := map[int64][]entity.TransitKeyVersion{}
+
+	repo.EXPECT().GetUserKey(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, _ int64, name string) (entity.TransitKey, error) {
+			if k, ok := keys[name]; ok {
+				return *k, nil
+			}
+			return entity.TransitKey{}, pgx.ErrNoRows
+		})
+	repo.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, k *entity.TransitKey, v *entity.TransitKeyVersion) error {
+			k.ID = int64(len(keys) + 1)
+			k.LatestVersion = 1
+			v.KeyID, v.Version = k.ID, 1
+			stored := *k
+			keys[k.Name] = &stored
+			versions[k.ID] = []entity.TransitKeyVersion{*v}
+			return nil
+		})
+	repo.EXPECT().Rotate(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, keyID int64, v *entity.TransitKeyVersion) error {
+			for _, k := range keys {
+				if k.ID == keyID {
+					k.LatestVersion++
+					v.KeyID, v.Version = keyID, k.LatestVersion
+				}
+			}
+			versions[keyID] = append(versions[keyID], *v)
+			return nil
+		})
+	repo.EXPECT().GetVersion(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, keyID int64, version int) (entity.TransitKeyVersion, error) {
+			return versions[keyID][version-1], nil
+		})
+	return repo
+}
+
+func newTestTransitService(t *testing.T) (TransitService, *mocks.MockTeamRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	crypto, err := NewCryptoService(config.SecurityConfig{DataEncryptionKey: "6368616e676520746869732070617373"})
+	require.NoError(t, err)
+	teamRepo := mocks.NewMockTeamRepository(ctrl)
+	return NewTransitService(newTransitKeyStore(ctrl), teamRepo, crypto), teamRepo
+}
+
+func TestTransitService_EncryptAcrossRotation(t *testing.T) {
+	svc, _ := newTestTransitService(t)
+	ctx := t.Context()
+	ref := dto.TransitKeyRef{Name: "orders"}
+
+	_, err := svc.CreateKey(ctx, 1, ref, "")
+	require.NoError(t, err)
+	_, err = svc.CreateKey(ctx, 1, ref, "")
+	require.ErrorIs(t, err, ErrTransitKeyExists)
+
+	v1, err := svc.Encrypt(ctx, 1, ref, []byte("4111 1111"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(v1, "keeper:v1:"))
+
+	key, err := svc.RotateKey(ctx, 1, ref)
+	require.NoError(t, err)
+	assert.Equal(t, 2, key.LatestVersion)
+
+	plaintext, err := svc.Decrypt(ctx, 1, ref, v1)
+	require.NoError(t, err)
+	assert.Equal(t, "4111 1111", string(plaintext))
+
+	v2, err := svc.Rewrap(ctx, 1, ref, v1)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(v2, "keeper:v2:"))
+	plaintext, err = svc.Decrypt(ctx, 1, ref, v2)
+	require.NoError(t, err)
+	assert.Equal(t, "4111 1111", string(plaintext))
+
+	_, err = svc.Decrypt(ctx, 1, ref, "keeper:v3:AAAA")
+	require.ErrorIs(t, err, ErrInvalidTransitValue)
+	_, err = svc.Decrypt(ctx, 1, ref, "vault:v1:AAAA")
+	require.ErrorIs(t, err, ErrInvalidTransitValue)
+}
+
+func TestTransitService_SignVerify(t *testing.T) {
+	svc, _ := newTestTransitService(t)
+	ctx := t.Context()
+	ref := dto.TransitKeyRef{Name: "release"}
+
+	_, err := svc.CreateKey(ctx, 1, ref, entity.TransitKeyEd25519)
+	require.NoError(t, err)
+
+	signature, err := svc.Sign(ctx, 1, ref, []byte("v1.0.0"))
+	require.NoError(t, err)
+	_, err = svc.RotateKey(ctx, 1, ref)
+	require.NoError(t, err)
+
+	valid, err := svc.Verify(ctx, 1, ref, []byte("v1.0.0"), signature)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	valid, err = svc.Verify(ctx, 1, ref, []byte("v1.0.1"), signature)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = svc.Encrypt(ctx, 1, ref, []byte("data"))
+	require.ErrorIs(t, err, ErrTransitKeyType)
+
+	mac, err := svc.HMAC(ctx, 1, ref, []byte("data"))
+	require.NoError(t, err)
+	again, err := svc.HMAC(ctx, 1, ref, []byte("data"))
+	require.NoError(t, err)
+	assert.Equal(t, mac, again)
+	assert.True(t, strings.HasPrefix(mac, "keeper:v2:"))
+}
+
+func TestTransitService_TeamRoles(t *testing.T) {
+	svc, teamRepo := newTestTransitService(t)
+	ctx := t.Context()
+	team := entity.Team{ID: 4, Name: "payments"}
+	ref := dto.TransitKeyRef{Name: "cards", Team: team.Name}
+
+	teamRepo.EXPECT().GetByName(ctx, team.Name).Return(team, nil).AnyTimes()
+	teamRepo.EXPECT().GetMember(ctx, team.ID, int64(2)).Return(entity.TeamMember{Role: entity.TeamRoleViewer}, nil).AnyTimes()
+	teamRepo.EXPECT().GetMember(ctx, team.ID, int64(3)).Return(entity.TeamMember{}, pgx.ErrNoRows).AnyTimes()
+
+	_, err := svc.CreateKey(ctx, 2, ref, "")
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, err = svc.Encrypt(ctx, 3, ref, []byte("data"))
+	require.ErrorIs(t, err, ErrAccessDenied)
+}
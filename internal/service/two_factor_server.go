@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"keeper/internal/security"
+	"strings"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	pgxpool "github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	totpIssuer = "Keeper"
+
+	recoveryCodeCount = 10
+	// recoveryCodeBytes gives 80 bits per code, so the SHA-256 hashes can't be
+	// brute-forced if the table leaks.
+	recoveryCodeBytes = 10
+	recoveryCodeGroup = 4
+
+	challengeBytes       = 32
+	challengeTTL         = 5 * time.Minute
+	challengeMaxAttempts = 5
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor enrolment was not started")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrInvalidLoginChallenge   = errors.New("invalid or expired login challenge")
+)
+
+// TwoFactorRequiredError is returned by Login when the password was right but
+// the account has TOTP enabled. Challenge is passed to VerifyTwoFactor.
+type TwoFactorRequiredError struct {
+	Challenge string
+}
+
+func (e *TwoFactorRequiredError) Error() string {
+	return "two-factor code required"
+}
+
+// TwoFactorService manages TOTP enrolment and the second login step.
+// Challenge and Verify run in the caller's login transaction.
+type TwoFactorService interface {
+	Enroll(ctx context.Context, userID int64) (dto.TOTPEnrollment, error)
+	Confirm(ctx context.Context, userID int64, code string) ([]string, error)
+	Challenge(ctx context.Context, tx pgx.Tx, userID int64, deviceName string) (string, error)
+	Verify(ctx context.Context, tx pgx.Tx, challenge, code string) (entity.LoginChallenge, error)
+}
+
+type twoFactorService struct {
+	repo     repository.TwoFactorRepository
+	userRepo repository.UserRepositoryInterface
+	crypto   CryptoService
+	db       *pgxpool.Pool
+	now      func() time.Time
+}
+
+func NewTwoFactorService(
+	db *pgxpool.Pool,
+	repo repository.TwoFactorRepository,
+	userRepo repository.UserRepositoryInterface,
+	crypto CryptoService,
+) TwoFactorService {
+	return &twoFactorService{
+		db:       db,
+		repo:     repo,
+		userRepo: userRepo,
+		crypto:   crypto,
+		now:      time.Now,
+	}
+}
+
+// Enroll generates a new secret. It doesn't protect logins until Confirm
+// proves the user's authenticator produces matching codes.
+func (s *twoFactorService) Enroll(ctx context.Context, userID int64) (dto.TOTPEnrollment, error) {
+	login, err := s.userRepo.GetLoginByID(ctx, userID)
+	if err != nil {
+		return dto.TOTPEnrollment{}, fmt.Errorf("failed to enroll TOTP: %w", err)
+	}
+
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return dto.TOTPEnrollment{}, fmt.Errorf("failed to enroll TOTP: %w", err)
+	}
+	encrypted, err := s.crypto.Encode([]byte(secret))
+	if err != nil {
+		return dto.TOTPEnrollment{}, fmt.Errorf("failed to enroll TOTP: %w", err)
+	}
+
+	saved, err := s.repo.SaveTOTP(ctx, userID, encrypted)
+	if err != nil {
+		return dto.TOTPEnrollment{}, fmt.Errorf("failed to enroll TOTP: %w", err)
+	}
+	if !saved {
+		return dto.TOTPEnrollment{}, ErrTwoFactorAlreadyEnabled
+	}
+
+	return dto.TOTPEnrollment{
+		Secret: secret,
+		URI:    security.TOTPURI(totpIssuer, login, secret),
+	}, nil
+}
+
+// Confirm enables TOTP once the user enters a valid code and returns the
+// recovery codes. They are only stored hashed, so this is the one chance to
+// show them.
+func (s *twoFactorService) Confirm(ctx context.Context, userID int64, code string) ([]string, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx: %w", err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	cred, err := s.repo.GetTOTP(ctx, tx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to confirm TOTP: %w", err)
+	}
+	if cred.ConfirmedAt != nil {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	step, err := s.checkTOTP(cred, code)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ConfirmTOTP(ctx, tx, userID, step); err != nil {
+		return nil, fmt.Errorf("failed to confirm TOTP: %w", err)
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceRecoveryCodes(ctx, tx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("failed to confirm TOTP: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return codes, nil
+}
+
+// Challenge returns an empty string when the user has no confirmed TOTP and
+// the login can complete right away.
+func (s *twoFactorService) Challenge(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID int64,
+	deviceName string,
+) (string, error) {
+	cred, err := s.repo.GetTOTP(ctx, tx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to check two-factor status: %w", err)
+	}
+	if cred.ConfirmedAt == nil {
+		return "", nil
+	}
+
+	raw := make([]byte, challengeBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate login challenge: %w", err)
+	}
+	challenge := base64.RawURLEncoding.EncodeToString(raw)
+
+	err = s.repo.CreateChallenge(ctx, tx, entity.LoginChallenge{
+		ChallengeHash: sha256Hex(challenge),
+		UserID:        userID,
+		DeviceName:    deviceName,
+		ExpiresAt:     s.now().Add(challengeTTL),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start two-factor login: %w", err)
+	}
+	return challenge, nil
+}
+
+// Verify consumes the challenge if code is a current TOTP code or an unused
+// recovery code. A wrong code counts as an attempt; on ErrInvalidTwoFactorCode
+// the challenge is still returned and the caller must commit the transaction
+// so the count is kept.
+func (s *twoFactorService) Verify(
+	ctx context.Context,
+	tx pgx.Tx,
+	challenge, code string,
+) (entity.LoginChallenge, error) {
+	c, err := s.repo.FindChallengeForUpdate(ctx, tx, sha256Hex(challenge))
+	if err != nil {
+		return entity.LoginChallenge{}, fmt.Errorf("%w: %w", ErrInvalidLoginChallenge, err)
+	}
+	if s.now().After(c.ExpiresAt) || c.Attempts >= challengeMaxAttempts {
+		if err := s.repo.DeleteChallenge(ctx, tx, c.ID); err != nil {
+			return entity.LoginChallenge{}, fmt.Errorf("failed to verify two-factor code: %w", err)
+		}
+		return entity.LoginChallenge{}, ErrInvalidLoginChallenge
+	}
+
+	ok, err := s.checkSecondFactor(ctx, tx, c.UserID, code)
+	if err != nil {
+		return entity.LoginChallenge{}, err
+	}
+	if !ok {
+		if err := s.repo.IncrementChallengeAttempts(ctx, tx, c.ID); err != nil {
+			return entity.LoginChallenge{}, fmt.Errorf("failed to verify two-factor code: %w", err)
+		}
+		return c, ErrInvalidTwoFactorCode
+	}
+
+	if err := s.repo.DeleteChallenge(ctx, tx, c.ID); err != nil {
+		return entity.LoginChallenge{}, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+	return c, nil
+}
+
+func (s *twoFactorService) checkSecondFactor(ctx context.Context, tx pgx.Tx, userID int64, code string) (bool, error) {
+	cred, err := s.repo.GetTOTP(ctx, tx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+
+	step, err := s.checkTOTP(cred, code)
+	if err == nil {
+		if err := s.repo.SetLastStep(ctx, tx, userID, step); err != nil {
+			return false, fmt.Errorf("failed to verify two-factor code: %w", err)
+		}
+		return true, nil
+	}
+	if !errors.Is(err, ErrInvalidTwoFactorCode) {
+		return false, err
+	}
+
+	used, err := s.repo.UseRecoveryCode(ctx, tx, userID, hashRecoveryCode(code))
+	if err != nil {
+		return false, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+	return used, nil
+}
+
+// checkTOTP returns the matched time step. A step at or before the last
+// accepted one is rejected so an observed code can't be replayed.
+func (s *twoFactorService) checkTOTP(cred entity.TOTPCredential, code string) (int64, error) {
+	secret, err := s.crypto.Decode(cred.Secret)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decrypt TOTP secret: %w", err)
+	}
+	step, ok, err := security.ValidateTOTP(string(secret), code, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to check TOTP code: %w", err)
+	}
+	if !ok || step <= cred.LastStep {
+		return 0, ErrInvalidTwoFactorCode
+	}
+	return step, nil
+}
+
+// generateRecoveryCodes returns the codes to show and their hashes to store.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	raw := make([]byte, recoveryCodeBytes)
+	for range recoveryCodeCount {
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		plain := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw))
+		var groups []string
+		for i := 0; i < len(plain); i += recoveryCodeGroup {
+			groups = append(groups, plain[i:min(i+recoveryCodeGroup, len(plain))])
+		}
+		code := strings.Join(groups, "-")
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case, dashes and spaces so codes can be typed the
+// way they're read.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return sha256Hex(normalized)
+}
+
+func sha256Hex(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"keeper/internal/config"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"keeper/internal/security"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDataKey = "2fd36a2c3bcd3426f0fc92c84f8c56c1e91b40e372e3f1b739b1c1b0fa6fc457"
+
+func newTestTwoFactorService(t *testing.T, repo *mocks.MockTwoFactorRepository, now time.Time) (*twoFactorService, string, []byte) {
+	t.Helper()
+	crypto, err := NewCryptoService(config.SecurityConfig{DataEncryptionKey: testDataKey})
+	require.NoError(t, err)
+
+	secret, err := security.GenerateTOTPSecret()
+	require.NoError(t, err)
+	encrypted, err := crypto.Encode([]byte(secret))
+	require.NoError(t, err)
+
+	svc := &twoFactorService{repo: repo, crypto: crypto, now: func() time.Time { return now }}
+	return svc, secret, encrypted
+}
+
+func TestTwoFactorService_VerifyTOTP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockTwoFactorRepository(ctrl)
+	now := time.Unix(1_700_000_000, 0)
+	svc, secret, encrypted := newTestTwoFactorService(t, repo, now)
+	ctx := t.Context()
+
+	step := security.TOTPStep(now)
+	code, err := security.TOTPCode(secret, step)
+	require.NoError(t, err)
+
+	challenge := entity.LoginChallenge{ID: 7, UserID: 3, DeviceName: "laptop", ExpiresAt: now.Add(time.Minute)}
+	confirmed := now.Add(-time.Hour)
+	repo.EXPECT().FindChallengeForUpdate(ctx, nil, sha256Hex("c1")).Return(challenge, nil)
+	repo.EXPECT().GetTOTP(ctx, nil, int64(3)).
+		Return(entity.TOTPCredential{UserID: 3, Secret: encrypted, ConfirmedAt: &confirmed}, nil)
+	repo.EXPECT().SetLastStep(ctx, nil, int64(3), step).Return(nil)
+	repo.EXPECT().DeleteChallenge(ctx, nil, int64(7)).Return(nil)
+
+	got, err := svc.Verify(ctx, nil, "c1", code)
+	require.NoError(t, err)
+	assert.Equal(t, "laptop", got.DeviceName)
+}
+
+func TestTwoFactorService_VerifyRejectsReplayedCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockTwoFactorRepository(ctrl)
+	now := time.Unix(1_700_000_000, 0)
+	svc, secret, encrypted := newTestTwoFactorService(t, repo, now)
+	ctx := t.Context()
+
+	step := security.TOTPStep(now)
+	code, err := security.TOTPCode(secret, step)
+	require.NoError(t, err)
+
+	challenge := entity.LoginChallenge{ID: 7, UserID: 3, ExpiresAt: now.Add(time.Minute)}
+	repo.EXPECT().FindChallengeForUpdate(ctx, nil, sha256Hex("c1")).Return(challenge, nil)
+	repo.EXPECT().GetTOTP(ctx, nil, int64(3)).
+		Return(entity.TOTPCredential{UserID: 3, Secret: encrypted, LastStep: step}, nil)
+	repo.EXPECT().UseRecoveryCode(ctx, nil, int64(3), hashRecoveryCode(code)).Return(false, nil)
+	repo.EXPECT().IncrementChallengeAttempts(ctx, nil, int64(7)).Return(nil)
+
+	_, err = svc.Verify(ctx, nil, "c1", code)
+	require.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+}
+
+func TestTwoFactorService_VerifyRecoveryCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockTwoFactorRepository(ctrl)
+	now := time.Unix(1_700_000_000, 0)
+	svc, _, encrypted := newTestTwoFactorService(t, repo, now)
+	ctx := t.Context()
+
+	codes, hashes, err := generateRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, recoveryCodeCount)
+	assert.Equal(t, hashes[0], hashRecoveryCode(" "+codes[0]+" "))
+
+	challenge := entity.LoginChallenge{ID: 7, UserID: 3, ExpiresAt: now.Add(time.Minute)}
+	repo.EXPECT().FindChallengeForUpdate(ctx, nil, sha256Hex("c1")).Return(challenge, nil)
+	repo.EXPECT().GetTOTP(ctx, nil, int64(3)).Return(entity.TOTPCredential{UserID: 3, Secret: encrypted}, nil)
+	repo.EXPECT().UseRecoveryCode(ctx, nil, int64(3), hashes[0]).Return(true, nil)
+	repo.EXPECT().DeleteChallenge(ctx, nil, int64(7)).Return(nil)
+
+	_, err = svc.Verify(ctx, nil, "c1", codes[0])
+	require.NoError(t, err)
+}
+
+func TestTwoFactorService_VerifyExhaustedChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockTwoFactorRepository(ctrl)
+	now := time.Unix(1_700_000_000, 0)
+	svc, _, _ := newTestTwoFactorService(t, repo, now)
+	ctx := t.Context()
+
+	challenge := entity.LoginChallenge{ID: 7, UserID: 3, Attempts: challengeMaxAttempts, ExpiresAt: now.Add(time.Minute)}
+	repo.EXPECT().FindChallengeForUpdate(ctx, nil, sha256Hex("c1")).Return(challenge, nil)
+	repo.EXPECT().DeleteChallenge(ctx, nil, int64(7)).Return(nil)
+
+	_, err := svc.Verify(ctx, nil, "c1", "123456")
+	require.ErrorIs(t, err, ErrInvalidLoginChallenge)
+}
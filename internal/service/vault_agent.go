@@ -3,22 +3,32 @@ package service
 import (
 	"context"
 	"fmt"
+	"keeper/internal/client"
 	"keeper/internal/dto"
 	pb "keeper/internal/proto/v1"
 	pbModel "keeper/internal/proto/v1/model"
+	"math"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type RemoteVaultService interface {
-	GetSecret(ctx context.Context, token string, path string) (*dto.AgentGetSecret, error)
-	ListSecretPaths(ctx context.Context, token string) ([]string, error)
+	GetSecret(ctx context.Context, token, owner, path string) (*dto.AgentGetSecret, error)
+	GetSecretVersion(ctx context.Context, token, owner, path string, version int64) (*dto.AgentGetSecret, error)
+	ListSecretVersions(ctx context.Context, token, owner, path string) ([]dto.AgentSecretVersion, error)
+	ListSecretPaths(ctx context.Context, token string) (*dto.AgentSecretList, error)
 	SaveSecret(ctx context.Context, req *dto.AgentCreateSecret) error
-	DeleteSecret(ctx context.Context, token, path string) error
-	DestroySecret(ctx context.Context, token, path string) error
-	DeleteMetadata(ctx context.Context, token, path string) error
-	UndeleteSecret(ctx context.Context, token, path string, version int64) error
+	DeleteSecret(ctx context.Context, token, owner, path string) error
+	DeleteVersions(ctx context.Context, token, owner, path string, versions []int64) error
+	DestroySecret(ctx context.Context, token, owner, path string) error
+	DestroyVersions(ctx context.Context, token, owner, path string, versions []int64) error
+	DeleteMetadata(ctx context.Context, token, owner, path string) error
+	UndeleteSecret(ctx context.Context, token, owner, path string, version int64) error
+	GrantAccess(ctx context.Context, token, grantee, path, access string) error
+	RevokeAccess(ctx context.Context, token, grantee, path string) error
+	ListGrants(ctx context.Context, token string) (*dto.AgentGrantList, error)
+	GetChangesSince(ctx context.Context, token string, page dto.ChangesPage) (*dto.AgentSecretChanges, error)
 }
 
 type remoteVaultService struct {
@@ -29,11 +39,20 @@ func NewRemoteVaultService(client pb.DataServiceClient) RemoteVaultService {
 	return &remoteVaultService{client: client}
 }
 
-func (s *remoteVaultService) GetSecret(ctx context.Context, token string, path string) (*dto.AgentGetSecret, error) {
+func (s *remoteVaultService) GetSecret(ctx context.Context, token, owner, path string) (*dto.AgentGetSecret, error) {
+	return s.GetSecretVersion(ctx, token, owner, path, 0)
+}
+
+func (s *remoteVaultService) GetSecretVersion(
+	ctx context.Context,
+	token, owner, path string,
+	version int64,
+) (*dto.AgentGetSecret, error) {
 	pbReq := &pbModel.GetSecretRequest{}
-	pbReq.SetToken(token)
+	pbReq.SetOwner(owner)
 	pbReq.SetPath(path)
-	resp, err := s.client.GetSecret(ctx, pbReq)
+	pbReq.SetVersion(version)
+	resp, err := s.client.GetSecret(ctx, pbReq, client.WithToken(token))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get secret: %w", err)
 	}
@@ -64,21 +83,60 @@ func (s *remoteVaultService) GetSecret(ctx context.Context, token string, path s
 	}, nil
 }
 
-func (s *remoteVaultService) ListSecretPaths(ctx context.Context, token string) ([]string, error) {
+func (s *remoteVaultService) ListSecretVersions(
+	ctx context.Context,
+	token, owner, path string,
+) ([]dto.AgentSecretVersion, error) {
+	pbReq := &pbModel.ListSecretVersionsRequest{}
+	pbReq.SetOwner(owner)
+	pbReq.SetPath(path)
+	resp, err := s.client.ListSecretVersions(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secret versions: %w", err)
+	}
+
+	versions := make([]dto.AgentSecretVersion, 0, len(resp.GetVersions()))
+	for _, v := range resp.GetVersions() {
+		var deletedAt *time.Time
+		if ts := v.GetDeletedAt(); ts != nil {
+			t := ts.AsTime()
+			deletedAt = &t
+		}
+		versions = append(versions, dto.AgentSecretVersion{
+			Version:   v.GetVersion(),
+			CreatedAt: v.GetCreatedAt().AsTime(),
+			DeletedAt: deletedAt,
+			Destroyed: v.GetDestroyed(),
+		})
+	}
+	return versions, nil
+}
+
+func (s *remoteVaultService) ListSecretPaths(ctx context.Context, token string) (*dto.AgentSecretList, error) {
 	req := &pbModel.ListSecretPathsRequest{}
-	req.SetToken(token)
-	resp, err := s.client.ListSecrets(ctx, req)
+	resp, err := s.client.ListSecrets(ctx, req, client.WithToken(token))
 	if err != nil {
 		return nil, fmt.Errorf("failed to list secrets: %w", err)
 	}
-	paths := resp.GetPaths()
 
-	return paths, nil
+	shared := make([]dto.AgentSharedSecret, 0, len(resp.GetShared()))
+	for _, item := range resp.GetShared() {
+		shared = append(shared, dto.AgentSharedSecret{
+			Owner:  item.GetOwner(),
+			Path:   item.GetPath(),
+			Access: item.GetAccess(),
+		})
+	}
+
+	return &dto.AgentSecretList{
+		Paths:  resp.GetPaths(),
+		Shared: shared,
+	}, nil
 }
 
 func (s *remoteVaultService) SaveSecret(ctx context.Context, req *dto.AgentCreateSecret) error {
 	pbReq := &pbModel.WriteSecret{}
-	pbReq.SetToken(req.Token)
+	pbReq.SetOwner(req.Owner)
 	pbReq.SetPath(req.Path)
 	pbReq.SetDescription(req.Description)
 	pbReq.SetValue(req.Payload)
@@ -86,55 +144,175 @@ func (s *remoteVaultService) SaveSecret(ctx context.Context, req *dto.AgentCreat
 	if req.FilePath != nil {
 		pbReq.SetFilePath(*req.FilePath)
 	}
+	if req.CAS != nil {
+		pbReq.SetCas(*req.CAS)
+	}
 
-	_, err := s.client.SaveSecret(ctx, pbReq)
+	_, err := s.client.SaveSecret(ctx, pbReq, client.WithToken(req.Token))
 	if err != nil {
 		return fmt.Errorf("failed to create secret: %w", err)
 	}
 	return nil
 }
 
-func (s *remoteVaultService) DeleteSecret(ctx context.Context, token string, path string) error {
+func (s *remoteVaultService) DeleteSecret(ctx context.Context, token, owner, path string) error {
 	pbReq := &pbModel.DeleteSecretRequest{}
-	pbReq.SetToken(token)
+	pbReq.SetOwner(owner)
 	pbReq.SetPath(path)
-	_, err := s.client.DeleteSecret(ctx, pbReq)
+	_, err := s.client.DeleteSecret(ctx, pbReq, client.WithToken(token))
 	if err != nil {
 		return fmt.Errorf("failed to delete secret: %w", err)
 	}
 	return nil
 }
 
-func (s *remoteVaultService) DestroySecret(ctx context.Context, token string, path string) error {
+// DeleteVersions soft-deletes only the listed versions of path.
+func (s *remoteVaultService) DeleteVersions(ctx context.Context, token, owner, path string, versions []int64) error {
 	pbReq := &pbModel.DeleteSecretRequest{}
-	pbReq.SetToken(token)
+	pbReq.SetOwner(owner)
 	pbReq.SetPath(path)
-	_, err := s.client.DestroySecret(ctx, pbReq)
+	pbReq.SetVersions(versions)
+	_, err := s.client.DeleteSecret(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to delete secret versions: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteVaultService) DestroySecret(ctx context.Context, token, owner, path string) error {
+	pbReq := &pbModel.DeleteSecretRequest{}
+	pbReq.SetOwner(owner)
+	pbReq.SetPath(path)
+	_, err := s.client.DestroySecret(ctx, pbReq, client.WithToken(token))
 	if err != nil {
 		return fmt.Errorf("failed to destroy secret: %w", err)
 	}
 	return nil
 }
 
-func (s *remoteVaultService) DeleteMetadata(ctx context.Context, token string, path string) error {
+func (s *remoteVaultService) DestroyVersions(ctx context.Context, token, owner, path string, versions []int64) error {
+	pbReq := &pbModel.DeleteSecretRequest{}
+	pbReq.SetOwner(owner)
+	pbReq.SetPath(path)
+	pbReq.SetVersions(versions)
+	_, err := s.client.DestroySecret(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to destroy secret versions: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteVaultService) DeleteMetadata(ctx context.Context, token, owner, path string) error {
 	pbReq := &pbModel.DeleteSecretRequest{}
-	pbReq.SetToken(token)
+	pbReq.SetOwner(owner)
 	pbReq.SetPath(path)
-	_, err := s.client.DeleteMetadata(ctx, pbReq)
+	_, err := s.client.DeleteMetadata(ctx, pbReq, client.WithToken(token))
 	if err != nil {
 		return fmt.Errorf("failed to delete metadata secret: %w", err)
 	}
 	return nil
 }
 
-func (s *remoteVaultService) UndeleteSecret(ctx context.Context, token string, path string, version int64) error {
+func (s *remoteVaultService) UndeleteSecret(
+	ctx context.Context,
+	token, owner, path string,
+	version int64,
+) error {
 	pbReq := &pbModel.UndeleteSecretRequest{}
-	pbReq.SetToken(token)
+	pbReq.SetOwner(owner)
 	pbReq.SetPath(path)
 	pbReq.SetVersion(version)
-	_, err := s.client.UndeleteSecret(ctx, pbReq)
+	_, err := s.client.UndeleteSecret(ctx, pbReq, client.WithToken(token))
 	if err != nil {
 		return fmt.Errorf("failed to undelete secret: %w", err)
 	}
 	return nil
 }
+
+func (s *remoteVaultService) GrantAccess(ctx context.Context, token, grantee, path, access string) error {
+	pbReq := &pbModel.GrantAccessRequest{}
+	pbReq.SetGrantee(grantee)
+	pbReq.SetPath(path)
+	pbReq.SetAccess(access)
+	_, err := s.client.GrantAccess(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to grant access: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteVaultService) RevokeAccess(ctx context.Context, token, grantee, path string) error {
+	pbReq := &pbModel.RevokeAccessRequest{}
+	pbReq.SetGrantee(grantee)
+	pbReq.SetPath(path)
+	_, err := s.client.RevokeAccess(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to revoke access: %w", err)
+	}
+	return nil
+}
+
+func (s *remoteVaultService) ListGrants(ctx context.Context, token string) (*dto.AgentGrantList, error) {
+	pbReq := &pbModel.ListGrantsRequest{}
+	resp, err := s.client.ListGrants(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grants: %w", err)
+	}
+
+	return &dto.AgentGrantList{
+		Granted:  fromPbGrants(resp.GetGranted()),
+		Received: fromPbGrants(resp.GetReceived()),
+	}, nil
+}
+
+func fromPbGrants(grants []*pbModel.Grant) []dto.AgentGrant {
+	result := make([]dto.AgentGrant, 0, len(grants))
+	for _, g := range grants {
+		result = append(result, dto.AgentGrant{
+			Owner:     g.GetOwner(),
+			Grantee:   g.GetGrantee(),
+			Path:      g.GetPath(),
+			Access:    g.GetAccess(),
+			CreatedAt: g.GetCreatedAt().AsTime(),
+		})
+	}
+	return result
+}
+
+func (s *remoteVaultService) GetChangesSince(
+	ctx context.Context,
+	token string,
+	page dto.ChangesPage,
+) (*dto.AgentSecretChanges, error) {
+	pbReq := &pbModel.GetChangesSinceRequest{}
+	pbReq.SetCursor(page.Cursor)
+	pbReq.SetHorizon(page.Horizon)
+	pbReq.SetAfterId(page.AfterID)
+	pbReq.SetLimit(int32(min(page.Limit, math.MaxInt32)))
+	resp, err := s.client.GetChangesSince(ctx, pbReq, client.WithToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changes: %w", err)
+	}
+
+	changes := make([]dto.AgentSecretChange, 0, len(resp.GetChanges()))
+	for _, c := range resp.GetChanges() {
+		changes = append(changes, dto.AgentSecretChange{
+			ExpiredAt:     c.GetExpiredAt().AsTime(),
+			CreatedAt:     c.GetCreatedAt().AsTime(),
+			Path:          c.GetPath(),
+			Description:   c.GetDescription(),
+			FilePath:      c.GetFilePath(),
+			Payload:       c.GetValue(),
+			Version:       c.GetVersion(),
+			LatestVersion: c.GetLatestVersion(),
+			Deleted:       c.GetDeleted(),
+		})
+	}
+	return &dto.AgentSecretChanges{
+		Changes: changes,
+		Cursor:  resp.GetCursor(),
+		Horizon: resp.GetHorizon(),
+		AfterID: resp.GetAfterId(),
+		HasMore: resp.GetHasMore(),
+	}, nil
+}
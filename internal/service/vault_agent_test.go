@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"keeper/internal/client"
 	"keeper/internal/dto"
 	"keeper/internal/proto/v1/mock"
 	"keeper/internal/proto/v1/model"
@@ -35,10 +36,10 @@ func TestRemoteVaultService_GetSecret(t *testing.T) {
 	mockResp.SetExpiredAt(timestamppb.New(expiredAt))
 
 	mockClient.EXPECT().
-		GetSecret(gomock.Any(), gomock.Any()).
+		GetSecret(gomock.Any(), gomock.Any(), client.WithToken("token123")).
 		Return(mockResp, nil)
 
-	result, err := svc.GetSecret(t.Context(), "token123", "secret/foo")
+	result, err := svc.GetSecret(t.Context(), "token123", "", "secret/foo")
 	require.NoError(t, err)
 	require.Equal(t, "secret/foo", result.Path)
 	require.Equal(t, "my secret", result.Description)
@@ -57,10 +58,10 @@ func TestRemoteVaultService_GetSecret_Error(t *testing.T) {
 	svc := NewRemoteVaultService(mockClient)
 
 	mockClient.EXPECT().
-		GetSecret(gomock.Any(), gomock.Any()).
+		GetSecret(gomock.Any(), gomock.Any(), client.WithToken("bad-token")).
 		Return(nil, errors.New("rpc error"))
 
-	result, err := svc.GetSecret(t.Context(), "bad-token", "bad/path")
+	result, err := svc.GetSecret(t.Context(), "bad-token", "", "bad/path")
 	require.Nil(t, result)
 	require.ErrorContains(t, err, "failed to get secret")
 }
@@ -72,16 +73,23 @@ func TestRemoteVaultService_ListSecretPaths(t *testing.T) {
 	mockClient := mock.NewMockDataServiceClient(ctrl)
 	svc := NewRemoteVaultService(mockClient)
 
+	shared := &model.SharedSecretPath{}
+	shared.SetOwner("alice")
+	shared.SetPath("db/password")
+	shared.SetAccess("read")
+
 	mockResp := &model.ListSecretPathsResponse{}
 	mockResp.SetPaths([]string{"secret/foo", "secret/bar"})
+	mockResp.SetShared([]*model.SharedSecretPath{shared})
 
 	mockClient.EXPECT().
-		ListSecrets(gomock.Any(), gomock.Any()).
+		ListSecrets(gomock.Any(), gomock.Any(), client.WithToken("token123")).
 		Return(mockResp, nil)
 
-	paths, err := svc.ListSecretPaths(t.Context(), "token123")
+	list, err := svc.ListSecretPaths(t.Context(), "token123")
 	require.NoError(t, err)
-	require.Equal(t, []string{"secret/foo", "secret/bar"}, paths)
+	require.Equal(t, []string{"secret/foo", "secret/bar"}, list.Paths)
+	require.Equal(t, []dto.AgentSharedSecret{{Owner: "alice", Path: "db/password", Access: "read"}}, list.Shared)
 }
 
 func TestRemoteVaultService_SaveSecret(t *testing.T) {
@@ -100,7 +108,7 @@ func TestRemoteVaultService_SaveSecret(t *testing.T) {
 	}
 
 	mockClient.EXPECT().
-		SaveSecret(gomock.Any(), gomock.Any()).
+		SaveSecret(gomock.Any(), gomock.Any(), client.WithToken("token123")).
 		Return(&model.SaveSecretResponse{}, nil)
 
 	err := svc.SaveSecret(t.Context(), req)
@@ -115,10 +123,10 @@ func TestRemoteVaultService_DeleteSecret(t *testing.T) {
 	svc := NewRemoteVaultService(mockClient)
 
 	mockClient.EXPECT().
-		DeleteSecret(gomock.Any(), gomock.Any()).
+		DeleteSecret(gomock.Any(), gomock.Any(), client.WithToken("token")).
 		Return(&model.DeleteSecretResponse{}, nil)
 
-	err := svc.DeleteSecret(t.Context(), "token", "secret/foo")
+	err := svc.DeleteSecret(t.Context(), "token", "", "secret/foo")
 	require.NoError(t, err)
 }
 
@@ -130,9 +138,93 @@ func TestRemoteVaultService_DeleteSecret_Error(t *testing.T) {
 	svc := NewRemoteVaultService(mockClient)
 
 	mockClient.EXPECT().
-		DeleteSecret(gomock.Any(), gomock.Any()).
+		DeleteSecret(gomock.Any(), gomock.Any(), client.WithToken("token")).
 		Return(nil, errors.New("fail"))
 
-	err := svc.DeleteSecret(t.Context(), "token", "secret/foo")
+	err := svc.DeleteSecret(t.Context(), "token", "", "secret/foo")
 	require.ErrorContains(t, err, "failed to delete secret")
 }
+
+func TestRemoteVaultService_ListGrants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockDataServiceClient(ctrl)
+	svc := NewRemoteVaultService(mockClient)
+
+	createdAt := time.Now()
+	grant := &model.Grant{}
+	grant.SetOwner("alice")
+	grant.SetGrantee("bob")
+	grant.SetPath("db/")
+	grant.SetAccess("write")
+	grant.SetCreatedAt(timestamppb.New(createdAt))
+
+	mockResp := &model.ListGrantsResponse{}
+	mockResp.SetGranted([]*model.Grant{grant})
+
+	mockClient.EXPECT().
+		ListGrants(gomock.Any(), gomock.Any(), client.WithToken("token")).
+		Return(mockResp, nil)
+
+	grants, err := svc.ListGrants(t.Context(), "token")
+	require.NoError(t, err)
+	require.Len(t, grants.Granted, 1)
+	require.Empty(t, grants.Received)
+	require.Equal(t, "bob", grants.Granted[0].Grantee)
+	require.Equal(t, "write", grants.Granted[0].Access)
+	require.WithinDuration(t, createdAt, grants.Granted[0].CreatedAt, time.Second)
+}
+
+func TestRemoteVaultService_GrantAccess_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockDataServiceClient(ctrl)
+	svc := NewRemoteVaultService(mockClient)
+
+	mockClient.EXPECT().
+		GrantAccess(gomock.Any(), gomock.Any(), client.WithToken("token")).
+		Return(nil, errors.New("fail"))
+
+	err := svc.GrantAccess(t.Context(), "token", "bob", "db/", "read")
+	require.ErrorContains(t, err, "failed to grant access")
+}
+
+func TestRemoteVaultService_GetChangesSince(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockDataServiceClient(ctrl)
+	svc := NewRemoteVaultService(mockClient)
+
+	change := &model.SecretChange{}
+	change.SetPath("app/db")
+	change.SetVersion(2)
+	change.SetLatestVersion(3)
+	change.SetValue([]byte(`"czNjcmV0"`))
+	resp := &model.GetChangesSinceResponse{}
+	resp.SetChanges([]*model.SecretChange{change})
+	resp.SetCursor(100)
+	resp.SetHorizon(900)
+	resp.SetAfterId(7)
+	resp.SetHasMore(true)
+
+	mockClient.EXPECT().
+		GetChangesSince(gomock.Any(), gomock.Any(), client.WithToken("token123")).
+		DoAndReturn(func(_ any, req *model.GetChangesSinceRequest, _ ...any) (*model.GetChangesSinceResponse, error) {
+			require.Equal(t, int64(100), req.GetCursor())
+			require.Equal(t, int64(900), req.GetHorizon())
+			require.Equal(t, int64(4), req.GetAfterId())
+			return resp, nil
+		})
+
+	page, err := svc.GetChangesSince(t.Context(), "token123", dto.ChangesPage{Cursor: 100, Horizon: 900, AfterID: 4})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.Equal(t, int64(7), page.AfterID)
+	require.Len(t, page.Changes, 1)
+	require.Equal(t, "app/db", page.Changes[0].Path)
+	require.Equal(t, int64(3), page.Changes[0].LatestVersion)
+	require.Equal(t, []byte(`"czNjcmV0"`), page.Changes[0].Payload)
+}
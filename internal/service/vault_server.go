@@ -2,53 +2,209 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"keeper/internal/dto"
 	"keeper/internal/entity"
 	"keeper/internal/repository"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+const (
+	errorDeleteSecret = "failed to delete secret: %w"
+
+	// maxChangesPage caps a page of GetChangesSince.
+	maxChangesPage = 500
 )
 
-const errorDeleteSecret = "failed to delete secret: %w"
+// ErrCheckAndSet is returned by SaveSecret when the check-and-set version is
+// not the latest version of the path.
+var ErrCheckAndSet = errors.New("check-and-set version does not match the latest version")
 
+// VaultService methods take the caller's userID and an optional owner login
+// used to address secrets shared by other users, see authorize.
 type VaultService interface {
-	GetSecret(ctx context.Context, userID int64, path string) (dto.DecryptedSecretResponse, error)
+	GetSecret(ctx context.Context, userID int64, owner, path string) (dto.DecryptedSecretResponse, error)
+	GetSecretVersion(
+		ctx context.Context,
+		userID int64,
+		owner, path string,
+		version int64,
+	) (dto.DecryptedSecretResponse, error)
+	ListSecretVersions(ctx context.Context, userID int64, owner, path string) ([]entity.SecretVersion, error)
 	ListSecretsPaths(ctx context.Context, userID int64) ([]string, error)
-	SaveSecret(ctx context.Context, request *dto.ServerCreateSecret) error
-	DeleteSecret(ctx context.Context, userID int64, path string) error
-	DestroySecret(ctx context.Context, userID int64, path string) error
-	DeleteMetadata(ctx context.Context, userID int64, path string) error
-	UndeleteSecret(ctx context.Context, userID int64, path string, version int64) error
+	ListSharedSecrets(ctx context.Context, userID int64) ([]entity.SharedSecret, error)
+	SaveSecret(ctx context.Context, request *dto.ServerCreateSecret) (entity.SecretVersion, error)
+	DeleteSecret(ctx context.Context, userID int64, owner, path string) error
+	DeleteVersions(ctx context.Context, userID int64, owner, path string, versions []int64) error
+	DestroySecret(ctx context.Context, userID int64, owner, path string) error
+	DestroyVersions(ctx context.Context, userID int64, owner, path string, versions []int64) error
+	DeleteMetadata(ctx context.Context, userID int64, owner, path string) error
+	UndeleteSecret(ctx context.Context, userID int64, owner, path string, version int64) error
+	SecretExists(ctx context.Context, userID int64, owner, path string) (bool, error)
+	GetChangesSince(ctx context.Context, userID int64, page dto.ChangesPage) (dto.SecretChanges, error)
 }
 
 type vaultService struct {
 	repo          repository.VaultRepositoryInterface
+	grantRepo     repository.GrantRepository
+	teamRepo      repository.TeamRepository
+	userRepo      repository.UserRepositoryInterface
 	cryptoService CryptoService
 	fileRepo      repository.FileRepository
 }
 
 func NewVaultService(
 	repo repository.VaultRepositoryInterface,
+	grantRepo repository.GrantRepository,
+	teamRepo repository.TeamRepository,
+	userRepo repository.UserRepositoryInterface,
 	cryptoService CryptoService,
 	fileRepo repository.FileRepository,
 ) VaultService {
 	return &vaultService{
 		repo:          repo,
+		grantRepo:     grantRepo,
+		teamRepo:      teamRepo,
+		userRepo:      userRepo,
 		cryptoService: cryptoService,
 		fileRepo:      fileRepo,
 	}
 }
 
+// authorize returns the vault that holds path and checks that userID may access
+// it with the requested level. Paths under team/<name>/ belong to the team and
+// are governed by the caller's team role. Otherwise an empty owner (or the
+// caller's own login) addresses the caller's vault and any other owner must
+// have granted access. Grants and memberships are looked up on every call, so
+// revoking one takes effect on the next request.
+func (s *vaultService) authorize(
+	ctx context.Context,
+	userID int64,
+	owner, path, access string,
+) (entity.SecretOwner, error) {
+	teamName, isTeam, err := teamFromPath(path)
+	if err != nil {
+		return entity.SecretOwner{}, err
+	}
+	if isTeam {
+		if owner != "" {
+			return entity.SecretOwner{}, errors.New("owner can't be set for team secrets")
+		}
+		return s.authorizeTeam(ctx, userID, teamName, access)
+	}
+
+	if owner == "" {
+		return entity.SecretOwner{UserID: userID}, nil
+	}
+
+	ownerID, err := s.userRepo.GetIDByLogin(ctx, owner)
+	if err != nil {
+		return entity.SecretOwner{}, fmt.Errorf("failed to find owner: %w", err)
+	}
+	if ownerID == userID {
+		return entity.SecretOwner{UserID: userID}, nil
+	}
+	if access == entity.AccessManage {
+		return entity.SecretOwner{}, fmt.Errorf("%w: only the owner can do this", ErrAccessDenied)
+	}
+
+	granted, err := s.grantRepo.FindAccess(ctx, ownerID, userID, path)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.SecretOwner{}, fmt.Errorf("%w: no grant on %s", ErrAccessDenied, path)
+	}
+	if err != nil {
+		return entity.SecretOwner{}, fmt.Errorf("failed to check grant: %w", err)
+	}
+	if access == entity.AccessWrite && granted != entity.AccessWrite {
+		return entity.SecretOwner{}, fmt.Errorf("%w: read-only grant", ErrAccessDenied)
+	}
+	return entity.SecretOwner{UserID: ownerID}, nil
+}
+
+func (s *vaultService) authorizeTeam(
+	ctx context.Context,
+	userID int64,
+	teamName, access string,
+) (entity.SecretOwner, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		return entity.SecretOwner{}, fmt.Errorf("failed to find team: %w", err)
+	}
+	member, err := s.teamRepo.GetMember(ctx, team.ID, userID)
+	if err != nil {
+		return entity.SecretOwner{}, fmt.Errorf("%w: not a member of team %s", ErrAccessDenied, teamName)
+	}
+	if !roleAllows(member.Role, access) {
+		return entity.SecretOwner{}, fmt.Errorf("%w: role %s in team %s", ErrAccessDenied, member.Role, teamName)
+	}
+	return entity.SecretOwner{TeamID: team.ID}, nil
+}
+
 func (s *vaultService) GetSecret(
 	ctx context.Context,
 	userID int64,
-	path string,
+	owner, path string,
 ) (dto.DecryptedSecretResponse, error) {
-	secret, err := s.repo.GetByUserAndPath(ctx, userID, path)
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessRead)
+	if err != nil {
+		return dto.DecryptedSecretResponse{}, err
+	}
+
+	secret, err := s.repo.GetByUserAndPath(ctx, secretOwner, path)
+	if err != nil {
+		return dto.DecryptedSecretResponse{}, fmt.Errorf("failed to get secret: %w", err)
+	}
+	return s.decryptSecret(ctx, secret)
+}
+
+// GetSecretVersion reads an earlier version; version 0 reads the latest.
+func (s *vaultService) GetSecretVersion(
+	ctx context.Context,
+	userID int64,
+	owner, path string,
+	version int64,
+) (dto.DecryptedSecretResponse, error) {
+	if version == 0 {
+		return s.GetSecret(ctx, userID, owner, path)
+	}
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessRead)
+	if err != nil {
+		return dto.DecryptedSecretResponse{}, err
+	}
+
+	secret, err := s.repo.GetVersion(ctx, secretOwner, path, version)
 	if err != nil {
 		return dto.DecryptedSecretResponse{}, fmt.Errorf("failed to get secret: %w", err)
 	}
+	return s.decryptSecret(ctx, secret)
+}
 
-	var decrypted []byte
+func (s *vaultService) ListSecretVersions(
+	ctx context.Context,
+	userID int64,
+	owner, path string,
+) ([]entity.SecretVersion, error) {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessRead)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.repo.ListVersions(ctx, secretOwner, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secret versions: %w", err)
+	}
+	return versions, nil
+}
+
+func (s *vaultService) decryptSecret(
+	ctx context.Context,
+	secret entity.OneSecretVersionWithMetadata,
+) (dto.DecryptedSecretResponse, error) {
+	var (
+		decrypted []byte
+		err       error
+	)
 	if secret.FilePath != nil {
 		var file []byte
 		file, err = s.fileRepo.Load(ctx, *secret.FilePath)
@@ -91,28 +247,43 @@ func (s *vaultService) ListSecretsPaths(ctx context.Context, userID int64) ([]st
 	return paths, nil
 }
 
-func (s *vaultService) SaveSecret(ctx context.Context, request *dto.ServerCreateSecret) error {
+func (s *vaultService) ListSharedSecrets(ctx context.Context, userID int64) ([]entity.SharedSecret, error) {
+	shared, err := s.grantRepo.ListSharedSecrets(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared secrets: %w", err)
+	}
+	return shared, nil
+}
+
+func (s *vaultService) SaveSecret(ctx context.Context, request *dto.ServerCreateSecret) (entity.SecretVersion, error) {
 	var secretVersion *entity.SecretVersion
 
+	secretOwner, err := s.authorize(ctx, request.UserID, request.Owner, request.Path, entity.AccessWrite)
+	if err != nil {
+		return entity.SecretVersion{}, err
+	}
+
 	encrypted, err := s.cryptoService.Encode(request.Payload)
 	if err != nil {
-		return fmt.Errorf("failed to encrypt secret: %w", err)
+		return entity.SecretVersion{}, fmt.Errorf("failed to encrypt secret: %w", err)
 	}
 
 	secretMetadata := &entity.SecretMetadata{
-		UserID:      request.UserID,
+		UserID:      secretOwner.UserID,
+		TeamID:      secretOwner.TeamID,
 		Path:        request.Path,
 		ExpiredAt:   request.ExpiredAt,
 		Description: request.Description,
+		KeepCurrent: request.KeepMetadata,
 	}
 	if request.FilePath != nil && s.fileRepo != nil {
 		err = s.fileRepo.Save(ctx, *request.FilePath, encrypted)
 		if err != nil {
-			return fmt.Errorf("failed to store file: %w", err)
+			return entity.SecretVersion{}, fmt.Errorf("failed to store file: %w", err)
 		}
 		placeholder, err := s.cryptoService.Encode([]byte(`{"status": "FILE-UPLOADED"}`))
 		if err != nil {
-			return fmt.Errorf("failed to encrypt file placeholder: %w", err)
+			return entity.SecretVersion{}, fmt.Errorf("failed to encrypt file placeholder: %w", err)
 		}
 
 		secretVersion = &entity.SecretVersion{
@@ -124,42 +295,175 @@ func (s *vaultService) SaveSecret(ctx context.Context, request *dto.ServerCreate
 			Value: encrypted,
 		}
 	}
+	secretVersion.CAS = request.CAS
 
 	_, err = s.repo.SaveOrUpdate(ctx, secretMetadata, secretVersion)
+	if errors.Is(err, repository.ErrVersionMismatch) {
+		return entity.SecretVersion{}, ErrCheckAndSet
+	}
+	if err != nil {
+		return entity.SecretVersion{}, fmt.Errorf("failed to save secret: %w", err)
+	}
+	return *secretVersion, nil
+}
+
+func (s *vaultService) DeleteSecret(ctx context.Context, userID int64, owner, path string) error {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessWrite)
+	if err != nil {
+		return err
+	}
+	err = s.repo.Delete(ctx, secretOwner, path)
 	if err != nil {
-		return fmt.Errorf("failed to save secret: %w", err)
+		return fmt.Errorf(errorDeleteSecret, err)
 	}
 	return nil
 }
 
-func (s *vaultService) DeleteSecret(ctx context.Context, userID int64, path string) error {
-	err := s.repo.Delete(ctx, userID, path)
+// DeleteVersions soft-deletes only the listed versions of path.
+func (s *vaultService) DeleteVersions(
+	ctx context.Context,
+	userID int64,
+	owner, path string,
+	versions []int64,
+) error {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessWrite)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteVersions(ctx, secretOwner, path, versions)
 	if err != nil {
 		return fmt.Errorf(errorDeleteSecret, err)
 	}
 	return nil
 }
 
-func (s *vaultService) DestroySecret(ctx context.Context, userID int64, path string) error {
-	err := s.repo.DestroySecret(ctx, userID, path)
+func (s *vaultService) DestroySecret(ctx context.Context, userID int64, owner, path string) error {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessManage)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DestroySecret(ctx, secretOwner, path)
 	if err != nil {
 		return fmt.Errorf(errorDeleteSecret, err)
 	}
 	return nil
 }
 
-func (s *vaultService) DeleteMetadata(ctx context.Context, userID int64, path string) error {
-	err := s.repo.DeleteMetadata(ctx, userID, path)
+// DestroyVersions wipes only the listed versions of path.
+func (s *vaultService) DestroyVersions(
+	ctx context.Context,
+	userID int64,
+	owner, path string,
+	versions []int64,
+) error {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessManage)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DestroyVersions(ctx, secretOwner, path, versions)
 	if err != nil {
 		return fmt.Errorf(errorDeleteSecret, err)
 	}
 	return nil
 }
 
-func (s *vaultService) UndeleteSecret(ctx context.Context, userID int64, path string, version int64) error {
-	err := s.repo.UndeleteSecret(ctx, userID, path, version)
+func (s *vaultService) DeleteMetadata(ctx context.Context, userID int64, owner, path string) error {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessManage)
+	if err != nil {
+		return err
+	}
+	err = s.repo.DeleteMetadata(ctx, secretOwner, path)
 	if err != nil {
 		return fmt.Errorf(errorDeleteSecret, err)
 	}
 	return nil
 }
+
+func (s *vaultService) UndeleteSecret(
+	ctx context.Context,
+	userID int64,
+	owner, path string,
+	version int64,
+) error {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessWrite)
+	if err != nil {
+		return err
+	}
+	err = s.repo.UndeleteSecret(ctx, secretOwner, path, version)
+	if err != nil {
+		return fmt.Errorf(errorDeleteSecret, err)
+	}
+	return nil
+}
+
+// SecretExists tells whether path already holds a secret the caller can write
+// to, so that a save can be classified as create or update.
+func (s *vaultService) SecretExists(ctx context.Context, userID int64, owner, path string) (bool, error) {
+	secretOwner, err := s.authorize(ctx, userID, owner, path, entity.AccessWrite)
+	if err != nil {
+		return false, err
+	}
+	_, err = s.repo.GetByUserAndPath(ctx, secretOwner, path)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get secret: %w", err)
+	}
+	return true, nil
+}
+
+// GetChangesSince returns a page of the secrets in userID's own vault that
+// changed since page.Cursor. The first page of a sync fixes its horizon, the
+// oldest transaction still running, and the sync ends there: the next one
+// starts from it and picks up whatever was still in flight.
+func (s *vaultService) GetChangesSince(
+	ctx context.Context,
+	userID int64,
+	page dto.ChangesPage,
+) (dto.SecretChanges, error) {
+	limit := page.Limit
+	if limit <= 0 || limit > maxChangesPage {
+		limit = maxChangesPage
+	}
+	horizon := page.Horizon
+	if horizon == 0 {
+		var err error
+		horizon, err = s.repo.ChangeHorizon(ctx)
+		if err != nil {
+			return dto.SecretChanges{}, fmt.Errorf("failed to get changes: %w", err)
+		}
+	}
+	if horizon <= page.Cursor {
+		return dto.SecretChanges{Cursor: page.Cursor}, nil
+	}
+
+	changes, err := s.repo.ListChanges(ctx, userID, page.Cursor, horizon, page.AfterID, limit+1)
+	if err != nil {
+		return dto.SecretChanges{}, fmt.Errorf("failed to get changes: %w", err)
+	}
+	result := dto.SecretChanges{Cursor: horizon}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		result = dto.SecretChanges{
+			Cursor:  page.Cursor,
+			Horizon: horizon,
+			AfterID: changes[limit-1].ID,
+			HasMore: true,
+		}
+	}
+
+	for i := range changes {
+		c := &changes[i]
+		if c.Version == 0 || c.FilePath != nil {
+			c.Value = nil
+			continue
+		}
+		c.Value, err = s.cryptoService.Decode(c.Value)
+		if err != nil {
+			return dto.SecretChanges{}, fmt.Errorf("failed to decrypt secret %s: %w", c.Path, err)
+		}
+	}
+	result.Changes = changes
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"keeper/internal/config"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultService_GetChangesSince(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	crypto, err := NewCryptoService(config.SecurityConfig{DataEncryptionKey: "6368616e676520746869732070617373"})
+	require.NoError(t, err)
+	repo := mocks.NewMockVaultRepositoryInterface(ctrl)
+	svc := NewVaultService(repo, nil, nil, nil, crypto, nil)
+	ctx := t.Context()
+
+	sealed, err := crypto.Encode([]byte(`{"password":"s3cret"}`))
+	require.NoError(t, err)
+	file := "id_rsa"
+	changes := []entity.SecretChange{
+		{ID: 1, Path: "app/db", Version: 2, Latest: 2, Value: sealed},
+		{ID: 4, Path: "app/key", Version: 1, Latest: 1, Value: sealed, FilePath: &file},
+		{ID: 7, Path: "app/old", Latest: 3, Value: sealed},
+	}
+
+	t.Run("last page moves the cursor to the horizon", func(t *testing.T) {
+		repo.EXPECT().ChangeHorizon(ctx).Return(int64(900), nil)
+		repo.EXPECT().ListChanges(ctx, int64(1), int64(100), int64(900), int64(0), 11).
+			Return(append([]entity.SecretChange(nil), changes...), nil)
+
+		page, err := svc.GetChangesSince(ctx, 1, dto.ChangesPage{Cursor: 100, Limit: 10})
+		require.NoError(t, err)
+		require.False(t, page.HasMore)
+		require.Equal(t, int64(900), page.Cursor)
+		require.Len(t, page.Changes, 3)
+		require.JSONEq(t, `{"password":"s3cret"}`, string(page.Changes[0].Value))
+		require.Nil(t, page.Changes[1].Value, "files are read with GetSecret")
+		require.Nil(t, page.Changes[2].Value, "a deleted secret has no value")
+	})
+
+	t.Run("a full page keeps the cursor and pins the horizon", func(t *testing.T) {
+		repo.EXPECT().ChangeHorizon(ctx).Return(int64(900), nil)
+		repo.EXPECT().ListChanges(ctx, int64(1), int64(100), int64(900), int64(0), 3).
+			Return(append([]entity.SecretChange(nil), changes...), nil)
+
+		page, err := svc.GetChangesSince(ctx, 1, dto.ChangesPage{Cursor: 100, Limit: 2})
+		require.NoError(t, err)
+		require.True(t, page.HasMore)
+		require.Len(t, page.Changes, 2)
+		require.Equal(t, int64(100), page.Cursor)
+		require.Equal(t, int64(900), page.Horizon)
+		require.Equal(t, int64(4), page.AfterID)
+
+		repo.EXPECT().ListChanges(ctx, int64(1), int64(100), int64(900), int64(4), 3).
+			Return([]entity.SecretChange{changes[2]}, nil)
+		page, err = svc.GetChangesSince(ctx, 1, dto.ChangesPage{Cursor: 100, Horizon: 900, AfterID: 4, Limit: 2})
+		require.NoError(t, err)
+		require.False(t, page.HasMore)
+		require.Equal(t, int64(900), page.Cursor)
+	})
+
+	t.Run("nothing committed since the cursor", func(t *testing.T) {
+		repo.EXPECT().ChangeHorizon(ctx).Return(int64(900), nil)
+
+		page, err := svc.GetChangesSince(ctx, 1, dto.ChangesPage{Cursor: 900})
+		require.NoError(t, err)
+		require.Empty(t, page.Changes)
+		require.Equal(t, int64(900), page.Cursor)
+	})
+}
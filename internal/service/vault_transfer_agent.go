@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"keeper/internal/dto"
+	"keeper/internal/kpx"
+	"time"
+)
+
+// Conflict strategies for an import into a path that already holds a secret.
+const (
+	ImportSkip       = "skip"
+	ImportOverwrite  = "overwrite"
+	ImportNewVersion = "new-version"
+)
+
+var ErrImportStrategy = errors.New("conflict strategy must be skip, overwrite or new-version")
+
+// VaultTransferService copies the secrets a user can list to and from .kpx
+// files. Every call to the server gets its own timeout, so large vaults are
+// not cut off by a single deadline.
+type VaultTransferService interface {
+	Export(ctx context.Context, token string, w *kpx.Writer, allVersions bool) (dto.VaultTransferStats, error)
+	Import(ctx context.Context, token string, r SecretSource, strategy string) (dto.VaultTransferStats, error)
+}
+
+// SecretSource yields the secrets to import and io.EOF after the last one.
+// *kpx.Reader is one; SliceSource serves secrets converted in memory.
+type SecretSource interface {
+	Next() (*kpx.Secret, error)
+}
+
+type sliceSource struct {
+	secrets []*kpx.Secret
+}
+
+func SliceSource(secrets []*kpx.Secret) SecretSource {
+	return &sliceSource{secrets: secrets}
+}
+
+func (s *sliceSource) Next() (*kpx.Secret, error) {
+	if len(s.secrets) == 0 {
+		return nil, io.EOF
+	}
+	next := s.secrets[0]
+	s.secrets = s.secrets[1:]
+	return next, nil
+}
+
+type vaultTransferService struct {
+	vault   RemoteVaultService
+	timeout time.Duration
+}
+
+func NewVaultTransferService(vault RemoteVaultService, timeout time.Duration) VaultTransferService {
+	return &vaultTransferService{vault: vault, timeout: timeout}
+}
+
+// Export writes the latest live version of every secret, or all live
+// versions. Paths whose versions are all deleted or destroyed are skipped.
+func (s *vaultTransferService) Export(
+	ctx context.Context,
+	token string,
+	w *kpx.Writer,
+	allVersions bool,
+) (dto.VaultTransferStats, error) {
+	var stats dto.VaultTransferStats
+
+	callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	list, err := s.vault.ListSecretPaths(callCtx, token)
+	cancel()
+	if err != nil {
+		return stats, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	for _, path := range list.Paths {
+		secret, err := s.exportSecret(ctx, token, path, allVersions)
+		if err != nil {
+			return stats, fmt.Errorf("%s: %w", path, err)
+		}
+		if secret == nil {
+			stats.Skipped++
+			continue
+		}
+		if err := w.Write(secret); err != nil {
+			return stats, fmt.Errorf("failed to write %s: %w", secret.Path, err)
+		}
+		stats.Secrets++
+		stats.Versions += len(secret.Versions)
+	}
+	return stats, nil
+}
+
+func (s *vaultTransferService) exportSecret(
+	ctx context.Context,
+	token, path string,
+	allVersions bool,
+) (*kpx.Secret, error) {
+	callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	versions, err := s.vault.ListSecretVersions(callCtx, token, "", path)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", path, err)
+	}
+
+	live := make([]int64, 0, len(versions))
+	for _, v := range versions {
+		if v.DeletedAt == nil && !v.Destroyed {
+			live = append(live, v.Version)
+		}
+	}
+	if len(live) == 0 {
+		return nil, nil
+	}
+	if !allVersions {
+		live = live[len(live)-1:]
+	}
+
+	secret := &kpx.Secret{Path: path, Versions: make([]kpx.Version, 0, len(live))}
+	for _, version := range live {
+		callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+		got, err := s.vault.GetSecretVersion(callCtx, token, "", path, version)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s version %d: %w", path, version, err)
+		}
+		v := kpx.Version{Version: got.Version, CreatedAt: got.CreatedAt}
+		if got.FilePath != nil {
+			v.FileName = *got.FilePath
+			v.Data = got.Payload
+		} else if err := json.Unmarshal(got.Payload, &v.Data); err != nil {
+			// The server sends values as a base64 JSON string.
+			return nil, fmt.Errorf("failed to decode version %d: %w", version, err)
+		}
+		secret.Versions = append(secret.Versions, v)
+		secret.Description = got.Description
+		secret.ExpiresAt = got.ExpiredAt
+	}
+	return secret, nil
+}
+
+// Import writes every version in the file, oldest first, so each becomes a
+// new version on the server. An existing path is left alone with skip, has
+// its versions destroyed first with overwrite, and keeps its history with
+// new-version.
+func (s *vaultTransferService) Import(
+	ctx context.Context,
+	token string,
+	r SecretSource,
+	strategy string,
+) (dto.VaultTransferStats, error) {
+	var stats dto.VaultTransferStats
+	switch strategy {
+	case ImportSkip, ImportOverwrite, ImportNewVersion:
+	default:
+		return stats, ErrImportStrategy
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	list, err := s.vault.ListSecretPaths(callCtx, token)
+	cancel()
+	if err != nil {
+		return stats, fmt.Errorf("failed to list secrets: %w", err)
+	}
+	existing := make(map[string]bool, len(list.Paths))
+	for _, path := range list.Paths {
+		existing[path] = true
+	}
+
+	for {
+		secret, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, fmt.Errorf("failed to read import: %w", err)
+		}
+
+		var replaced []int64
+		if existing[secret.Path] {
+			switch strategy {
+			case ImportSkip:
+				stats.Skipped++
+				continue
+			case ImportOverwrite:
+				if replaced, err = s.storedVersions(ctx, token, secret.Path); err != nil {
+					return stats, err
+				}
+			}
+		}
+
+		for _, v := range secret.Versions {
+			req := &dto.AgentCreateSecret{
+				Token:       token,
+				Path:        secret.Path,
+				Description: secret.Description,
+				Payload:     v.Data,
+				ExpiredAt:   secret.ExpiresAt,
+			}
+			if v.FileName != "" {
+				req.FilePath = &v.FileName
+			}
+			callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+			err := s.vault.SaveSecret(callCtx, req)
+			cancel()
+			if err != nil {
+				return stats, fmt.Errorf("%s: %w", secret.Path, err)
+			}
+			stats.Versions++
+		}
+
+		// The old versions go only once the imported ones are stored, so a
+		// failed import never leaves the path without a value.
+		if len(replaced) > 0 {
+			callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+			err := s.vault.DestroyVersions(callCtx, token, "", secret.Path, replaced)
+			cancel()
+			if err != nil {
+				return stats, fmt.Errorf("%s: imported, but the old versions are left: %w", secret.Path, err)
+			}
+		}
+		existing[secret.Path] = true
+		stats.Secrets++
+	}
+}
+
+// storedVersions returns the versions of path that still hold data, which an
+// overwrite replaces.
+func (s *vaultTransferService) storedVersions(ctx context.Context, token, path string) ([]int64, error) {
+	callCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	versions, err := s.vault.ListSecretVersions(callCtx, token, "", path)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", path, err)
+	}
+	stored := make([]int64, 0, len(versions))
+	for _, v := range versions {
+		if !v.Destroyed {
+			stored = append(stored, v.Version)
+		}
+	}
+	return stored, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"keeper/internal/dto"
+	"keeper/internal/kpx"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeVersion struct {
+	data      []byte
+	fileName  string
+	deleted   bool
+	destroyed bool
+}
+
+// fakeVault keeps secrets in memory and answers the way the server does:
+// values come back as base64 JSON strings, files as raw bytes.
+type fakeVault struct {
+	RemoteVaultService
+	secrets  map[string][]fakeVersion
+	order    []string
+	failSave bool
+}
+
+func newFakeVault() *fakeVault {
+	return &fakeVault{secrets: map[string][]fakeVersion{}}
+}
+
+func (f *fakeVault) add(path string, v fakeVersion) {
+	if _, ok := f.secrets[path]; !ok {
+		f.order = append(f.order, path)
+	}
+	f.secrets[path] = append(f.secrets[path], v)
+}
+
+func (f *fakeVault) ListSecretPaths(_ context.Context, _ string) (*dto.AgentSecretList, error) {
+	return &dto.AgentSecretList{Paths: slices.Clone(f.order)}, nil
+}
+
+func (f *fakeVault) ListSecretVersions(_ context.Context, _, _, path string) ([]dto.AgentSecretVersion, error) {
+	versions := make([]dto.AgentSecretVersion, 0, len(f.secrets[path]))
+	for i, v := range f.secrets[path] {
+		info := dto.AgentSecretVersion{Version: int64(i + 1), Destroyed: v.destroyed}
+		if v.deleted {
+			now := time.Now()
+			info.DeletedAt = &now
+		}
+		versions = append(versions, info)
+	}
+	return versions, nil
+}
+
+func (f *fakeVault) GetSecretVersion(_ context.Context, _, _, path string, version int64) (*dto.AgentGetSecret, error) {
+	v := f.secrets[path][version-1]
+	got := &dto.AgentGetSecret{Path: path, Version: version, Description: "desc " + path}
+	if v.fileName != "" {
+		got.FilePath = &v.fileName
+		got.Payload = v.data
+		return got, nil
+	}
+	payload, err := json.Marshal(v.data)
+	got.Payload = payload
+	return got, err
+}
+
+func (f *fakeVault) SaveSecret(_ context.Context, req *dto.AgentCreateSecret) error {
+	if f.failSave {
+		return errors.New("unavailable")
+	}
+	v := fakeVersion{data: req.Payload}
+	if req.FilePath != nil {
+		v.fileName = *req.FilePath
+	}
+	f.add(req.Path, v)
+	return nil
+}
+
+func (f *fakeVault) DestroyVersions(_ context.Context, _, _, path string, versions []int64) error {
+	for _, version := range versions {
+		f.secrets[path][version-1].destroyed = true
+	}
+	return nil
+}
+
+func exportVault(t *testing.T, vault RemoteVaultService, allVersions bool) ([]byte, dto.VaultTransferStats) {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := kpx.NewWriter(&buf, "pass", kpx.Header{})
+	require.NoError(t, err)
+	stats, err := NewVaultTransferService(vault, time.Second).Export(t.Context(), "token", w, allVersions)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes(), stats
+}
+
+func importVault(t *testing.T, vault RemoteVaultService, data []byte, strategy string) dto.VaultTransferStats {
+	t.Helper()
+	r, err := kpx.NewReader(bytes.NewReader(data), "pass")
+	require.NoError(t, err)
+	stats, err := NewVaultTransferService(vault, time.Second).Import(t.Context(), "token", r, strategy)
+	require.NoError(t, err)
+	return stats
+}
+
+func sourceVault() *fakeVault {
+	src := newFakeVault()
+	src.add("db", fakeVersion{data: []byte(`{"password":"v1"}`)})
+	src.add("db", fakeVersion{data: []byte(`{"password":"v2"}`), deleted: true})
+	src.add("db", fakeVersion{data: []byte(`{"password":"v3"}`)})
+	src.add("key", fakeVersion{data: []byte{0, 1, 255}, fileName: "id_rsa"})
+	src.add("gone", fakeVersion{data: []byte(`{}`), destroyed: true})
+	return src
+}
+
+func TestVaultTransfer_RoundTrip(t *testing.T) {
+	data, stats := exportVault(t, sourceVault(), true)
+	require.Equal(t, dto.VaultTransferStats{Secrets: 2, Versions: 3, Skipped: 1}, stats)
+
+	dst := newFakeVault()
+	stats = importVault(t, dst, data, ImportSkip)
+	require.Equal(t, dto.VaultTransferStats{Secrets: 2, Versions: 3}, stats)
+	require.Equal(t, []fakeVersion{
+		{data: []byte(`{"password":"v1"}`)},
+		{data: []byte(`{"password":"v3"}`)},
+	}, dst.secrets["db"])
+	require.Equal(t, []fakeVersion{{data: []byte{0, 1, 255}, fileName: "id_rsa"}}, dst.secrets["key"])
+
+	latest, stats := exportVault(t, sourceVault(), false)
+	require.Equal(t, 2, stats.Versions)
+	r, err := kpx.NewReader(bytes.NewReader(latest), "pass")
+	require.NoError(t, err)
+	secret, err := r.Next()
+	require.NoError(t, err)
+	require.Len(t, secret.Versions, 1)
+	require.Equal(t, int64(3), secret.Versions[0].Version)
+	require.Equal(t, "desc db", secret.Description)
+}
+
+func TestVaultTransfer_ImportConflicts(t *testing.T) {
+	data, _ := exportVault(t, sourceVault(), false)
+	existing := func() *fakeVault {
+		dst := newFakeVault()
+		dst.add("db", fakeVersion{data: []byte(`{"password":"local"}`)})
+		return dst
+	}
+
+	dst := existing()
+	stats := importVault(t, dst, data, ImportSkip)
+	require.Equal(t, dto.VaultTransferStats{Secrets: 1, Versions: 1, Skipped: 1}, stats)
+	require.Len(t, dst.secrets["db"], 1)
+
+	dst = existing()
+	importVault(t, dst, data, ImportNewVersion)
+	require.Len(t, dst.secrets["db"], 2)
+	require.False(t, dst.secrets["db"][0].destroyed)
+
+	dst = existing()
+	importVault(t, dst, data, ImportOverwrite)
+	require.Len(t, dst.secrets["db"], 2)
+	require.True(t, dst.secrets["db"][0].destroyed)
+	require.False(t, dst.secrets["db"][1].destroyed)
+	require.Equal(t, []byte(`{"password":"v3"}`), dst.secrets["db"][1].data)
+
+	// A failed save keeps the value the path had.
+	dst = existing()
+	dst.failSave = true
+	r, err := kpx.NewReader(bytes.NewReader(data), "pass")
+	require.NoError(t, err)
+	_, err = NewVaultTransferService(dst, time.Second).Import(t.Context(), "token", r, ImportOverwrite)
+	require.Error(t, err)
+	require.Len(t, dst.secrets["db"], 1)
+	require.False(t, dst.secrets["db"][0].destroyed)
+
+	r, err = kpx.NewReader(bytes.NewReader(data), "pass")
+	require.NoError(t, err)
+	_, err = NewVaultTransferService(dst, time.Second).Import(t.Context(), "token", r, "merge")
+	require.ErrorIs(t, err, ErrImportStrategy)
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"keeper/internal/client"
+	"keeper/internal/dto"
+	pb "keeper/internal/proto/v1"
+	pbModel "keeper/internal/proto/v1/model"
+)
+
+type RemoteWrapService interface {
+	Wrap(ctx context.Context, token string, req dto.WrapSecret) (dto.WrappedToken, error)
+	// Unwrap needs no access token, wrapToken is the credential.
+	Unwrap(ctx context.Context, wrapToken string) (dto.UnwrappedSecret, error)
+}
+
+type remoteWrapService struct {
+	client pb.WrapServiceClient
+}
+
+func NewRemoteWrapService(client pb.WrapServiceClient) RemoteWrapService {
+	return &remoteWrapService{client: client}
+}
+
+func (s *remoteWrapService) Wrap(ctx context.Context, token string, secret dto.WrapSecret) (dto.WrappedToken, error) {
+	req := &pbModel.WrapRequest{}
+	req.SetPath(secret.Path)
+	req.SetOwner(secret.Owner)
+	req.SetValue(secret.Value)
+	req.SetTtlSeconds(int64(secret.TTL.Seconds()))
+	resp, err := s.client.Wrap(ctx, req, client.WithToken(token))
+	if err != nil {
+		return dto.WrappedToken{}, fmt.Errorf("failed to wrap secret: %w", err)
+	}
+	return dto.WrappedToken{
+		Token:     resp.GetWrapToken(),
+		URL:       resp.GetUrl(),
+		ExpiresAt: resp.GetExpiresAt().AsTime(),
+	}, nil
+}
+
+func (s *remoteWrapService) Unwrap(ctx context.Context, wrapToken string) (dto.UnwrappedSecret, error) {
+	req := &pbModel.UnwrapRequest{}
+	req.SetWrapToken(wrapToken)
+	resp, err := s.client.Unwrap(ctx, req)
+	if err != nil {
+		return dto.UnwrappedSecret{}, fmt.Errorf("failed to unwrap: %w", err)
+	}
+	return dto.UnwrappedSecret{
+		Path:        resp.GetPath(),
+		Description: resp.GetDescription(),
+		FileName:    resp.GetFileName(),
+		Value:       resp.GetValue(),
+		CreatedAt:   resp.GetCreatedAt().AsTime(),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository"
+	"path/filepath"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+)
+
+// WrapTokenPrefix starts every wrapping token so it can't be mistaken for an
+// access token or an API key.
+const WrapTokenPrefix = "kpw_"
+
+const (
+	wrapTokenBytes = 32
+	DefaultWrapTTL = 24 * time.Hour
+	MaxWrapTTL     = 7 * 24 * time.Hour
+)
+
+var ErrInvalidWrapToken = errors.New("wrapping token is invalid, expired or already used")
+
+// WrapService hands out single-use copies of secrets. A wrapped value can be
+// unwrapped by anyone holding the token, exactly once and before it expires.
+type WrapService interface {
+	Wrap(ctx context.Context, req dto.WrapSecret) (dto.WrappedToken, error)
+	Unwrap(ctx context.Context, token string) (dto.UnwrappedSecret, error)
+}
+
+type wrapService struct {
+	repo          repository.WrapRepository
+	vaultService  VaultService
+	cryptoService CryptoService
+	now           func() time.Time
+}
+
+func NewWrapService(repo repository.WrapRepository, vaultService VaultService, cryptoService CryptoService) WrapService {
+	return &wrapService{
+		repo:          repo,
+		vaultService:  vaultService,
+		cryptoService: cryptoService,
+		now:           time.Now,
+	}
+}
+
+// Wrap copies the secret at Path, read with the caller's access rights, or
+// the ad-hoc Value when Path is empty.
+func (s *wrapService) Wrap(ctx context.Context, req dto.WrapSecret) (dto.WrappedToken, error) {
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = DefaultWrapTTL
+	}
+	if ttl < time.Second || ttl > MaxWrapTTL {
+		return dto.WrappedToken{}, fmt.Errorf("wrap ttl must be between 1s and %s", MaxWrapTTL)
+	}
+
+	wrapped := entity.WrappedSecret{CreatedBy: req.UserID}
+	value := req.Value
+	if req.Path != "" {
+		secret, err := s.vaultService.GetSecret(ctx, req.UserID, req.Owner, req.Path)
+		if err != nil {
+			return dto.WrappedToken{}, fmt.Errorf("failed to read secret: %w", err)
+		}
+		if secret.DeletedAt != nil {
+			return dto.WrappedToken{}, fmt.Errorf("secret %s is deleted", req.Path)
+		}
+		wrapped.Path = secret.Path
+		wrapped.Description = secret.Description
+		if secret.FilePath != nil {
+			wrapped.FileName = filepath.Base(*secret.FilePath)
+		}
+		value = secret.Data
+	} else if len(value) == 0 {
+		return dto.WrappedToken{}, errors.New("either a path or a value is required")
+	}
+
+	payload, err := s.cryptoService.Encode(value)
+	if err != nil {
+		return dto.WrappedToken{}, fmt.Errorf("failed to encrypt wrapped value: %w", err)
+	}
+	raw := make([]byte, wrapTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return dto.WrappedToken{}, fmt.Errorf("failed to generate wrapping token: %w", err)
+	}
+	token := WrapTokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	wrapped.TokenHash = sha256Hex(token)
+	wrapped.Payload = payload
+	wrapped.ExpiresAt = s.now().Add(ttl)
+
+	// Expired rows are never unwrapped; clearing them here keeps the table small.
+	if _, err := s.repo.DeleteExpired(ctx); err != nil {
+		return dto.WrappedToken{}, fmt.Errorf("failed to clean up wrapped secrets: %w", err)
+	}
+	if err := s.repo.Create(ctx, &wrapped); err != nil {
+		return dto.WrappedToken{}, fmt.Errorf("failed to store wrapped secret: %w", err)
+	}
+	return dto.WrappedToken{Token: token, ExpiresAt: wrapped.ExpiresAt}, nil
+}
+
+func (s *wrapService) Unwrap(ctx context.Context, token string) (dto.UnwrappedSecret, error) {
+	wrapped, err := s.repo.Take(ctx, sha256Hex(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dto.UnwrappedSecret{}, ErrInvalidWrapToken
+	}
+	if err != nil {
+		return dto.UnwrappedSecret{}, fmt.Errorf("failed to unwrap: %w", err)
+	}
+
+	value, err := s.cryptoService.Decode(wrapped.Payload)
+	if err != nil {
+		return dto.UnwrappedSecret{}, fmt.Errorf("failed to decrypt wrapped value: %w", err)
+	}
+	return dto.UnwrappedSecret{
+		CreatedAt:   wrapped.CreatedAt,
+		Path:        wrapped.Path,
+		Description: wrapped.Description,
+		FileName:    wrapped.FileName,
+		Value:       value,
+	}, nil
+}
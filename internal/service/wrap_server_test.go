@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"keeper/internal/config"
+	"keeper/internal/dto"
+	"keeper/internal/entity"
+	"keeper/internal/repository/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubVault serves a single secret; other VaultService methods are not used.
+type stubVault struct {
+	VaultService
+	secret dto.DecryptedSecretResponse
+}
+
+func (v stubVault) GetSecret(_ context.Context, _ int64, _, _ string) (dto.DecryptedSecretResponse, error) {
+	return v.secret, nil
+}
+
+func newTestWrapService(t *testing.T, vault VaultService) (WrapService, *mocks.MockWrapRepository) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	crypto, err := NewCryptoService(config.SecurityConfig{DataEncryptionKey: "6368616e676520746869732070617373"})
+	require.NoError(t, err)
+	repo := mocks.NewMockWrapRepository(ctrl)
+	return NewWrapService(repo, vault, crypto), repo
+}
+
+func TestWrapService_WrapAndUnwrap(t *testing.T) {
+	svc, repo := newTestWrapService(t, nil)
+	ctx := t.Context()
+
+	var stored entity.WrappedSecret
+	repo.EXPECT().DeleteExpired(ctx).Return(int64(0), nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, w *entity.WrappedSecret) error {
+		stored = *w
+		return nil
+	})
+
+	wrapped, err := svc.Wrap(ctx, dto.WrapSecret{UserID: 1, Value: []byte("hunter2")})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(wrapped.Token, WrapTokenPrefix))
+	assert.WithinDuration(t, time.Now().Add(DefaultWrapTTL), wrapped.ExpiresAt, time.Minute)
+	assert.Equal(t, sha256Hex(wrapped.Token), stored.TokenHash)
+	assert.NotContains(t, string(stored.Payload), "hunter2")
+
+	repo.EXPECT().Take(ctx, sha256Hex(wrapped.Token)).Return(stored, nil)
+	got, err := svc.Unwrap(ctx, wrapped.Token)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hunter2"), got.Value)
+
+	repo.EXPECT().Take(ctx, sha256Hex(wrapped.Token)).Return(entity.WrappedSecret{}, pgx.ErrNoRows)
+	_, err = svc.Unwrap(ctx, wrapped.Token)
+	require.ErrorIs(t, err, ErrInvalidWrapToken)
+}
+
+func TestWrapService_WrapStoredSecret(t *testing.T) {
+	file := "/tmp/uploads/id_rsa"
+	svc, repo := newTestWrapService(t, stubVault{secret: dto.DecryptedSecretResponse{
+		Path:        "ssh/deploy",
+		Description: "deploy key",
+		FilePath:    &file,
+		Data:        []byte("KEY"),
+	}})
+	ctx := t.Context()
+
+	repo.EXPECT().DeleteExpired(ctx).Return(int64(0), nil)
+	repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, w *entity.WrappedSecret) error {
+		assert.Equal(t, "ssh/deploy", w.Path)
+		assert.Equal(t, "id_rsa", w.FileName)
+		assert.Equal(t, int64(3), w.CreatedBy)
+		return nil
+	})
+
+	_, err := svc.Wrap(ctx, dto.WrapSecret{UserID: 3, Path: "ssh/deploy", TTL: time.Hour})
+	require.NoError(t, err)
+}
+
+func TestWrapService_WrapValidation(t *testing.T) {
+	svc, _ := newTestWrapService(t, nil)
+	ctx := t.Context()
+
+	_, err := svc.Wrap(ctx, dto.WrapSecret{UserID: 1})
+	require.Error(t, err)
+
+	_, err = svc.Wrap(ctx, dto.WrapSecret{UserID: 1, Value: []byte("x"), TTL: MaxWrapTTL + time.Hour})
+	require.Error(t, err)
+
+	_, err = svc.Wrap(ctx, dto.WrapSecret{UserID: 1, Value: []byte("x"), TTL: time.Nanosecond})
+	require.Error(t, err)
+	_, err = svc.Wrap(ctx, dto.WrapSecret{UserID: 1, Value: []byte("x"), TTL: -time.Hour})
+	require.Error(t, err)
+}
@@ -38,10 +38,35 @@ func runMigrations(dsn string) error {
 	return nil
 }
 
+// MigrateTo applies migrations up to version. A restore uses it to build the
+// schema a backup was taken at before loading rows into it.
+func MigrateTo(dsn string, version uint) error {
+	d, err := iofs.New(migrationsDir, "migrations")
+	if err != nil {
+		return fmt.Errorf("failed to return an iofs driver: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", d, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to get a new migrate instance: %w", err)
+	}
+	if err := m.Migrate(version); err != nil {
+		if !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("failed to migrate the DB to version %d: %w", version, err)
+		}
+	}
+	return nil
+}
+
 func NewDB(ctx context.Context, dsn string) (*DB, error) {
 	if err := runMigrations(dsn); err != nil {
 		return nil, fmt.Errorf("failed to run DB migrations: %w", err)
 	}
+	return OpenDB(ctx, dsn)
+}
+
+// OpenDB connects without running migrations.
+func OpenDB(ctx context.Context, dsn string) (*DB, error) {
 	pool, err := pgxpool.New(ctx, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a connection pool: %w", err)
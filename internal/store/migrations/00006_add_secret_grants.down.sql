@@ -0,0 +1,5 @@
+BEGIN TRANSACTION;
+
+DROP TABLE secret_grants;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS secret_grants (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    owner_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    grantee_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    path_prefix VARCHAR(255) NOT NULL,
+    access VARCHAR(16) NOT NULL CHECK (access IN ('read', 'write')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(owner_id, grantee_id, path_prefix)
+);
+
+CREATE INDEX IF NOT EXISTS secret_grants_grantee_idx ON secret_grants (grantee_id);
+
+COMMIT;
\ No newline at end of file
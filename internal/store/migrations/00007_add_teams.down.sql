@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+DELETE FROM secrets_metadata WHERE team_id IS NOT NULL;
+
+DROP INDEX IF EXISTS secrets_metadata_team_title_idx;
+
+ALTER TABLE secrets_metadata
+    DROP CONSTRAINT secrets_metadata_owner_check,
+    DROP COLUMN team_id,
+    ALTER COLUMN user_id SET NOT NULL;
+
+DROP TABLE team_members;
+DROP TABLE teams;
+
+COMMIT;
\ No newline at end of file
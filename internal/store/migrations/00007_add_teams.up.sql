@@ -0,0 +1,27 @@
+BEGIN TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS teams (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    team_id INTEGER NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS team_members_user_idx ON team_members (user_id);
+
+ALTER TABLE secrets_metadata
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE,
+    ADD CONSTRAINT secrets_metadata_owner_check CHECK ((user_id IS NULL) <> (team_id IS NULL));
+
+CREATE UNIQUE INDEX IF NOT EXISTS secrets_metadata_team_title_idx
+    ON secrets_metadata (team_id, title) WHERE team_id IS NOT NULL;
+
+COMMIT;
\ No newline at end of file
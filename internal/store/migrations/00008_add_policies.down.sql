@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+DROP TABLE policy_attachments;
+DROP TABLE policies;
+
+COMMIT;
\ No newline at end of file
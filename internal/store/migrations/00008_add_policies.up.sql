@@ -0,0 +1,22 @@
+BEGIN TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS policies (
+    id INTEGER PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    rules JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS policy_attachments (
+    policy_id INTEGER NOT NULL REFERENCES policies(id) ON DELETE CASCADE,
+    subject_type VARCHAR(16) NOT NULL CHECK (subject_type IN ('user', 'team', 'token')),
+    subject_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (policy_id, subject_type, subject_id)
+);
+
+CREATE INDEX IF NOT EXISTS policy_attachments_subject_idx ON policy_attachments (subject_type, subject_id);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS audit_log_no_update ON audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+DROP TABLE IF EXISTS audit_log;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS access_tokens_user_idx;
+
+ALTER TABLE access_tokens
+    DROP COLUMN IF EXISTS device_name,
+    DROP COLUMN IF EXISTS last_ip,
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS revoked_at;
+
+COMMIT;
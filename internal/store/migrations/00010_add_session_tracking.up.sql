@@ -0,0 +1,11 @@
+BEGIN TRANSACTION;
+
+ALTER TABLE access_tokens
+    ADD COLUMN device_name VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN last_ip VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at TIMESTAMP,
+    ADD COLUMN revoked_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS access_tokens_user_idx ON access_tokens (user_id);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN TRANSACTION;
+
+-- Each access_tokens row is a session (token family). Its refresh tokens are
+-- single-use: using one marks it used and issues the next one.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    session_id INTEGER NOT NULL REFERENCES access_tokens(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_session_idx ON refresh_tokens (session_id);
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN TRANSACTION;
+
+-- secret is encrypted with the data encryption key. last_step is the last
+-- accepted TOTP time step, a code is never accepted twice.
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret BYTEA NOT NULL,
+    confirmed_at TIMESTAMP,
+    last_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash CHAR(64) NOT NULL,
+    used_at TIMESTAMP,
+    UNIQUE (user_id, code_hash)
+);
+
+-- A login that passed the password check and waits for the second factor.
+CREATE TABLE IF NOT EXISTS login_challenges (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    challenge_hash CHAR(64) NOT NULL UNIQUE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_name TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS lockout_events;
+DROP TABLE IF EXISTS auth_throttle;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN TRANSACTION;
+
+-- Failed attempt counters for Login and attempt counters for Register. key is
+-- "account:<login>", "ip:<address>" or "register:<address>".
+CREATE TABLE IF NOT EXISTS auth_throttle (
+    key TEXT PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    last_failure_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS lockout_events (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    key TEXT NOT NULL,
+    failures INTEGER NOT NULL,
+    client_ip TEXT NOT NULL DEFAULT '',
+    locked_until TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS lockout_events_created_idx ON lockout_events (created_at);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN TRANSACTION;
+
+-- Client certificate subjects allowed to log in as a user without a password.
+-- subject is the RFC 2253 form of the verified certificate's subject.
+CREATE TABLE IF NOT EXISTS client_certificates (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    subject TEXT NOT NULL UNIQUE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
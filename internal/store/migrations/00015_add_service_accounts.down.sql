@@ -0,0 +1,8 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS service_accounts;
+DELETE FROM users WHERE kind = 'service';
+ALTER TABLE users DROP COLUMN IF EXISTS kind;
+
+COMMIT;
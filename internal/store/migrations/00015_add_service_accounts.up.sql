@@ -0,0 +1,39 @@
+BEGIN TRANSACTION;
+
+-- Service accounts are principals in users so ownership, grants, team roles
+-- and policies apply to them unchanged. They have no password and can only
+-- authenticate with API keys.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'user';
+
+CREATE TABLE IF NOT EXISTS service_accounts (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    user_id INTEGER NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    owner_user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    owner_team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((owner_user_id IS NULL) <> (owner_team_id IS NULL))
+);
+
+-- Only the SHA-256 of a key is stored; prefix is kept so keys can be told
+-- apart in listings.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    service_account_id BIGINT NOT NULL REFERENCES service_accounts(id) ON DELETE CASCADE,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,
+    prefix VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    path_prefixes TEXT[] NOT NULL,
+    capabilities TEXT[] NOT NULL,
+    allowed_cidrs TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    last_ip TEXT NOT NULL DEFAULT '',
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_service_account_idx ON api_keys (service_account_id);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN TRANSACTION;
+
+-- Keyring for signing access tokens. The newest key without retired_at signs
+-- new tokens; retired keys stay for verification until the tokens they
+-- signed have expired. private_key is PKCS#8 encrypted with the data key.
+CREATE TABLE IF NOT EXISTS jwt_keys (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    kid VARCHAR(64) NOT NULL UNIQUE,
+    algorithm VARCHAR(10) NOT NULL CHECK (algorithm IN ('EdDSA', 'ES256')),
+    private_key BYTEA NOT NULL,
+    public_key BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    retired_at TIMESTAMP
+);
+
+COMMIT;
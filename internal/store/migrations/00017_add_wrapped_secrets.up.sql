@@ -0,0 +1,20 @@
+BEGIN TRANSACTION;
+
+-- Single-use copies of secrets handed out by Wrap. Only the SHA-256 of the
+-- wrapping token is stored; payload is encrypted with the data key. A row is
+-- deleted when it is unwrapped.
+CREATE TABLE IF NOT EXISTS wrapped_secrets (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    path TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    file_name TEXT NOT NULL DEFAULT '',
+    payload BYTEA NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS wrapped_secrets_expires_idx ON wrapped_secrets (expires_at);
+
+COMMIT;
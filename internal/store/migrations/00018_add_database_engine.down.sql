@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS db_leases;
+DROP TABLE IF EXISTS db_roles;
+DROP TABLE IF EXISTS db_connections;
+
+COMMIT;
@@ -0,0 +1,40 @@
+BEGIN TRANSACTION;
+
+-- Target PostgreSQL servers that get dynamic users. dsn is encrypted with
+-- the data key since it carries an admin password.
+CREATE TABLE IF NOT EXISTS db_connections (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name TEXT NOT NULL UNIQUE,
+    dsn BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Role templates: SQL run on the connection to create and drop a user.
+CREATE TABLE IF NOT EXISTS db_roles (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name TEXT NOT NULL UNIQUE,
+    connection_id BIGINT NOT NULL REFERENCES db_connections(id),
+    creation_statements TEXT NOT NULL,
+    revocation_statements TEXT NOT NULL,
+    default_ttl_seconds BIGINT NOT NULL,
+    max_ttl_seconds BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- One lease per generated user. revoked_at is set once the user is dropped.
+CREATE TABLE IF NOT EXISTS db_leases (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    lease_id TEXT NOT NULL UNIQUE,
+    role_id BIGINT NOT NULL REFERENCES db_roles(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    username TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS db_leases_active_idx ON db_leases (expires_at) WHERE revoked_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS transit_key_versions;
+DROP TABLE IF EXISTS transit_keys;
+
+COMMIT;
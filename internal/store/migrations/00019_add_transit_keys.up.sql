@@ -0,0 +1,31 @@
+BEGIN TRANSACTION;
+
+-- Named encryption and signing keys of the transit engine, owned by a user or
+-- a team. Key material never leaves the server.
+CREATE TABLE IF NOT EXISTS transit_keys (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('aes256-gcm', 'ed25519')),
+    owner_user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    owner_team_id INTEGER REFERENCES teams(id) ON DELETE CASCADE,
+    latest_version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((owner_user_id IS NULL) <> (owner_team_id IS NULL)),
+    UNIQUE (owner_user_id, name),
+    UNIQUE (owner_team_id, name)
+);
+
+-- Every rotation adds a version; old versions stay to decrypt and verify
+-- older data. material and hmac_key are encrypted with the data key.
+CREATE TABLE IF NOT EXISTS transit_key_versions (
+    key_id BIGINT NOT NULL REFERENCES transit_keys(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    material BYTEA NOT NULL,
+    hmac_key BYTEA NOT NULL,
+    public_key BYTEA,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (key_id, version)
+);
+
+COMMIT;
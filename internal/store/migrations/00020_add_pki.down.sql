@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS pki_certificates;
+DROP TABLE IF EXISTS pki_roles;
+DROP TABLE IF EXISTS pki_ca;
+
+COMMIT;
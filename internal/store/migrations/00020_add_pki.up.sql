@@ -0,0 +1,46 @@
+BEGIN TRANSACTION;
+
+-- The CA of the PKI engine: a generated root or an imported intermediate.
+-- There is at most one row. private_key is PKCS#8 encrypted with the data
+-- key; chain holds the issuers above an intermediate, PEM encoded.
+CREATE TABLE IF NOT EXISTS pki_ca (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    certificate TEXT NOT NULL,
+    private_key BYTEA NOT NULL,
+    chain TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Constraints on certificates issued through a role.
+CREATE TABLE IF NOT EXISTS pki_roles (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    allowed_domains TEXT[] NOT NULL DEFAULT '{}',
+    allow_bare_domains BOOLEAN NOT NULL DEFAULT FALSE,
+    allow_subdomains BOOLEAN NOT NULL DEFAULT FALSE,
+    allow_ip_sans BOOLEAN NOT NULL DEFAULT FALSE,
+    key_types TEXT[] NOT NULL,
+    server_auth BOOLEAN NOT NULL DEFAULT TRUE,
+    client_auth BOOLEAN NOT NULL DEFAULT FALSE,
+    default_ttl_seconds BIGINT NOT NULL,
+    max_ttl_seconds BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Every issued certificate, kept for revocation and the CRL. The private
+-- key is returned to the requester only and never stored.
+CREATE TABLE IF NOT EXISTS pki_certificates (
+    serial VARCHAR(64) PRIMARY KEY,
+    role VARCHAR(100) NOT NULL,
+    common_name TEXT NOT NULL,
+    issued_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    certificate TEXT NOT NULL,
+    not_after TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS pki_certificates_revoked_idx ON pki_certificates (revoked_at) WHERE revoked_at IS NOT NULL;
+
+COMMIT;
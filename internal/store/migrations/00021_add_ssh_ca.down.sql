@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS ssh_roles;
+DROP TABLE IF EXISTS ssh_ca;
+
+COMMIT;
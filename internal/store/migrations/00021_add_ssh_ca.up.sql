@@ -0,0 +1,25 @@
+BEGIN TRANSACTION;
+
+-- The SSH user CA. There is at most one row. private_key is PKCS#8 encrypted
+-- with the data key; public_key is in authorized_keys format.
+CREATE TABLE IF NOT EXISTS ssh_ca (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    public_key TEXT NOT NULL,
+    private_key BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Principals, extensions and validity of certificates signed through a role.
+CREATE TABLE IF NOT EXISTS ssh_roles (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    allowed_principals TEXT[] NOT NULL,
+    default_principals TEXT[] NOT NULL DEFAULT '{}',
+    extensions TEXT[] NOT NULL DEFAULT '{}',
+    default_ttl_seconds BIGINT NOT NULL,
+    max_ttl_seconds BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
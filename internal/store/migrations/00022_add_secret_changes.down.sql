@@ -0,0 +1,10 @@
+BEGIN TRANSACTION;
+
+DROP TRIGGER IF EXISTS secret_versions_changed ON secret_versions;
+DROP FUNCTION IF EXISTS secret_versions_touch();
+DROP TRIGGER IF EXISTS secrets_metadata_changed ON secrets_metadata;
+DROP FUNCTION IF EXISTS secrets_metadata_touch();
+DROP INDEX IF EXISTS secrets_metadata_changes_idx;
+ALTER TABLE secrets_metadata DROP COLUMN IF EXISTS change_xid;
+
+COMMIT;
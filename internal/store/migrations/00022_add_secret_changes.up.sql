@@ -0,0 +1,37 @@
+BEGIN TRANSACTION;
+
+-- change_xid is the transaction that last changed a secret or any of its
+-- versions. GetChangesSince hands out windows of transaction ids that are
+-- below the oldest running transaction, so a change committed late by a long
+-- transaction still lands in a later window instead of being skipped. Rows
+-- that predate the column are in the first window of every replica.
+ALTER TABLE secrets_metadata
+    ADD COLUMN IF NOT EXISTS change_xid xid8 NOT NULL DEFAULT '0';
+
+CREATE INDEX IF NOT EXISTS secrets_metadata_changes_idx
+    ON secrets_metadata (user_id, change_xid) WHERE team_id IS NULL;
+
+CREATE OR REPLACE FUNCTION secrets_metadata_touch() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.change_xid := pg_current_xact_id();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER secrets_metadata_changed
+    BEFORE INSERT OR UPDATE ON secrets_metadata
+    FOR EACH ROW EXECUTE FUNCTION secrets_metadata_touch();
+
+CREATE OR REPLACE FUNCTION secret_versions_touch() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE secrets_metadata SET change_xid = pg_current_xact_id()
+    WHERE id = NEW.metadata_id AND change_xid <> pg_current_xact_id();
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER secret_versions_changed
+    AFTER INSERT OR UPDATE ON secret_versions
+    FOR EACH ROW EXECUTE FUNCTION secret_versions_touch();
+
+COMMIT;
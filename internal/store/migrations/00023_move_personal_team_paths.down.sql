@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+-- The moved secrets stay under legacy/team/: moving them back would hide
+-- them behind the team namespace again.
+
+COMMIT;
@@ -0,0 +1,34 @@
+BEGIN TRANSACTION;
+
+-- Personal secrets stored under team/ before teams existed would now resolve
+-- to a team, so they move to legacy/team/. A path already taken there, or
+-- one that would grow too long, is cut to fit and gets the metadata id
+-- appended.
+UPDATE secrets_metadata m
+SET title = 'legacy/' || m.title
+WHERE m.user_id IS NOT NULL
+  AND m.title LIKE 'team/%'
+  AND length(m.title) <= 248
+  AND NOT EXISTS (
+      SELECT 1 FROM secrets_metadata o
+      WHERE o.user_id = m.user_id AND o.title = 'legacy/' || m.title
+  );
+
+UPDATE secrets_metadata
+SET title = left('legacy/' || title, 254 - length(id::text)) || '~' || id
+WHERE user_id IS NOT NULL AND title LIKE 'team/%';
+
+UPDATE secret_grants
+SET path_prefix = 'legacy/' || path_prefix
+WHERE path_prefix LIKE 'team/%'
+  AND length(path_prefix) <= 248
+  AND NOT EXISTS (
+      SELECT 1 FROM secret_grants o
+      WHERE o.owner_id = secret_grants.owner_id
+        AND o.grantee_id = secret_grants.grantee_id
+        AND o.path_prefix = 'legacy/' || secret_grants.path_prefix
+  );
+
+DELETE FROM secret_grants WHERE path_prefix LIKE 'team/%';
+
+COMMIT;
@@ -3,16 +3,41 @@ package util
 import (
 	"context"
 	"errors"
+	"keeper/internal/entity"
 )
 
 type contextKey string
 
-const userIDKey contextKey = "userID"
+const (
+	userIDKey contextKey = "userID"
+	tokenKey  contextKey = "token"
+	apiKeyKey contextKey = "apiKey"
+	callerKey contextKey = "caller"
+)
 
 func SetUserID(ctx context.Context, userID int64) context.Context {
+	if caller, ok := ctx.Value(callerKey).(*int64); ok {
+		*caller = userID
+	}
 	return context.WithValue(ctx, userIDKey, userID)
 }
 
+// TrackCaller returns a context in which SetUserID also reports the user to
+// TrackedCaller, so code that runs before authentication, like the audit,
+// learns who the caller turned out to be.
+func TrackCaller(ctx context.Context) context.Context {
+	return context.WithValue(ctx, callerKey, new(int64))
+}
+
+// TrackedCaller returns the user authenticated under a TrackCaller context,
+// or 0 if there is none.
+func TrackedCaller(ctx context.Context) int64 {
+	if caller, ok := ctx.Value(callerKey).(*int64); ok {
+		return *caller
+	}
+	return 0
+}
+
 func GetUserID(ctx context.Context) (int64, error) {
 	userID, ok := ctx.Value(userIDKey).(int64)
 	if !ok {
@@ -20,3 +45,23 @@ func GetUserID(ctx context.Context) (int64, error) {
 	}
 	return userID, nil
 }
+
+func SetToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey, token)
+}
+
+func GetToken(ctx context.Context) string {
+	token, _ := ctx.Value(tokenKey).(string)
+	return token
+}
+
+// SetAPIKey marks the request as authenticated with a service account key
+// rather than a user session.
+func SetAPIKey(ctx context.Context, key entity.APIKey) context.Context {
+	return context.WithValue(ctx, apiKeyKey, key)
+}
+
+func GetAPIKey(ctx context.Context) (entity.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyKey).(entity.APIKey)
+	return key, ok
+}
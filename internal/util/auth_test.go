@@ -26,3 +26,10 @@ func TestGetUserID_NoUserID(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "unauthorized", err.Error())
 }
+
+func TestSetGetToken(t *testing.T) {
+	ctx := SetToken(t.Context(), "jwt")
+
+	assert.Equal(t, "jwt", GetToken(ctx))
+	assert.Empty(t, GetToken(t.Context()))
+}